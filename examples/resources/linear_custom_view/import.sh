@@ -0,0 +1 @@
+terraform import linear_custom_view.example 2d9a4e1c-7b3f-4c8a-9e5d-1f6b2a3c4d5e
@@ -0,0 +1,10 @@
+resource "linear_custom_view" "example" {
+  name    = "Blocked in current cycle"
+  team_id = linear_team.example.id
+  shared  = true
+
+  filter = jsonencode({
+    hasBlockedByRelations = { eq = true }
+    cycle                 = { isActive = { eq = true } }
+  })
+}
@@ -0,0 +1 @@
+terraform import linear_cycle.example SOME:12
@@ -0,0 +1,6 @@
+resource "linear_cycle" "example" {
+  name      = "Hardening"
+  starts_at = "2024-03-04T00:00:00Z"
+  ends_at   = "2024-03-11T00:00:00Z"
+  team_id   = linear_team.example.id
+}
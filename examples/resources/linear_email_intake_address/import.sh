@@ -0,0 +1 @@
+terraform import linear_email_intake_address.example 8c1f2b3a-4d5e-4f60-9a7b-0c1d2e3f4a5b
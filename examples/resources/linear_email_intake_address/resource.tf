@@ -0,0 +1,10 @@
+resource "linear_email_intake_address" "example" {
+  team_id         = linear_team.example.id
+  replies_enabled = true
+  sender_name     = "Support"
+  rotate_trigger  = "2024-01"
+}
+
+output "support_email" {
+  value = linear_email_intake_address.example.email
+}
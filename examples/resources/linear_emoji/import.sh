@@ -0,0 +1 @@
+terraform import linear_emoji.example 5f2c8e1a-9b3d-4a6f-8e7c-1d2b3a4c5e6f
@@ -0,0 +1,9 @@
+resource "linear_emoji" "mascot" {
+  name = "mascot"
+  file = "${path.module}/emojis/mascot.png"
+}
+
+resource "linear_emoji" "status" {
+  name = "status-green"
+  url  = "https://example.com/emojis/status-green.png"
+}
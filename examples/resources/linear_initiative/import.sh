@@ -0,0 +1 @@
+terraform import linear_initiative.example 5a6b7c8d-9e0f-4a1b-8c2d-3e4f5a6b7c8d
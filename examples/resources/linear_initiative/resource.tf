@@ -0,0 +1,6 @@
+resource "linear_initiative" "example" {
+  name        = "Global Expansion"
+  description = "Enter three new markets"
+  status      = "Active"
+  target_date = "2024-12-31"
+}
@@ -0,0 +1,4 @@
+resource "linear_initiative_project" "example" {
+  initiative_id = linear_initiative.example.id
+  project_id    = linear_project.example.id
+}
@@ -0,0 +1,4 @@
+resource "linear_initiative_relation" "example" {
+  parent_initiative_id = linear_initiative.company.id
+  child_initiative_id  = linear_initiative.example.id
+}
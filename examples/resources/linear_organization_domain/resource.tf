@@ -0,0 +1,15 @@
+# Claiming needs the DNS TXT record to be published, so the domain is created
+# with `claim = false` first, and claimed by setting `claim = true` in a second
+# apply once the record below exists.
+resource "linear_organization_domain" "example" {
+  name  = "example.com"
+  claim = false
+}
+
+resource "aws_route53_record" "linear" {
+  zone_id = aws_route53_zone.example.zone_id
+  name    = "example.com"
+  type    = "TXT"
+  ttl     = 300
+  records = [linear_organization_domain.example.verification_record]
+}
@@ -0,0 +1 @@
+terraform import linear_organization_invite.example 5c3e1a2b-7d4f-4e8a-9b6c-1d2e3f4a5b6c
@@ -0,0 +1,9 @@
+resource "linear_organization_invite" "example" {
+  email    = "new-hire@example.com"
+  role     = "member"
+  team_ids = [linear_team.example.id]
+
+  metadata = {
+    source = "hr"
+  }
+}
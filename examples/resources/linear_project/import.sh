@@ -0,0 +1,2 @@
+terraform import linear_project.example 5a6b7c8d-9e0f-4a1b-8c2d-3e4f5a6b7c8d
+terraform import linear_project.example 9a8b7c6d5e4f
@@ -0,0 +1,7 @@
+resource "linear_project" "example" {
+  name        = "Launch"
+  description = "Everything needed for the launch"
+  team_ids    = [linear_team.example.id]
+  priority    = 2
+  target_date = "2024-03-31"
+}
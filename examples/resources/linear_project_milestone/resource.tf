@@ -0,0 +1,5 @@
+resource "linear_project_milestone" "example" {
+  name        = "Beta"
+  target_date = "2024-02-15"
+  project_id  = linear_project.example.id
+}
@@ -0,0 +1 @@
+terraform import linear_project_status.example "On Hold"
@@ -0,0 +1,7 @@
+resource "linear_project_status" "example" {
+  name       = "On Hold"
+  type       = "paused"
+  color      = "#f2994a"
+  position   = 5
+  indefinite = true
+}
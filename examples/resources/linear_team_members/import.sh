@@ -0,0 +1 @@
+terraform import linear_team_members.example SOME
@@ -0,0 +1,7 @@
+resource "linear_team_members" "example" {
+  team_id = linear_team.example.id
+  user_ids = [
+    "6f5ae5a5-1b4c-4f2a-9c77-2c0b8a3d9e66",
+    "3b7c9e2d-4a1f-4e6b-8d5c-9f0a1b2c3d77",
+  ]
+}
@@ -0,0 +1 @@
+terraform import linear_team_membership.example SOME:someone@example.com
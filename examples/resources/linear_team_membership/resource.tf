@@ -0,0 +1,5 @@
+resource "linear_team_membership" "example" {
+  team_id = linear_team.example.id
+  user_id = "6f5ae5a5-1b4c-4f2a-9c77-2c0b8a3d9e66"
+  owner   = true
+}
@@ -0,0 +1 @@
+terraform import linear_time_schedule.example 8b1e6f2a-3c4d-4e5f-9a6b-7c8d9e0f1a2b
@@ -0,0 +1,15 @@
+resource "linear_time_schedule" "example" {
+  name = "Triage rotation"
+  entries = [
+    {
+      user_id   = "6f5ae5a5-1b4c-4f2a-9c77-2c0b8a3d9e66"
+      starts_at = "2024-03-04T09:00:00+01:00"
+      ends_at   = "2024-03-11T09:00:00+01:00"
+    },
+    {
+      user_id   = "3b7c9e2d-4a1f-4e6b-8d5c-9f0a1b2c3d77"
+      starts_at = "2024-03-11T09:00:00+01:00"
+      ends_at   = "2024-03-18T09:00:00+01:00"
+    },
+  ]
+}
@@ -0,0 +1 @@
+terraform import linear_triage_responsibility.example SOME
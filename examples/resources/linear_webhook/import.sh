@@ -0,0 +1 @@
+terraform import linear_webhook.example 1cf5a9a5-34b9-4e45-8b93-5c1b3d0c2a3e
@@ -0,0 +1,7 @@
+resource "linear_webhook" "example" {
+  url            = "https://example.com/linear"
+  label          = "Issue events"
+  resource_types = ["Issue", "Comment"]
+  team_id        = linear_team.example.id
+  secret         = var.webhook_secret
+}
@@ -0,0 +1,416 @@
+package linearmock
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+type executor struct {
+	server *Server
+	vars   map[string]interface{}
+}
+
+// mutationVerbs are the suffixes of the mutations handled generically, longest
+// first so that `Unarchive` is not mistaken for `Archive`.
+var mutationVerbs = []string{"Unarchive", "Archive", "Create", "Update", "Delete"}
+
+// defaultPageSize is the number of entities in a connection when `first` is
+// not given, as with the Linear API.
+const defaultPageSize = 50
+
+func (e *executor) selectionSet(obj Object, def *ast.Definition, set ast.SelectionSet) (map[string]interface{}, error) {
+	result := map[string]interface{}{}
+
+	for _, selection := range set {
+		switch sel := selection.(type) {
+		case *ast.Field:
+			if sel.Name == "__typename" {
+				result[sel.Alias] = def.Name
+				continue
+			}
+
+			value, err := e.resolve(obj, def, sel)
+
+			if err != nil {
+				return nil, err
+			}
+
+			completed, err := e.complete(value, sel.Definition.Type, sel.SelectionSet)
+
+			if err != nil {
+				return nil, err
+			}
+
+			result[sel.Alias] = completed
+		case *ast.FragmentSpread:
+			if err := e.merge(result, obj, def, sel.Definition.TypeCondition, sel.Definition.SelectionSet); err != nil {
+				return nil, err
+			}
+		case *ast.InlineFragment:
+			if err := e.merge(result, obj, def, sel.TypeCondition, sel.SelectionSet); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func (e *executor) merge(result map[string]interface{}, obj Object, def *ast.Definition, typeCondition string, set ast.SelectionSet) error {
+	if typeCondition != "" && typeCondition != def.Name {
+		applies := false
+
+		for _, possible := range e.server.schema.PossibleTypes[typeCondition] {
+			if possible.Name == def.Name {
+				applies = true
+			}
+		}
+
+		if !applies {
+			return nil
+		}
+	}
+
+	fragment, err := e.selectionSet(obj, def, set)
+
+	if err != nil {
+		return err
+	}
+
+	for key, value := range fragment {
+		if existing, ok := result[key].(map[string]interface{}); ok {
+			if nested, ok := value.(map[string]interface{}); ok {
+				for k, v := range nested {
+					existing[k] = v
+				}
+
+				continue
+			}
+		}
+
+		result[key] = value
+	}
+
+	return nil
+}
+
+func (e *executor) complete(value interface{}, typ *ast.Type, set ast.SelectionSet) (interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	if typ.Elem != nil {
+		var items []interface{}
+
+		switch list := value.(type) {
+		case []interface{}:
+			items = list
+		case []Object:
+			for _, item := range list {
+				items = append(items, item)
+			}
+		default:
+			return nil, fmt.Errorf("expected a list for %s, got %T", typ.String(), value)
+		}
+
+		result := make([]interface{}, 0, len(items))
+
+		for _, item := range items {
+			completed, err := e.complete(item, typ.Elem, set)
+
+			if err != nil {
+				return nil, err
+			}
+
+			result = append(result, completed)
+		}
+
+		return result, nil
+	}
+
+	def := e.server.schema.Types[typ.Name()]
+
+	switch def.Kind {
+	case ast.Scalar, ast.Enum:
+		return value, nil
+	case ast.Interface, ast.Union:
+		obj, ok := value.(Object)
+
+		if !ok {
+			return nil, fmt.Errorf("expected an object for %s, got %T", def.Name, value)
+		}
+
+		typeName, _ := obj["__typename"].(string)
+		def = e.server.schema.Types[typeName]
+
+		if def == nil {
+			return nil, fmt.Errorf("unknown concrete type %q for %s", typeName, typ.Name())
+		}
+
+		return e.selectionSet(obj, def, set)
+	}
+
+	var obj Object
+
+	switch v := value.(type) {
+	case Object:
+		obj = v
+	case map[string]interface{}:
+		obj = v
+	case string:
+		ref, err := e.server.find(def.Name, v)
+
+		if err != nil {
+			// Dangling references resolve to null, like entities deleted in Linear
+			return nil, nil
+		}
+
+		obj = ref
+	default:
+		return nil, fmt.Errorf("expected an object for %s, got %T", def.Name, value)
+	}
+
+	return e.selectionSet(obj, def, set)
+}
+
+func (e *executor) resolve(obj Object, def *ast.Definition, field *ast.Field) (interface{}, error) {
+	args := field.ArgumentMap(e.vars)
+
+	switch def {
+	case e.server.schema.Query:
+		return e.query(field, args)
+	case e.server.schema.Mutation:
+		return e.mutation(field, args)
+	}
+
+	if resolver, ok := fieldResolvers[def.Name+"."+field.Name]; ok {
+		return resolver(e.server, obj, args)
+	}
+
+	value, stored := obj[field.Name]
+	fieldDef := e.server.schema.Types[field.Definition.Type.Name()]
+
+	if !isConnection(fieldDef) {
+		return value, nil
+	}
+
+	nodeType := nodeTypeName(e.server.schema, field.Definition.Type)
+	nodeDef := e.server.schema.Types[nodeType]
+
+	var nodes []Object
+
+	if stored {
+		for _, id := range asList(value) {
+			if node, err := e.server.find(nodeType, fmt.Sprint(id)); err == nil {
+				nodes = append(nodes, node)
+			}
+		}
+	} else if back := backReference(nodeDef, def.Name); back != "" {
+		for _, node := range e.server.all(nodeType) {
+			if node[back] == obj["id"] {
+				nodes = append(nodes, node)
+			}
+		}
+	}
+
+	return e.server.connection(nodeDef, nodes, args), nil
+}
+
+func (e *executor) query(field *ast.Field, args map[string]interface{}) (interface{}, error) {
+	if resolver, ok := queryResolvers[field.Name]; ok {
+		return resolver(e.server, args)
+	}
+
+	def := e.server.schema.Types[field.Definition.Type.Name()]
+
+	if isConnection(def) {
+		nodeDef := e.server.schema.Types[nodeTypeName(e.server.schema, field.Definition.Type)]
+
+		return e.server.connection(nodeDef, e.server.all(nodeDef.Name), args), nil
+	}
+
+	if def.Kind != ast.Object {
+		return nil, fmt.Errorf("query %s is not supported by the mock server", field.Name)
+	}
+
+	if id, ok := args["id"].(string); ok {
+		return e.server.find(def.Name, id)
+	}
+
+	return e.server.singleton(def.Name)
+}
+
+func (e *executor) mutation(field *ast.Field, args map[string]interface{}) (interface{}, error) {
+	s := e.server
+
+	if resolver, ok := mutationResolvers[field.Name]; ok {
+		return resolver(s, args)
+	}
+
+	verb := ""
+	typeName := ""
+
+	for _, candidate := range mutationVerbs {
+		if strings.HasSuffix(field.Name, candidate) {
+			verb = candidate
+			typeName = strings.ToUpper(field.Name[:1]) + strings.TrimSuffix(field.Name, candidate)[1:]
+			break
+		}
+	}
+
+	if verb == "" || s.schema.Types[typeName] == nil {
+		return nil, fmt.Errorf("mutation %s is not supported by the mock server", field.Name)
+	}
+
+	payloadDef := s.schema.Types[field.Definition.Type.Name()]
+	input, _ := args["input"].(map[string]interface{})
+
+	if verb == "Create" {
+		obj, err := s.create(typeName, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return s.payload(payloadDef, typeName, obj), nil
+	}
+
+	var obj Object
+	var err error
+
+	if id, ok := args["id"].(string); ok {
+		obj, err = s.find(typeName, id)
+	} else {
+		obj, err = s.singleton(typeName)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch verb {
+	case "Update":
+		if err := s.update(typeName, obj, input); err != nil {
+			return nil, err
+		}
+	case "Delete":
+		if hook, ok := deleteHooks[typeName]; ok {
+			if err := hook(s, obj); err != nil {
+				return nil, err
+			}
+		}
+
+		s.remove(typeName, obj["id"].(string))
+	case "Archive":
+		obj["archivedAt"] = now()
+	case "Unarchive":
+		delete(obj, "archivedAt")
+	}
+
+	return s.payload(payloadDef, typeName, obj), nil
+}
+
+// payload builds a mutation payload, referencing the entity from any field of
+// its type (`issueLabel`, `entity`).
+func (s *Server) payload(def *ast.Definition, typeName string, obj Object) Object {
+	s.lastSyncId++
+
+	result := Object{
+		"success":    true,
+		"lastSyncId": s.lastSyncId,
+		"entityId":   obj["id"],
+	}
+
+	for _, field := range def.Fields {
+		if field.Type.Name() == typeName {
+			result[field.Name] = obj["id"]
+		}
+	}
+
+	return result
+}
+
+// connection builds a connection of entities, leaving out archived ones unless
+// asked for and applying the filter argument. Pages are taken forward with the
+// `first` and `after` arguments, using the id of an entity as its cursor.
+func (s *Server) connection(def *ast.Definition, nodes []Object, args map[string]interface{}) Object {
+	includeArchived, _ := args["includeArchived"].(bool)
+	filter, _ := args["filter"].(map[string]interface{})
+	after, _ := args["after"].(string)
+
+	first := defaultPageSize
+
+	switch value := args["first"].(type) {
+	case int64:
+		first = int(value)
+	case float64:
+		first = int(value)
+	}
+
+	selected := []interface{}{}
+	edges := []interface{}{}
+	hasPreviousPage := after != ""
+	hasNextPage := false
+
+	for _, node := range nodes {
+		if node["archivedAt"] != nil && !includeArchived {
+			continue
+		}
+
+		if filter != nil && !s.matches(def, node, filter) {
+			continue
+		}
+
+		if after != "" {
+			if node["id"] == after {
+				after = ""
+			}
+
+			continue
+		}
+
+		if len(selected) == first {
+			hasNextPage = true
+			break
+		}
+
+		selected = append(selected, node)
+		edges = append(edges, Object{"node": node, "cursor": node["id"]})
+	}
+
+	pageInfo := Object{
+		"hasNextPage":     hasNextPage,
+		"hasPreviousPage": hasPreviousPage,
+	}
+
+	if len(selected) > 0 {
+		pageInfo["startCursor"] = selected[0].(Object)["id"]
+		pageInfo["endCursor"] = selected[len(selected)-1].(Object)["id"]
+	}
+
+	return Object{
+		"nodes":    selected,
+		"edges":    edges,
+		"pageInfo": pageInfo,
+	}
+}
+
+// backReference returns the field of the node type that references the parent
+// type, preferring the one named after it (`team` for `Team`).
+func backReference(nodeDef *ast.Definition, parentType string) string {
+	name := strings.ToLower(parentType[:1]) + parentType[1:]
+
+	if field := nodeDef.Fields.ForName(name); field != nil && field.Type.Name() == parentType && field.Type.Elem == nil {
+		return name
+	}
+
+	for _, field := range nodeDef.Fields {
+		if field.Type.Name() == parentType && field.Type.Elem == nil {
+			return field.Name
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,454 @@
+package linearmock
+
+import (
+	"fmt"
+	"math/rand"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Behaviour of the Linear API that can not be derived from the schema alone.
+
+type hook func(s *Server, obj Object, input map[string]interface{}) error
+
+var (
+	// lookupFields are the fields the API accepts in place of an identifier.
+	lookupFields = map[string][]string{
+		"Team":    {"key"},
+		"Project": {"slugId"},
+		"Emoji":   {"name"},
+	}
+
+	// createHooks run on a new entity before it is stored.
+	createHooks = map[string]hook{
+		"Team":                 validateTeam,
+		"IssueLabel":           validateIssueLabel,
+		"Project":              createProject,
+		"Initiative":           createInitiative,
+		"Cycle":                validateCycle,
+		"TeamMembership":       validateTeamMembership,
+		"TriageResponsibility": validateTriageResponsibility,
+		"EmailIntakeAddress":   createEmailIntakeAddress,
+		"Emoji":                createEmoji,
+	}
+
+	// afterCreateHooks run once a new entity is stored.
+	afterCreateHooks = map[string]func(s *Server, obj Object){
+		"Team": createDefaultWorkflowStates,
+	}
+
+	// updateHooks run on the updated copy of an entity before it is stored.
+	updateHooks = map[string]hook{
+		"Team":               validateTeam,
+		"IssueLabel":         validateIssueLabel,
+		"Cycle":              validateCycle,
+		"OrganizationDomain": validateOrganizationDomain,
+	}
+
+	// deleteHooks run before an entity is deleted.
+	deleteHooks = map[string]func(s *Server, obj Object) error{
+		"Team":                      deleteTeamEntities,
+		"GitAutomationTargetBranch": deleteTargetBranchStates,
+		"Project":                   deleteProjectEntities,
+		"Initiative":                deleteInitiativeEntities,
+	}
+
+	// fieldResolvers override how a field (`Type.field`) of an entity is resolved.
+	fieldResolvers = map[string]func(s *Server, obj Object, args map[string]interface{}) (interface{}, error){
+		"Team.triageResponsibility": teamTriageResponsibility,
+	}
+
+	// queryResolvers and mutationResolvers override generic root field handling.
+	queryResolvers = map[string]func(s *Server, args map[string]interface{}) (interface{}, error){
+		"organizationDomainClaimRequest": organizationDomainClaimRequest,
+	}
+	mutationResolvers = map[string]func(s *Server, args map[string]interface{}) (interface{}, error){
+		"organizationDomainClaim":  claimOrganizationDomain,
+		"organizationDomainVerify": verifyOrganizationDomain,
+		"emailIntakeAddressRotate": rotateEmailIntakeAddress,
+		"fileUpload":               fileUpload,
+	}
+)
+
+var colors = []string{"#bec2c8", "#95a2b3", "#5e6ad2", "#26b5ce", "#4cb782", "#f2c94c", "#f2994a", "#eb5757", "#f7c8c1"}
+
+func randomColor() string {
+	return colors[rand.Intn(len(colors))]
+}
+
+func validateTeam(s *Server, obj Object, input map[string]interface{}) error {
+	for _, team := range s.all("Team") {
+		if team["id"] != obj["id"] && team["key"] == obj["key"] {
+			return &InputError{Message: fmt.Sprintf("Team key %s is already in use", obj["key"])}
+		}
+	}
+
+	if obj["icon"] == nil {
+		obj["icon"] = "Bank"
+	}
+
+	if obj["color"] == nil {
+		obj["color"] = randomColor()
+	}
+
+	return nil
+}
+
+func validateIssueLabel(s *Server, obj Object, input map[string]interface{}) error {
+	for _, label := range s.all("IssueLabel") {
+		if label["id"] != obj["id"] && label["name"] == obj["name"] && label["team"] == obj["team"] {
+			return &InputError{Message: "Duplicate label name"}
+		}
+	}
+
+	if obj["color"] == nil {
+		obj["color"] = randomColor()
+	}
+
+	return nil
+}
+
+func validateTeamMembership(s *Server, obj Object, input map[string]interface{}) error {
+	for _, membership := range s.all("TeamMembership") {
+		if membership["user"] == obj["user"] && membership["team"] == obj["team"] {
+			return &InputError{Message: "User is already a member of the team"}
+		}
+	}
+
+	return nil
+}
+
+func validateTriageResponsibility(s *Server, obj Object, input map[string]interface{}) error {
+	if existing, _ := teamTriageResponsibility(s, Object{"id": obj["team"]}, nil); existing != nil {
+		return &InputError{Message: "Team already has a triage responsibility"}
+	}
+
+	return nil
+}
+
+// teamTriageResponsibility resolves the single triage responsibility of a
+// team, which references the team rather than the other way around.
+func teamTriageResponsibility(s *Server, team Object, args map[string]interface{}) (interface{}, error) {
+	for _, obj := range s.all("TriageResponsibility") {
+		if obj["team"] == team["id"] {
+			return obj, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// validateCycle numbers a new cycle after the last one of its team, and
+// rejects cycles that overlap with another cycle of the team.
+func validateCycle(s *Server, obj Object, input map[string]interface{}) error {
+	startsAt, startsErr := time.Parse(time.RFC3339, fmt.Sprint(obj["startsAt"]))
+	endsAt, endsErr := time.Parse(time.RFC3339, fmt.Sprint(obj["endsAt"]))
+
+	if startsErr != nil || endsErr != nil || !endsAt.After(startsAt) {
+		return &InputError{Message: "Cycle must end after it starts"}
+	}
+
+	number := float64(0)
+
+	for _, cycle := range s.all("Cycle") {
+		if cycle["team"] != obj["team"] || cycle["id"] == obj["id"] {
+			continue
+		}
+
+		if cycle["number"].(float64) > number {
+			number = cycle["number"].(float64)
+		}
+
+		if cycle["archivedAt"] != nil {
+			continue
+		}
+
+		otherStartsAt, _ := time.Parse(time.RFC3339, fmt.Sprint(cycle["startsAt"]))
+		otherEndsAt, _ := time.Parse(time.RFC3339, fmt.Sprint(cycle["endsAt"]))
+
+		if startsAt.Before(otherEndsAt) && otherStartsAt.Before(endsAt) {
+			return &InputError{Message: "Cycle overlaps with an existing cycle"}
+		}
+	}
+
+	if obj["number"] == nil {
+		obj["number"] = number + 1
+	}
+
+	return nil
+}
+
+var slugRegex = regexp.MustCompile("[^a-z0-9]+")
+
+// createProject fills in what Linear derives for a new project: the default
+// status of the workspace, the slug and the URL.
+func createProject(s *Server, obj Object, input map[string]interface{}) error {
+	if obj["status"] == nil {
+		for _, status := range s.all("ProjectStatus") {
+			if status["type"] == "backlog" {
+				obj["status"] = status["id"]
+				break
+			}
+		}
+
+		if obj["status"] == nil {
+			return &InputError{Message: "No default project status"}
+		}
+	}
+
+	if obj["color"] == nil {
+		obj["color"] = randomColor()
+	}
+
+	if obj["labelIds"] == nil {
+		obj["labelIds"] = []interface{}{}
+	}
+
+	if obj["description"] == nil {
+		obj["description"] = ""
+	}
+
+	// The URL leads with the name for readability, the slug alone identifies the project
+	slug := strings.Trim(slugRegex.ReplaceAllString(strings.ToLower(fmt.Sprint(obj["name"])), "-"), "-")
+
+	obj["slugId"] = fmt.Sprintf("%012x", rand.Int63n(1<<48))
+	obj["url"] = fmt.Sprintf("https://linear.app/%s/project/%s-%s", organizationUrlKey(s), slug, obj["slugId"])
+
+	return nil
+}
+
+// createInitiative fills in the slug and the URL of a new initiative.
+func createInitiative(s *Server, obj Object, input map[string]interface{}) error {
+	slug := strings.Trim(slugRegex.ReplaceAllString(strings.ToLower(fmt.Sprint(obj["name"])), "-"), "-")
+
+	obj["slugId"] = fmt.Sprintf("%012x", rand.Int63n(1<<48))
+	obj["url"] = fmt.Sprintf("https://linear.app/%s/initiative/%s-%s", organizationUrlKey(s), slug, obj["slugId"])
+
+	return nil
+}
+
+// deleteProjectEntities removes a project from the initiatives it is part of.
+func deleteProjectEntities(s *Server, project Object) error {
+	for _, obj := range s.all("InitiativeToProject") {
+		if obj["project"] == project["id"] {
+			s.remove("InitiativeToProject", obj["id"].(string))
+		}
+	}
+
+	return nil
+}
+
+// deleteInitiativeEntities removes the projects and the nesting of an
+// initiative along with it.
+func deleteInitiativeEntities(s *Server, initiative Object) error {
+	for _, obj := range s.all("InitiativeToProject") {
+		if obj["initiative"] == initiative["id"] {
+			s.remove("InitiativeToProject", obj["id"].(string))
+		}
+	}
+
+	for _, obj := range s.all("InitiativeRelation") {
+		if obj["initiative"] == initiative["id"] || obj["relatedInitiative"] == initiative["id"] {
+			s.remove("InitiativeRelation", obj["id"].(string))
+		}
+	}
+
+	return nil
+}
+
+func validateOrganizationDomain(s *Server, obj Object, input map[string]interface{}) error {
+	if obj["disableOrganizationCreation"] == true && obj["claimed"] != true {
+		return &InputError{Message: "Organization creation can only be disabled on claimed domains"}
+	}
+
+	return nil
+}
+
+// organizationDomainClaimRequest returns the DNS TXT record that proves the
+// ownership of a domain.
+func organizationDomainClaimRequest(s *Server, args map[string]interface{}) (interface{}, error) {
+	domain, err := s.find("OrganizationDomain", fmt.Sprint(args["id"]))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return Object{"verificationString": "linear-domain-verification=" + strings.ReplaceAll(fmt.Sprint(domain["id"]), "-", "")}, nil
+}
+
+// claimOrganizationDomain claims a domain as if its DNS TXT record was found.
+func claimOrganizationDomain(s *Server, args map[string]interface{}) (interface{}, error) {
+	domain, err := s.find("OrganizationDomain", fmt.Sprint(args["id"]))
+
+	if err != nil {
+		return nil, err
+	}
+
+	domain["verified"] = true
+	domain["claimed"] = true
+
+	return Object{"success": true}, nil
+}
+
+// verifyOrganizationDomain verifies a domain with the code sent to its
+// verification email, which is any code but `000000` in the mock.
+func verifyOrganizationDomain(s *Server, args map[string]interface{}) (interface{}, error) {
+	input, _ := args["input"].(map[string]interface{})
+	domain, err := s.find("OrganizationDomain", fmt.Sprint(input["organizationDomainId"]))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if input["verificationCode"] == "000000" {
+		return nil, &InputError{Message: "Invalid verification code"}
+	}
+
+	domain["verified"] = true
+
+	return s.payload(s.schema.Types["OrganizationDomainPayload"], "OrganizationDomain", domain), nil
+}
+
+// createEmailIntakeAddress gives a new email intake address of a team or
+// template its generated address, enabled by default.
+func createEmailIntakeAddress(s *Server, obj Object, input map[string]interface{}) error {
+	if (obj["team"] == nil) == (obj["template"] == nil) {
+		return &InputError{Message: "Email intake address needs either a team or a template"}
+	}
+
+	if obj["type"] == nil {
+		if obj["team"] != nil {
+			obj["type"] = "team"
+		} else {
+			obj["type"] = "template"
+		}
+	}
+
+	obj["address"] = randomEmailIntakeAddress()
+	obj["enabled"] = true
+
+	return nil
+}
+
+// rotateEmailIntakeAddress replaces the generated address of an email intake
+// address.
+func rotateEmailIntakeAddress(s *Server, args map[string]interface{}) (interface{}, error) {
+	address, err := s.find("EmailIntakeAddress", fmt.Sprint(args["id"]))
+
+	if err != nil {
+		return nil, err
+	}
+
+	address["address"] = randomEmailIntakeAddress()
+	address["updatedAt"] = now()
+
+	return s.payload(s.schema.Types["EmailIntakeAddressPayload"], "EmailIntakeAddress", address), nil
+}
+
+func randomEmailIntakeAddress() string {
+	return fmt.Sprintf("%016x", rand.Int63())
+}
+
+// uploadPath is where the files handed out by `fileUpload` are uploaded.
+const uploadPath = "/uploads/"
+
+// fileUpload hands out a URL to upload a file to, which is also the URL of the
+// uploaded file.
+func fileUpload(s *Server, args map[string]interface{}) (interface{}, error) {
+	url := s.url + uploadPath + newId() + "/" + fmt.Sprint(args["filename"])
+
+	// Known upload URLs are empty until the file is uploaded
+	s.uploads[url] = nil
+	s.lastSyncId++
+
+	return Object{
+		"success":    true,
+		"lastSyncId": s.lastSyncId,
+		"uploadFile": Object{
+			"filename":    args["filename"],
+			"contentType": args["contentType"],
+			"size":        args["size"],
+			"uploadUrl":   url,
+			"assetUrl":    url,
+			"headers": []Object{
+				{"key": "x-goog-content-length-range", "value": fmt.Sprintf("0,%v", args["size"])},
+			},
+		},
+	}, nil
+}
+
+// createEmoji checks that the name of a new emoji is unique, and that its image
+// was uploaded when it is one of the mock uploads.
+func createEmoji(s *Server, obj Object, input map[string]interface{}) error {
+	for _, emoji := range s.all("Emoji") {
+		if emoji["name"] == obj["name"] {
+			return &InputError{Message: fmt.Sprintf("Emoji %s already exists", obj["name"])}
+		}
+	}
+
+	if content, ok := s.uploads[fmt.Sprint(obj["url"])]; ok && content == nil {
+		return &InputError{Message: "Emoji image was not uploaded"}
+	}
+
+	obj["source"] = "custom"
+
+	return nil
+}
+
+func organizationUrlKey(s *Server) string {
+	if organization, err := s.singleton("Organization"); err == nil {
+		return fmt.Sprint(organization["urlKey"])
+	}
+
+	return "workspace"
+}
+
+// createDefaultWorkflowStates creates the workflow states Linear gives every
+// new team.
+func createDefaultWorkflowStates(s *Server, team Object) {
+	defaults := []struct{ name, color, ty string }{
+		{"Backlog", "#bec2c8", "backlog"},
+		{"Todo", "#e2e2e2", "unstarted"},
+		{"In Progress", "#f2c94c", "started"},
+		{"Done", "#5e6ad2", "completed"},
+		{"Canceled", "#95a2b3", "canceled"},
+	}
+
+	for _, state := range defaults {
+		obj := Object{
+			"name":     state.name,
+			"color":    state.color,
+			"type":     state.ty,
+			"position": float64(0),
+			"team":     team["id"],
+		}
+
+		s.fillDefaults(s.schema.Types["WorkflowState"], obj)
+		s.insert("WorkflowState", obj)
+	}
+}
+
+func deleteTeamEntities(s *Server, team Object) error {
+	for _, typeName := range []string{"WorkflowState", "IssueLabel", "GitAutomationState", "GitAutomationTargetBranch", "Template", "TeamMembership", "TriageResponsibility"} {
+		for _, obj := range s.all(typeName) {
+			if obj["team"] == team["id"] {
+				s.remove(typeName, obj["id"].(string))
+			}
+		}
+	}
+
+	return nil
+}
+
+// deleteTargetBranchStates removes the git automation states of a target
+// branch along with it.
+func deleteTargetBranchStates(s *Server, branch Object) error {
+	for _, obj := range s.all("GitAutomationState") {
+		if obj["targetBranch"] == branch["id"] {
+			s.remove("GitAutomationState", obj["id"].(string))
+		}
+	}
+
+	return nil
+}
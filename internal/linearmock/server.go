@@ -0,0 +1,267 @@
+// Package linearmock provides an in-memory fake of the Linear GraphQL API that
+// is built from the public Linear schema. It is used to run the provider
+// acceptance tests without a Linear workspace.
+//
+// Queries are validated and executed against the schema. Entities are stored as
+// plain maps keyed by their schema field names, with references to other
+// entities stored as their identifier. The common Linear conventions (`team(id:)`
+// lookups, `xxxCreate`/`xxxUpdate`/`xxxDelete`/`xxxArchive` mutations,
+// `xxxs(filter:)` connections) are handled generically, while the behaviour
+// that is specific to some entities lives in linear.go.
+package linearmock
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/vektah/gqlparser/v2/validator"
+)
+
+// Object is an entity of the fake API, keyed by schema field names.
+type Object map[string]interface{}
+
+// Server is an in-memory Linear GraphQL API. It implements http.Handler so it
+// can be served with httptest.
+type Server struct {
+	schema *ast.Schema
+
+	mu         sync.Mutex
+	tables     map[string]*table
+	lastSyncId float64
+
+	// url is where the server is reached, which the upload URLs handed out by
+	// `fileUpload` point to. uploads holds the uploaded files by URL.
+	url     string
+	uploads map[string][]byte
+}
+
+type table struct {
+	ids  []string
+	rows map[string]Object
+}
+
+var (
+	schemaCache   = map[string]*ast.Schema{}
+	schemaCacheMu sync.Mutex
+)
+
+// LoadSchema parses the GraphQL schema at the given path. Parsed schemas are
+// cached, as the Linear schema is large and is shared by every test.
+func LoadSchema(path string) (*ast.Schema, error) {
+	schemaCacheMu.Lock()
+	defer schemaCacheMu.Unlock()
+
+	if schema, ok := schemaCache[path]; ok {
+		return schema, nil
+	}
+
+	source, err := os.ReadFile(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	schema, gqlErr := gqlparser.LoadSchema(&ast.Source{Name: path, Input: string(source)})
+
+	if gqlErr != nil {
+		return nil, gqlErr
+	}
+
+	schemaCache[path] = schema
+
+	return schema, nil
+}
+
+// NewServer returns an empty fake API for the given schema.
+func NewServer(schema *ast.Schema) *Server {
+	return &Server{
+		schema:  schema,
+		tables:  map[string]*table{},
+		url:     "http://linear.mock",
+		uploads: map[string][]byte{},
+	}
+}
+
+type request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+type response struct {
+	Data   interface{}   `json:"data"`
+	Errors gqlerror.List `json:"errors,omitempty"`
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.url = "http://" + r.Host
+	s.mu.Unlock()
+
+	if r.Method == http.MethodPut && strings.HasPrefix(r.URL.Path, uploadPath) {
+		s.upload(w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if r.Method != http.MethodPost {
+		writeErrors(w, http.StatusMethodNotAllowed, gqlerror.Errorf("Only POST requests are supported"))
+		return
+	}
+
+	if r.Header.Get("Authorization") == "" {
+		err := gqlerror.Errorf("Authentication required, not authenticated")
+		err.Extensions = map[string]interface{}{"code": "AUTHENTICATION_ERROR"}
+
+		writeErrors(w, http.StatusBadRequest, err)
+		return
+	}
+
+	var req request
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeErrors(w, http.StatusBadRequest, gqlerror.Errorf("Invalid request body: %s", err))
+		return
+	}
+
+	data, errs := s.Execute(req.Query, req.OperationName, req.Variables)
+
+	if len(errs) > 0 {
+		// Linear answers failed operations with a 400 status
+		json.NewEncoder(withStatus(w, http.StatusBadRequest)).Encode(response{Data: data, Errors: errs})
+		return
+	}
+
+	json.NewEncoder(w).Encode(response{Data: data})
+}
+
+// upload stores a file sent to an upload URL handed out by `fileUpload`, which
+// like the signed URLs of Linear does not take the API credentials.
+func (s *Server) upload(w http.ResponseWriter, r *http.Request) {
+	content, err := io.ReadAll(r.Body)
+
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	url := s.url + r.URL.Path
+
+	if _, ok := s.uploads[url]; !ok {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	s.uploads[url] = content
+}
+
+// Execute runs a GraphQL operation against the fake API.
+func (s *Server) Execute(query string, operationName string, variables map[string]interface{}) (interface{}, gqlerror.List) {
+	doc, errs := gqlparser.LoadQuery(s.schema, query)
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	op := doc.Operations.ForName(operationName)
+
+	if op == nil {
+		return nil, gqlerror.List{gqlerror.Errorf("Unknown operation %q", operationName)}
+	}
+
+	vars, gqlErr := validator.VariableValues(s.schema, op, variables)
+
+	if gqlErr != nil {
+		return nil, gqlerror.List{gqlErr}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e := &executor{server: s, vars: vars}
+
+	var root *ast.Definition
+
+	if op.Operation == ast.Mutation {
+		root = s.schema.Mutation
+	} else {
+		root = s.schema.Query
+	}
+
+	data, err := e.selectionSet(Object{}, root, op.SelectionSet)
+
+	if err != nil {
+		return nil, gqlerror.List{toGqlError(err)}
+	}
+
+	return data, nil
+}
+
+func withStatus(w http.ResponseWriter, status int) http.ResponseWriter {
+	w.WriteHeader(status)
+
+	return w
+}
+
+func writeErrors(w http.ResponseWriter, status int, errs ...*gqlerror.Error) {
+	json.NewEncoder(withStatus(w, status)).Encode(response{Errors: errs})
+}
+
+// NotFoundError is returned when an entity can not be found, with the same
+// message and extensions as the Linear API.
+type NotFoundError struct {
+	Type string
+}
+
+func (e *NotFoundError) Error() string {
+	return "Entity not found: " + e.Type
+}
+
+// InputError is returned for invalid mutation input.
+type InputError struct {
+	Message string
+}
+
+func (e *InputError) Error() string {
+	return e.Message
+}
+
+func toGqlError(err error) *gqlerror.Error {
+	gqlErr := gqlerror.Errorf("%s", err.Error())
+
+	switch e := err.(type) {
+	case *NotFoundError:
+		gqlErr.Extensions = map[string]interface{}{
+			"type":                   "invalid input",
+			"code":                   "INVALID_INPUT",
+			"userError":              true,
+			"userPresentableMessage": fmt.Sprintf("Could not find referenced %s.", e.Type),
+		}
+	case *InputError:
+		gqlErr.Extensions = map[string]interface{}{
+			"type":                   "invalid input",
+			"code":                   "INVALID_INPUT",
+			"userError":              true,
+			"userPresentableMessage": e.Message,
+		}
+	}
+
+	return gqlErr
+}
+
+func now() string {
+	return time.Now().UTC().Format("2006-01-02T15:04:05.000Z")
+}
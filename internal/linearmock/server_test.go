@@ -0,0 +1,233 @@
+package linearmock
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func testServer(t *testing.T) *Server {
+	schema, err := LoadSchema("../../schema.graphql")
+
+	if err != nil {
+		t.Fatalf("unable to load schema: %s", err)
+	}
+
+	return NewServer(schema)
+}
+
+func execute(t *testing.T, s *Server, query string, variables map[string]interface{}) map[string]interface{} {
+	data, errs := s.Execute(query, "", variables)
+
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors: %s", errs)
+	}
+
+	return data.(map[string]interface{})
+}
+
+func TestCreateAndQuery(t *testing.T) {
+	s := testServer(t)
+
+	data := execute(t, s, `mutation($input: TeamCreateInput!) {
+		teamCreate(input: $input) { success team { id key name } }
+	}`, map[string]interface{}{
+		"input": map[string]interface{}{"key": "ENG", "name": "Engineering"},
+	})
+
+	team := data["teamCreate"].(map[string]interface{})["team"].(map[string]interface{})
+
+	if team["key"] != "ENG" || team["name"] != "Engineering" {
+		t.Fatalf("unexpected team: %v", team)
+	}
+
+	data = execute(t, s, `query($id: String!) {
+		team(id: $id) { id states(filter: { type: { eq: "started" } }) { nodes { name } } }
+	}`, map[string]interface{}{"id": "ENG"})
+
+	queried := data["team"].(map[string]interface{})
+
+	if queried["id"] != team["id"] {
+		t.Fatalf("expected team %s, got %v", team["id"], queried["id"])
+	}
+
+	states := queried["states"].(map[string]interface{})["nodes"].([]interface{})
+
+	if len(states) != 1 || states[0].(map[string]interface{})["name"] != "In Progress" {
+		t.Fatalf("unexpected states: %v", states)
+	}
+}
+
+func TestUpdateAndDelete(t *testing.T) {
+	s := testServer(t)
+
+	label := s.Add("IssueLabel", Object{"name": "Bug", "color": "#eb5757"})
+
+	data := execute(t, s, `mutation($id: String!, $input: IssueLabelUpdateInput!) {
+		issueLabelUpdate(id: $id, input: $input) { issueLabel { name color } }
+	}`, map[string]interface{}{
+		"id":    label["id"],
+		"input": map[string]interface{}{"name": "Defect"},
+	})
+
+	updated := data["issueLabelUpdate"].(map[string]interface{})["issueLabel"].(map[string]interface{})
+
+	if updated["name"] != "Defect" || updated["color"] != "#eb5757" {
+		t.Fatalf("unexpected label: %v", updated)
+	}
+
+	execute(t, s, `mutation($id: String!) { issueLabelDelete(id: $id) { success } }`, map[string]interface{}{"id": label["id"]})
+
+	if s.Get("IssueLabel", label["id"].(string)) != nil {
+		t.Fatalf("expected label to be deleted")
+	}
+}
+
+func TestConnectionPages(t *testing.T) {
+	s := testServer(t)
+
+	for _, name := range []string{"Bug", "Feature", "Chore"} {
+		s.Add("IssueLabel", Object{"name": name, "color": "#eb5757"})
+	}
+
+	query := `query($after: String) {
+		issueLabels(first: 2, after: $after) { nodes { name } pageInfo { hasNextPage endCursor } }
+	}`
+
+	data := execute(t, s, query, map[string]interface{}{"after": nil})
+
+	labels := data["issueLabels"].(map[string]interface{})
+	pageInfo := labels["pageInfo"].(map[string]interface{})
+
+	if len(labels["nodes"].([]interface{})) != 2 || pageInfo["hasNextPage"] != true {
+		t.Fatalf("unexpected first page: %v", labels)
+	}
+
+	data = execute(t, s, query, map[string]interface{}{"after": pageInfo["endCursor"]})
+
+	labels = data["issueLabels"].(map[string]interface{})
+	nodes := labels["nodes"].([]interface{})
+
+	if len(nodes) != 1 || nodes[0].(map[string]interface{})["name"] != "Chore" || labels["pageInfo"].(map[string]interface{})["hasNextPage"] != false {
+		t.Fatalf("unexpected last page: %v", labels)
+	}
+}
+
+func TestNotFound(t *testing.T) {
+	s := testServer(t)
+
+	_, errs := s.Execute(`query { team(id: "missing") { id } }`, "", nil)
+
+	if len(errs) != 1 {
+		t.Fatalf("expected a single error, got %v", errs)
+	}
+
+	if errs[0].Message != "Entity not found: Team" {
+		t.Errorf("unexpected message: %s", errs[0].Message)
+	}
+
+	if errs[0].Extensions["userPresentableMessage"] != "Could not find referenced Team." {
+		t.Errorf("unexpected extensions: %v", errs[0].Extensions)
+	}
+}
+
+func TestInvalidQuery(t *testing.T) {
+	s := testServer(t)
+
+	_, errs := s.Execute(`query { team(id: "x") { notAField } }`, "", nil)
+
+	if len(errs) == 0 {
+		t.Fatalf("expected validation errors")
+	}
+}
+
+func TestServeHTTP(t *testing.T) {
+	server := httptest.NewServer(testServer(t))
+	defer server.Close()
+
+	body, _ := json.Marshal(map[string]interface{}{"query": `query { teams { nodes { id } } }`})
+
+	tests := []struct {
+		name          string
+		authorization string
+		status        int
+	}{
+		{"authenticated", "lin_api_mock", http.StatusOK},
+		{"unauthenticated", "", http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodPost, server.URL, bytes.NewReader(body))
+
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+
+			resp, err := http.DefaultClient.Do(req)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			resp.Body.Close()
+
+			if resp.StatusCode != tt.status {
+				t.Errorf("expected status %d, got %d", tt.status, resp.StatusCode)
+			}
+		})
+	}
+}
+
+func TestServeHTTPUpload(t *testing.T) {
+	s := testServer(t)
+	server := httptest.NewServer(s)
+	defer server.Close()
+
+	upload := func(url string) int {
+		req, _ := http.NewRequest(http.MethodPut, url, bytes.NewReader([]byte("image")))
+
+		resp, err := http.DefaultClient.Do(req)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		resp.Body.Close()
+
+		return resp.StatusCode
+	}
+
+	if status := upload(server.URL + uploadPath + "unknown/emoji.png"); status != http.StatusForbidden {
+		t.Errorf("expected status %d for an unknown upload URL, got %d", http.StatusForbidden, status)
+	}
+
+	// Upload URLs point to where the server was last reached
+	s.url = server.URL
+
+	data := execute(t, s, `mutation {
+		fileUpload(filename: "emoji.png", contentType: "image/png", size: 5) { uploadFile { uploadUrl assetUrl } }
+	}`, nil)
+
+	file := data["fileUpload"].(map[string]interface{})["uploadFile"].(map[string]interface{})
+	createEmoji := `mutation($input: EmojiCreateInput!) { emojiCreate(input: $input) { success } }`
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{"name": "mascot", "url": file["assetUrl"]},
+	}
+
+	if _, errs := s.Execute(createEmoji, "", variables); len(errs) == 0 {
+		t.Errorf("expected an error for an emoji that was not uploaded")
+	}
+
+	if status := upload(file["uploadUrl"].(string)); status != http.StatusOK {
+		t.Errorf("expected status %d, got %d", http.StatusOK, status)
+	}
+
+	if string(s.uploads[file["assetUrl"].(string)]) != "image" {
+		t.Errorf("expected the file to be uploaded, got %q", s.uploads[file["assetUrl"].(string)])
+	}
+
+	execute(t, s, createEmoji, variables)
+}
@@ -0,0 +1,486 @@
+package linearmock
+
+import (
+	"crypto/rand"
+	"fmt"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// Add stores an entity of the given type as is, apart from filling in the
+// identifier, timestamps and schema defaults when they are missing. References
+// to other entities are given as their identifier, keyed by the schema field
+// name (for example `team`). It is meant for seeding fixtures.
+func (s *Server) Add(typeName string, obj Object) Object {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	def := s.schema.Types[typeName]
+
+	if def == nil {
+		panic(fmt.Sprintf("linearmock: unknown type %s", typeName))
+	}
+
+	obj = copyObject(obj)
+
+	s.fillDefaults(def, obj)
+	s.insert(typeName, obj)
+
+	return copyObject(obj)
+}
+
+// Get returns a copy of a stored entity, or nil when it does not exist.
+func (s *Server) Get(typeName string, id string) Object {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if obj, err := s.find(typeName, id); err == nil {
+		return copyObject(obj)
+	}
+
+	return nil
+}
+
+// List returns copies of all stored entities of the given type, in insertion
+// order, including archived ones.
+func (s *Server) List(typeName string) []Object {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var result []Object
+
+	for _, obj := range s.all(typeName) {
+		result = append(result, copyObject(obj))
+	}
+
+	return result
+}
+
+// Delete removes a stored entity, as if it was deleted outside of Terraform.
+func (s *Server) Delete(typeName string, id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.remove(typeName, id)
+}
+
+func (s *Server) table(typeName string) *table {
+	t, ok := s.tables[typeName]
+
+	if !ok {
+		t = &table{rows: map[string]Object{}}
+		s.tables[typeName] = t
+	}
+
+	return t
+}
+
+func (s *Server) insert(typeName string, obj Object) {
+	t := s.table(typeName)
+	id := obj["id"].(string)
+
+	if _, ok := t.rows[id]; !ok {
+		t.ids = append(t.ids, id)
+	}
+
+	t.rows[id] = obj
+}
+
+func (s *Server) remove(typeName string, id string) {
+	t := s.table(typeName)
+
+	if _, ok := t.rows[id]; !ok {
+		return
+	}
+
+	delete(t.rows, id)
+
+	for i, existing := range t.ids {
+		if existing == id {
+			t.ids = append(t.ids[:i], t.ids[i+1:]...)
+			break
+		}
+	}
+}
+
+func (s *Server) all(typeName string) []Object {
+	t := s.table(typeName)
+	result := make([]Object, 0, len(t.ids))
+
+	for _, id := range t.ids {
+		result = append(result, t.rows[id])
+	}
+
+	return result
+}
+
+// find looks an entity up by identifier, or by one of the alternative lookup
+// fields the API accepts in place of the identifier (for example the team key).
+func (s *Server) find(typeName string, id string) (Object, error) {
+	t := s.table(typeName)
+
+	if obj, ok := t.rows[id]; ok {
+		return obj, nil
+	}
+
+	for _, field := range lookupFields[typeName] {
+		for _, obj := range s.all(typeName) {
+			if value, ok := obj[field].(string); ok && value == id {
+				return obj, nil
+			}
+		}
+	}
+
+	return nil, &NotFoundError{Type: typeName}
+}
+
+// singleton returns the only entity of a type, such as the organization.
+func (s *Server) singleton(typeName string) (Object, error) {
+	objs := s.all(typeName)
+
+	if len(objs) == 0 {
+		return nil, &NotFoundError{Type: typeName}
+	}
+
+	return objs[0], nil
+}
+
+// create builds an entity from a create mutation input.
+func (s *Server) create(typeName string, input map[string]interface{}) (Object, error) {
+	def := s.schema.Types[typeName]
+	obj := Object{}
+
+	if id, ok := input["id"].(string); ok && id != "" {
+		if _, exists := s.table(typeName).rows[id]; exists {
+			return nil, &InputError{Message: fmt.Sprintf("%s with id %s already exists", typeName, id)}
+		}
+
+		obj["id"] = id
+	}
+
+	if err := s.assign(def, obj, input); err != nil {
+		return nil, err
+	}
+
+	if hook, ok := createHooks[typeName]; ok {
+		if err := hook(s, obj, input); err != nil {
+			return nil, err
+		}
+	}
+
+	s.fillDefaults(def, obj)
+
+	s.insert(typeName, obj)
+
+	if hook, ok := afterCreateHooks[typeName]; ok {
+		hook(s, obj)
+	}
+
+	return obj, nil
+}
+
+// update applies an update mutation input to an entity.
+func (s *Server) update(typeName string, obj Object, input map[string]interface{}) error {
+	def := s.schema.Types[typeName]
+	updated := copyObject(obj)
+
+	if err := s.assign(def, updated, input); err != nil {
+		return err
+	}
+
+	if hook, ok := updateHooks[typeName]; ok {
+		if err := hook(s, updated, input); err != nil {
+			return err
+		}
+	}
+
+	updated["updatedAt"] = now()
+
+	for key := range obj {
+		delete(obj, key)
+	}
+
+	for key, value := range updated {
+		obj[key] = value
+	}
+
+	return nil
+}
+
+// assign copies input fields onto an entity. Inputs named after an object field
+// with an `Id` suffix (`teamId`) are stored as a reference under the field name
+// (`team`), and `Ids` suffixed inputs (`labelIds`) as a list of references under
+// the plural field name (`labels`).
+func (s *Server) assign(def *ast.Definition, obj Object, input map[string]interface{}) error {
+	for key, value := range input {
+		if key == "id" {
+			continue
+		}
+
+		if field := def.Fields.ForName(key); field != nil {
+			obj[key] = value
+			continue
+		}
+
+		if strings.HasSuffix(key, "Ids") {
+			name := strings.TrimSuffix(key, "Ids") + "s"
+
+			if field := def.Fields.ForName(name); field != nil {
+				ids, _ := value.([]interface{})
+
+				for _, id := range ids {
+					if _, err := s.find(nodeTypeName(s.schema, field.Type), fmt.Sprint(id)); err != nil {
+						return err
+					}
+				}
+
+				obj[name] = value
+				continue
+			}
+		}
+
+		if strings.HasSuffix(key, "Id") {
+			name := strings.TrimSuffix(key, "Id")
+
+			if field := def.Fields.ForName(name); field != nil && s.schema.Types[field.Type.Name()].Kind == ast.Object {
+				if id, ok := value.(string); ok {
+					ref, err := s.find(field.Type.Name(), id)
+
+					if err != nil {
+						return err
+					}
+
+					value = ref["id"]
+				}
+
+				obj[name] = value
+				continue
+			}
+		}
+
+		obj[key] = value
+	}
+
+	return nil
+}
+
+// fillDefaults sets missing identifier, timestamps and non-null scalar fields
+// to their zero value, so that every entity satisfies the schema.
+func (s *Server) fillDefaults(def *ast.Definition, obj Object) {
+	if def.Fields.ForName("id") != nil {
+		if id, ok := obj["id"].(string); !ok || id == "" {
+			obj["id"] = newId()
+		}
+	}
+
+	for _, field := range def.Fields {
+		if _, ok := obj[field.Name]; ok || !field.Type.NonNull || field.Type.Elem != nil {
+			continue
+		}
+
+		fieldDef := s.schema.Types[field.Type.Name()]
+
+		switch fieldDef.Kind {
+		case ast.Enum:
+			obj[field.Name] = fieldDef.EnumValues[0].Name
+		case ast.Scalar:
+			switch fieldDef.Name {
+			case "Boolean":
+				obj[field.Name] = false
+			case "Int", "Float":
+				obj[field.Name] = float64(0)
+			case "DateTime":
+				obj[field.Name] = now()
+			case "JSON", "JSONObject":
+				obj[field.Name] = map[string]interface{}{}
+			default:
+				obj[field.Name] = ""
+			}
+		}
+	}
+}
+
+// matches evaluates a Linear filter input (`{ name: { eq: "x" }, team: { key:
+// { eq: "ABC" } } }`) against an entity.
+func (s *Server) matches(def *ast.Definition, obj Object, filter map[string]interface{}) bool {
+	for key, raw := range filter {
+		cond, _ := raw.(map[string]interface{})
+
+		switch key {
+		case "and":
+			for _, sub := range asList(raw) {
+				if m, ok := sub.(map[string]interface{}); ok && !s.matches(def, obj, m) {
+					return false
+				}
+			}
+
+			continue
+		case "or":
+			subs := asList(raw)
+			matched := len(subs) == 0
+
+			for _, sub := range subs {
+				if m, ok := sub.(map[string]interface{}); ok && s.matches(def, obj, m) {
+					matched = true
+				}
+			}
+
+			if !matched {
+				return false
+			}
+
+			continue
+		}
+
+		if cond == nil {
+			continue
+		}
+
+		field := def.Fields.ForName(key)
+
+		if field == nil {
+			continue
+		}
+
+		value := obj[key]
+		fieldDef := s.schema.Types[field.Type.Name()]
+
+		if fieldDef.Kind == ast.Object && field.Type.Elem == nil && !isConnection(fieldDef) {
+			var ref Object
+
+			if id, ok := value.(string); ok {
+				ref, _ = s.find(fieldDef.Name, id)
+			}
+
+			if isNull, ok := cond["null"].(bool); ok && isNull != (ref == nil) {
+				return false
+			}
+
+			rest := map[string]interface{}{}
+
+			for k, v := range cond {
+				if k != "null" {
+					rest[k] = v
+				}
+			}
+
+			if len(rest) == 0 {
+				continue
+			}
+
+			if ref == nil || !s.matches(fieldDef, ref, rest) {
+				return false
+			}
+
+			continue
+		}
+
+		if fieldDef.Kind == ast.Object {
+			// Collection filters (`some`, `every`) are not supported
+			continue
+		}
+
+		if !compare(value, cond) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func compare(value interface{}, cond map[string]interface{}) bool {
+	str := func(v interface{}) string { return fmt.Sprint(v) }
+
+	for op, expected := range cond {
+		switch op {
+		case "eq":
+			if value == nil || str(value) != str(expected) {
+				return false
+			}
+		case "neq":
+			if value != nil && str(value) == str(expected) {
+				return false
+			}
+		case "eqIgnoreCase":
+			if value == nil || !strings.EqualFold(str(value), str(expected)) {
+				return false
+			}
+		case "in", "nin":
+			found := false
+
+			for _, candidate := range asList(expected) {
+				if value != nil && str(value) == str(candidate) {
+					found = true
+				}
+			}
+
+			if found != (op == "in") {
+				return false
+			}
+		case "null":
+			if isNull, _ := expected.(bool); isNull != (value == nil) {
+				return false
+			}
+		case "contains", "containsIgnoreCase", "startsWith", "endsWith":
+			haystack, needle := str(value), str(expected)
+
+			if op == "containsIgnoreCase" {
+				haystack, needle = strings.ToLower(haystack), strings.ToLower(needle)
+			}
+
+			if value == nil ||
+				(op == "startsWith" && !strings.HasPrefix(haystack, needle)) ||
+				(op == "endsWith" && !strings.HasSuffix(haystack, needle)) ||
+				(strings.HasPrefix(op, "contains") && !strings.Contains(haystack, needle)) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func isConnection(def *ast.Definition) bool {
+	return strings.HasSuffix(def.Name, "Connection") && def.Fields.ForName("nodes") != nil
+}
+
+// nodeTypeName returns the entity type of a connection or list field.
+func nodeTypeName(schema *ast.Schema, typ *ast.Type) string {
+	def := schema.Types[typ.Name()]
+
+	if isConnection(def) {
+		return def.Fields.ForName("nodes").Type.Name()
+	}
+
+	return typ.Name()
+}
+
+func asList(value interface{}) []interface{} {
+	list, _ := value.([]interface{})
+
+	return list
+}
+
+func copyObject(obj Object) Object {
+	result := make(Object, len(obj))
+
+	for key, value := range obj {
+		result[key] = value
+	}
+
+	return result
+}
+
+func newId() string {
+	b := make([]byte, 16)
+
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
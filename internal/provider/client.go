@@ -1,6 +1,13 @@
 package provider
 
-import "net/http"
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+)
 
 type authedTransport struct {
 	token   string
@@ -12,3 +19,44 @@ func (t *authedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 
 	return t.wrapped.RoundTrip(req)
 }
+
+// newHttpTransport builds the transport used to reach the API, routing it
+// through the given proxy and trusting the given CA bundle when they are set.
+func newHttpTransport(proxyUrl string, caBundleFile string) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if proxyUrl != "" {
+		parsed, err := url.Parse(proxyUrl)
+
+		if err != nil || parsed.Host == "" {
+			return nil, fmt.Errorf("invalid proxy URL %q", proxyUrl)
+		}
+
+		transport.Proxy = http.ProxyURL(parsed)
+	}
+
+	if caBundleFile != "" {
+		pem, err := os.ReadFile(caBundleFile)
+
+		if err != nil {
+			return nil, fmt.Errorf("unable to read CA bundle: %w", err)
+		}
+
+		pool, err := x509.SystemCertPool()
+
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in CA bundle %q", caBundleFile)
+		}
+
+		transport.TLSClientConfig = &tls.Config{
+			MinVersion: tls.VersionTLS12,
+			RootCAs:    pool,
+		}
+	}
+
+	return transport, nil
+}
@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+
+	"github.com/Khan/genqlient/graphql"
+)
+
+// cachingClient shares the results of queries between the resources of a
+// Terraform run, so that for example all the workflow states of a team are
+// read once per refresh instead of once per workflow state. Results are keyed
+// by operation and variables, which hold the team the query is about, and the
+// whole cache is dropped whenever a mutation is made.
+type cachingClient struct {
+	wrapped graphql.Client
+
+	mu      sync.Mutex
+	entries map[string]*cacheEntry
+}
+
+type cacheEntry struct {
+	ready chan struct{}
+	data  json.RawMessage
+	err   error
+}
+
+// isCaching reports whether the queries of the client go through the read
+// cache, in which case reading a whole collection once is cheaper than reading
+// its entities one by one.
+func isCaching(client graphql.Client) bool {
+	if uploader, ok := client.(*uploadingClient); ok {
+		client = uploader.Client
+	}
+
+	_, ok := client.(*cachingClient)
+
+	return ok
+}
+
+func newCachingClient(wrapped graphql.Client) *cachingClient {
+	return &cachingClient{
+		wrapped: wrapped,
+		entries: map[string]*cacheEntry{},
+	}
+}
+
+func (c *cachingClient) MakeRequest(ctx context.Context, req *graphql.Request, resp *graphql.Response) error {
+	if !strings.HasPrefix(strings.TrimSpace(req.Query), "query") {
+		err := c.wrapped.MakeRequest(ctx, req, resp)
+
+		// Even a failed mutation may have changed something
+		c.invalidate()
+
+		return err
+	}
+
+	variables, err := json.Marshal(req.Variables)
+
+	if err != nil {
+		return err
+	}
+
+	key := req.OpName + ":" + string(variables)
+
+	c.mu.Lock()
+	entries := c.entries
+	entry, cached := entries[key]
+
+	if !cached {
+		entry = &cacheEntry{ready: make(chan struct{})}
+		entries[key] = entry
+	}
+
+	c.mu.Unlock()
+
+	if cached {
+		// Wait for the same query made concurrently by another resource
+		select {
+		case <-entry.ready:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	} else {
+		entry.err = c.wrapped.MakeRequest(ctx, req, &graphql.Response{Data: &entry.data})
+		close(entry.ready)
+
+		if entry.err != nil {
+			c.mu.Lock()
+			delete(entries, key)
+			c.mu.Unlock()
+		}
+	}
+
+	if entry.err != nil {
+		return entry.err
+	}
+
+	return json.Unmarshal(entry.data, resp.Data)
+}
+
+// invalidate drops every cached result. Queries still in flight complete into
+// the dropped cache, so their possibly outdated results are not reused.
+func (c *cachingClient) invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = map[string]*cacheEntry{}
+}
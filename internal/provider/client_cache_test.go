@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+)
+
+type countingClient struct {
+	mu    sync.Mutex
+	calls int
+	err   error
+}
+
+func (c *countingClient) MakeRequest(ctx context.Context, req *graphql.Request, resp *graphql.Response) error {
+	c.mu.Lock()
+	c.calls++
+	calls := c.calls
+	c.mu.Unlock()
+
+	time.Sleep(10 * time.Millisecond)
+
+	if c.err != nil {
+		return c.err
+	}
+
+	return json.Unmarshal([]byte(`{"call":`+strconv.Itoa(calls)+`}`), resp.Data)
+}
+
+func TestCachingClient(t *testing.T) {
+	wrapped := &countingClient{}
+	client := newCachingClient(wrapped)
+
+	query := func(variables map[string]interface{}) int {
+		var data struct {
+			Call int `json:"call"`
+		}
+
+		err := client.MakeRequest(context.Background(), &graphql.Request{
+			OpName:    "getTeamWorkflowStates",
+			Query:     "query getTeamWorkflowStates($teamId: ID!) { }",
+			Variables: variables,
+		}, &graphql.Response{Data: &data})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		return data.Call
+	}
+
+	mutate := func() {
+		err := client.MakeRequest(context.Background(), &graphql.Request{
+			OpName: "updateWorkflowState",
+			Query:  "mutation updateWorkflowState($id: String!) { }",
+		}, &graphql.Response{Data: &json.RawMessage{}})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	team := map[string]interface{}{"teamId": "a"}
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if call := query(team); call != 1 {
+				t.Errorf("expected concurrent queries to share the first result, got call %d", call)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if call := query(map[string]interface{}{"teamId": "b"}); call != 2 {
+		t.Errorf("expected another team to be queried, got call %d", call)
+	}
+
+	mutate()
+
+	if call := query(team); call != 4 {
+		t.Errorf("expected the cache to be dropped after a mutation, got call %d", call)
+	}
+
+	if wrapped.calls != 4 {
+		t.Errorf("expected 4 requests, got %d", wrapped.calls)
+	}
+}
+
+func TestCachingClientError(t *testing.T) {
+	wrapped := &countingClient{err: errors.New("unavailable")}
+	client := newCachingClient(wrapped)
+
+	for i := 0; i < 2; i++ {
+		err := client.MakeRequest(context.Background(), &graphql.Request{
+			OpName: "getWorkspace",
+			Query:  "query getWorkspace { }",
+		}, &graphql.Response{Data: &json.RawMessage{}})
+
+		if err == nil {
+			t.Fatalf("expected an error")
+		}
+	}
+
+	if wrapped.calls != 2 {
+		t.Errorf("expected errors not to be cached, got %d requests", wrapped.calls)
+	}
+}
+
+func TestIsCaching(t *testing.T) {
+	wrapped := &countingClient{}
+
+	cases := []struct {
+		name    string
+		client  graphql.Client
+		caching bool
+	}{
+		{"plain", wrapped, false},
+		{"caching", newCachingClient(wrapped), true},
+		{"uploading", &uploadingClient{Client: wrapped}, false},
+		{"uploading and caching", &uploadingClient{Client: newCachingClient(wrapped)}, true},
+	}
+
+	for _, c := range cases {
+		if isCaching(c.client) != c.caching {
+			t.Errorf("%s: expected isCaching to be %t", c.name, c.caching)
+		}
+	}
+}
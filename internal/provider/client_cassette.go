@@ -0,0 +1,275 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// Cassettes record the GraphQL requests made to the API along with their
+// responses, so that the acceptance tests can replay them later without
+// reaching the API.
+const (
+	cassetteEnvVarName     = "LINEAR_CASSETTE"
+	cassetteModeEnvVarName = "LINEAR_CASSETTE_MODE"
+
+	cassetteModeRecord = "record"
+	cassetteModeReplay = "replay"
+
+	cassetteRedacted = "REDACTED"
+)
+
+type cassetteRequest struct {
+	OperationName string          `json:"operationName"`
+	Query         string          `json:"query"`
+	Variables     json.RawMessage `json:"variables,omitempty"`
+}
+
+type cassetteResponse struct {
+	Status int    `json:"status"`
+	Body   string `json:"body"`
+}
+
+type cassetteInteraction struct {
+	Request  cassetteRequest  `json:"request"`
+	Response cassetteResponse `json:"response"`
+
+	played bool
+}
+
+type cassette struct {
+	path string
+	mode string
+
+	mu           sync.Mutex
+	Interactions []*cassetteInteraction `json:"interactions"`
+}
+
+var (
+	// cassettes are shared by every provider configured in the process, as
+	// Terraform configures the provider again for each command of a test.
+	cassettes   = map[string]*cassette{}
+	cassettesMu sync.Mutex
+)
+
+// loadCassette returns the cassette at the given path, reading it from disk
+// the first time it is used in replay mode.
+func loadCassette(path string, mode string) (*cassette, error) {
+	if mode == "" {
+		mode = cassetteModeReplay
+	}
+
+	if mode != cassetteModeRecord && mode != cassetteModeReplay {
+		return nil, fmt.Errorf("invalid cassette mode %q, expected %q or %q", mode, cassetteModeRecord, cassetteModeReplay)
+	}
+
+	cassettesMu.Lock()
+	defer cassettesMu.Unlock()
+
+	if c, ok := cassettes[path]; ok {
+		if c.mode != mode {
+			return nil, fmt.Errorf("cassette %s is already in use in %s mode", path, c.mode)
+		}
+
+		return c, nil
+	}
+
+	c := &cassette{path: path, mode: mode}
+
+	if mode == cassetteModeReplay {
+		content, err := os.ReadFile(path)
+
+		if err != nil {
+			return nil, fmt.Errorf("unable to read cassette, record it with %s=%s: %w", cassetteModeEnvVarName, cassetteModeRecord, err)
+		}
+
+		if err := json.Unmarshal(content, c); err != nil {
+			return nil, fmt.Errorf("unable to parse cassette %s: %w", path, err)
+		}
+	}
+
+	cassettes[path] = c
+
+	return c, nil
+}
+
+// ejectCassette stops using the cassette at the given path, writing it to disk
+// when it was recorded.
+func ejectCassette(path string) error {
+	cassettesMu.Lock()
+	c, ok := cassettes[path]
+	delete(cassettes, path)
+	cassettesMu.Unlock()
+
+	if !ok || c.mode != cassetteModeRecord {
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	content, err := json.MarshalIndent(c, "", "  ")
+
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(content, '\n'), 0o644)
+}
+
+// cassetteTransport records the requests made through the wrapped transport
+// into a cassette, or answers them from the cassette without reaching the
+// wrapped transport when replaying. The token is scrubbed from recordings.
+type cassetteTransport struct {
+	cassette *cassette
+	token    string
+	wrapped  http.RoundTripper
+}
+
+func (t *cassetteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// File uploads are not GraphQL requests, so they are not recorded and
+	// succeed without a body when replayed
+	if req.Method == http.MethodPut {
+		if t.cassette.mode == cassetteModeReplay {
+			return &http.Response{
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+				Proto:      "HTTP/1.1",
+				ProtoMajor: 1,
+				ProtoMinor: 1,
+				Header:     http.Header{},
+				Body:       http.NoBody,
+				Request:    req,
+			}, nil
+		}
+
+		return t.wrapped.RoundTrip(req)
+	}
+
+	var body []byte
+
+	if req.Body != nil {
+		var err error
+
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var recorded cassetteRequest
+
+	if err := json.Unmarshal([]byte(t.scrub(string(body))), &recorded); err != nil {
+		return nil, fmt.Errorf("unable to parse GraphQL request: %w", err)
+	}
+
+	if t.cassette.mode == cassetteModeReplay {
+		return t.replay(req, recorded)
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	resp, err := t.wrapped.RoundTrip(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	t.cassette.mu.Lock()
+	t.cassette.Interactions = append(t.cassette.Interactions, &cassetteInteraction{
+		Request: recorded,
+		Response: cassetteResponse{
+			Status: resp.StatusCode,
+			Body:   t.scrub(string(respBody)),
+		},
+	})
+	t.cassette.mu.Unlock()
+
+	return resp, nil
+}
+
+// replay answers with the first interaction not played yet that has the same
+// operation, query and variables. Requests are not matched in order, as
+// Terraform makes some of them concurrently.
+func (t *cassetteTransport) replay(req *http.Request, recorded cassetteRequest) (*http.Response, error) {
+	t.cassette.mu.Lock()
+	defer t.cassette.mu.Unlock()
+
+	for _, interaction := range t.cassette.Interactions {
+		if interaction.played || !interaction.Request.matches(recorded) {
+			continue
+		}
+
+		interaction.played = true
+
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.Status, http.StatusText(interaction.Response.Status)),
+			StatusCode:    interaction.Response.Status,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        http.Header{"Content-Type": []string{"application/json"}},
+			Body:          io.NopCloser(strings.NewReader(interaction.Response.Body)),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("no recorded interaction in cassette %s matches the %s operation with variables %s", t.cassette.path, recorded.OperationName, recorded.Variables)
+}
+
+func (t *cassetteTransport) scrub(content string) string {
+	if t.token == "" {
+		return content
+	}
+
+	return strings.ReplaceAll(content, t.token, cassetteRedacted)
+}
+
+func (r cassetteRequest) matches(other cassetteRequest) bool {
+	if r.OperationName != other.OperationName || r.Query != other.Query {
+		return false
+	}
+
+	var variables, otherVariables interface{}
+
+	if err := decodeVariables(r.Variables, &variables); err != nil {
+		return false
+	}
+
+	if err := decodeVariables(other.Variables, &otherVariables); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(variables, otherVariables)
+}
+
+func decodeVariables(raw json.RawMessage, value *interface{}) error {
+	if len(raw) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(raw, value)
+}
@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCassetteTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, `{"data":{"viewer":{"id":"1","token":"`+r.Header.Get("Authorization")+`"}}}`)
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	query := `{"operationName":"getViewer","query":"query getViewer { viewer { id } }","variables":{"id":"1"}}`
+
+	send := func(transport http.RoundTripper, body string) (string, error) {
+		req, _ := http.NewRequest(http.MethodPost, server.URL, strings.NewReader(body))
+		resp, err := transport.RoundTrip(req)
+
+		if err != nil {
+			return "", err
+		}
+
+		defer resp.Body.Close()
+
+		content, err := io.ReadAll(resp.Body)
+
+		return string(content), err
+	}
+
+	recorder, err := loadCassette(path, cassetteModeRecord)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	transport := &cassetteTransport{
+		cassette: recorder,
+		token:    "lin_api_secret",
+		wrapped:  &authedTransport{token: "lin_api_secret", wrapped: http.DefaultTransport},
+	}
+
+	if _, err := send(transport, query); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := ejectCassette(path); err != nil {
+		t.Fatalf("unable to save cassette: %s", err)
+	}
+
+	content, _ := os.ReadFile(path)
+
+	if strings.Contains(string(content), "lin_api_secret") {
+		t.Fatalf("expected the token to be scrubbed from the cassette, got %s", content)
+	}
+
+	player, err := loadCassette(path, cassetteModeReplay)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	defer ejectCassette(path)
+
+	transport = &cassetteTransport{
+		cassette: player,
+		wrapped:  http.NewFileTransport(http.Dir(t.TempDir())),
+	}
+
+	// Variables are matched regardless of formatting
+	body, err := send(transport, `{"operationName":"getViewer","query":"query getViewer { viewer { id } }","variables":{ "id": "1" }}`)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := `{"data":{"viewer":{"id":"1","token":"REDACTED"}}}`; body != expected {
+		t.Errorf("expected %s, got %s", expected, body)
+	}
+
+	if _, err := send(transport, query); err == nil {
+		t.Errorf("expected an error when replaying an interaction twice")
+	}
+
+	if _, err := send(transport, `{"operationName":"getViewer","query":"query getViewer { viewer { id } }","variables":{"id":"2"}}`); err == nil {
+		t.Errorf("expected an error for a request that was not recorded")
+	}
+}
+
+func TestLoadCassette(t *testing.T) {
+	if _, err := loadCassette(filepath.Join(t.TempDir(), "missing.json"), cassetteModeReplay); err == nil {
+		t.Errorf("expected an error for a missing cassette")
+	}
+
+	if _, err := loadCassette(filepath.Join(t.TempDir(), "cassette.json"), "rewind"); err == nil {
+		t.Errorf("expected an error for an invalid mode")
+	}
+}
@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const logRedacted = "REDACTED"
+
+// logSecretKeys are the parts of variable names whose values are never logged.
+var logSecretKeys = []string{"secret", "token", "password"}
+
+// logHeaderPrefixes are the response headers that are logged, which describe
+// the rate limits of the API.
+var logHeaderPrefixes = []string{"X-Ratelimit-", "X-Complexity"}
+
+// loggingTransport logs every request made to the API at the debug level with
+// its GraphQL operation, variables, status, duration and rate limit headers.
+// Request headers, which carry the credentials, and query strings, which sign
+// the upload URLs, are never logged.
+type loggingTransport struct {
+	wrapped http.RoundTripper
+}
+
+type loggedRequest struct {
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+type loggedResponse struct {
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	// The query string is left out, as it signs the upload URLs
+	url := *req.URL
+	url.RawQuery = ""
+	url.Fragment = ""
+
+	fields := map[string]interface{}{
+		"http_method": req.Method,
+		"http_url":    url.String(),
+	}
+
+	if req.Body != nil {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+
+		if err != nil {
+			return nil, err
+		}
+
+		req.Body = io.NopCloser(bytes.NewReader(body))
+
+		var operation loggedRequest
+
+		if json.Unmarshal(body, &operation) == nil && operation.OperationName != "" {
+			fields["graphql_operation"] = operation.OperationName
+			fields["graphql_variables"] = redactVariables(operation.Variables)
+		}
+	}
+
+	start := time.Now()
+	resp, err := t.wrapped.RoundTrip(req)
+	fields["duration_ms"] = time.Since(start).Milliseconds()
+
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.Debug(ctx, "Linear API request failed", fields)
+
+		return nil, err
+	}
+
+	fields["http_status"] = resp.StatusCode
+
+	for name, values := range resp.Header {
+		for _, prefix := range logHeaderPrefixes {
+			if strings.HasPrefix(name, prefix) {
+				fields["header_"+strings.ToLower(strings.ReplaceAll(name, "-", "_"))] = strings.Join(values, ", ")
+			}
+		}
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	var response loggedResponse
+
+	if json.Unmarshal(body, &response) == nil && len(response.Errors) > 0 {
+		messages := make([]string, 0, len(response.Errors))
+
+		for _, e := range response.Errors {
+			messages = append(messages, e.Message)
+		}
+
+		fields["graphql_errors"] = messages
+	}
+
+	tflog.Debug(ctx, "Linear API request", fields)
+
+	return resp, nil
+}
+
+// redactVariables returns a copy of the variables with the values of secret
+// looking keys replaced, at any depth.
+func redactVariables(variables map[string]interface{}) map[string]interface{} {
+	if variables == nil {
+		return nil
+	}
+
+	result := make(map[string]interface{}, len(variables))
+
+	for key, value := range variables {
+		result[key] = redactValue(key, value)
+	}
+
+	return result
+}
+
+func redactValue(key string, value interface{}) interface{} {
+	lower := strings.ToLower(key)
+
+	for _, secret := range logSecretKeys {
+		if strings.Contains(lower, secret) && value != nil {
+			return logRedacted
+		}
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return redactVariables(v)
+	case []interface{}:
+		result := make([]interface{}, len(v))
+
+		for i, item := range v {
+			result[i] = redactValue(key, item)
+		}
+
+		return result
+	}
+
+	return value
+}
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestLoggingTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Requests-Remaining", "1499")
+		w.Header().Set("X-Complexity", "12")
+		w.WriteHeader(http.StatusBadRequest)
+		io.WriteString(w, `{"errors":[{"message":"Entity not found: Team"}]}`)
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	body := `{"operationName":"createWebhook","query":"mutation createWebhook { }","variables":{"input":{"url":"https://example.com","secret":"s3cr3t"}}}`
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPost, server.URL, strings.NewReader(body))
+	req.Header.Set("Authorization", "lin_api_secret")
+
+	client := http.Client{Transport: &authedTransport{token: "lin_api_secret", wrapped: &loggingTransport{wrapped: http.DefaultTransport}}}
+
+	resp, err := client.Do(req)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	content, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if !strings.Contains(string(content), "Entity not found") {
+		t.Errorf("expected the response body to be preserved, got %s", content)
+	}
+
+	logged := output.String()
+
+	if strings.Contains(logged, "lin_api_secret") || strings.Contains(logged, "s3cr3t") {
+		t.Errorf("expected secrets to never be logged, got %s", logged)
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+
+	if err != nil {
+		t.Fatalf("unable to decode logs: %s", err)
+	}
+
+	if len(entries) != 1 {
+		t.Fatalf("expected a single log entry, got %v", entries)
+	}
+
+	entry := entries[0]
+
+	for key, expected := range map[string]interface{}{
+		"graphql_operation":                     "createWebhook",
+		"http_status":                           float64(400),
+		"header_x_ratelimit_requests_remaining": "1499",
+		"header_x_complexity":                   "12",
+		"graphql_errors":                        []interface{}{"Entity not found: Team"},
+		"graphql_variables": map[string]interface{}{
+			"input": map[string]interface{}{"url": "https://example.com", "secret": "REDACTED"},
+		},
+	} {
+		if !reflect.DeepEqual(entry[key], expected) {
+			t.Errorf("expected %s to be %v, got %v", key, expected, entry[key])
+		}
+	}
+
+	if _, ok := entry["duration_ms"]; !ok {
+		t.Errorf("expected the duration to be logged")
+	}
+}
+
+func TestLoggingTransportUploadURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPut, server.URL+"/uploads/file.png?X-Goog-Signature=s1gn4tur3", strings.NewReader("content"))
+
+	client := http.Client{Transport: &loggingTransport{wrapped: http.DefaultTransport}}
+
+	resp, err := client.Do(req)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	resp.Body.Close()
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+
+	if err != nil {
+		t.Fatalf("unable to decode logs: %s", err)
+	}
+
+	if len(entries) != 1 {
+		t.Fatalf("expected a single log entry, got %v", entries)
+	}
+
+	if expected := server.URL + "/uploads/file.png"; entries[0]["http_url"] != expected {
+		t.Errorf("expected http_url to be %s, got %v", expected, entries[0]["http_url"])
+	}
+}
@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// oauthExpiryMargin is how long before its expiry an access token is refreshed,
+// so that it does not expire while a request is in flight.
+const oauthExpiryMargin = time.Minute
+
+// oauthTokenSource exchanges the client credentials of an OAuth application for
+// an access token, and exchanges them again once the token is about to expire.
+type oauthTokenSource struct {
+	tokenUrl     string
+	clientId     string
+	clientSecret string
+	scopes       []string
+	client       *http.Client
+
+	mu      sync.Mutex
+	token   string
+	expires time.Time
+	now     func() time.Time
+}
+
+type oauthTokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	TokenType        string `json:"token_type"`
+	ExpiresIn        int64  `json:"expires_in"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// Token returns a valid access token, fetching a new one when there is none
+// yet or when it is about to expire.
+func (s *oauthTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && (s.expires.IsZero() || s.now().Add(oauthExpiryMargin).Before(s.expires)) {
+		return s.token, nil
+	}
+
+	form := url.Values{
+		"grant_type":    {"client_credentials"},
+		"client_id":     {s.clientId},
+		"client_secret": {s.clientSecret},
+	}
+
+	if len(s.scopes) > 0 {
+		form.Set("scope", strings.Join(s.scopes, ","))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.tokenUrl, strings.NewReader(form.Encode()))
+
+	if err != nil {
+		return "", err
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := s.client.Do(req)
+
+	if err != nil {
+		return "", fmt.Errorf("unable to get OAuth access token: %w", err)
+	}
+
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+
+	if err != nil {
+		return "", fmt.Errorf("unable to get OAuth access token: %w", err)
+	}
+
+	var token oauthTokenResponse
+
+	if err := json.Unmarshal(body, &token); err != nil {
+		return "", fmt.Errorf("unable to get OAuth access token, got status %d: %s", resp.StatusCode, body)
+	}
+
+	if resp.StatusCode != http.StatusOK || token.AccessToken == "" {
+		if token.Error != "" {
+			return "", fmt.Errorf("unable to get OAuth access token, got error %s: %s", token.Error, token.ErrorDescription)
+		}
+
+		return "", fmt.Errorf("unable to get OAuth access token, got status %d: %s", resp.StatusCode, body)
+	}
+
+	if token.TokenType != "" && !strings.EqualFold(token.TokenType, "bearer") {
+		return "", fmt.Errorf("unable to use OAuth access token of type %q", token.TokenType)
+	}
+
+	s.token = token.AccessToken
+	s.expires = time.Time{}
+
+	if token.ExpiresIn > 0 {
+		s.expires = s.now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	}
+
+	return s.token, nil
+}
+
+// oauthTransport authenticates requests with an access token from the token
+// source.
+type oauthTransport struct {
+	source  *oauthTokenSource
+	wrapped http.RoundTripper
+}
+
+func (t *oauthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.source.Token(req.Context())
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	return t.wrapped.RoundTrip(req)
+}
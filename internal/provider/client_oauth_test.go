@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestOauthTransport(t *testing.T) {
+	exchanges := 0
+
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("unable to parse token request: %s", err)
+		}
+
+		if r.Form.Get("client_id") != "app" || r.Form.Get("client_secret") != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			io.WriteString(w, `{"error":"invalid_client","error_description":"Client authentication failed"}`)
+			return
+		}
+
+		if r.Form.Get("grant_type") != "client_credentials" || r.Form.Get("scope") != "read,write" {
+			t.Errorf("unexpected token request: %s", r.Form.Encode())
+		}
+
+		exchanges++
+
+		fmt.Fprintf(w, `{"access_token":"lin_oauth_%d","token_type":"Bearer","expires_in":3600,"scope":"read write"}`, exchanges)
+	}))
+	defer tokenServer.Close()
+
+	var authorization string
+
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+	}))
+	defer apiServer.Close()
+
+	now := time.Now()
+
+	source := &oauthTokenSource{
+		tokenUrl:     tokenServer.URL,
+		clientId:     "app",
+		clientSecret: "secret",
+		scopes:       []string{"read", "write"},
+		client:       http.DefaultClient,
+		now:          func() time.Time { return now },
+	}
+
+	client := http.Client{Transport: &oauthTransport{source: source, wrapped: http.DefaultTransport}}
+
+	request := func(expected string) {
+		resp, err := client.Post(apiServer.URL, "application/json", nil)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		resp.Body.Close()
+
+		if authorization != expected {
+			t.Errorf("expected authorization %q, got %q", expected, authorization)
+		}
+	}
+
+	request("Bearer lin_oauth_1")
+	request("Bearer lin_oauth_1")
+
+	if exchanges != 1 {
+		t.Errorf("expected the access token to be reused, got %d exchanges", exchanges)
+	}
+
+	// Refreshed shortly before it expires
+	now = now.Add(59*time.Minute + 30*time.Second)
+
+	request("Bearer lin_oauth_2")
+
+	source = &oauthTokenSource{
+		tokenUrl:     tokenServer.URL,
+		clientId:     "app",
+		clientSecret: "wrong",
+		client:       http.DefaultClient,
+		now:          time.Now,
+	}
+
+	if _, err := source.Token(context.Background()); err == nil || err.Error() != "unable to get OAuth access token, got error invalid_client: Client authentication failed" {
+		t.Errorf("expected an invalid client error, got %v", err)
+	}
+}
@@ -0,0 +1,231 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/http/httptrace"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+const retryBaseWait = 500 * time.Millisecond
+
+// retryTransport retries requests that were rate limited or could not be sent.
+// Queries are also retried after a transient server error or a failed
+// connection, while mutations are not, as they may already have been applied.
+// Each attempt, including the time spent in throttleTransport, is bounded by
+// timeout, and the wait between attempts follows the rate limit headers
+// returned by Linear, falling back to a jittered exponential backoff.
+type retryTransport struct {
+	maxAttempts int
+	maxWait     time.Duration
+	timeout     time.Duration
+	wrapped     http.RoundTripper
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+
+	if req.Body != nil {
+		var err error
+
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	mutation := isMutation(body)
+
+	for attempt := 1; ; attempt++ {
+		res, resBody, sent, err := t.attempt(req, body)
+
+		if err != nil {
+			if attempt >= t.maxAttempts || (sent && mutation) || req.Context().Err() != nil {
+				return nil, err
+			}
+
+			if err := t.wait(req, t.retryWait(http.Header{}, false, attempt)); err != nil {
+				return nil, err
+			}
+
+			continue
+		}
+
+		rateLimited := res.StatusCode == http.StatusTooManyRequests || isRateLimitedResponse(resBody)
+		serverError := res.StatusCode >= http.StatusInternalServerError && !mutation
+
+		if attempt >= t.maxAttempts || !(rateLimited || serverError) {
+			return res, nil
+		}
+
+		res.Body.Close()
+
+		if err := t.wait(req, t.retryWait(res.Header, rateLimited, attempt)); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// wait waits before the next attempt, unless the request is canceled first.
+func (t *retryTransport) wait(req *http.Request, wait time.Duration) error {
+	select {
+	case <-req.Context().Done():
+		return req.Context().Err()
+	case <-time.After(wait):
+		return nil
+	}
+}
+
+// attempt sends a copy of the request and buffers the response body, so that
+// the body can be inspected and the per-attempt timeout released. It also
+// reports whether the request was fully written, after which the API may have
+// acted on it even if the attempt failed.
+func (t *retryTransport) attempt(req *http.Request, body []byte) (*http.Response, []byte, bool, error) {
+	var sent atomic.Bool
+
+	ctx, cancel := context.WithTimeout(req.Context(), t.timeout)
+	defer cancel()
+
+	ctx = httptrace.WithClientTrace(ctx, &httptrace.ClientTrace{
+		WroteRequest: func(info httptrace.WroteRequestInfo) {
+			if info.Err == nil {
+				sent.Store(true)
+			}
+		},
+	})
+
+	attemptReq := req.Clone(ctx)
+
+	if body != nil {
+		attemptReq.Body = io.NopCloser(bytes.NewReader(body))
+		attemptReq.ContentLength = int64(len(body))
+	}
+
+	res, err := t.wrapped.RoundTrip(attemptReq)
+
+	if err != nil {
+		return nil, nil, sent.Load(), err
+	}
+
+	resBody, err := io.ReadAll(res.Body)
+	res.Body.Close()
+
+	if err != nil {
+		return nil, nil, true, err
+	}
+
+	res.Body = io.NopCloser(bytes.NewReader(resBody))
+
+	return res, resBody, true, nil
+}
+
+// retryWait returns how long to wait before the next attempt, never more than
+// maxWait. The rate limit reset header is only honoured for rate limited
+// responses, as Linear sends it with every response.
+func (t *retryTransport) retryWait(header http.Header, rateLimited bool, attempt int) time.Duration {
+	wait, ok := rateLimitWait(header, rateLimited, time.Now())
+
+	if !ok {
+		backoff := retryBaseWait << (attempt - 1)
+
+		if backoff <= 0 || backoff > t.maxWait {
+			backoff = t.maxWait
+		}
+
+		// Full jitter, so that parallel resource operations do not retry in lockstep.
+		wait = time.Duration(rand.Int63n(int64(backoff) + 1))
+	}
+
+	if wait > t.maxWait {
+		wait = t.maxWait
+	}
+
+	return wait
+}
+
+// isMutation reports whether the GraphQL request body holds a mutation.
+func isMutation(body []byte) bool {
+	var payload struct {
+		Query string `json:"query"`
+	}
+
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return false
+	}
+
+	for _, line := range strings.Split(payload.Query, "\n") {
+		line = strings.TrimSpace(line)
+
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		return strings.HasPrefix(line, "mutation")
+	}
+
+	return false
+}
+
+// isRateLimitedResponse reports whether the GraphQL response body carries a
+// `RATELIMITED` error, which Linear returns with a 400 status.
+func isRateLimitedResponse(body []byte) bool {
+	var payload struct {
+		Errors []struct {
+			Extensions struct {
+				Code string `json:"code"`
+			} `json:"extensions"`
+		} `json:"errors"`
+	}
+
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return false
+	}
+
+	for _, err := range payload.Errors {
+		if err.Extensions.Code == "RATELIMITED" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// rateLimitWait reads the wait requested by the API from the `Retry-After`
+// header (seconds or HTTP date) or, when rate limited, from
+// `X-RateLimit-Requests-Reset` (UTC epoch milliseconds).
+func rateLimitWait(header http.Header, rateLimited bool, now time.Time) (time.Duration, bool) {
+	if value := header.Get("Retry-After"); value != "" {
+		if seconds, err := strconv.ParseInt(value, 10, 64); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second, true
+		}
+
+		if date, err := http.ParseTime(value); err == nil {
+			return nonNegative(date.Sub(now)), true
+		}
+	}
+
+	if value := header.Get("X-RateLimit-Requests-Reset"); rateLimited && value != "" {
+		if millis, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return nonNegative(time.UnixMilli(millis).Sub(now)), true
+		}
+	}
+
+	return 0, false
+}
+
+func nonNegative(d time.Duration) time.Duration {
+	if d < 0 {
+		return 0
+	}
+
+	return d
+}
@@ -0,0 +1,314 @@
+package provider
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newTestRetryClient(maxAttempts int) *http.Client {
+	return &http.Client{
+		Transport: &retryTransport{
+			maxAttempts: maxAttempts,
+			maxWait:     10 * time.Millisecond,
+			timeout:     5 * time.Second,
+			wrapped:     http.DefaultTransport,
+		},
+	}
+}
+
+func TestRetryTransportRetriesTransientFailures(t *testing.T) {
+	responses := []func(w http.ResponseWriter){
+		func(w http.ResponseWriter) {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+		},
+		func(w http.ResponseWriter) {
+			w.WriteHeader(http.StatusBadGateway)
+		},
+		func(w http.ResponseWriter) {
+			w.WriteHeader(http.StatusBadRequest)
+			io.WriteString(w, `{"errors":[{"message":"Rate limit exceeded","extensions":{"code":"RATELIMITED"}}]}`)
+		},
+		func(w http.ResponseWriter) {
+			io.WriteString(w, `{"data":{}}`)
+		},
+	}
+
+	var bodies []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+
+		responses[len(bodies)-1](w)
+	}))
+	defer server.Close()
+
+	res, err := newTestRetryClient(5).Post(server.URL, "application/json", strings.NewReader(`{"query":"{}"}`))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	defer res.Body.Close()
+
+	body, _ := io.ReadAll(res.Body)
+
+	if res.StatusCode != http.StatusOK || string(body) != `{"data":{}}` {
+		t.Fatalf("unexpected final response %d: %s", res.StatusCode, body)
+	}
+
+	if len(bodies) != 4 {
+		t.Fatalf("expected 4 attempts, got %d", len(bodies))
+	}
+
+	for _, b := range bodies {
+		if b != `{"query":"{}"}` {
+			t.Fatalf("expected request body to be replayed, got %q", b)
+		}
+	}
+}
+
+func TestRetryTransportStopsAfterMaxAttempts(t *testing.T) {
+	attempts := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	res, err := newTestRetryClient(3).Post(server.URL, "application/json", strings.NewReader(`{}`))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	res.Body.Close()
+
+	if res.StatusCode != http.StatusServiceUnavailable || attempts != 3 {
+		t.Fatalf("expected 3 attempts ending in 503, got %d attempts ending in %d", attempts, res.StatusCode)
+	}
+}
+
+func TestRetryTransportDoesNotRetryClientErrors(t *testing.T) {
+	attempts := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusBadRequest)
+		io.WriteString(w, `{"errors":[{"message":"Entity not found"}]}`)
+	}))
+	defer server.Close()
+
+	res, err := newTestRetryClient(5).Post(server.URL, "application/json", strings.NewReader(`{}`))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	res.Body.Close()
+
+	if attempts != 1 {
+		t.Fatalf("expected a single attempt, got %d", attempts)
+	}
+}
+
+const testMutation = `{"query":"\nmutation updateTeam ($id: String!) {\n\tteamUpdate(id: $id) {\n\t\tsuccess\n\t}\n}\n"}`
+
+func TestRetryTransportDoesNotRetryMutationServerErrors(t *testing.T) {
+	attempts := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	res, err := newTestRetryClient(5).Post(server.URL, "application/json", strings.NewReader(testMutation))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	res.Body.Close()
+
+	if res.StatusCode != http.StatusBadGateway || attempts != 1 {
+		t.Fatalf("expected a single attempt ending in 502, got %d attempts ending in %d", attempts, res.StatusCode)
+	}
+}
+
+func TestRetryTransportRetriesRateLimitedMutations(t *testing.T) {
+	attempts := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+
+		if attempts == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		io.WriteString(w, `{"data":{}}`)
+	}))
+	defer server.Close()
+
+	res, err := newTestRetryClient(5).Post(server.URL, "application/json", strings.NewReader(testMutation))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	res.Body.Close()
+
+	if res.StatusCode != http.StatusOK || attempts != 2 {
+		t.Fatalf("expected 2 attempts ending in 200, got %d attempts ending in %d", attempts, res.StatusCode)
+	}
+}
+
+func TestRetryTransportRetriesUnsentRequests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, `{"data":{}}`)
+	}))
+	defer server.Close()
+
+	attempts := 0
+
+	client := &http.Client{
+		Transport: &retryTransport{
+			maxAttempts: 5,
+			maxWait:     10 * time.Millisecond,
+			timeout:     5 * time.Second,
+			wrapped: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				attempts++
+
+				if attempts == 1 {
+					return nil, errors.New("connection refused")
+				}
+
+				return http.DefaultTransport.RoundTrip(req)
+			}),
+		},
+	}
+
+	res, err := client.Post(server.URL, "application/json", strings.NewReader(testMutation))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	res.Body.Close()
+
+	if res.StatusCode != http.StatusOK || attempts != 2 {
+		t.Fatalf("expected 2 attempts ending in 200, got %d attempts ending in %d", attempts, res.StatusCode)
+	}
+}
+
+func TestRetryTransportDoesNotRetrySentMutations(t *testing.T) {
+	var attempts atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		io.ReadAll(r.Body)
+
+		// Drop the connection once the mutation was received
+		conn, _, _ := w.(http.Hijacker).Hijack()
+		conn.Close()
+	}))
+	defer server.Close()
+
+	_, err := newTestRetryClient(5).Post(server.URL, "application/json", strings.NewReader(testMutation))
+
+	if err == nil {
+		t.Fatalf("expected an error")
+	}
+
+	if attempts.Load() != 1 {
+		t.Fatalf("expected a single attempt, got %d", attempts.Load())
+	}
+}
+
+func TestRetryTransportRetriesSentQueries(t *testing.T) {
+	var attempts atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.ReadAll(r.Body)
+
+		// Drop the connection once the first query was received
+		if attempts.Add(1) == 1 {
+			conn, _, _ := w.(http.Hijacker).Hijack()
+			conn.Close()
+			return
+		}
+
+		io.WriteString(w, `{"data":{}}`)
+	}))
+	defer server.Close()
+
+	res, err := newTestRetryClient(5).Post(server.URL, "application/json", strings.NewReader(`{"query":"{ viewer { id } }"}`))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	res.Body.Close()
+
+	if res.StatusCode != http.StatusOK || attempts.Load() != 2 {
+		t.Fatalf("expected 2 attempts ending in 200, got %d attempts ending in %d", attempts.Load(), res.StatusCode)
+	}
+}
+
+func TestIsMutation(t *testing.T) {
+	cases := map[string]bool{
+		testMutation: true,
+		`{"query":"\nquery getTeam ($key: String!) {\n\tteam(id: $key) {\n\t\tid\n\t}\n}\n"}`: false,
+		`{"query":"{ viewer { id } }"}`: false,
+		`not json`:                      false,
+	}
+
+	for body, expected := range cases {
+		if isMutation([]byte(body)) != expected {
+			t.Errorf("expected isMutation to be %t for %s", expected, body)
+		}
+	}
+}
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestRateLimitWait(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name        string
+		header      http.Header
+		rateLimited bool
+		wait        time.Duration
+		ok          bool
+	}{
+		{"none", http.Header{}, true, 0, false},
+		{"retry after seconds", http.Header{"Retry-After": {"3"}}, false, 3 * time.Second, true},
+		{"retry after date", http.Header{"Retry-After": {now.Add(2 * time.Second).Format(http.TimeFormat)}}, false, 2 * time.Second, true},
+		{"reset when rate limited", http.Header{"X-Ratelimit-Requests-Reset": {strconv.FormatInt(now.Add(1500*time.Millisecond).UnixMilli(), 10)}}, true, 1500 * time.Millisecond, true},
+		{"reset when not rate limited", http.Header{"X-Ratelimit-Requests-Reset": {strconv.FormatInt(now.Add(time.Hour).UnixMilli(), 10)}}, false, 0, false},
+		{"reset in the past", http.Header{"X-Ratelimit-Requests-Reset": {strconv.FormatInt(now.Add(-time.Hour).UnixMilli(), 10)}}, true, 0, true},
+	}
+
+	for _, c := range cases {
+		wait, ok := rateLimitWait(c.header, c.rateLimited, now)
+
+		if wait != c.wait || ok != c.ok {
+			t.Errorf("%s: expected (%s, %t), got (%s, %t)", c.name, c.wait, c.ok, wait, ok)
+		}
+	}
+}
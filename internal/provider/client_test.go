@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestHttpTransportProxy(t *testing.T) {
+	transport, err := newHttpTransport("http://proxy.example.com:3128", "")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	req, _ := http.NewRequest(http.MethodPost, defaultApiUrl, nil)
+	proxy, err := transport.Proxy(req)
+
+	if err != nil || proxy == nil || proxy.Host != "proxy.example.com:3128" {
+		t.Fatalf("expected request to be proxied, got %v (%v)", proxy, err)
+	}
+
+	if _, err := newHttpTransport("not a url", ""); err == nil {
+		t.Fatal("expected error for invalid proxy URL")
+	}
+}
+
+func TestHttpTransportCaBundle(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	// Without the bundle the self-signed server certificate is not trusted.
+	transport, err := newHttpTransport("", "")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := (&http.Client{Transport: transport}).Get(server.URL); err == nil {
+		t.Fatal("expected certificate verification to fail")
+	}
+
+	bundle := filepath.Join(t.TempDir(), "ca.pem")
+	block := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	if err := os.WriteFile(bundle, block, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	transport, err = newHttpTransport("", bundle)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	res, err := (&http.Client{Transport: transport}).Get(server.URL)
+
+	if err != nil {
+		t.Fatalf("expected request to succeed with CA bundle, got: %s", err)
+	}
+
+	res.Body.Close()
+
+	if _, err := newHttpTransport("", filepath.Join(t.TempDir(), "missing.pem")); err == nil {
+		t.Fatal("expected error for missing CA bundle")
+	}
+}
@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// throttleThreshold is the share of a rate limit budget below which requests
+// are spread out until the budget resets.
+const throttleThreshold = 0.1
+
+// throttleTransport optionally limits the number of requests in flight, and
+// slows requests down once the request or complexity budget of the API is
+// running low, so that large plans do not get rate limited. It sits below
+// retryTransport, so that a request waiting to be retried does not hold a slot.
+type throttleTransport struct {
+	slots   chan struct{}
+	wrapped http.RoundTripper
+
+	mu    sync.Mutex
+	next  time.Time
+	delay time.Duration
+	now   func() time.Time
+}
+
+// newThrottleTransport returns a throttleTransport sending at most
+// maxConcurrent requests at the same time, or any number of them when
+// maxConcurrent is 0.
+func newThrottleTransport(maxConcurrent int, wrapped http.RoundTripper) *throttleTransport {
+	t := &throttleTransport{
+		wrapped: wrapped,
+		now:     time.Now,
+	}
+
+	if maxConcurrent > 0 {
+		t.slots = make(chan struct{}, maxConcurrent)
+	}
+
+	return t
+}
+
+func (t *throttleTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	if t.slots != nil {
+		select {
+		case t.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+
+		defer func() { <-t.slots }()
+	}
+
+	if wait := t.schedule(); wait > 0 {
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	res, err := t.wrapped.RoundTrip(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	t.mu.Lock()
+	t.delay = throttleDelay(res.Header, t.now())
+	t.mu.Unlock()
+
+	return res, nil
+}
+
+// schedule reserves the next time a request can be sent at, and returns how
+// long to wait until then.
+func (t *throttleTransport) schedule() time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.now()
+	start := t.next
+
+	if start.Before(now) {
+		start = now
+	}
+
+	t.next = start.Add(t.delay)
+
+	return start.Sub(now)
+}
+
+// throttleDelay returns how long to leave between requests so that the budget
+// described by the rate limit headers lasts until it resets. There is no delay
+// while more than throttleThreshold of the budget is left.
+func throttleDelay(header http.Header, now time.Time) time.Duration {
+	delay := budgetDelay(header, "Requests", 1, now)
+
+	cost, err := strconv.ParseFloat(header.Get("X-Complexity"), 64)
+
+	if err != nil || cost < 1 {
+		cost = 1
+	}
+
+	if complexityDelay := budgetDelay(header, "Complexity", cost, now); complexityDelay > delay {
+		delay = complexityDelay
+	}
+
+	return delay
+}
+
+func budgetDelay(header http.Header, budget string, cost float64, now time.Time) time.Duration {
+	limit, limitErr := strconv.ParseFloat(header.Get("X-RateLimit-"+budget+"-Limit"), 64)
+	remaining, remainingErr := strconv.ParseFloat(header.Get("X-RateLimit-"+budget+"-Remaining"), 64)
+	reset, resetErr := strconv.ParseInt(header.Get("X-RateLimit-"+budget+"-Reset"), 10, 64)
+
+	if limitErr != nil || remainingErr != nil || resetErr != nil || remaining > limit*throttleThreshold {
+		return 0
+	}
+
+	untilReset := nonNegative(time.UnixMilli(reset).Sub(now))
+	left := remaining / cost
+
+	if left < 1 {
+		return untilReset
+	}
+
+	return time.Duration(float64(untilReset) / left)
+}
@@ -0,0 +1,238 @@
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestThrottleTransportConcurrency(t *testing.T) {
+	var inFlight, maxInFlight int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+
+			if current <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, current) {
+				break
+			}
+		}
+
+		time.Sleep(20 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	client := http.Client{Transport: newThrottleTransport(2, http.DefaultTransport)}
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			resp, err := client.Get(server.URL)
+
+			if err != nil {
+				t.Errorf("unexpected error: %s", err)
+				return
+			}
+
+			resp.Body.Close()
+		}()
+	}
+
+	wg.Wait()
+
+	if maxInFlight != 2 {
+		t.Errorf("expected at most 2 requests in flight, got %d", maxInFlight)
+	}
+}
+
+func TestThrottleTransportSchedule(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+
+	transport := newThrottleTransport(1, nil)
+	transport.now = func() time.Time { return now }
+	transport.delay = time.Second
+
+	for i, expected := range []time.Duration{0, time.Second, 2 * time.Second} {
+		if wait := transport.schedule(); wait != expected {
+			t.Errorf("request %d: expected a wait of %s, got %s", i, expected, wait)
+		}
+	}
+}
+
+func TestThrottleDelay(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	reset := strconv.FormatInt(now.Add(time.Minute).UnixMilli(), 10)
+
+	tests := []struct {
+		name     string
+		header   http.Header
+		expected time.Duration
+	}{
+		{
+			name:     "no headers",
+			header:   http.Header{},
+			expected: 0,
+		},
+		{
+			name: "plenty of budget left",
+			header: http.Header{
+				"X-Ratelimit-Requests-Limit":     {"1500"},
+				"X-Ratelimit-Requests-Remaining": {"1000"},
+				"X-Ratelimit-Requests-Reset":     {reset},
+			},
+			expected: 0,
+		},
+		{
+			name: "requests running low",
+			header: http.Header{
+				"X-Ratelimit-Requests-Limit":     {"1500"},
+				"X-Ratelimit-Requests-Remaining": {"60"},
+				"X-Ratelimit-Requests-Reset":     {reset},
+			},
+			expected: time.Second,
+		},
+		{
+			name: "complexity running low",
+			header: http.Header{
+				"X-Ratelimit-Requests-Limit":       {"1500"},
+				"X-Ratelimit-Requests-Remaining":   {"1000"},
+				"X-Ratelimit-Requests-Reset":       {reset},
+				"X-Ratelimit-Complexity-Limit":     {"250000"},
+				"X-Ratelimit-Complexity-Remaining": {"6000"},
+				"X-Ratelimit-Complexity-Reset":     {reset},
+				"X-Complexity":                     {"200"},
+			},
+			expected: 2 * time.Second,
+		},
+		{
+			name: "complexity exhausted",
+			header: http.Header{
+				"X-Ratelimit-Complexity-Limit":     {"250000"},
+				"X-Ratelimit-Complexity-Remaining": {"100"},
+				"X-Ratelimit-Complexity-Reset":     {reset},
+				"X-Complexity":                     {"200"},
+			},
+			expected: time.Minute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if delay := throttleDelay(tt.header, now); delay != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, delay)
+			}
+		})
+	}
+}
+
+func TestThrottleTransportUnlimited(t *testing.T) {
+	const requests = 10
+
+	var arrived int32
+	all := make(chan struct{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&arrived, 1) == requests {
+			close(all)
+		}
+
+		select {
+		case <-all:
+		case <-time.After(time.Second):
+			w.WriteHeader(http.StatusGatewayTimeout)
+		}
+	}))
+	defer server.Close()
+
+	client := http.Client{Transport: newThrottleTransport(0, http.DefaultTransport)}
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < requests; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			resp, err := client.Get(server.URL)
+
+			if err != nil {
+				t.Errorf("unexpected error: %s", err)
+				return
+			}
+
+			resp.Body.Close()
+
+			if resp.StatusCode != http.StatusOK {
+				t.Errorf("expected all %d requests to be in flight at the same time", requests)
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+func TestThrottleTransportReleasesSlotWhileRetrying(t *testing.T) {
+	var limited atomic.Bool
+	rateLimited := make(chan struct{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/limited" && limited.CompareAndSwap(false, true) {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			close(rateLimited)
+		}
+	}))
+	defer server.Close()
+
+	client := http.Client{Transport: &retryTransport{
+		maxAttempts: 2,
+		maxWait:     time.Second,
+		timeout:     5 * time.Second,
+		wrapped:     newThrottleTransport(1, http.DefaultTransport),
+	}}
+
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		resp, err := client.Get(server.URL + "/limited")
+
+		if err != nil {
+			t.Errorf("unexpected error: %s", err)
+			return
+		}
+
+		resp.Body.Close()
+	}()
+
+	<-rateLimited
+
+	start := time.Now()
+	resp, err := client.Get(server.URL + "/other")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	resp.Body.Close()
+
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("expected the request to be sent while the other one waits to be retried, took %s", elapsed)
+	}
+
+	<-done
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Khan/genqlient/graphql"
+)
+
+// uploadingClient is the API client handed to the resources, which also
+// uploads files to the storage URLs handed out by the `fileUpload` mutation.
+// Uploads are sent without the API credentials, as the URLs are signed.
+type uploadingClient struct {
+	graphql.Client
+
+	uploads *http.Client
+}
+
+// uploadFile uploads a public file through the `fileUpload` mutation, and
+// returns the URL the file is served from.
+func uploadFile(ctx context.Context, client graphql.Client, filename string, contentType string, content []byte) (string, error) {
+	uploader, ok := client.(*uploadingClient)
+
+	if !ok {
+		return "", fmt.Errorf("the client of the provider does not support file uploads")
+	}
+
+	response, err := createFileUpload(ctx, client, filename, contentType, len(content), true)
+
+	if err != nil {
+		return "", err
+	}
+
+	file := response.FileUpload.UploadFile
+
+	if file == nil {
+		return "", fmt.Errorf("no upload URL was returned for %s", filename)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, file.UploadUrl, bytes.NewReader(content))
+
+	if err != nil {
+		return "", err
+	}
+
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Cache-Control", "public, max-age=31536000")
+
+	for _, header := range file.Headers {
+		req.Header.Set(header.Key, header.Value)
+	}
+
+	resp, err := uploader.uploads.Do(req)
+
+	if err != nil {
+		return "", err
+	}
+
+	resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return "", fmt.Errorf("upload of %s failed with status %s", filename, resp.Status)
+	}
+
+	return file.AssetUrl, nil
+}
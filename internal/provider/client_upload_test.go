@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Khan/genqlient/graphql"
+)
+
+// uploadResponseClient answers the `fileUpload` mutation with the given
+// upload URL.
+type uploadResponseClient struct {
+	uploadUrl string
+}
+
+func (c *uploadResponseClient) MakeRequest(ctx context.Context, req *graphql.Request, resp *graphql.Response) error {
+	data, _ := json.Marshal(map[string]interface{}{
+		"fileUpload": map[string]interface{}{
+			"uploadFile": map[string]interface{}{
+				"uploadUrl": c.uploadUrl,
+				"assetUrl":  "https://uploads.example.com/emoji.png",
+				"headers": []map[string]interface{}{
+					{"key": "x-goog-content-length-range", "value": "0,5"},
+				},
+			},
+		},
+	})
+
+	return json.Unmarshal(data, resp.Data)
+}
+
+func TestUploadFile(t *testing.T) {
+	var uploaded *http.Request
+	var content []byte
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		uploaded = r
+		content, _ = io.ReadAll(r.Body)
+	}))
+	defer server.Close()
+
+	client := &uploadingClient{
+		Client:  &uploadResponseClient{uploadUrl: server.URL + "/upload"},
+		uploads: server.Client(),
+	}
+
+	url, err := uploadFile(context.Background(), client, "emoji.png", "image/png", []byte("image"))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if url != "https://uploads.example.com/emoji.png" {
+		t.Errorf("expected the asset URL, got %q", url)
+	}
+
+	if uploaded.Method != http.MethodPut || string(content) != "image" {
+		t.Errorf("expected the file to be PUT, got %s with %q", uploaded.Method, content)
+	}
+
+	if uploaded.Header.Get("Content-Type") != "image/png" || uploaded.Header.Get("X-Goog-Content-Length-Range") != "0,5" {
+		t.Errorf("expected the upload headers to be set, got %v", uploaded.Header)
+	}
+
+	if uploaded.Header.Get("Authorization") != "" {
+		t.Errorf("expected the upload to be sent without credentials")
+	}
+}
+
+func TestUploadFileFailed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	client := &uploadingClient{
+		Client:  &uploadResponseClient{uploadUrl: server.URL + "/upload"},
+		uploads: server.Client(),
+	}
+
+	if _, err := uploadFile(context.Background(), client, "emoji.png", "image/png", []byte("image")); err == nil {
+		t.Errorf("expected an error for a failed upload")
+	}
+
+	if _, err := uploadFile(context.Background(), client.Client, "emoji.png", "image/png", []byte("image")); err == nil {
+		t.Errorf("expected an error for a client that does not upload files")
+	}
+}
@@ -81,6 +81,11 @@ func (d *WorkspaceDataSource) Read(ctx context.Context, req datasource.ReadReque
 
 	response, err := getWorkspace(ctx, *d.client)
 
+	if isNotFound(err) {
+		resp.Diagnostics.AddError("Workspace Not Found", fmt.Sprintf("Unable to find the workspace of the credentials, got error: %s", err))
+		return
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workspace, got error: %s", err))
 		return
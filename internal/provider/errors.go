@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// graphqlErrors returns the GraphQL errors carried by an error returned from a
+// genqlient operation. Linear answers most failed operations with a non-200
+// status, in which case genqlient only returns the raw body as part of the
+// error message, so that body is decoded as well.
+func graphqlErrors(err error) gqlerror.List {
+	var list gqlerror.List
+
+	if errors.As(err, &list) {
+		return list
+	}
+
+	var single *gqlerror.Error
+
+	if errors.As(err, &single) {
+		return gqlerror.List{single}
+	}
+
+	message := err.Error()
+	status := strings.Index(message, "returned error ")
+
+	if status < 0 {
+		return nil
+	}
+
+	start := strings.Index(message[status:], "{")
+
+	if start < 0 {
+		return nil
+	}
+
+	start += status
+
+	var body struct {
+		Errors gqlerror.List `json:"errors"`
+	}
+
+	if json.Unmarshal([]byte(message[start:]), &body) != nil {
+		return nil
+	}
+
+	return body.Errors
+}
+
+// isNotFound reports whether the error means that the requested entity does
+// not exist (anymore), for example because it was deleted outside of Terraform.
+func isNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	for _, gqlErr := range graphqlErrors(err) {
+		if strings.HasPrefix(gqlErr.Message, "Entity not found") {
+			return true
+		}
+
+		if message, ok := gqlErr.Extensions["userPresentableMessage"].(string); ok && strings.HasPrefix(message, "Could not find referenced") {
+			return true
+		}
+	}
+
+	return false
+}
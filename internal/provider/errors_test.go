@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+func TestIsNotFound(t *testing.T) {
+	cases := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{"nil", nil, false},
+		{"unrelated", errors.New("connection refused"), false},
+		{"graphql list", gqlerror.List{{Message: "Entity not found: Template"}}, true},
+		{"graphql list other", gqlerror.List{{Message: "Argument Validation Error"}}, false},
+		{"http status", errors.New(`returned error 400 Bad Request: {"errors":[{"message":"Entity not found: IssueLabel","extensions":{"code":"INVALID_INPUT"}}]}`), true},
+		{"http status presentable", errors.New(`returned error 400 Bad Request: {"errors":[{"message":"Invalid input","extensions":{"userPresentableMessage":"Could not find referenced Team."}}]}`), true},
+		{"http status other", errors.New(`returned error 400 Bad Request: {"errors":[{"message":"Rate limit exceeded","extensions":{"code":"RATELIMITED"}}]}`), false},
+		{"wrapped", fmt.Errorf("unable to get team workflow: %w", errors.New(`returned error 400 Bad Request: {"errors":[{"message":"Entity not found: Team"}]}`)), true},
+		{"http status not json", errors.New(`returned error 502 Bad Gateway: <html></html>`), false},
+	}
+
+	for _, c := range cases {
+		if actual := isNotFound(c.err); actual != c.expected {
+			t.Errorf("%s: expected %t, got %t", c.name, c.expected, actual)
+		}
+	}
+}
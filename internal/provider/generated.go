@@ -10,6 +10,344 @@ import (
 	"github.com/Khan/genqlient/graphql"
 )
 
+// CustomView includes the GraphQL fields of CustomView requested by the fragment CustomView.
+// The GraphQL type's documentation follows.
+//
+// A custom view that has been saved by a user.
+type CustomView struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The name of the custom view.
+	Name string `json:"name"`
+	// The description of the custom view.
+	Description *string `json:"description"`
+	// The icon of the custom view.
+	Icon *string `json:"icon"`
+	// The color of the icon of the custom view.
+	Color *string `json:"color"`
+	// The team associated with the custom view.
+	Team *CustomViewTeam `json:"team"`
+	// Whether the custom view is shared with everyone in the organization.
+	Shared bool `json:"shared"`
+	// The filter applied to issues in the custom view.
+	FilterData map[string]interface{} `json:"filterData"`
+	// The filter applied to projects in the custom view.
+	ProjectFilterData map[string]interface{} `json:"projectFilterData"`
+}
+
+// GetId returns CustomView.Id, and is useful for accessing the field via an interface.
+func (v *CustomView) GetId() string { return v.Id }
+
+// GetName returns CustomView.Name, and is useful for accessing the field via an interface.
+func (v *CustomView) GetName() string { return v.Name }
+
+// GetDescription returns CustomView.Description, and is useful for accessing the field via an interface.
+func (v *CustomView) GetDescription() *string { return v.Description }
+
+// GetIcon returns CustomView.Icon, and is useful for accessing the field via an interface.
+func (v *CustomView) GetIcon() *string { return v.Icon }
+
+// GetColor returns CustomView.Color, and is useful for accessing the field via an interface.
+func (v *CustomView) GetColor() *string { return v.Color }
+
+// GetTeam returns CustomView.Team, and is useful for accessing the field via an interface.
+func (v *CustomView) GetTeam() *CustomViewTeam { return v.Team }
+
+// GetShared returns CustomView.Shared, and is useful for accessing the field via an interface.
+func (v *CustomView) GetShared() bool { return v.Shared }
+
+// GetFilterData returns CustomView.FilterData, and is useful for accessing the field via an interface.
+func (v *CustomView) GetFilterData() map[string]interface{} { return v.FilterData }
+
+// GetProjectFilterData returns CustomView.ProjectFilterData, and is useful for accessing the field via an interface.
+func (v *CustomView) GetProjectFilterData() map[string]interface{} { return v.ProjectFilterData }
+
+type CustomViewCreateInput struct {
+	// The identifier in UUID v4 format. If none is provided, the backend will generate one.
+	Id string `json:"id,omitempty"`
+	// The name of the custom view.
+	Name string `json:"name"`
+	// The description of the custom view.
+	Description *string `json:"description"`
+	// The icon of the custom view.
+	Icon *string `json:"icon"`
+	// The color of the icon of the custom view.
+	Color *string `json:"color"`
+	// The id of the team associated with the custom view.
+	TeamId *string `json:"teamId"`
+	// The id of the project associated with the custom view.
+	ProjectId *string `json:"projectId,omitempty"`
+	// The id of the initiative associated with the custom view.
+	InitiativeId *string `json:"initiativeId,omitempty"`
+	// The owner of the custom view.
+	OwnerId *string `json:"ownerId,omitempty"`
+	// The filter applied to issues in the custom view.
+	FilterData *map[string]interface{} `json:"filterData,omitempty"`
+	// The project filter applied to issues in the custom view.
+	ProjectFilterData *map[string]interface{} `json:"projectFilterData,omitempty"`
+	// The feed item filter applied to issues in the custom view.
+	FeedItemFilterData *map[string]interface{} `json:"feedItemFilterData,omitempty"`
+	// Whether the custom view is shared with everyone in the organization.
+	Shared bool `json:"shared"`
+}
+
+// GetId returns CustomViewCreateInput.Id, and is useful for accessing the field via an interface.
+func (v *CustomViewCreateInput) GetId() string { return v.Id }
+
+// GetName returns CustomViewCreateInput.Name, and is useful for accessing the field via an interface.
+func (v *CustomViewCreateInput) GetName() string { return v.Name }
+
+// GetDescription returns CustomViewCreateInput.Description, and is useful for accessing the field via an interface.
+func (v *CustomViewCreateInput) GetDescription() *string { return v.Description }
+
+// GetIcon returns CustomViewCreateInput.Icon, and is useful for accessing the field via an interface.
+func (v *CustomViewCreateInput) GetIcon() *string { return v.Icon }
+
+// GetColor returns CustomViewCreateInput.Color, and is useful for accessing the field via an interface.
+func (v *CustomViewCreateInput) GetColor() *string { return v.Color }
+
+// GetTeamId returns CustomViewCreateInput.TeamId, and is useful for accessing the field via an interface.
+func (v *CustomViewCreateInput) GetTeamId() *string { return v.TeamId }
+
+// GetProjectId returns CustomViewCreateInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *CustomViewCreateInput) GetProjectId() *string { return v.ProjectId }
+
+// GetInitiativeId returns CustomViewCreateInput.InitiativeId, and is useful for accessing the field via an interface.
+func (v *CustomViewCreateInput) GetInitiativeId() *string { return v.InitiativeId }
+
+// GetOwnerId returns CustomViewCreateInput.OwnerId, and is useful for accessing the field via an interface.
+func (v *CustomViewCreateInput) GetOwnerId() *string { return v.OwnerId }
+
+// GetFilterData returns CustomViewCreateInput.FilterData, and is useful for accessing the field via an interface.
+func (v *CustomViewCreateInput) GetFilterData() *map[string]interface{} { return v.FilterData }
+
+// GetProjectFilterData returns CustomViewCreateInput.ProjectFilterData, and is useful for accessing the field via an interface.
+func (v *CustomViewCreateInput) GetProjectFilterData() *map[string]interface{} {
+	return v.ProjectFilterData
+}
+
+// GetFeedItemFilterData returns CustomViewCreateInput.FeedItemFilterData, and is useful for accessing the field via an interface.
+func (v *CustomViewCreateInput) GetFeedItemFilterData() *map[string]interface{} {
+	return v.FeedItemFilterData
+}
+
+// GetShared returns CustomViewCreateInput.Shared, and is useful for accessing the field via an interface.
+func (v *CustomViewCreateInput) GetShared() bool { return v.Shared }
+
+// CustomViewTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type CustomViewTeam struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
+
+// GetId returns CustomViewTeam.Id, and is useful for accessing the field via an interface.
+func (v *CustomViewTeam) GetId() string { return v.Id }
+
+type CustomViewUpdateInput struct {
+	// The name of the custom view.
+	Name string `json:"name,omitempty"`
+	// The description of the custom view.
+	Description *string `json:"description"`
+	// The icon of the custom view.
+	Icon *string `json:"icon"`
+	// The color of the icon of the custom view.
+	Color *string `json:"color"`
+	// The id of the team associated with the custom view.
+	TeamId *string `json:"teamId"`
+	// [Internal] The id of the project associated with the custom view.
+	ProjectId *string `json:"projectId,omitempty"`
+	// [Internal] The id of the initiative associated with the custom view.
+	InitiativeId *string `json:"initiativeId,omitempty"`
+	// The owner of the custom view.
+	OwnerId *string `json:"ownerId,omitempty"`
+	// The filter applied to issues in the custom view.
+	FilterData *map[string]interface{} `json:"filterData,omitempty"`
+	// The project filter applied to issues in the custom view.
+	ProjectFilterData *map[string]interface{} `json:"projectFilterData,omitempty"`
+	// The feed item filter applied to issues in the custom view.
+	FeedItemFilterData *map[string]interface{} `json:"feedItemFilterData,omitempty"`
+	// Whether the custom view is shared with everyone in the organization.
+	Shared bool `json:"shared"`
+}
+
+// GetName returns CustomViewUpdateInput.Name, and is useful for accessing the field via an interface.
+func (v *CustomViewUpdateInput) GetName() string { return v.Name }
+
+// GetDescription returns CustomViewUpdateInput.Description, and is useful for accessing the field via an interface.
+func (v *CustomViewUpdateInput) GetDescription() *string { return v.Description }
+
+// GetIcon returns CustomViewUpdateInput.Icon, and is useful for accessing the field via an interface.
+func (v *CustomViewUpdateInput) GetIcon() *string { return v.Icon }
+
+// GetColor returns CustomViewUpdateInput.Color, and is useful for accessing the field via an interface.
+func (v *CustomViewUpdateInput) GetColor() *string { return v.Color }
+
+// GetTeamId returns CustomViewUpdateInput.TeamId, and is useful for accessing the field via an interface.
+func (v *CustomViewUpdateInput) GetTeamId() *string { return v.TeamId }
+
+// GetProjectId returns CustomViewUpdateInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *CustomViewUpdateInput) GetProjectId() *string { return v.ProjectId }
+
+// GetInitiativeId returns CustomViewUpdateInput.InitiativeId, and is useful for accessing the field via an interface.
+func (v *CustomViewUpdateInput) GetInitiativeId() *string { return v.InitiativeId }
+
+// GetOwnerId returns CustomViewUpdateInput.OwnerId, and is useful for accessing the field via an interface.
+func (v *CustomViewUpdateInput) GetOwnerId() *string { return v.OwnerId }
+
+// GetFilterData returns CustomViewUpdateInput.FilterData, and is useful for accessing the field via an interface.
+func (v *CustomViewUpdateInput) GetFilterData() *map[string]interface{} { return v.FilterData }
+
+// GetProjectFilterData returns CustomViewUpdateInput.ProjectFilterData, and is useful for accessing the field via an interface.
+func (v *CustomViewUpdateInput) GetProjectFilterData() *map[string]interface{} {
+	return v.ProjectFilterData
+}
+
+// GetFeedItemFilterData returns CustomViewUpdateInput.FeedItemFilterData, and is useful for accessing the field via an interface.
+func (v *CustomViewUpdateInput) GetFeedItemFilterData() *map[string]interface{} {
+	return v.FeedItemFilterData
+}
+
+// GetShared returns CustomViewUpdateInput.Shared, and is useful for accessing the field via an interface.
+func (v *CustomViewUpdateInput) GetShared() bool { return v.Shared }
+
+// Cycle includes the GraphQL fields of Cycle requested by the fragment Cycle.
+// The GraphQL type's documentation follows.
+//
+// A set of issues to be resolved in a specified amount of time.
+type Cycle struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The number of the cycle.
+	Number float64 `json:"number"`
+	// The custom name of the cycle.
+	Name *string `json:"name"`
+	// The cycle's description.
+	Description *string `json:"description"`
+	// The start time of the cycle.
+	StartsAt time.Time `json:"startsAt"`
+	// The end time of the cycle.
+	EndsAt time.Time `json:"endsAt"`
+	// The team that the cycle is associated with.
+	Team CycleTeam `json:"team"`
+	// The time at which the entity was archived. Null if the entity has not been archived.
+	ArchivedAt *time.Time `json:"archivedAt"`
+}
+
+// GetId returns Cycle.Id, and is useful for accessing the field via an interface.
+func (v *Cycle) GetId() string { return v.Id }
+
+// GetNumber returns Cycle.Number, and is useful for accessing the field via an interface.
+func (v *Cycle) GetNumber() float64 { return v.Number }
+
+// GetName returns Cycle.Name, and is useful for accessing the field via an interface.
+func (v *Cycle) GetName() *string { return v.Name }
+
+// GetDescription returns Cycle.Description, and is useful for accessing the field via an interface.
+func (v *Cycle) GetDescription() *string { return v.Description }
+
+// GetStartsAt returns Cycle.StartsAt, and is useful for accessing the field via an interface.
+func (v *Cycle) GetStartsAt() time.Time { return v.StartsAt }
+
+// GetEndsAt returns Cycle.EndsAt, and is useful for accessing the field via an interface.
+func (v *Cycle) GetEndsAt() time.Time { return v.EndsAt }
+
+// GetTeam returns Cycle.Team, and is useful for accessing the field via an interface.
+func (v *Cycle) GetTeam() CycleTeam { return v.Team }
+
+// GetArchivedAt returns Cycle.ArchivedAt, and is useful for accessing the field via an interface.
+func (v *Cycle) GetArchivedAt() *time.Time { return v.ArchivedAt }
+
+type CycleCreateInput struct {
+	// The identifier in UUID v4 format. If none is provided, the backend will generate one.
+	Id string `json:"id,omitempty"`
+	// The custom name of the cycle.
+	Name *string `json:"name"`
+	// The description of the cycle.
+	Description *string `json:"description"`
+	// The team to associate the cycle with.
+	TeamId string `json:"teamId"`
+	// The start date of the cycle.
+	StartsAt time.Time `json:"startsAt"`
+	// The end date of the cycle.
+	EndsAt time.Time `json:"endsAt"`
+	// The completion time of the cycle. If null, the cycle hasn't been completed.
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+}
+
+// GetId returns CycleCreateInput.Id, and is useful for accessing the field via an interface.
+func (v *CycleCreateInput) GetId() string { return v.Id }
+
+// GetName returns CycleCreateInput.Name, and is useful for accessing the field via an interface.
+func (v *CycleCreateInput) GetName() *string { return v.Name }
+
+// GetDescription returns CycleCreateInput.Description, and is useful for accessing the field via an interface.
+func (v *CycleCreateInput) GetDescription() *string { return v.Description }
+
+// GetTeamId returns CycleCreateInput.TeamId, and is useful for accessing the field via an interface.
+func (v *CycleCreateInput) GetTeamId() string { return v.TeamId }
+
+// GetStartsAt returns CycleCreateInput.StartsAt, and is useful for accessing the field via an interface.
+func (v *CycleCreateInput) GetStartsAt() time.Time { return v.StartsAt }
+
+// GetEndsAt returns CycleCreateInput.EndsAt, and is useful for accessing the field via an interface.
+func (v *CycleCreateInput) GetEndsAt() time.Time { return v.EndsAt }
+
+// GetCompletedAt returns CycleCreateInput.CompletedAt, and is useful for accessing the field via an interface.
+func (v *CycleCreateInput) GetCompletedAt() *time.Time { return v.CompletedAt }
+
+// CycleTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type CycleTeam struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
+
+// GetId returns CycleTeam.Id, and is useful for accessing the field via an interface.
+func (v *CycleTeam) GetId() string { return v.Id }
+
+type CycleUpdateInput struct {
+	// The custom name of the cycle.
+	Name *string `json:"name"`
+	// The description of the cycle.
+	Description *string `json:"description"`
+	// The start date of the cycle.
+	StartsAt time.Time `json:"startsAt"`
+	// The end date of the cycle.
+	EndsAt time.Time `json:"endsAt"`
+	// The end date of the cycle.
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+}
+
+// GetName returns CycleUpdateInput.Name, and is useful for accessing the field via an interface.
+func (v *CycleUpdateInput) GetName() *string { return v.Name }
+
+// GetDescription returns CycleUpdateInput.Description, and is useful for accessing the field via an interface.
+func (v *CycleUpdateInput) GetDescription() *string { return v.Description }
+
+// GetStartsAt returns CycleUpdateInput.StartsAt, and is useful for accessing the field via an interface.
+func (v *CycleUpdateInput) GetStartsAt() time.Time { return v.StartsAt }
+
+// GetEndsAt returns CycleUpdateInput.EndsAt, and is useful for accessing the field via an interface.
+func (v *CycleUpdateInput) GetEndsAt() time.Time { return v.EndsAt }
+
+// GetCompletedAt returns CycleUpdateInput.CompletedAt, and is useful for accessing the field via an interface.
+func (v *CycleUpdateInput) GetCompletedAt() *time.Time { return v.CompletedAt }
+
+// By which resolution is a date defined.
+type DateResolutionType string
+
+const (
+	DateResolutionTypeMonth    DateResolutionType = "month"
+	DateResolutionTypeQuarter  DateResolutionType = "quarter"
+	DateResolutionTypeHalfyear DateResolutionType = "halfYear"
+	DateResolutionTypeYear     DateResolutionType = "year"
+)
+
 // The day of the week.
 type Day string
 
@@ -23,6 +361,219 @@ const (
 	DaySaturday  Day = "Saturday"
 )
 
+// EmailIntakeAddress includes the GraphQL fields of EmailIntakeAddress requested by the fragment EmailIntakeAddress.
+// The GraphQL type's documentation follows.
+//
+// An email address that can be used for submitting issues.
+type EmailIntakeAddress struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// Unique email address user name (before @) used for incoming email.
+	Address string `json:"address"`
+	// The email address used to forward emails to the intake address.
+	ForwardingEmailAddress *string `json:"forwardingEmailAddress"`
+	// The name to be used for outgoing emails.
+	SenderName *string `json:"senderName"`
+	// Whether the email address is enabled.
+	Enabled bool `json:"enabled"`
+	// Whether email replies are enabled.
+	RepliesEnabled bool `json:"repliesEnabled"`
+	// The team that the email address is associated with.
+	Team *EmailIntakeAddressTeam `json:"team"`
+	// The template that the email address is associated with.
+	Template *EmailIntakeAddressTemplate `json:"template"`
+	// The time at which the entity was archived. Null if the entity has not been archived.
+	ArchivedAt *time.Time `json:"archivedAt"`
+}
+
+// GetId returns EmailIntakeAddress.Id, and is useful for accessing the field via an interface.
+func (v *EmailIntakeAddress) GetId() string { return v.Id }
+
+// GetAddress returns EmailIntakeAddress.Address, and is useful for accessing the field via an interface.
+func (v *EmailIntakeAddress) GetAddress() string { return v.Address }
+
+// GetForwardingEmailAddress returns EmailIntakeAddress.ForwardingEmailAddress, and is useful for accessing the field via an interface.
+func (v *EmailIntakeAddress) GetForwardingEmailAddress() *string { return v.ForwardingEmailAddress }
+
+// GetSenderName returns EmailIntakeAddress.SenderName, and is useful for accessing the field via an interface.
+func (v *EmailIntakeAddress) GetSenderName() *string { return v.SenderName }
+
+// GetEnabled returns EmailIntakeAddress.Enabled, and is useful for accessing the field via an interface.
+func (v *EmailIntakeAddress) GetEnabled() bool { return v.Enabled }
+
+// GetRepliesEnabled returns EmailIntakeAddress.RepliesEnabled, and is useful for accessing the field via an interface.
+func (v *EmailIntakeAddress) GetRepliesEnabled() bool { return v.RepliesEnabled }
+
+// GetTeam returns EmailIntakeAddress.Team, and is useful for accessing the field via an interface.
+func (v *EmailIntakeAddress) GetTeam() *EmailIntakeAddressTeam { return v.Team }
+
+// GetTemplate returns EmailIntakeAddress.Template, and is useful for accessing the field via an interface.
+func (v *EmailIntakeAddress) GetTemplate() *EmailIntakeAddressTemplate { return v.Template }
+
+// GetArchivedAt returns EmailIntakeAddress.ArchivedAt, and is useful for accessing the field via an interface.
+func (v *EmailIntakeAddress) GetArchivedAt() *time.Time { return v.ArchivedAt }
+
+type EmailIntakeAddressCreateInput struct {
+	// The identifier in UUID v4 format. If none is provided, the backend will generate one.
+	Id string `json:"id,omitempty"`
+	// The type of the email address. If not provided, the backend will default to team or template.
+	Type *EmailIntakeAddressType `json:"type,omitempty"`
+	// The email address used to forward emails to the intake address.
+	ForwardingEmailAddress *string `json:"forwardingEmailAddress"`
+	// The name to be used for outgoing emails.
+	SenderName *string `json:"senderName"`
+	// The identifier or key of the team this email address will intake issues for.
+	TeamId *string `json:"teamId,omitempty"`
+	// The identifier of the template this email address will intake issues for.
+	TemplateId *string `json:"templateId,omitempty"`
+	// Whether email replies are enabled.
+	RepliesEnabled bool `json:"repliesEnabled"`
+}
+
+// GetId returns EmailIntakeAddressCreateInput.Id, and is useful for accessing the field via an interface.
+func (v *EmailIntakeAddressCreateInput) GetId() string { return v.Id }
+
+// GetType returns EmailIntakeAddressCreateInput.Type, and is useful for accessing the field via an interface.
+func (v *EmailIntakeAddressCreateInput) GetType() *EmailIntakeAddressType { return v.Type }
+
+// GetForwardingEmailAddress returns EmailIntakeAddressCreateInput.ForwardingEmailAddress, and is useful for accessing the field via an interface.
+func (v *EmailIntakeAddressCreateInput) GetForwardingEmailAddress() *string {
+	return v.ForwardingEmailAddress
+}
+
+// GetSenderName returns EmailIntakeAddressCreateInput.SenderName, and is useful for accessing the field via an interface.
+func (v *EmailIntakeAddressCreateInput) GetSenderName() *string { return v.SenderName }
+
+// GetTeamId returns EmailIntakeAddressCreateInput.TeamId, and is useful for accessing the field via an interface.
+func (v *EmailIntakeAddressCreateInput) GetTeamId() *string { return v.TeamId }
+
+// GetTemplateId returns EmailIntakeAddressCreateInput.TemplateId, and is useful for accessing the field via an interface.
+func (v *EmailIntakeAddressCreateInput) GetTemplateId() *string { return v.TemplateId }
+
+// GetRepliesEnabled returns EmailIntakeAddressCreateInput.RepliesEnabled, and is useful for accessing the field via an interface.
+func (v *EmailIntakeAddressCreateInput) GetRepliesEnabled() bool { return v.RepliesEnabled }
+
+// EmailIntakeAddressTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type EmailIntakeAddressTeam struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
+
+// GetId returns EmailIntakeAddressTeam.Id, and is useful for accessing the field via an interface.
+func (v *EmailIntakeAddressTeam) GetId() string { return v.Id }
+
+// EmailIntakeAddressTemplate includes the requested fields of the GraphQL type Template.
+// The GraphQL type's documentation follows.
+//
+// A template object used for creating entities faster.
+type EmailIntakeAddressTemplate struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
+
+// GetId returns EmailIntakeAddressTemplate.Id, and is useful for accessing the field via an interface.
+func (v *EmailIntakeAddressTemplate) GetId() string { return v.Id }
+
+// The type of the email address.
+type EmailIntakeAddressType string
+
+const (
+	EmailIntakeAddressTypeTeam     EmailIntakeAddressType = "team"
+	EmailIntakeAddressTypeTemplate EmailIntakeAddressType = "template"
+	EmailIntakeAddressTypeAsks     EmailIntakeAddressType = "asks"
+)
+
+type EmailIntakeAddressUpdateInput struct {
+	// Whether the email address is currently enabled. If set to false, the email
+	// address will be disabled and no longer accept incoming emails.
+	Enabled bool `json:"enabled"`
+	// The email address used to forward emails to the intake address.
+	ForwardingEmailAddress *string `json:"forwardingEmailAddress"`
+	// The name to be used for outgoing emails.
+	SenderName *string `json:"senderName"`
+	// Whether email replies are enabled.
+	RepliesEnabled bool `json:"repliesEnabled"`
+	// The identifier or key of the team this email address will intake issues for.
+	TeamId *string `json:"teamId,omitempty"`
+	// The identifier of the template this email address will intake issues for.
+	TemplateId *string `json:"templateId,omitempty"`
+	// Whether customer requests are enabled.
+	CustomerRequestsEnabled *bool `json:"customerRequestsEnabled,omitempty"`
+}
+
+// GetEnabled returns EmailIntakeAddressUpdateInput.Enabled, and is useful for accessing the field via an interface.
+func (v *EmailIntakeAddressUpdateInput) GetEnabled() bool { return v.Enabled }
+
+// GetForwardingEmailAddress returns EmailIntakeAddressUpdateInput.ForwardingEmailAddress, and is useful for accessing the field via an interface.
+func (v *EmailIntakeAddressUpdateInput) GetForwardingEmailAddress() *string {
+	return v.ForwardingEmailAddress
+}
+
+// GetSenderName returns EmailIntakeAddressUpdateInput.SenderName, and is useful for accessing the field via an interface.
+func (v *EmailIntakeAddressUpdateInput) GetSenderName() *string { return v.SenderName }
+
+// GetRepliesEnabled returns EmailIntakeAddressUpdateInput.RepliesEnabled, and is useful for accessing the field via an interface.
+func (v *EmailIntakeAddressUpdateInput) GetRepliesEnabled() bool { return v.RepliesEnabled }
+
+// GetTeamId returns EmailIntakeAddressUpdateInput.TeamId, and is useful for accessing the field via an interface.
+func (v *EmailIntakeAddressUpdateInput) GetTeamId() *string { return v.TeamId }
+
+// GetTemplateId returns EmailIntakeAddressUpdateInput.TemplateId, and is useful for accessing the field via an interface.
+func (v *EmailIntakeAddressUpdateInput) GetTemplateId() *string { return v.TemplateId }
+
+// GetCustomerRequestsEnabled returns EmailIntakeAddressUpdateInput.CustomerRequestsEnabled, and is useful for accessing the field via an interface.
+func (v *EmailIntakeAddressUpdateInput) GetCustomerRequestsEnabled() *bool {
+	return v.CustomerRequestsEnabled
+}
+
+// Emoji includes the GraphQL fields of Emoji requested by the fragment Emoji.
+// The GraphQL type's documentation follows.
+//
+// A custom emoji.
+type Emoji struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The emoji's name.
+	Name string `json:"name"`
+	// The emoji image URL.
+	Url string `json:"url"`
+	// The time at which the entity was archived. Null if the entity has not been archived.
+	ArchivedAt *time.Time `json:"archivedAt"`
+}
+
+// GetId returns Emoji.Id, and is useful for accessing the field via an interface.
+func (v *Emoji) GetId() string { return v.Id }
+
+// GetName returns Emoji.Name, and is useful for accessing the field via an interface.
+func (v *Emoji) GetName() string { return v.Name }
+
+// GetUrl returns Emoji.Url, and is useful for accessing the field via an interface.
+func (v *Emoji) GetUrl() string { return v.Url }
+
+// GetArchivedAt returns Emoji.ArchivedAt, and is useful for accessing the field via an interface.
+func (v *Emoji) GetArchivedAt() *time.Time { return v.ArchivedAt }
+
+type EmojiCreateInput struct {
+	// The identifier in UUID v4 format. If none is provided, the backend will generate one.
+	Id string `json:"id,omitempty"`
+	// The name of the custom emoji.
+	Name string `json:"name"`
+	// The URL for the emoji.
+	Url string `json:"url"`
+}
+
+// GetId returns EmojiCreateInput.Id, and is useful for accessing the field via an interface.
+func (v *EmojiCreateInput) GetId() string { return v.Id }
+
+// GetName returns EmojiCreateInput.Name, and is useful for accessing the field via an interface.
+func (v *EmojiCreateInput) GetName() string { return v.Name }
+
+// GetUrl returns EmojiCreateInput.Url, and is useful for accessing the field via an interface.
+func (v *EmojiCreateInput) GetUrl() string { return v.Url }
+
 // Cadence to generate feed summary
 type FeedSummarySchedule string
 
@@ -32,6 +583,14 @@ const (
 	FeedSummaryScheduleNever  FeedSummarySchedule = "never"
 )
 
+// By which resolution is frequency defined.
+type FrequencyResolutionType string
+
+const (
+	FrequencyResolutionTypeDaily  FrequencyResolutionType = "daily"
+	FrequencyResolutionTypeWeekly FrequencyResolutionType = "weekly"
+)
+
 type GitAutomationStateCreateInput struct {
 	// The identifier in UUID v4 format. If none is provided, the backend will generate one.
 	Id *string `json:"id,omitempty"`
@@ -112,76 +671,457 @@ func (v *GitAutomationTargetBranchCreateInput) GetBranchPattern() string { retur
 // GetIsRegex returns GitAutomationTargetBranchCreateInput.IsRegex, and is useful for accessing the field via an interface.
 func (v *GitAutomationTargetBranchCreateInput) GetIsRegex() bool { return v.IsRegex }
 
-// IssueLabel includes the GraphQL fields of IssueLabel requested by the fragment IssueLabel.
+// Initiative includes the GraphQL fields of Initiative requested by the fragment Initiative.
 // The GraphQL type's documentation follows.
 //
-// Labels that can be associated with issues.
-type IssueLabel struct {
+// An initiative to group projects.
+type Initiative struct {
 	// The unique identifier of the entity.
 	Id string `json:"id"`
-	// The label's name.
+	// The name of the initiative.
 	Name string `json:"name"`
-	// The label's description.
+	// The initiative's unique URL slug.
+	SlugId string `json:"slugId"`
+	// Initiative URL.
+	Url string `json:"url"`
+	// The description of the initiative.
 	Description *string `json:"description"`
-	// The label's color as a HEX string.
+	// The user who owns the initiative.
+	Owner *InitiativeOwnerUser `json:"owner"`
+	// The initiative's color.
 	Color *string `json:"color"`
-	// The parent label.
-	Parent *IssueLabelParentIssueLabel `json:"parent"`
-	// The team that the label is associated with. If null, the label is associated with the global workspace.
-	Team *IssueLabelTeam `json:"team"`
+	// The icon of the initiative.
+	Icon *string `json:"icon"`
+	// The status of the initiative. One of Planned, Active, Completed
+	Status InitiativeStatus `json:"status"`
+	// The estimated completion date of the initiative.
+	TargetDate *string `json:"targetDate"`
+	// A flag that indicates whether the initiative is in the trash bin.
+	Trashed bool `json:"trashed"`
+	// The time at which the entity was archived. Null if the entity has not been archived.
+	ArchivedAt *time.Time `json:"archivedAt"`
 }
 
-// GetId returns IssueLabel.Id, and is useful for accessing the field via an interface.
-func (v *IssueLabel) GetId() string { return v.Id }
+// GetId returns Initiative.Id, and is useful for accessing the field via an interface.
+func (v *Initiative) GetId() string { return v.Id }
 
-// GetName returns IssueLabel.Name, and is useful for accessing the field via an interface.
-func (v *IssueLabel) GetName() string { return v.Name }
+// GetName returns Initiative.Name, and is useful for accessing the field via an interface.
+func (v *Initiative) GetName() string { return v.Name }
 
-// GetDescription returns IssueLabel.Description, and is useful for accessing the field via an interface.
-func (v *IssueLabel) GetDescription() *string { return v.Description }
+// GetSlugId returns Initiative.SlugId, and is useful for accessing the field via an interface.
+func (v *Initiative) GetSlugId() string { return v.SlugId }
 
-// GetColor returns IssueLabel.Color, and is useful for accessing the field via an interface.
-func (v *IssueLabel) GetColor() *string { return v.Color }
+// GetUrl returns Initiative.Url, and is useful for accessing the field via an interface.
+func (v *Initiative) GetUrl() string { return v.Url }
 
-// GetParent returns IssueLabel.Parent, and is useful for accessing the field via an interface.
-func (v *IssueLabel) GetParent() *IssueLabelParentIssueLabel { return v.Parent }
+// GetDescription returns Initiative.Description, and is useful for accessing the field via an interface.
+func (v *Initiative) GetDescription() *string { return v.Description }
 
-// GetTeam returns IssueLabel.Team, and is useful for accessing the field via an interface.
-func (v *IssueLabel) GetTeam() *IssueLabelTeam { return v.Team }
+// GetOwner returns Initiative.Owner, and is useful for accessing the field via an interface.
+func (v *Initiative) GetOwner() *InitiativeOwnerUser { return v.Owner }
 
-type IssueLabelCreateInput struct {
+// GetColor returns Initiative.Color, and is useful for accessing the field via an interface.
+func (v *Initiative) GetColor() *string { return v.Color }
+
+// GetIcon returns Initiative.Icon, and is useful for accessing the field via an interface.
+func (v *Initiative) GetIcon() *string { return v.Icon }
+
+// GetStatus returns Initiative.Status, and is useful for accessing the field via an interface.
+func (v *Initiative) GetStatus() InitiativeStatus { return v.Status }
+
+// GetTargetDate returns Initiative.TargetDate, and is useful for accessing the field via an interface.
+func (v *Initiative) GetTargetDate() *string { return v.TargetDate }
+
+// GetTrashed returns Initiative.Trashed, and is useful for accessing the field via an interface.
+func (v *Initiative) GetTrashed() bool { return v.Trashed }
+
+// GetArchivedAt returns Initiative.ArchivedAt, and is useful for accessing the field via an interface.
+func (v *Initiative) GetArchivedAt() *time.Time { return v.ArchivedAt }
+
+// The properties of the initiative to create.
+type InitiativeCreateInput struct {
 	// The identifier in UUID v4 format. If none is provided, the backend will generate one.
 	Id string `json:"id,omitempty"`
-	// The name of the label.
+	// The name of the initiative.
 	Name string `json:"name"`
-	// The description of the label.
-	Description *string `json:"description"`
-	// The color of the label.
+	// The description of the initiative.
+	Description *string `json:"description,omitempty"`
+	// The owner of the initiative.
+	OwnerId *string `json:"ownerId"`
+	// The sort order of the initiative within the organization.
+	SortOrder *float64 `json:"sortOrder,omitempty"`
+	// The initiative's color.
 	Color *string `json:"color,omitempty"`
-	// The identifier of the parent label.
-	ParentId *string `json:"parentId"`
-	// The team associated with the label. If not given, the label will be associated with the entire workspace.
-	TeamId *string `json:"teamId"`
-	// Whether the label is a group.
-	IsGroup bool `json:"isGroup"`
+	// The initiative's icon.
+	Icon *string `json:"icon"`
+	// The initiative's status.
+	Status InitiativeStatus `json:"status,omitempty"`
+	// The estimated completion date of the initiative.
+	TargetDate *string `json:"targetDate"`
+	// The resolution of the initiative's estimated completion date.
+	TargetDateResolution *DateResolutionType `json:"targetDateResolution,omitempty"`
+	// The initiative's content in markdown format.
+	Content *string `json:"content,omitempty"`
 }
 
-// GetId returns IssueLabelCreateInput.Id, and is useful for accessing the field via an interface.
-func (v *IssueLabelCreateInput) GetId() string { return v.Id }
+// GetId returns InitiativeCreateInput.Id, and is useful for accessing the field via an interface.
+func (v *InitiativeCreateInput) GetId() string { return v.Id }
 
-// GetName returns IssueLabelCreateInput.Name, and is useful for accessing the field via an interface.
-func (v *IssueLabelCreateInput) GetName() string { return v.Name }
+// GetName returns InitiativeCreateInput.Name, and is useful for accessing the field via an interface.
+func (v *InitiativeCreateInput) GetName() string { return v.Name }
 
-// GetDescription returns IssueLabelCreateInput.Description, and is useful for accessing the field via an interface.
-func (v *IssueLabelCreateInput) GetDescription() *string { return v.Description }
+// GetDescription returns InitiativeCreateInput.Description, and is useful for accessing the field via an interface.
+func (v *InitiativeCreateInput) GetDescription() *string { return v.Description }
 
-// GetColor returns IssueLabelCreateInput.Color, and is useful for accessing the field via an interface.
-func (v *IssueLabelCreateInput) GetColor() *string { return v.Color }
+// GetOwnerId returns InitiativeCreateInput.OwnerId, and is useful for accessing the field via an interface.
+func (v *InitiativeCreateInput) GetOwnerId() *string { return v.OwnerId }
 
-// GetParentId returns IssueLabelCreateInput.ParentId, and is useful for accessing the field via an interface.
-func (v *IssueLabelCreateInput) GetParentId() *string { return v.ParentId }
+// GetSortOrder returns InitiativeCreateInput.SortOrder, and is useful for accessing the field via an interface.
+func (v *InitiativeCreateInput) GetSortOrder() *float64 { return v.SortOrder }
 
-// GetTeamId returns IssueLabelCreateInput.TeamId, and is useful for accessing the field via an interface.
+// GetColor returns InitiativeCreateInput.Color, and is useful for accessing the field via an interface.
+func (v *InitiativeCreateInput) GetColor() *string { return v.Color }
+
+// GetIcon returns InitiativeCreateInput.Icon, and is useful for accessing the field via an interface.
+func (v *InitiativeCreateInput) GetIcon() *string { return v.Icon }
+
+// GetStatus returns InitiativeCreateInput.Status, and is useful for accessing the field via an interface.
+func (v *InitiativeCreateInput) GetStatus() InitiativeStatus { return v.Status }
+
+// GetTargetDate returns InitiativeCreateInput.TargetDate, and is useful for accessing the field via an interface.
+func (v *InitiativeCreateInput) GetTargetDate() *string { return v.TargetDate }
+
+// GetTargetDateResolution returns InitiativeCreateInput.TargetDateResolution, and is useful for accessing the field via an interface.
+func (v *InitiativeCreateInput) GetTargetDateResolution() *DateResolutionType {
+	return v.TargetDateResolution
+}
+
+// GetContent returns InitiativeCreateInput.Content, and is useful for accessing the field via an interface.
+func (v *InitiativeCreateInput) GetContent() *string { return v.Content }
+
+// InitiativeOwnerUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A user that has access to the the resources of an organization.
+type InitiativeOwnerUser struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
+
+// GetId returns InitiativeOwnerUser.Id, and is useful for accessing the field via an interface.
+func (v *InitiativeOwnerUser) GetId() string { return v.Id }
+
+// InitiativeRelation includes the GraphQL fields of InitiativeRelation requested by the fragment InitiativeRelation.
+// The GraphQL type's documentation follows.
+//
+// A relation representing the dependency between two initiatives.
+type InitiativeRelation struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The parent initiative.
+	Initiative InitiativeRelationInitiative `json:"initiative"`
+	// The child initiative.
+	RelatedInitiative InitiativeRelationRelatedInitiative `json:"relatedInitiative"`
+}
+
+// GetId returns InitiativeRelation.Id, and is useful for accessing the field via an interface.
+func (v *InitiativeRelation) GetId() string { return v.Id }
+
+// GetInitiative returns InitiativeRelation.Initiative, and is useful for accessing the field via an interface.
+func (v *InitiativeRelation) GetInitiative() InitiativeRelationInitiative { return v.Initiative }
+
+// GetRelatedInitiative returns InitiativeRelation.RelatedInitiative, and is useful for accessing the field via an interface.
+func (v *InitiativeRelation) GetRelatedInitiative() InitiativeRelationRelatedInitiative {
+	return v.RelatedInitiative
+}
+
+type InitiativeRelationCreateInput struct {
+	// The identifier in UUID v4 format. If none is provided, the backend will generate one.
+	Id string `json:"id,omitempty"`
+	// The identifier of the parent initiative.
+	InitiativeId string `json:"initiativeId"`
+	// The identifier of the child initiative.
+	RelatedInitiativeId string `json:"relatedInitiativeId"`
+	// The sort order of the initiative relation.
+	SortOrder *float64 `json:"sortOrder,omitempty"`
+}
+
+// GetId returns InitiativeRelationCreateInput.Id, and is useful for accessing the field via an interface.
+func (v *InitiativeRelationCreateInput) GetId() string { return v.Id }
+
+// GetInitiativeId returns InitiativeRelationCreateInput.InitiativeId, and is useful for accessing the field via an interface.
+func (v *InitiativeRelationCreateInput) GetInitiativeId() string { return v.InitiativeId }
+
+// GetRelatedInitiativeId returns InitiativeRelationCreateInput.RelatedInitiativeId, and is useful for accessing the field via an interface.
+func (v *InitiativeRelationCreateInput) GetRelatedInitiativeId() string { return v.RelatedInitiativeId }
+
+// GetSortOrder returns InitiativeRelationCreateInput.SortOrder, and is useful for accessing the field via an interface.
+func (v *InitiativeRelationCreateInput) GetSortOrder() *float64 { return v.SortOrder }
+
+// InitiativeRelationInitiative includes the requested fields of the GraphQL type Initiative.
+// The GraphQL type's documentation follows.
+//
+// An initiative to group projects.
+type InitiativeRelationInitiative struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
+
+// GetId returns InitiativeRelationInitiative.Id, and is useful for accessing the field via an interface.
+func (v *InitiativeRelationInitiative) GetId() string { return v.Id }
+
+// InitiativeRelationRelatedInitiative includes the requested fields of the GraphQL type Initiative.
+// The GraphQL type's documentation follows.
+//
+// An initiative to group projects.
+type InitiativeRelationRelatedInitiative struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
+
+// GetId returns InitiativeRelationRelatedInitiative.Id, and is useful for accessing the field via an interface.
+func (v *InitiativeRelationRelatedInitiative) GetId() string { return v.Id }
+
+type InitiativeStatus string
+
+const (
+	InitiativeStatusPlanned   InitiativeStatus = "Planned"
+	InitiativeStatusActive    InitiativeStatus = "Active"
+	InitiativeStatusCompleted InitiativeStatus = "Completed"
+)
+
+// InitiativeToProject includes the GraphQL fields of InitiativeToProject requested by the fragment InitiativeToProject.
+// The GraphQL type's documentation follows.
+//
+// Join table between projects and initiatives.
+type InitiativeToProject struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The initiative that the project is associated with.
+	Initiative InitiativeToProjectInitiative `json:"initiative"`
+	// The project that the initiative is associated with.
+	Project InitiativeToProjectProject `json:"project"`
+}
+
+// GetId returns InitiativeToProject.Id, and is useful for accessing the field via an interface.
+func (v *InitiativeToProject) GetId() string { return v.Id }
+
+// GetInitiative returns InitiativeToProject.Initiative, and is useful for accessing the field via an interface.
+func (v *InitiativeToProject) GetInitiative() InitiativeToProjectInitiative { return v.Initiative }
+
+// GetProject returns InitiativeToProject.Project, and is useful for accessing the field via an interface.
+func (v *InitiativeToProject) GetProject() InitiativeToProjectProject { return v.Project }
+
+// The properties of the initiativeToProject to create.
+type InitiativeToProjectCreateInput struct {
+	// The identifier in UUID v4 format. If none is provided, the backend will generate one.
+	Id string `json:"id,omitempty"`
+	// The identifier of the project.
+	ProjectId string `json:"projectId"`
+	// The identifier of the initiative.
+	InitiativeId string `json:"initiativeId"`
+	// The sort order for the project within its organization.
+	SortOrder *float64 `json:"sortOrder,omitempty"`
+}
+
+// GetId returns InitiativeToProjectCreateInput.Id, and is useful for accessing the field via an interface.
+func (v *InitiativeToProjectCreateInput) GetId() string { return v.Id }
+
+// GetProjectId returns InitiativeToProjectCreateInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *InitiativeToProjectCreateInput) GetProjectId() string { return v.ProjectId }
+
+// GetInitiativeId returns InitiativeToProjectCreateInput.InitiativeId, and is useful for accessing the field via an interface.
+func (v *InitiativeToProjectCreateInput) GetInitiativeId() string { return v.InitiativeId }
+
+// GetSortOrder returns InitiativeToProjectCreateInput.SortOrder, and is useful for accessing the field via an interface.
+func (v *InitiativeToProjectCreateInput) GetSortOrder() *float64 { return v.SortOrder }
+
+// InitiativeToProjectInitiative includes the requested fields of the GraphQL type Initiative.
+// The GraphQL type's documentation follows.
+//
+// An initiative to group projects.
+type InitiativeToProjectInitiative struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
+
+// GetId returns InitiativeToProjectInitiative.Id, and is useful for accessing the field via an interface.
+func (v *InitiativeToProjectInitiative) GetId() string { return v.Id }
+
+// InitiativeToProjectProject includes the requested fields of the GraphQL type Project.
+// The GraphQL type's documentation follows.
+//
+// A project.
+type InitiativeToProjectProject struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
+
+// GetId returns InitiativeToProjectProject.Id, and is useful for accessing the field via an interface.
+func (v *InitiativeToProjectProject) GetId() string { return v.Id }
+
+// The properties of the initiative to update.
+type InitiativeUpdateInput struct {
+	// The name of the initiative.
+	Name string `json:"name,omitempty"`
+	// The description of the initiative.
+	Description *string `json:"description"`
+	// The owner of the initiative.
+	OwnerId *string `json:"ownerId"`
+	// The sort order of the initiative within the organization.
+	SortOrder *float64 `json:"sortOrder,omitempty"`
+	// The initiative's color.
+	Color *string `json:"color,omitempty"`
+	// The initiative's icon.
+	Icon *string `json:"icon"`
+	// The estimated completion date of the initiative.
+	TargetDate *string `json:"targetDate"`
+	// The initiative's status.
+	Status InitiativeStatus `json:"status,omitempty"`
+	// The resolution of the initiative's estimated completion date.
+	TargetDateResolution *DateResolutionType `json:"targetDateResolution,omitempty"`
+	// Whether the initiative has been trashed.
+	Trashed *bool `json:"trashed,omitempty"`
+	// The initiative's content in markdown format.
+	Content *string `json:"content,omitempty"`
+	// The n-weekly frequency at which to prompt for updates. When not set, reminders are inherited from workspace.
+	UpdateReminderFrequencyInWeeks *float64 `json:"updateReminderFrequencyInWeeks,omitempty"`
+	// The frequency at which to prompt for updates. When not set, reminders are inherited from workspace.
+	UpdateReminderFrequency *float64 `json:"updateReminderFrequency,omitempty"`
+	// The frequency resolution.
+	FrequencyResolution *FrequencyResolutionType `json:"frequencyResolution,omitempty"`
+	// The day at which to prompt for updates.
+	UpdateRemindersDay *Day `json:"updateRemindersDay,omitempty"`
+	// The hour at which to prompt for updates.
+	UpdateRemindersHour *int `json:"updateRemindersHour,omitempty"`
+}
+
+// GetName returns InitiativeUpdateInput.Name, and is useful for accessing the field via an interface.
+func (v *InitiativeUpdateInput) GetName() string { return v.Name }
+
+// GetDescription returns InitiativeUpdateInput.Description, and is useful for accessing the field via an interface.
+func (v *InitiativeUpdateInput) GetDescription() *string { return v.Description }
+
+// GetOwnerId returns InitiativeUpdateInput.OwnerId, and is useful for accessing the field via an interface.
+func (v *InitiativeUpdateInput) GetOwnerId() *string { return v.OwnerId }
+
+// GetSortOrder returns InitiativeUpdateInput.SortOrder, and is useful for accessing the field via an interface.
+func (v *InitiativeUpdateInput) GetSortOrder() *float64 { return v.SortOrder }
+
+// GetColor returns InitiativeUpdateInput.Color, and is useful for accessing the field via an interface.
+func (v *InitiativeUpdateInput) GetColor() *string { return v.Color }
+
+// GetIcon returns InitiativeUpdateInput.Icon, and is useful for accessing the field via an interface.
+func (v *InitiativeUpdateInput) GetIcon() *string { return v.Icon }
+
+// GetTargetDate returns InitiativeUpdateInput.TargetDate, and is useful for accessing the field via an interface.
+func (v *InitiativeUpdateInput) GetTargetDate() *string { return v.TargetDate }
+
+// GetStatus returns InitiativeUpdateInput.Status, and is useful for accessing the field via an interface.
+func (v *InitiativeUpdateInput) GetStatus() InitiativeStatus { return v.Status }
+
+// GetTargetDateResolution returns InitiativeUpdateInput.TargetDateResolution, and is useful for accessing the field via an interface.
+func (v *InitiativeUpdateInput) GetTargetDateResolution() *DateResolutionType {
+	return v.TargetDateResolution
+}
+
+// GetTrashed returns InitiativeUpdateInput.Trashed, and is useful for accessing the field via an interface.
+func (v *InitiativeUpdateInput) GetTrashed() *bool { return v.Trashed }
+
+// GetContent returns InitiativeUpdateInput.Content, and is useful for accessing the field via an interface.
+func (v *InitiativeUpdateInput) GetContent() *string { return v.Content }
+
+// GetUpdateReminderFrequencyInWeeks returns InitiativeUpdateInput.UpdateReminderFrequencyInWeeks, and is useful for accessing the field via an interface.
+func (v *InitiativeUpdateInput) GetUpdateReminderFrequencyInWeeks() *float64 {
+	return v.UpdateReminderFrequencyInWeeks
+}
+
+// GetUpdateReminderFrequency returns InitiativeUpdateInput.UpdateReminderFrequency, and is useful for accessing the field via an interface.
+func (v *InitiativeUpdateInput) GetUpdateReminderFrequency() *float64 {
+	return v.UpdateReminderFrequency
+}
+
+// GetFrequencyResolution returns InitiativeUpdateInput.FrequencyResolution, and is useful for accessing the field via an interface.
+func (v *InitiativeUpdateInput) GetFrequencyResolution() *FrequencyResolutionType {
+	return v.FrequencyResolution
+}
+
+// GetUpdateRemindersDay returns InitiativeUpdateInput.UpdateRemindersDay, and is useful for accessing the field via an interface.
+func (v *InitiativeUpdateInput) GetUpdateRemindersDay() *Day { return v.UpdateRemindersDay }
+
+// GetUpdateRemindersHour returns InitiativeUpdateInput.UpdateRemindersHour, and is useful for accessing the field via an interface.
+func (v *InitiativeUpdateInput) GetUpdateRemindersHour() *int { return v.UpdateRemindersHour }
+
+// IssueLabel includes the GraphQL fields of IssueLabel requested by the fragment IssueLabel.
+// The GraphQL type's documentation follows.
+//
+// Labels that can be associated with issues.
+type IssueLabel struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The label's name.
+	Name string `json:"name"`
+	// The label's description.
+	Description *string `json:"description"`
+	// The label's color as a HEX string.
+	Color *string `json:"color"`
+	// The parent label.
+	Parent *IssueLabelParentIssueLabel `json:"parent"`
+	// The team that the label is associated with. If null, the label is associated with the global workspace.
+	Team *IssueLabelTeam `json:"team"`
+}
+
+// GetId returns IssueLabel.Id, and is useful for accessing the field via an interface.
+func (v *IssueLabel) GetId() string { return v.Id }
+
+// GetName returns IssueLabel.Name, and is useful for accessing the field via an interface.
+func (v *IssueLabel) GetName() string { return v.Name }
+
+// GetDescription returns IssueLabel.Description, and is useful for accessing the field via an interface.
+func (v *IssueLabel) GetDescription() *string { return v.Description }
+
+// GetColor returns IssueLabel.Color, and is useful for accessing the field via an interface.
+func (v *IssueLabel) GetColor() *string { return v.Color }
+
+// GetParent returns IssueLabel.Parent, and is useful for accessing the field via an interface.
+func (v *IssueLabel) GetParent() *IssueLabelParentIssueLabel { return v.Parent }
+
+// GetTeam returns IssueLabel.Team, and is useful for accessing the field via an interface.
+func (v *IssueLabel) GetTeam() *IssueLabelTeam { return v.Team }
+
+type IssueLabelCreateInput struct {
+	// The identifier in UUID v4 format. If none is provided, the backend will generate one.
+	Id string `json:"id,omitempty"`
+	// The name of the label.
+	Name string `json:"name"`
+	// The description of the label.
+	Description *string `json:"description"`
+	// The color of the label.
+	Color *string `json:"color,omitempty"`
+	// The identifier of the parent label.
+	ParentId *string `json:"parentId"`
+	// The team associated with the label. If not given, the label will be associated with the entire workspace.
+	TeamId *string `json:"teamId"`
+	// Whether the label is a group.
+	IsGroup bool `json:"isGroup"`
+}
+
+// GetId returns IssueLabelCreateInput.Id, and is useful for accessing the field via an interface.
+func (v *IssueLabelCreateInput) GetId() string { return v.Id }
+
+// GetName returns IssueLabelCreateInput.Name, and is useful for accessing the field via an interface.
+func (v *IssueLabelCreateInput) GetName() string { return v.Name }
+
+// GetDescription returns IssueLabelCreateInput.Description, and is useful for accessing the field via an interface.
+func (v *IssueLabelCreateInput) GetDescription() *string { return v.Description }
+
+// GetColor returns IssueLabelCreateInput.Color, and is useful for accessing the field via an interface.
+func (v *IssueLabelCreateInput) GetColor() *string { return v.Color }
+
+// GetParentId returns IssueLabelCreateInput.ParentId, and is useful for accessing the field via an interface.
+func (v *IssueLabelCreateInput) GetParentId() *string { return v.ParentId }
+
+// GetTeamId returns IssueLabelCreateInput.TeamId, and is useful for accessing the field via an interface.
 func (v *IssueLabelCreateInput) GetTeamId() *string { return v.TeamId }
 
 // GetIsGroup returns IssueLabelCreateInput.IsGroup, and is useful for accessing the field via an interface.
@@ -338,6 +1278,179 @@ func (v *Organization) GetDefaultFeedSummarySchedule() FeedSummarySchedule {
 // GetCustomersEnabled returns Organization.CustomersEnabled, and is useful for accessing the field via an interface.
 func (v *Organization) GetCustomersEnabled() bool { return v.CustomersEnabled }
 
+// OrganizationDomain includes the GraphQL fields of OrganizationDomain requested by the fragment OrganizationDomain.
+// The GraphQL type's documentation follows.
+//
+// Defines the use of a domain by an organization.
+type OrganizationDomain struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// Domain name.
+	Name string `json:"name"`
+	// E-mail used to verify this domain.
+	VerificationEmail *string `json:"verificationEmail"`
+	// What type of auth is the domain used for.
+	AuthType OrganizationDomainAuthType `json:"authType"`
+	// Is this domain verified.
+	Verified bool `json:"verified"`
+	// Whether the domains was claimed by the organization through DNS verification.
+	Claimed *bool `json:"claimed"`
+	// Prevent users with this domain to create new workspaces.
+	DisableOrganizationCreation *bool `json:"disableOrganizationCreation"`
+}
+
+// GetId returns OrganizationDomain.Id, and is useful for accessing the field via an interface.
+func (v *OrganizationDomain) GetId() string { return v.Id }
+
+// GetName returns OrganizationDomain.Name, and is useful for accessing the field via an interface.
+func (v *OrganizationDomain) GetName() string { return v.Name }
+
+// GetVerificationEmail returns OrganizationDomain.VerificationEmail, and is useful for accessing the field via an interface.
+func (v *OrganizationDomain) GetVerificationEmail() *string { return v.VerificationEmail }
+
+// GetAuthType returns OrganizationDomain.AuthType, and is useful for accessing the field via an interface.
+func (v *OrganizationDomain) GetAuthType() OrganizationDomainAuthType { return v.AuthType }
+
+// GetVerified returns OrganizationDomain.Verified, and is useful for accessing the field via an interface.
+func (v *OrganizationDomain) GetVerified() bool { return v.Verified }
+
+// GetClaimed returns OrganizationDomain.Claimed, and is useful for accessing the field via an interface.
+func (v *OrganizationDomain) GetClaimed() *bool { return v.Claimed }
+
+// GetDisableOrganizationCreation returns OrganizationDomain.DisableOrganizationCreation, and is useful for accessing the field via an interface.
+func (v *OrganizationDomain) GetDisableOrganizationCreation() *bool {
+	return v.DisableOrganizationCreation
+}
+
+// What type of auth is the domain used for.
+type OrganizationDomainAuthType string
+
+const (
+	OrganizationDomainAuthTypeSaml    OrganizationDomainAuthType = "saml"
+	OrganizationDomainAuthTypeGeneral OrganizationDomainAuthType = "general"
+)
+
+type OrganizationDomainCreateInput struct {
+	// The identifier in UUID v4 format. If none is provided, the backend will generate one.
+	Id string `json:"id,omitempty"`
+	// The domain name to add.
+	Name string `json:"name"`
+	// The email address to which to send the verification code.
+	VerificationEmail *string `json:"verificationEmail"`
+	// The authentication type this domain is for.
+	AuthType string `json:"authType"`
+}
+
+// GetId returns OrganizationDomainCreateInput.Id, and is useful for accessing the field via an interface.
+func (v *OrganizationDomainCreateInput) GetId() string { return v.Id }
+
+// GetName returns OrganizationDomainCreateInput.Name, and is useful for accessing the field via an interface.
+func (v *OrganizationDomainCreateInput) GetName() string { return v.Name }
+
+// GetVerificationEmail returns OrganizationDomainCreateInput.VerificationEmail, and is useful for accessing the field via an interface.
+func (v *OrganizationDomainCreateInput) GetVerificationEmail() *string { return v.VerificationEmail }
+
+// GetAuthType returns OrganizationDomainCreateInput.AuthType, and is useful for accessing the field via an interface.
+func (v *OrganizationDomainCreateInput) GetAuthType() string { return v.AuthType }
+
+type OrganizationDomainUpdateInput struct {
+	// Prevent users with this domain to create new workspaces. Only allowed to set on claimed domains!
+	DisableOrganizationCreation bool `json:"disableOrganizationCreation"`
+}
+
+// GetDisableOrganizationCreation returns OrganizationDomainUpdateInput.DisableOrganizationCreation, and is useful for accessing the field via an interface.
+func (v *OrganizationDomainUpdateInput) GetDisableOrganizationCreation() bool {
+	return v.DisableOrganizationCreation
+}
+
+type OrganizationDomainVerificationInput struct {
+	// The identifier in UUID v4 format of the domain being verified.
+	OrganizationDomainId string `json:"organizationDomainId"`
+	// The verification code sent via email.
+	VerificationCode string `json:"verificationCode"`
+}
+
+// GetOrganizationDomainId returns OrganizationDomainVerificationInput.OrganizationDomainId, and is useful for accessing the field via an interface.
+func (v *OrganizationDomainVerificationInput) GetOrganizationDomainId() string {
+	return v.OrganizationDomainId
+}
+
+// GetVerificationCode returns OrganizationDomainVerificationInput.VerificationCode, and is useful for accessing the field via an interface.
+func (v *OrganizationDomainVerificationInput) GetVerificationCode() string { return v.VerificationCode }
+
+// OrganizationInvite includes the GraphQL fields of OrganizationInvite requested by the fragment OrganizationInvite.
+// The GraphQL type's documentation follows.
+//
+// An invitation to the organization that has been sent via email.
+type OrganizationInvite struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The invitees email address.
+	Email string `json:"email"`
+	// The user role that the invitee will receive upon accepting the invite.
+	Role UserRoleType `json:"role"`
+	// Extra metadata associated with the organization invite.
+	Metadata map[string]interface{} `json:"metadata"`
+	// The time at which the invite was accepted. Null, if the invite hasn't been accepted.
+	AcceptedAt *time.Time `json:"acceptedAt"`
+	// The time at which the invite will be expiring. Null, if the invite shouldn't expire.
+	ExpiresAt *time.Time `json:"expiresAt"`
+}
+
+// GetId returns OrganizationInvite.Id, and is useful for accessing the field via an interface.
+func (v *OrganizationInvite) GetId() string { return v.Id }
+
+// GetEmail returns OrganizationInvite.Email, and is useful for accessing the field via an interface.
+func (v *OrganizationInvite) GetEmail() string { return v.Email }
+
+// GetRole returns OrganizationInvite.Role, and is useful for accessing the field via an interface.
+func (v *OrganizationInvite) GetRole() UserRoleType { return v.Role }
+
+// GetMetadata returns OrganizationInvite.Metadata, and is useful for accessing the field via an interface.
+func (v *OrganizationInvite) GetMetadata() map[string]interface{} { return v.Metadata }
+
+// GetAcceptedAt returns OrganizationInvite.AcceptedAt, and is useful for accessing the field via an interface.
+func (v *OrganizationInvite) GetAcceptedAt() *time.Time { return v.AcceptedAt }
+
+// GetExpiresAt returns OrganizationInvite.ExpiresAt, and is useful for accessing the field via an interface.
+func (v *OrganizationInvite) GetExpiresAt() *time.Time { return v.ExpiresAt }
+
+type OrganizationInviteCreateInput struct {
+	// The identifier in UUID v4 format. If none is provided, the backend will generate one.
+	Id string `json:"id,omitempty"`
+	// The email of the invitee.
+	Email string `json:"email"`
+	// What user role the invite should grant.
+	Role UserRoleType `json:"role"`
+	// The teams that the user has been invited to.
+	TeamIds []string `json:"teamIds"`
+	// [INTERNAL] Optional metadata about the invite.
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// GetId returns OrganizationInviteCreateInput.Id, and is useful for accessing the field via an interface.
+func (v *OrganizationInviteCreateInput) GetId() string { return v.Id }
+
+// GetEmail returns OrganizationInviteCreateInput.Email, and is useful for accessing the field via an interface.
+func (v *OrganizationInviteCreateInput) GetEmail() string { return v.Email }
+
+// GetRole returns OrganizationInviteCreateInput.Role, and is useful for accessing the field via an interface.
+func (v *OrganizationInviteCreateInput) GetRole() UserRoleType { return v.Role }
+
+// GetTeamIds returns OrganizationInviteCreateInput.TeamIds, and is useful for accessing the field via an interface.
+func (v *OrganizationInviteCreateInput) GetTeamIds() []string { return v.TeamIds }
+
+// GetMetadata returns OrganizationInviteCreateInput.Metadata, and is useful for accessing the field via an interface.
+func (v *OrganizationInviteCreateInput) GetMetadata() map[string]interface{} { return v.Metadata }
+
+type OrganizationInviteUpdateInput struct {
+	// The teams that the user has been invited to.
+	TeamIds []string `json:"teamIds"`
+}
+
+// GetTeamIds returns OrganizationInviteUpdateInput.TeamIds, and is useful for accessing the field via an interface.
+func (v *OrganizationInviteUpdateInput) GetTeamIds() []string { return v.TeamIds }
+
 // [INTERNAL] Organization IP restriction configuration.
 type OrganizationIpRestrictionInput struct {
 	// IP range in CIDR format.
@@ -565,26 +1678,686 @@ const (
 	ProductIntelligenceScopeNone          ProductIntelligenceScope = "none"
 )
 
-// Team includes the GraphQL fields of Team requested by the fragment Team.
+// Project includes the GraphQL fields of Project requested by the fragment Project.
 // The GraphQL type's documentation follows.
 //
-// An organizational unit that contains issues.
-type Team struct {
+// A project.
+type Project struct {
 	// The unique identifier of the entity.
 	Id string `json:"id"`
-	// The team's name.
+	// The project's name.
 	Name string `json:"name"`
-	// The team's unique key. The key is used in URLs.
-	Key string `json:"key"`
-	// Whether the team is private or not.
-	Private bool `json:"private"`
-	// The team's description.
-	Description *string `json:"description"`
-	// The icon of the team.
+	// The project's unique URL slug.
+	SlugId string `json:"slugId"`
+	// Project URL.
+	Url string `json:"url"`
+	// The project's description.
+	Description string `json:"description"`
+	// The icon of the project.
 	Icon *string `json:"icon"`
-	// The team's color.
-	Color *string `json:"color"`
-	// [Internal] The team's parent team.
+	// The project's color.
+	Color string `json:"color"`
+	// The status that the project is associated with.
+	Status ProjectStatusProjectStatus `json:"status"`
+	// The project lead.
+	Lead *ProjectLeadUser `json:"lead"`
+	// Teams associated with this project.
+	Teams ProjectTeamsTeamConnection `json:"teams"`
+	// The priority of the project. 0 = No priority, 1 = Urgent, 2 = High, 3 = Normal, 4 = Low.
+	Priority int `json:"priority"`
+	// The estimated start date of the project.
+	StartDate *string `json:"startDate"`
+	// The estimated completion date of the project.
+	TargetDate *string `json:"targetDate"`
+	// Id of the labels associated with this project.
+	LabelIds []string `json:"labelIds"`
+	// A flag that indicates whether the project is in the trash bin.
+	Trashed bool `json:"trashed"`
+	// The time at which the entity was archived. Null if the entity has not been archived.
+	ArchivedAt *time.Time `json:"archivedAt"`
+}
+
+// GetId returns Project.Id, and is useful for accessing the field via an interface.
+func (v *Project) GetId() string { return v.Id }
+
+// GetName returns Project.Name, and is useful for accessing the field via an interface.
+func (v *Project) GetName() string { return v.Name }
+
+// GetSlugId returns Project.SlugId, and is useful for accessing the field via an interface.
+func (v *Project) GetSlugId() string { return v.SlugId }
+
+// GetUrl returns Project.Url, and is useful for accessing the field via an interface.
+func (v *Project) GetUrl() string { return v.Url }
+
+// GetDescription returns Project.Description, and is useful for accessing the field via an interface.
+func (v *Project) GetDescription() string { return v.Description }
+
+// GetIcon returns Project.Icon, and is useful for accessing the field via an interface.
+func (v *Project) GetIcon() *string { return v.Icon }
+
+// GetColor returns Project.Color, and is useful for accessing the field via an interface.
+func (v *Project) GetColor() string { return v.Color }
+
+// GetStatus returns Project.Status, and is useful for accessing the field via an interface.
+func (v *Project) GetStatus() ProjectStatusProjectStatus { return v.Status }
+
+// GetLead returns Project.Lead, and is useful for accessing the field via an interface.
+func (v *Project) GetLead() *ProjectLeadUser { return v.Lead }
+
+// GetTeams returns Project.Teams, and is useful for accessing the field via an interface.
+func (v *Project) GetTeams() ProjectTeamsTeamConnection { return v.Teams }
+
+// GetPriority returns Project.Priority, and is useful for accessing the field via an interface.
+func (v *Project) GetPriority() int { return v.Priority }
+
+// GetStartDate returns Project.StartDate, and is useful for accessing the field via an interface.
+func (v *Project) GetStartDate() *string { return v.StartDate }
+
+// GetTargetDate returns Project.TargetDate, and is useful for accessing the field via an interface.
+func (v *Project) GetTargetDate() *string { return v.TargetDate }
+
+// GetLabelIds returns Project.LabelIds, and is useful for accessing the field via an interface.
+func (v *Project) GetLabelIds() []string { return v.LabelIds }
+
+// GetTrashed returns Project.Trashed, and is useful for accessing the field via an interface.
+func (v *Project) GetTrashed() bool { return v.Trashed }
+
+// GetArchivedAt returns Project.ArchivedAt, and is useful for accessing the field via an interface.
+func (v *Project) GetArchivedAt() *time.Time { return v.ArchivedAt }
+
+type ProjectCreateInput struct {
+	// The identifier in UUID v4 format. If none is provided, the backend will generate one.
+	Id *string `json:"id,omitempty"`
+	// The name of the project.
+	Name string `json:"name"`
+	// The icon of the project.
+	Icon *string `json:"icon"`
+	// The color of the project.
+	Color *string `json:"color,omitempty"`
+	// The ID of the project status.
+	StatusId *string `json:"statusId,omitempty"`
+	// The description for the project.
+	Description *string `json:"description,omitempty"`
+	// The project content as markdown.
+	Content *string `json:"content,omitempty"`
+	// The identifiers of the teams this project is associated with.
+	TeamIds []string `json:"teamIds"`
+	// The ID of the issue from which that project is created.
+	ConvertedFromIssueId *string `json:"convertedFromIssueId,omitempty"`
+	// The ID of the last template applied to the project.
+	LastAppliedTemplateId *string `json:"lastAppliedTemplateId,omitempty"`
+	// The identifier of the project lead.
+	LeadId *string `json:"leadId"`
+	// The identifiers of the members of this project.
+	MemberIds []string `json:"memberIds,omitempty"`
+	// The planned start date of the project.
+	StartDate *string `json:"startDate"`
+	// The resolution of the project's start date.
+	StartDateResolution *DateResolutionType `json:"startDateResolution,omitempty"`
+	// The planned target date of the project.
+	TargetDate *string `json:"targetDate"`
+	// The resolution of the project's estimated completion date.
+	TargetDateResolution *DateResolutionType `json:"targetDateResolution,omitempty"`
+	// The sort order for the project within shared views.
+	SortOrder *float64 `json:"sortOrder,omitempty"`
+	// The sort order for the project within shared views, when ordered by priority.
+	PrioritySortOrder *float64 `json:"prioritySortOrder,omitempty"`
+	// The priority of the project. 0 = No priority, 1 = Urgent, 2 = High, 3 = Normal, 4 = Low.
+	Priority int `json:"priority"`
+	// [Internal]The identifiers of the project labels associated with this project.
+	LabelIds []string `json:"labelIds"`
+}
+
+// GetId returns ProjectCreateInput.Id, and is useful for accessing the field via an interface.
+func (v *ProjectCreateInput) GetId() *string { return v.Id }
+
+// GetName returns ProjectCreateInput.Name, and is useful for accessing the field via an interface.
+func (v *ProjectCreateInput) GetName() string { return v.Name }
+
+// GetIcon returns ProjectCreateInput.Icon, and is useful for accessing the field via an interface.
+func (v *ProjectCreateInput) GetIcon() *string { return v.Icon }
+
+// GetColor returns ProjectCreateInput.Color, and is useful for accessing the field via an interface.
+func (v *ProjectCreateInput) GetColor() *string { return v.Color }
+
+// GetStatusId returns ProjectCreateInput.StatusId, and is useful for accessing the field via an interface.
+func (v *ProjectCreateInput) GetStatusId() *string { return v.StatusId }
+
+// GetDescription returns ProjectCreateInput.Description, and is useful for accessing the field via an interface.
+func (v *ProjectCreateInput) GetDescription() *string { return v.Description }
+
+// GetContent returns ProjectCreateInput.Content, and is useful for accessing the field via an interface.
+func (v *ProjectCreateInput) GetContent() *string { return v.Content }
+
+// GetTeamIds returns ProjectCreateInput.TeamIds, and is useful for accessing the field via an interface.
+func (v *ProjectCreateInput) GetTeamIds() []string { return v.TeamIds }
+
+// GetConvertedFromIssueId returns ProjectCreateInput.ConvertedFromIssueId, and is useful for accessing the field via an interface.
+func (v *ProjectCreateInput) GetConvertedFromIssueId() *string { return v.ConvertedFromIssueId }
+
+// GetLastAppliedTemplateId returns ProjectCreateInput.LastAppliedTemplateId, and is useful for accessing the field via an interface.
+func (v *ProjectCreateInput) GetLastAppliedTemplateId() *string { return v.LastAppliedTemplateId }
+
+// GetLeadId returns ProjectCreateInput.LeadId, and is useful for accessing the field via an interface.
+func (v *ProjectCreateInput) GetLeadId() *string { return v.LeadId }
+
+// GetMemberIds returns ProjectCreateInput.MemberIds, and is useful for accessing the field via an interface.
+func (v *ProjectCreateInput) GetMemberIds() []string { return v.MemberIds }
+
+// GetStartDate returns ProjectCreateInput.StartDate, and is useful for accessing the field via an interface.
+func (v *ProjectCreateInput) GetStartDate() *string { return v.StartDate }
+
+// GetStartDateResolution returns ProjectCreateInput.StartDateResolution, and is useful for accessing the field via an interface.
+func (v *ProjectCreateInput) GetStartDateResolution() *DateResolutionType {
+	return v.StartDateResolution
+}
+
+// GetTargetDate returns ProjectCreateInput.TargetDate, and is useful for accessing the field via an interface.
+func (v *ProjectCreateInput) GetTargetDate() *string { return v.TargetDate }
+
+// GetTargetDateResolution returns ProjectCreateInput.TargetDateResolution, and is useful for accessing the field via an interface.
+func (v *ProjectCreateInput) GetTargetDateResolution() *DateResolutionType {
+	return v.TargetDateResolution
+}
+
+// GetSortOrder returns ProjectCreateInput.SortOrder, and is useful for accessing the field via an interface.
+func (v *ProjectCreateInput) GetSortOrder() *float64 { return v.SortOrder }
+
+// GetPrioritySortOrder returns ProjectCreateInput.PrioritySortOrder, and is useful for accessing the field via an interface.
+func (v *ProjectCreateInput) GetPrioritySortOrder() *float64 { return v.PrioritySortOrder }
+
+// GetPriority returns ProjectCreateInput.Priority, and is useful for accessing the field via an interface.
+func (v *ProjectCreateInput) GetPriority() int { return v.Priority }
+
+// GetLabelIds returns ProjectCreateInput.LabelIds, and is useful for accessing the field via an interface.
+func (v *ProjectCreateInput) GetLabelIds() []string { return v.LabelIds }
+
+// ProjectLeadUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A user that has access to the the resources of an organization.
+type ProjectLeadUser struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
+
+// GetId returns ProjectLeadUser.Id, and is useful for accessing the field via an interface.
+func (v *ProjectLeadUser) GetId() string { return v.Id }
+
+// ProjectMilestone includes the GraphQL fields of ProjectMilestone requested by the fragment ProjectMilestone.
+// The GraphQL type's documentation follows.
+//
+// A milestone for a project.
+type ProjectMilestone struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The name of the project milestone.
+	Name string `json:"name"`
+	// The project milestone's description in markdown format.
+	Description *string `json:"description"`
+	// The planned completion date of the milestone.
+	TargetDate *string `json:"targetDate"`
+	// The order of the milestone in relation to other milestones within a project.
+	SortOrder float64 `json:"sortOrder"`
+	// The project of the milestone.
+	Project ProjectMilestoneProject `json:"project"`
+}
+
+// GetId returns ProjectMilestone.Id, and is useful for accessing the field via an interface.
+func (v *ProjectMilestone) GetId() string { return v.Id }
+
+// GetName returns ProjectMilestone.Name, and is useful for accessing the field via an interface.
+func (v *ProjectMilestone) GetName() string { return v.Name }
+
+// GetDescription returns ProjectMilestone.Description, and is useful for accessing the field via an interface.
+func (v *ProjectMilestone) GetDescription() *string { return v.Description }
+
+// GetTargetDate returns ProjectMilestone.TargetDate, and is useful for accessing the field via an interface.
+func (v *ProjectMilestone) GetTargetDate() *string { return v.TargetDate }
+
+// GetSortOrder returns ProjectMilestone.SortOrder, and is useful for accessing the field via an interface.
+func (v *ProjectMilestone) GetSortOrder() float64 { return v.SortOrder }
+
+// GetProject returns ProjectMilestone.Project, and is useful for accessing the field via an interface.
+func (v *ProjectMilestone) GetProject() ProjectMilestoneProject { return v.Project }
+
+type ProjectMilestoneCreateInput struct {
+	// The identifier in UUID v4 format. If none is provided, the backend will generate one.
+	Id string `json:"id,omitempty"`
+	// The name of the project milestone.
+	Name string `json:"name"`
+	// The description of the project milestone in markdown format.
+	Description *string `json:"description"`
+	// [Internal] The description of the project milestone as a Prosemirror document.
+	DescriptionData map[string]interface{} `json:"descriptionData,omitempty"`
+	// The planned target date of the project milestone.
+	TargetDate *string `json:"targetDate"`
+	// Related project for the project milestone.
+	ProjectId string `json:"projectId"`
+	// The sort order for the project milestone within a project.
+	SortOrder *float64 `json:"sortOrder,omitempty"`
+}
+
+// GetId returns ProjectMilestoneCreateInput.Id, and is useful for accessing the field via an interface.
+func (v *ProjectMilestoneCreateInput) GetId() string { return v.Id }
+
+// GetName returns ProjectMilestoneCreateInput.Name, and is useful for accessing the field via an interface.
+func (v *ProjectMilestoneCreateInput) GetName() string { return v.Name }
+
+// GetDescription returns ProjectMilestoneCreateInput.Description, and is useful for accessing the field via an interface.
+func (v *ProjectMilestoneCreateInput) GetDescription() *string { return v.Description }
+
+// GetDescriptionData returns ProjectMilestoneCreateInput.DescriptionData, and is useful for accessing the field via an interface.
+func (v *ProjectMilestoneCreateInput) GetDescriptionData() map[string]interface{} {
+	return v.DescriptionData
+}
+
+// GetTargetDate returns ProjectMilestoneCreateInput.TargetDate, and is useful for accessing the field via an interface.
+func (v *ProjectMilestoneCreateInput) GetTargetDate() *string { return v.TargetDate }
+
+// GetProjectId returns ProjectMilestoneCreateInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *ProjectMilestoneCreateInput) GetProjectId() string { return v.ProjectId }
+
+// GetSortOrder returns ProjectMilestoneCreateInput.SortOrder, and is useful for accessing the field via an interface.
+func (v *ProjectMilestoneCreateInput) GetSortOrder() *float64 { return v.SortOrder }
+
+// ProjectMilestoneProject includes the requested fields of the GraphQL type Project.
+// The GraphQL type's documentation follows.
+//
+// A project.
+type ProjectMilestoneProject struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
+
+// GetId returns ProjectMilestoneProject.Id, and is useful for accessing the field via an interface.
+func (v *ProjectMilestoneProject) GetId() string { return v.Id }
+
+type ProjectMilestoneUpdateInput struct {
+	// The name of the project milestone.
+	Name string `json:"name,omitempty"`
+	// The description of the project milestone in markdown format.
+	Description *string `json:"description"`
+	// [Internal] The description of the project milestone as a Prosemirror document.
+	DescriptionData map[string]interface{} `json:"descriptionData,omitempty"`
+	// The planned target date of the project milestone.
+	TargetDate *string `json:"targetDate"`
+	// The sort order for the project milestone within a project.
+	SortOrder *float64 `json:"sortOrder,omitempty"`
+	// Related project for the project milestone.
+	ProjectId string `json:"projectId,omitempty"`
+}
+
+// GetName returns ProjectMilestoneUpdateInput.Name, and is useful for accessing the field via an interface.
+func (v *ProjectMilestoneUpdateInput) GetName() string { return v.Name }
+
+// GetDescription returns ProjectMilestoneUpdateInput.Description, and is useful for accessing the field via an interface.
+func (v *ProjectMilestoneUpdateInput) GetDescription() *string { return v.Description }
+
+// GetDescriptionData returns ProjectMilestoneUpdateInput.DescriptionData, and is useful for accessing the field via an interface.
+func (v *ProjectMilestoneUpdateInput) GetDescriptionData() map[string]interface{} {
+	return v.DescriptionData
+}
+
+// GetTargetDate returns ProjectMilestoneUpdateInput.TargetDate, and is useful for accessing the field via an interface.
+func (v *ProjectMilestoneUpdateInput) GetTargetDate() *string { return v.TargetDate }
+
+// GetSortOrder returns ProjectMilestoneUpdateInput.SortOrder, and is useful for accessing the field via an interface.
+func (v *ProjectMilestoneUpdateInput) GetSortOrder() *float64 { return v.SortOrder }
+
+// GetProjectId returns ProjectMilestoneUpdateInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *ProjectMilestoneUpdateInput) GetProjectId() string { return v.ProjectId }
+
+// ProjectStatus includes the GraphQL fields of ProjectStatus requested by the fragment ProjectStatus.
+// The GraphQL type's documentation follows.
+//
+// A project status.
+type ProjectStatus struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The name of the status.
+	Name string `json:"name"`
+	// The UI color of the status as a HEX string.
+	Color string `json:"color"`
+	// Description of the status.
+	Description *string `json:"description"`
+	// The type of the project status.
+	Type ProjectStatusType `json:"type"`
+	// The position of the status in the workspace's project flow.
+	Position float64 `json:"position"`
+	// Whether or not a project can be in this status indefinitely.
+	Indefinite bool `json:"indefinite"`
+	// The time at which the entity was archived. Null if the entity has not been archived.
+	ArchivedAt *time.Time `json:"archivedAt"`
+}
+
+// GetId returns ProjectStatus.Id, and is useful for accessing the field via an interface.
+func (v *ProjectStatus) GetId() string { return v.Id }
+
+// GetName returns ProjectStatus.Name, and is useful for accessing the field via an interface.
+func (v *ProjectStatus) GetName() string { return v.Name }
+
+// GetColor returns ProjectStatus.Color, and is useful for accessing the field via an interface.
+func (v *ProjectStatus) GetColor() string { return v.Color }
+
+// GetDescription returns ProjectStatus.Description, and is useful for accessing the field via an interface.
+func (v *ProjectStatus) GetDescription() *string { return v.Description }
+
+// GetType returns ProjectStatus.Type, and is useful for accessing the field via an interface.
+func (v *ProjectStatus) GetType() ProjectStatusType { return v.Type }
+
+// GetPosition returns ProjectStatus.Position, and is useful for accessing the field via an interface.
+func (v *ProjectStatus) GetPosition() float64 { return v.Position }
+
+// GetIndefinite returns ProjectStatus.Indefinite, and is useful for accessing the field via an interface.
+func (v *ProjectStatus) GetIndefinite() bool { return v.Indefinite }
+
+// GetArchivedAt returns ProjectStatus.ArchivedAt, and is useful for accessing the field via an interface.
+func (v *ProjectStatus) GetArchivedAt() *time.Time { return v.ArchivedAt }
+
+type ProjectStatusCreateInput struct {
+	// The identifier in UUID v4 format. If none is provided, the backend will generate one.
+	Id string `json:"id,omitempty"`
+	// The name of the status.
+	Name string `json:"name"`
+	// The UI color of the status as a HEX string.
+	Color string `json:"color"`
+	// Description of the status.
+	Description *string `json:"description"`
+	// The position of the status in the workspace's project flow.
+	Position float64 `json:"position"`
+	// The type of the project status.
+	Type ProjectStatusType `json:"type"`
+	// Whether or not a project can be in this status indefinitely.
+	Indefinite bool `json:"indefinite"`
+}
+
+// GetId returns ProjectStatusCreateInput.Id, and is useful for accessing the field via an interface.
+func (v *ProjectStatusCreateInput) GetId() string { return v.Id }
+
+// GetName returns ProjectStatusCreateInput.Name, and is useful for accessing the field via an interface.
+func (v *ProjectStatusCreateInput) GetName() string { return v.Name }
+
+// GetColor returns ProjectStatusCreateInput.Color, and is useful for accessing the field via an interface.
+func (v *ProjectStatusCreateInput) GetColor() string { return v.Color }
+
+// GetDescription returns ProjectStatusCreateInput.Description, and is useful for accessing the field via an interface.
+func (v *ProjectStatusCreateInput) GetDescription() *string { return v.Description }
+
+// GetPosition returns ProjectStatusCreateInput.Position, and is useful for accessing the field via an interface.
+func (v *ProjectStatusCreateInput) GetPosition() float64 { return v.Position }
+
+// GetType returns ProjectStatusCreateInput.Type, and is useful for accessing the field via an interface.
+func (v *ProjectStatusCreateInput) GetType() ProjectStatusType { return v.Type }
+
+// GetIndefinite returns ProjectStatusCreateInput.Indefinite, and is useful for accessing the field via an interface.
+func (v *ProjectStatusCreateInput) GetIndefinite() bool { return v.Indefinite }
+
+// ProjectStatusProjectStatus includes the requested fields of the GraphQL type ProjectStatus.
+// The GraphQL type's documentation follows.
+//
+// A project status.
+type ProjectStatusProjectStatus struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
+
+// GetId returns ProjectStatusProjectStatus.Id, and is useful for accessing the field via an interface.
+func (v *ProjectStatusProjectStatus) GetId() string { return v.Id }
+
+// A type of project status.
+type ProjectStatusType string
+
+const (
+	ProjectStatusTypeBacklog   ProjectStatusType = "backlog"
+	ProjectStatusTypePlanned   ProjectStatusType = "planned"
+	ProjectStatusTypeStarted   ProjectStatusType = "started"
+	ProjectStatusTypePaused    ProjectStatusType = "paused"
+	ProjectStatusTypeCompleted ProjectStatusType = "completed"
+	ProjectStatusTypeCanceled  ProjectStatusType = "canceled"
+)
+
+type ProjectStatusUpdateInput struct {
+	// The name of the status.
+	Name string `json:"name,omitempty"`
+	// The UI color of the status as a HEX string.
+	Color string `json:"color,omitempty"`
+	// Description of the status.
+	Description *string `json:"description"`
+	// The position of the status in the workspace's project flow.
+	Position float64 `json:"position"`
+	// The type of the project status.
+	Type ProjectStatusType `json:"type,omitempty"`
+	// Whether or not a project can be in this status indefinitely.
+	Indefinite bool `json:"indefinite"`
+}
+
+// GetName returns ProjectStatusUpdateInput.Name, and is useful for accessing the field via an interface.
+func (v *ProjectStatusUpdateInput) GetName() string { return v.Name }
+
+// GetColor returns ProjectStatusUpdateInput.Color, and is useful for accessing the field via an interface.
+func (v *ProjectStatusUpdateInput) GetColor() string { return v.Color }
+
+// GetDescription returns ProjectStatusUpdateInput.Description, and is useful for accessing the field via an interface.
+func (v *ProjectStatusUpdateInput) GetDescription() *string { return v.Description }
+
+// GetPosition returns ProjectStatusUpdateInput.Position, and is useful for accessing the field via an interface.
+func (v *ProjectStatusUpdateInput) GetPosition() float64 { return v.Position }
+
+// GetType returns ProjectStatusUpdateInput.Type, and is useful for accessing the field via an interface.
+func (v *ProjectStatusUpdateInput) GetType() ProjectStatusType { return v.Type }
+
+// GetIndefinite returns ProjectStatusUpdateInput.Indefinite, and is useful for accessing the field via an interface.
+func (v *ProjectStatusUpdateInput) GetIndefinite() bool { return v.Indefinite }
+
+// ProjectTeamsTeamConnection includes the requested fields of the GraphQL type TeamConnection.
+type ProjectTeamsTeamConnection struct {
+	Nodes []ProjectTeamsTeamConnectionNodesTeam `json:"nodes"`
+}
+
+// GetNodes returns ProjectTeamsTeamConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *ProjectTeamsTeamConnection) GetNodes() []ProjectTeamsTeamConnectionNodesTeam { return v.Nodes }
+
+// ProjectTeamsTeamConnectionNodesTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type ProjectTeamsTeamConnectionNodesTeam struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
+
+// GetId returns ProjectTeamsTeamConnectionNodesTeam.Id, and is useful for accessing the field via an interface.
+func (v *ProjectTeamsTeamConnectionNodesTeam) GetId() string { return v.Id }
+
+type ProjectUpdateInput struct {
+	// The ID of the project status.
+	StatusId *string `json:"statusId,omitempty"`
+	// The name of the project.
+	Name string `json:"name,omitempty"`
+	// The description for the project.
+	Description string `json:"description"`
+	// The project content as markdown.
+	Content *string `json:"content,omitempty"`
+	// The ID of the issue from which that project is created.
+	ConvertedFromIssueId *string `json:"convertedFromIssueId,omitempty"`
+	// The ID of the last template applied to the project.
+	LastAppliedTemplateId *string `json:"lastAppliedTemplateId,omitempty"`
+	// The icon of the project.
+	Icon *string `json:"icon"`
+	// The color of the project.
+	Color *string `json:"color,omitempty"`
+	// The identifiers of the teams this project is associated with.
+	TeamIds []string `json:"teamIds"`
+	// The time until which project update reminders are paused.
+	ProjectUpdateRemindersPausedUntilAt *time.Time `json:"projectUpdateRemindersPausedUntilAt,omitempty"`
+	// The n-weekly frequency at which to prompt for updates. When not set, reminders are inherited from workspace.
+	UpdateReminderFrequencyInWeeks *float64 `json:"updateReminderFrequencyInWeeks,omitempty"`
+	// The frequency at which to prompt for updates. When not set, reminders are inherited from workspace.
+	UpdateReminderFrequency *float64 `json:"updateReminderFrequency,omitempty"`
+	// The frequency resolution.
+	FrequencyResolution *FrequencyResolutionType `json:"frequencyResolution,omitempty"`
+	// The day at which to prompt for updates.
+	UpdateRemindersDay *Day `json:"updateRemindersDay,omitempty"`
+	// The hour at which to prompt for updates.
+	UpdateRemindersHour *int `json:"updateRemindersHour,omitempty"`
+	// The identifier of the project lead.
+	LeadId *string `json:"leadId"`
+	// The identifiers of the members of this project.
+	MemberIds []string `json:"memberIds,omitempty"`
+	// The planned start date of the project.
+	StartDate *string `json:"startDate"`
+	// The resolution of the project's start date.
+	StartDateResolution *DateResolutionType `json:"startDateResolution,omitempty"`
+	// The planned target date of the project.
+	TargetDate *string `json:"targetDate"`
+	// The resolution of the project's estimated completion date.
+	TargetDateResolution *DateResolutionType `json:"targetDateResolution,omitempty"`
+	// The date when the project was completed.
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+	// The date when the project was canceled.
+	CanceledAt *time.Time `json:"canceledAt,omitempty"`
+	// Whether to send new issue notifications to Slack.
+	SlackNewIssue *bool `json:"slackNewIssue,omitempty"`
+	// Whether to send new issue comment notifications to Slack.
+	SlackIssueComments *bool `json:"slackIssueComments,omitempty"`
+	// Whether to send issue status update notifications to Slack.
+	SlackIssueStatuses *bool `json:"slackIssueStatuses,omitempty"`
+	// The sort order for the project in shared views.
+	SortOrder *float64 `json:"sortOrder,omitempty"`
+	// The sort order for the project within shared views, when ordered by priority.
+	PrioritySortOrder *float64 `json:"prioritySortOrder,omitempty"`
+	// Whether the project has been trashed.
+	Trashed *bool `json:"trashed,omitempty"`
+	// The priority of the project. 0 = No priority, 1 = Urgent, 2 = High, 3 = Normal, 4 = Low.
+	Priority int `json:"priority"`
+	// [Internal] The identifiers of the project labels associated with this project.
+	LabelIds []string `json:"labelIds"`
+}
+
+// GetStatusId returns ProjectUpdateInput.StatusId, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetStatusId() *string { return v.StatusId }
+
+// GetName returns ProjectUpdateInput.Name, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetName() string { return v.Name }
+
+// GetDescription returns ProjectUpdateInput.Description, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetDescription() string { return v.Description }
+
+// GetContent returns ProjectUpdateInput.Content, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetContent() *string { return v.Content }
+
+// GetConvertedFromIssueId returns ProjectUpdateInput.ConvertedFromIssueId, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetConvertedFromIssueId() *string { return v.ConvertedFromIssueId }
+
+// GetLastAppliedTemplateId returns ProjectUpdateInput.LastAppliedTemplateId, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetLastAppliedTemplateId() *string { return v.LastAppliedTemplateId }
+
+// GetIcon returns ProjectUpdateInput.Icon, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetIcon() *string { return v.Icon }
+
+// GetColor returns ProjectUpdateInput.Color, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetColor() *string { return v.Color }
+
+// GetTeamIds returns ProjectUpdateInput.TeamIds, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetTeamIds() []string { return v.TeamIds }
+
+// GetProjectUpdateRemindersPausedUntilAt returns ProjectUpdateInput.ProjectUpdateRemindersPausedUntilAt, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetProjectUpdateRemindersPausedUntilAt() *time.Time {
+	return v.ProjectUpdateRemindersPausedUntilAt
+}
+
+// GetUpdateReminderFrequencyInWeeks returns ProjectUpdateInput.UpdateReminderFrequencyInWeeks, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetUpdateReminderFrequencyInWeeks() *float64 {
+	return v.UpdateReminderFrequencyInWeeks
+}
+
+// GetUpdateReminderFrequency returns ProjectUpdateInput.UpdateReminderFrequency, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetUpdateReminderFrequency() *float64 { return v.UpdateReminderFrequency }
+
+// GetFrequencyResolution returns ProjectUpdateInput.FrequencyResolution, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetFrequencyResolution() *FrequencyResolutionType {
+	return v.FrequencyResolution
+}
+
+// GetUpdateRemindersDay returns ProjectUpdateInput.UpdateRemindersDay, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetUpdateRemindersDay() *Day { return v.UpdateRemindersDay }
+
+// GetUpdateRemindersHour returns ProjectUpdateInput.UpdateRemindersHour, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetUpdateRemindersHour() *int { return v.UpdateRemindersHour }
+
+// GetLeadId returns ProjectUpdateInput.LeadId, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetLeadId() *string { return v.LeadId }
+
+// GetMemberIds returns ProjectUpdateInput.MemberIds, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetMemberIds() []string { return v.MemberIds }
+
+// GetStartDate returns ProjectUpdateInput.StartDate, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetStartDate() *string { return v.StartDate }
+
+// GetStartDateResolution returns ProjectUpdateInput.StartDateResolution, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetStartDateResolution() *DateResolutionType {
+	return v.StartDateResolution
+}
+
+// GetTargetDate returns ProjectUpdateInput.TargetDate, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetTargetDate() *string { return v.TargetDate }
+
+// GetTargetDateResolution returns ProjectUpdateInput.TargetDateResolution, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetTargetDateResolution() *DateResolutionType {
+	return v.TargetDateResolution
+}
+
+// GetCompletedAt returns ProjectUpdateInput.CompletedAt, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetCompletedAt() *time.Time { return v.CompletedAt }
+
+// GetCanceledAt returns ProjectUpdateInput.CanceledAt, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetCanceledAt() *time.Time { return v.CanceledAt }
+
+// GetSlackNewIssue returns ProjectUpdateInput.SlackNewIssue, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetSlackNewIssue() *bool { return v.SlackNewIssue }
+
+// GetSlackIssueComments returns ProjectUpdateInput.SlackIssueComments, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetSlackIssueComments() *bool { return v.SlackIssueComments }
+
+// GetSlackIssueStatuses returns ProjectUpdateInput.SlackIssueStatuses, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetSlackIssueStatuses() *bool { return v.SlackIssueStatuses }
+
+// GetSortOrder returns ProjectUpdateInput.SortOrder, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetSortOrder() *float64 { return v.SortOrder }
+
+// GetPrioritySortOrder returns ProjectUpdateInput.PrioritySortOrder, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetPrioritySortOrder() *float64 { return v.PrioritySortOrder }
+
+// GetTrashed returns ProjectUpdateInput.Trashed, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetTrashed() *bool { return v.Trashed }
+
+// GetPriority returns ProjectUpdateInput.Priority, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetPriority() int { return v.Priority }
+
+// GetLabelIds returns ProjectUpdateInput.LabelIds, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetLabelIds() []string { return v.LabelIds }
+
+// Team includes the GraphQL fields of Team requested by the fragment Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type Team struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The team's name.
+	Name string `json:"name"`
+	// The team's unique key. The key is used in URLs.
+	Key string `json:"key"`
+	// Whether the team is private or not.
+	Private bool `json:"private"`
+	// The team's description.
+	Description *string `json:"description"`
+	// The icon of the team.
+	Icon *string `json:"icon"`
+	// The team's color.
+	Color *string `json:"color"`
+	// [Internal] The team's parent team.
 	Parent *TeamParentTeam `json:"parent"`
 	// The timezone of the team. Defaults to "America/Los_Angeles"
 	Timezone string `json:"timezone"`
@@ -921,32 +2694,139 @@ func (v *TeamCreateInput) GetProductIntelligenceScope() ProductIntelligenceScope
 	return v.ProductIntelligenceScope
 }
 
-// TeamParentTeam includes the requested fields of the GraphQL type Team.
+// TeamMembership includes the GraphQL fields of TeamMembership requested by the fragment TeamMembership.
 // The GraphQL type's documentation follows.
 //
-// An organizational unit that contains issues.
-type TeamParentTeam struct {
+// Defines the membership of a user to a team.
+type TeamMembership struct {
 	// The unique identifier of the entity.
 	Id string `json:"id"`
+	// Whether the user is the owner of the team.
+	Owner bool `json:"owner"`
+	// The order of the item in the users team list.
+	SortOrder float64 `json:"sortOrder"`
+	// The team that the membership is associated with.
+	Team TeamMembershipTeam `json:"team"`
+	// The user that the membership is associated with.
+	User TeamMembershipUser `json:"user"`
+	// The time at which the entity was archived. Null if the entity has not been archived.
+	ArchivedAt *time.Time `json:"archivedAt"`
 }
 
-// GetId returns TeamParentTeam.Id, and is useful for accessing the field via an interface.
-func (v *TeamParentTeam) GetId() string { return v.Id }
+// GetId returns TeamMembership.Id, and is useful for accessing the field via an interface.
+func (v *TeamMembership) GetId() string { return v.Id }
 
-type TeamUpdateInput struct {
-	// The name of the team.
-	Name string `json:"name,omitempty"`
-	// The description of the team.
-	Description *string `json:"description"`
-	// The key of the team.
-	Key string `json:"key,omitempty"`
-	// The icon of the team.
-	Icon *string `json:"icon,omitempty"`
-	// The color of the team.
-	Color *string `json:"color,omitempty"`
-	// Whether the team uses cycles.
-	CyclesEnabled bool `json:"cyclesEnabled"`
-	// The day of the week that a new cycle starts.
+// GetOwner returns TeamMembership.Owner, and is useful for accessing the field via an interface.
+func (v *TeamMembership) GetOwner() bool { return v.Owner }
+
+// GetSortOrder returns TeamMembership.SortOrder, and is useful for accessing the field via an interface.
+func (v *TeamMembership) GetSortOrder() float64 { return v.SortOrder }
+
+// GetTeam returns TeamMembership.Team, and is useful for accessing the field via an interface.
+func (v *TeamMembership) GetTeam() TeamMembershipTeam { return v.Team }
+
+// GetUser returns TeamMembership.User, and is useful for accessing the field via an interface.
+func (v *TeamMembership) GetUser() TeamMembershipUser { return v.User }
+
+// GetArchivedAt returns TeamMembership.ArchivedAt, and is useful for accessing the field via an interface.
+func (v *TeamMembership) GetArchivedAt() *time.Time { return v.ArchivedAt }
+
+type TeamMembershipCreateInput struct {
+	// The identifier in UUID v4 format. If none is provided, the backend will generate one.
+	Id string `json:"id,omitempty"`
+	// The identifier of the user associated with the membership.
+	UserId string `json:"userId"`
+	// The identifier of the team associated with the membership.
+	TeamId string `json:"teamId"`
+	// Internal. Whether the user is the owner of the team.
+	Owner bool `json:"owner"`
+	// The position of the item in the users list.
+	SortOrder *float64 `json:"sortOrder,omitempty"`
+}
+
+// GetId returns TeamMembershipCreateInput.Id, and is useful for accessing the field via an interface.
+func (v *TeamMembershipCreateInput) GetId() string { return v.Id }
+
+// GetUserId returns TeamMembershipCreateInput.UserId, and is useful for accessing the field via an interface.
+func (v *TeamMembershipCreateInput) GetUserId() string { return v.UserId }
+
+// GetTeamId returns TeamMembershipCreateInput.TeamId, and is useful for accessing the field via an interface.
+func (v *TeamMembershipCreateInput) GetTeamId() string { return v.TeamId }
+
+// GetOwner returns TeamMembershipCreateInput.Owner, and is useful for accessing the field via an interface.
+func (v *TeamMembershipCreateInput) GetOwner() bool { return v.Owner }
+
+// GetSortOrder returns TeamMembershipCreateInput.SortOrder, and is useful for accessing the field via an interface.
+func (v *TeamMembershipCreateInput) GetSortOrder() *float64 { return v.SortOrder }
+
+// TeamMembershipTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type TeamMembershipTeam struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
+
+// GetId returns TeamMembershipTeam.Id, and is useful for accessing the field via an interface.
+func (v *TeamMembershipTeam) GetId() string { return v.Id }
+
+type TeamMembershipUpdateInput struct {
+	// Internal. Whether the user is the owner of the team.
+	Owner bool `json:"owner"`
+	// The position of the item in the users list.
+	SortOrder *float64 `json:"sortOrder,omitempty"`
+}
+
+// GetOwner returns TeamMembershipUpdateInput.Owner, and is useful for accessing the field via an interface.
+func (v *TeamMembershipUpdateInput) GetOwner() bool { return v.Owner }
+
+// GetSortOrder returns TeamMembershipUpdateInput.SortOrder, and is useful for accessing the field via an interface.
+func (v *TeamMembershipUpdateInput) GetSortOrder() *float64 { return v.SortOrder }
+
+// TeamMembershipUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A user that has access to the the resources of an organization.
+type TeamMembershipUser struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The user's email address.
+	Email string `json:"email"`
+}
+
+// GetId returns TeamMembershipUser.Id, and is useful for accessing the field via an interface.
+func (v *TeamMembershipUser) GetId() string { return v.Id }
+
+// GetEmail returns TeamMembershipUser.Email, and is useful for accessing the field via an interface.
+func (v *TeamMembershipUser) GetEmail() string { return v.Email }
+
+// TeamParentTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type TeamParentTeam struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
+
+// GetId returns TeamParentTeam.Id, and is useful for accessing the field via an interface.
+func (v *TeamParentTeam) GetId() string { return v.Id }
+
+type TeamUpdateInput struct {
+	// The name of the team.
+	Name string `json:"name,omitempty"`
+	// The description of the team.
+	Description *string `json:"description"`
+	// The key of the team.
+	Key string `json:"key,omitempty"`
+	// The icon of the team.
+	Icon *string `json:"icon,omitempty"`
+	// The color of the team.
+	Color *string `json:"color,omitempty"`
+	// Whether the team uses cycles.
+	CyclesEnabled bool `json:"cyclesEnabled"`
+	// The day of the week that a new cycle starts.
 	CycleStartDay float64 `json:"cycleStartDay"`
 	// The duration of each cycle in weeks.
 	CycleDuration int `json:"cycleDuration"`
@@ -1405,6 +3285,413 @@ func (v *TemplateUpdateInput) GetTemplateData() string { return v.TemplateData }
 // GetSortOrder returns TemplateUpdateInput.SortOrder, and is useful for accessing the field via an interface.
 func (v *TemplateUpdateInput) GetSortOrder() *float64 { return v.SortOrder }
 
+// TimeSchedule includes the GraphQL fields of TimeSchedule requested by the fragment TimeSchedule.
+// The GraphQL type's documentation follows.
+//
+// A time schedule.
+type TimeSchedule struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The name of the schedule.
+	Name string `json:"name"`
+	// The schedule entries.
+	Entries []TimeScheduleEntriesTimeScheduleEntry `json:"entries"`
+	// The time at which the entity was archived. Null if the entity has not been archived.
+	ArchivedAt *time.Time `json:"archivedAt"`
+}
+
+// GetId returns TimeSchedule.Id, and is useful for accessing the field via an interface.
+func (v *TimeSchedule) GetId() string { return v.Id }
+
+// GetName returns TimeSchedule.Name, and is useful for accessing the field via an interface.
+func (v *TimeSchedule) GetName() string { return v.Name }
+
+// GetEntries returns TimeSchedule.Entries, and is useful for accessing the field via an interface.
+func (v *TimeSchedule) GetEntries() []TimeScheduleEntriesTimeScheduleEntry { return v.Entries }
+
+// GetArchivedAt returns TimeSchedule.ArchivedAt, and is useful for accessing the field via an interface.
+func (v *TimeSchedule) GetArchivedAt() *time.Time { return v.ArchivedAt }
+
+type TimeScheduleCreateInput struct {
+	// The identifier in UUID v4 format. If none is provided, the backend will generate one.
+	Id string `json:"id,omitempty"`
+	// The name of the schedule.
+	Name string `json:"name"`
+	// The schedule entries.
+	Entries []TimeScheduleEntryInput `json:"entries"`
+	// The unique identifier of the external schedule.
+	ExternalId *string `json:"externalId,omitempty"`
+	// The URL to the external schedule.
+	ExternalUrl *string `json:"externalUrl,omitempty"`
+}
+
+// GetId returns TimeScheduleCreateInput.Id, and is useful for accessing the field via an interface.
+func (v *TimeScheduleCreateInput) GetId() string { return v.Id }
+
+// GetName returns TimeScheduleCreateInput.Name, and is useful for accessing the field via an interface.
+func (v *TimeScheduleCreateInput) GetName() string { return v.Name }
+
+// GetEntries returns TimeScheduleCreateInput.Entries, and is useful for accessing the field via an interface.
+func (v *TimeScheduleCreateInput) GetEntries() []TimeScheduleEntryInput { return v.Entries }
+
+// GetExternalId returns TimeScheduleCreateInput.ExternalId, and is useful for accessing the field via an interface.
+func (v *TimeScheduleCreateInput) GetExternalId() *string { return v.ExternalId }
+
+// GetExternalUrl returns TimeScheduleCreateInput.ExternalUrl, and is useful for accessing the field via an interface.
+func (v *TimeScheduleCreateInput) GetExternalUrl() *string { return v.ExternalUrl }
+
+// TimeScheduleEntriesTimeScheduleEntry includes the requested fields of the GraphQL type TimeScheduleEntry.
+type TimeScheduleEntriesTimeScheduleEntry struct {
+	// The Linear user id of the user on schedule. If the user cannot be mapped to a
+	// Linear user then `userEmail` can be used as a reference.
+	UserId *string `json:"userId"`
+	// The start date of the schedule in ISO 8601 date-time format.
+	StartsAt time.Time `json:"startsAt"`
+	// The end date of the schedule in ISO 8601 date-time format.
+	EndsAt time.Time `json:"endsAt"`
+}
+
+// GetUserId returns TimeScheduleEntriesTimeScheduleEntry.UserId, and is useful for accessing the field via an interface.
+func (v *TimeScheduleEntriesTimeScheduleEntry) GetUserId() *string { return v.UserId }
+
+// GetStartsAt returns TimeScheduleEntriesTimeScheduleEntry.StartsAt, and is useful for accessing the field via an interface.
+func (v *TimeScheduleEntriesTimeScheduleEntry) GetStartsAt() time.Time { return v.StartsAt }
+
+// GetEndsAt returns TimeScheduleEntriesTimeScheduleEntry.EndsAt, and is useful for accessing the field via an interface.
+func (v *TimeScheduleEntriesTimeScheduleEntry) GetEndsAt() time.Time { return v.EndsAt }
+
+type TimeScheduleEntryInput struct {
+	// The start date of the schedule in ISO 8601 date-time format.
+	StartsAt time.Time `json:"startsAt"`
+	// The end date of the schedule in ISO 8601 date-time format.
+	EndsAt time.Time `json:"endsAt"`
+	// The Linear user id of the user on schedule. If the user cannot be mapped to a
+	// Linear user then `userEmail` can be used as a reference.
+	UserId string `json:"userId"`
+	// The email, name or reference to the user on schedule. This is used in case the
+	// external user could not be mapped to a Linear user id.
+	UserEmail *string `json:"userEmail,omitempty"`
+}
+
+// GetStartsAt returns TimeScheduleEntryInput.StartsAt, and is useful for accessing the field via an interface.
+func (v *TimeScheduleEntryInput) GetStartsAt() time.Time { return v.StartsAt }
+
+// GetEndsAt returns TimeScheduleEntryInput.EndsAt, and is useful for accessing the field via an interface.
+func (v *TimeScheduleEntryInput) GetEndsAt() time.Time { return v.EndsAt }
+
+// GetUserId returns TimeScheduleEntryInput.UserId, and is useful for accessing the field via an interface.
+func (v *TimeScheduleEntryInput) GetUserId() string { return v.UserId }
+
+// GetUserEmail returns TimeScheduleEntryInput.UserEmail, and is useful for accessing the field via an interface.
+func (v *TimeScheduleEntryInput) GetUserEmail() *string { return v.UserEmail }
+
+type TimeScheduleUpdateInput struct {
+	// The name of the schedule.
+	Name string `json:"name"`
+	// The schedule entries.
+	Entries []TimeScheduleEntryInput `json:"entries"`
+	// The unique identifier of the external schedule.
+	ExternalId *string `json:"externalId,omitempty"`
+	// The URL to the external schedule.
+	ExternalUrl *string `json:"externalUrl,omitempty"`
+}
+
+// GetName returns TimeScheduleUpdateInput.Name, and is useful for accessing the field via an interface.
+func (v *TimeScheduleUpdateInput) GetName() string { return v.Name }
+
+// GetEntries returns TimeScheduleUpdateInput.Entries, and is useful for accessing the field via an interface.
+func (v *TimeScheduleUpdateInput) GetEntries() []TimeScheduleEntryInput { return v.Entries }
+
+// GetExternalId returns TimeScheduleUpdateInput.ExternalId, and is useful for accessing the field via an interface.
+func (v *TimeScheduleUpdateInput) GetExternalId() *string { return v.ExternalId }
+
+// GetExternalUrl returns TimeScheduleUpdateInput.ExternalUrl, and is useful for accessing the field via an interface.
+func (v *TimeScheduleUpdateInput) GetExternalUrl() *string { return v.ExternalUrl }
+
+// TriageResponsibility includes the GraphQL fields of TriageResponsibility requested by the fragment TriageResponsibility.
+// The GraphQL type's documentation follows.
+//
+// A team's triage responsibility.
+type TriageResponsibility struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The action to take when an issue is added to triage.
+	Action TriageResponsibilityAction `json:"action"`
+	// Set of users used for triage responsibility.
+	ManualSelection *TriageResponsibilityManualSelection `json:"manualSelection"`
+	// The time schedule used for scheduling.
+	TimeSchedule *TriageResponsibilityTimeSchedule `json:"timeSchedule"`
+	// The team to which the triage responsibility belongs to.
+	Team TriageResponsibilityTeam `json:"team"`
+	// The time at which the entity was archived. Null if the entity has not been archived.
+	ArchivedAt *time.Time `json:"archivedAt"`
+}
+
+// GetId returns TriageResponsibility.Id, and is useful for accessing the field via an interface.
+func (v *TriageResponsibility) GetId() string { return v.Id }
+
+// GetAction returns TriageResponsibility.Action, and is useful for accessing the field via an interface.
+func (v *TriageResponsibility) GetAction() TriageResponsibilityAction { return v.Action }
+
+// GetManualSelection returns TriageResponsibility.ManualSelection, and is useful for accessing the field via an interface.
+func (v *TriageResponsibility) GetManualSelection() *TriageResponsibilityManualSelection {
+	return v.ManualSelection
+}
+
+// GetTimeSchedule returns TriageResponsibility.TimeSchedule, and is useful for accessing the field via an interface.
+func (v *TriageResponsibility) GetTimeSchedule() *TriageResponsibilityTimeSchedule {
+	return v.TimeSchedule
+}
+
+// GetTeam returns TriageResponsibility.Team, and is useful for accessing the field via an interface.
+func (v *TriageResponsibility) GetTeam() TriageResponsibilityTeam { return v.Team }
+
+// GetArchivedAt returns TriageResponsibility.ArchivedAt, and is useful for accessing the field via an interface.
+func (v *TriageResponsibility) GetArchivedAt() *time.Time { return v.ArchivedAt }
+
+// Which action should be taken after an issue is added to triage.
+type TriageResponsibilityAction string
+
+const (
+	TriageResponsibilityActionAssign TriageResponsibilityAction = "assign"
+	TriageResponsibilityActionNotify TriageResponsibilityAction = "notify"
+)
+
+type TriageResponsibilityCreateInput struct {
+	// The identifier in UUID v4 format. If none is provided, the backend will generate one.
+	Id string `json:"id,omitempty"`
+	// The identifier of the team associated with the triage responsibility.
+	TeamId string `json:"teamId"`
+	// The action to take when an issue is added to triage.
+	Action string `json:"action"`
+	// The manual selection of users responsible for triage.
+	ManualSelection *TriageResponsibilityManualSelectionInput `json:"manualSelection,omitempty"`
+	// The identifier of the time schedule used for scheduling triage responsibility
+	TimeScheduleId *string `json:"timeScheduleId,omitempty"`
+}
+
+// GetId returns TriageResponsibilityCreateInput.Id, and is useful for accessing the field via an interface.
+func (v *TriageResponsibilityCreateInput) GetId() string { return v.Id }
+
+// GetTeamId returns TriageResponsibilityCreateInput.TeamId, and is useful for accessing the field via an interface.
+func (v *TriageResponsibilityCreateInput) GetTeamId() string { return v.TeamId }
+
+// GetAction returns TriageResponsibilityCreateInput.Action, and is useful for accessing the field via an interface.
+func (v *TriageResponsibilityCreateInput) GetAction() string { return v.Action }
+
+// GetManualSelection returns TriageResponsibilityCreateInput.ManualSelection, and is useful for accessing the field via an interface.
+func (v *TriageResponsibilityCreateInput) GetManualSelection() *TriageResponsibilityManualSelectionInput {
+	return v.ManualSelection
+}
+
+// GetTimeScheduleId returns TriageResponsibilityCreateInput.TimeScheduleId, and is useful for accessing the field via an interface.
+func (v *TriageResponsibilityCreateInput) GetTimeScheduleId() *string { return v.TimeScheduleId }
+
+// TriageResponsibilityManualSelection includes the requested fields of the GraphQL type TriageResponsibilityManualSelection.
+type TriageResponsibilityManualSelection struct {
+	// The set of users responsible for triage.
+	UserIds []string `json:"userIds"`
+}
+
+// GetUserIds returns TriageResponsibilityManualSelection.UserIds, and is useful for accessing the field via an interface.
+func (v *TriageResponsibilityManualSelection) GetUserIds() []string { return v.UserIds }
+
+// Manual triage responsibility using a set of users.
+type TriageResponsibilityManualSelectionInput struct {
+	// The set of users responsible for triage.
+	UserIds []string `json:"userIds"`
+	// [Internal] The index of the current userId used for the assign action when having more than one user.
+	AssignmentIndex *int `json:"assignmentIndex,omitempty"`
+}
+
+// GetUserIds returns TriageResponsibilityManualSelectionInput.UserIds, and is useful for accessing the field via an interface.
+func (v *TriageResponsibilityManualSelectionInput) GetUserIds() []string { return v.UserIds }
+
+// GetAssignmentIndex returns TriageResponsibilityManualSelectionInput.AssignmentIndex, and is useful for accessing the field via an interface.
+func (v *TriageResponsibilityManualSelectionInput) GetAssignmentIndex() *int {
+	return v.AssignmentIndex
+}
+
+// TriageResponsibilityTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type TriageResponsibilityTeam struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
+
+// GetId returns TriageResponsibilityTeam.Id, and is useful for accessing the field via an interface.
+func (v *TriageResponsibilityTeam) GetId() string { return v.Id }
+
+// TriageResponsibilityTimeSchedule includes the requested fields of the GraphQL type TimeSchedule.
+// The GraphQL type's documentation follows.
+//
+// A time schedule.
+type TriageResponsibilityTimeSchedule struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
+
+// GetId returns TriageResponsibilityTimeSchedule.Id, and is useful for accessing the field via an interface.
+func (v *TriageResponsibilityTimeSchedule) GetId() string { return v.Id }
+
+type TriageResponsibilityUpdateInput struct {
+	// The action to take when an issue is added to triage.
+	Action string `json:"action"`
+	// The manual selection of users responsible for triage.
+	ManualSelection *TriageResponsibilityManualSelectionInput `json:"manualSelection"`
+	// The identifier of the time schedule used for scheduling triage responsibility.
+	TimeScheduleId *string `json:"timeScheduleId"`
+}
+
+// GetAction returns TriageResponsibilityUpdateInput.Action, and is useful for accessing the field via an interface.
+func (v *TriageResponsibilityUpdateInput) GetAction() string { return v.Action }
+
+// GetManualSelection returns TriageResponsibilityUpdateInput.ManualSelection, and is useful for accessing the field via an interface.
+func (v *TriageResponsibilityUpdateInput) GetManualSelection() *TriageResponsibilityManualSelectionInput {
+	return v.ManualSelection
+}
+
+// GetTimeScheduleId returns TriageResponsibilityUpdateInput.TimeScheduleId, and is useful for accessing the field via an interface.
+func (v *TriageResponsibilityUpdateInput) GetTimeScheduleId() *string { return v.TimeScheduleId }
+
+// The different permission roles available to users on an organization.
+type UserRoleType string
+
+const (
+	UserRoleTypeAdmin UserRoleType = "admin"
+	UserRoleTypeGuest UserRoleType = "guest"
+	UserRoleTypeUser  UserRoleType = "user"
+	UserRoleTypeApp   UserRoleType = "app"
+)
+
+// Webhook includes the GraphQL fields of Webhook requested by the fragment Webhook.
+// The GraphQL type's documentation follows.
+//
+// A webhook used to send HTTP notifications over data updates.
+type Webhook struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// Webhook label.
+	Label *string `json:"label"`
+	// Webhook URL.
+	Url string `json:"url"`
+	// Whether the Webhook is enabled.
+	Enabled bool `json:"enabled"`
+	// The team that the webhook is associated with. If null, the webhook is
+	// associated with all public teams of the organization or multiple teams.
+	Team *WebhookTeam `json:"team"`
+	// Whether the Webhook is enabled for all public teams, including teams created after the webhook was created.
+	AllPublicTeams bool `json:"allPublicTeams"`
+	// The resource types this webhook is subscribed to.
+	ResourceTypes []string `json:"resourceTypes"`
+}
+
+// GetId returns Webhook.Id, and is useful for accessing the field via an interface.
+func (v *Webhook) GetId() string { return v.Id }
+
+// GetLabel returns Webhook.Label, and is useful for accessing the field via an interface.
+func (v *Webhook) GetLabel() *string { return v.Label }
+
+// GetUrl returns Webhook.Url, and is useful for accessing the field via an interface.
+func (v *Webhook) GetUrl() string { return v.Url }
+
+// GetEnabled returns Webhook.Enabled, and is useful for accessing the field via an interface.
+func (v *Webhook) GetEnabled() bool { return v.Enabled }
+
+// GetTeam returns Webhook.Team, and is useful for accessing the field via an interface.
+func (v *Webhook) GetTeam() *WebhookTeam { return v.Team }
+
+// GetAllPublicTeams returns Webhook.AllPublicTeams, and is useful for accessing the field via an interface.
+func (v *Webhook) GetAllPublicTeams() bool { return v.AllPublicTeams }
+
+// GetResourceTypes returns Webhook.ResourceTypes, and is useful for accessing the field via an interface.
+func (v *Webhook) GetResourceTypes() []string { return v.ResourceTypes }
+
+type WebhookCreateInput struct {
+	// Label for the webhook.
+	Label *string `json:"label"`
+	// The identifier in UUID v4 format. If none is provided, the backend will generate one.
+	Id string `json:"id,omitempty"`
+	// Whether this webhook is enabled.
+	Enabled bool `json:"enabled"`
+	// A secret token used to sign the webhook payload.
+	Secret *string `json:"secret,omitempty"`
+	// The URL that will be called on data changes.
+	Url string `json:"url"`
+	// List of resources the webhook should subscribe to.
+	ResourceTypes []string `json:"resourceTypes"`
+	// The identifier or key of the team associated with the Webhook.
+	TeamId *string `json:"teamId,omitempty"`
+	// Whether this webhook is enabled for all public teams.
+	AllPublicTeams bool `json:"allPublicTeams"`
+}
+
+// GetLabel returns WebhookCreateInput.Label, and is useful for accessing the field via an interface.
+func (v *WebhookCreateInput) GetLabel() *string { return v.Label }
+
+// GetId returns WebhookCreateInput.Id, and is useful for accessing the field via an interface.
+func (v *WebhookCreateInput) GetId() string { return v.Id }
+
+// GetEnabled returns WebhookCreateInput.Enabled, and is useful for accessing the field via an interface.
+func (v *WebhookCreateInput) GetEnabled() bool { return v.Enabled }
+
+// GetSecret returns WebhookCreateInput.Secret, and is useful for accessing the field via an interface.
+func (v *WebhookCreateInput) GetSecret() *string { return v.Secret }
+
+// GetUrl returns WebhookCreateInput.Url, and is useful for accessing the field via an interface.
+func (v *WebhookCreateInput) GetUrl() string { return v.Url }
+
+// GetResourceTypes returns WebhookCreateInput.ResourceTypes, and is useful for accessing the field via an interface.
+func (v *WebhookCreateInput) GetResourceTypes() []string { return v.ResourceTypes }
+
+// GetTeamId returns WebhookCreateInput.TeamId, and is useful for accessing the field via an interface.
+func (v *WebhookCreateInput) GetTeamId() *string { return v.TeamId }
+
+// GetAllPublicTeams returns WebhookCreateInput.AllPublicTeams, and is useful for accessing the field via an interface.
+func (v *WebhookCreateInput) GetAllPublicTeams() bool { return v.AllPublicTeams }
+
+// WebhookTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type WebhookTeam struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
+
+// GetId returns WebhookTeam.Id, and is useful for accessing the field via an interface.
+func (v *WebhookTeam) GetId() string { return v.Id }
+
+type WebhookUpdateInput struct {
+	// Label for the webhook.
+	Label *string `json:"label"`
+	// A secret token used to sign the webhook payload.
+	Secret *string `json:"secret"`
+	// Whether this webhook is enabled.
+	Enabled bool `json:"enabled"`
+	// The URL that will be called on data changes.
+	Url string `json:"url"`
+	// List of resources the webhook should subscribe to.
+	ResourceTypes []string `json:"resourceTypes"`
+}
+
+// GetLabel returns WebhookUpdateInput.Label, and is useful for accessing the field via an interface.
+func (v *WebhookUpdateInput) GetLabel() *string { return v.Label }
+
+// GetSecret returns WebhookUpdateInput.Secret, and is useful for accessing the field via an interface.
+func (v *WebhookUpdateInput) GetSecret() *string { return v.Secret }
+
+// GetEnabled returns WebhookUpdateInput.Enabled, and is useful for accessing the field via an interface.
+func (v *WebhookUpdateInput) GetEnabled() bool { return v.Enabled }
+
+// GetUrl returns WebhookUpdateInput.Url, and is useful for accessing the field via an interface.
+func (v *WebhookUpdateInput) GetUrl() string { return v.Url }
+
+// GetResourceTypes returns WebhookUpdateInput.ResourceTypes, and is useful for accessing the field via an interface.
+func (v *WebhookUpdateInput) GetResourceTypes() []string { return v.ResourceTypes }
+
 // WorkflowState includes the GraphQL fields of WorkflowState requested by the fragment WorkflowState.
 // The GraphQL type's documentation follows.
 //
@@ -1520,6 +3807,82 @@ func (v *WorkflowStateUpdateInput) GetDescription() *string { return v.Descripti
 // GetPosition returns WorkflowStateUpdateInput.Position, and is useful for accessing the field via an interface.
 func (v *WorkflowStateUpdateInput) GetPosition() float64 { return v.Position }
 
+// __archiveInitiativeInput is used internally by genqlient
+type __archiveInitiativeInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __archiveInitiativeInput.Id, and is useful for accessing the field via an interface.
+func (v *__archiveInitiativeInput) GetId() string { return v.Id }
+
+// __archiveProjectInput is used internally by genqlient
+type __archiveProjectInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __archiveProjectInput.Id, and is useful for accessing the field via an interface.
+func (v *__archiveProjectInput) GetId() string { return v.Id }
+
+// __claimOrganizationDomainInput is used internally by genqlient
+type __claimOrganizationDomainInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __claimOrganizationDomainInput.Id, and is useful for accessing the field via an interface.
+func (v *__claimOrganizationDomainInput) GetId() string { return v.Id }
+
+// __createCustomViewInput is used internally by genqlient
+type __createCustomViewInput struct {
+	Input CustomViewCreateInput `json:"input"`
+}
+
+// GetInput returns __createCustomViewInput.Input, and is useful for accessing the field via an interface.
+func (v *__createCustomViewInput) GetInput() CustomViewCreateInput { return v.Input }
+
+// __createCycleInput is used internally by genqlient
+type __createCycleInput struct {
+	Input CycleCreateInput `json:"input"`
+}
+
+// GetInput returns __createCycleInput.Input, and is useful for accessing the field via an interface.
+func (v *__createCycleInput) GetInput() CycleCreateInput { return v.Input }
+
+// __createEmailIntakeAddressInput is used internally by genqlient
+type __createEmailIntakeAddressInput struct {
+	Input EmailIntakeAddressCreateInput `json:"input"`
+}
+
+// GetInput returns __createEmailIntakeAddressInput.Input, and is useful for accessing the field via an interface.
+func (v *__createEmailIntakeAddressInput) GetInput() EmailIntakeAddressCreateInput { return v.Input }
+
+// __createEmojiInput is used internally by genqlient
+type __createEmojiInput struct {
+	Input EmojiCreateInput `json:"input"`
+}
+
+// GetInput returns __createEmojiInput.Input, and is useful for accessing the field via an interface.
+func (v *__createEmojiInput) GetInput() EmojiCreateInput { return v.Input }
+
+// __createFileUploadInput is used internally by genqlient
+type __createFileUploadInput struct {
+	Filename    string `json:"filename"`
+	ContentType string `json:"contentType"`
+	Size        int    `json:"size"`
+	MakePublic  bool   `json:"makePublic"`
+}
+
+// GetFilename returns __createFileUploadInput.Filename, and is useful for accessing the field via an interface.
+func (v *__createFileUploadInput) GetFilename() string { return v.Filename }
+
+// GetContentType returns __createFileUploadInput.ContentType, and is useful for accessing the field via an interface.
+func (v *__createFileUploadInput) GetContentType() string { return v.ContentType }
+
+// GetSize returns __createFileUploadInput.Size, and is useful for accessing the field via an interface.
+func (v *__createFileUploadInput) GetSize() int { return v.Size }
+
+// GetMakePublic returns __createFileUploadInput.MakePublic, and is useful for accessing the field via an interface.
+func (v *__createFileUploadInput) GetMakePublic() bool { return v.MakePublic }
+
 // __createGitAutomationStateInput is used internally by genqlient
 type __createGitAutomationStateInput struct {
 	Input GitAutomationStateCreateInput `json:"input"`
@@ -1538,6 +3901,30 @@ func (v *__createGitAutomationTargetBranchInput) GetInput() GitAutomationTargetB
 	return v.Input
 }
 
+// __createInitiativeInput is used internally by genqlient
+type __createInitiativeInput struct {
+	Input InitiativeCreateInput `json:"input"`
+}
+
+// GetInput returns __createInitiativeInput.Input, and is useful for accessing the field via an interface.
+func (v *__createInitiativeInput) GetInput() InitiativeCreateInput { return v.Input }
+
+// __createInitiativeRelationInput is used internally by genqlient
+type __createInitiativeRelationInput struct {
+	Input InitiativeRelationCreateInput `json:"input"`
+}
+
+// GetInput returns __createInitiativeRelationInput.Input, and is useful for accessing the field via an interface.
+func (v *__createInitiativeRelationInput) GetInput() InitiativeRelationCreateInput { return v.Input }
+
+// __createInitiativeToProjectInput is used internally by genqlient
+type __createInitiativeToProjectInput struct {
+	Input InitiativeToProjectCreateInput `json:"input"`
+}
+
+// GetInput returns __createInitiativeToProjectInput.Input, and is useful for accessing the field via an interface.
+func (v *__createInitiativeToProjectInput) GetInput() InitiativeToProjectCreateInput { return v.Input }
+
 // __createLabelInput is used internally by genqlient
 type __createLabelInput struct {
 	Input IssueLabelCreateInput `json:"input"`
@@ -1546,21 +3933,133 @@ type __createLabelInput struct {
 // GetInput returns __createLabelInput.Input, and is useful for accessing the field via an interface.
 func (v *__createLabelInput) GetInput() IssueLabelCreateInput { return v.Input }
 
-// __createTeamInput is used internally by genqlient
-type __createTeamInput struct {
-	Input TeamCreateInput `json:"input"`
+// __createOrganizationDomainInput is used internally by genqlient
+type __createOrganizationDomainInput struct {
+	Input                    OrganizationDomainCreateInput `json:"input"`
+	TriggerEmailVerification bool                          `json:"triggerEmailVerification"`
 }
 
-// GetInput returns __createTeamInput.Input, and is useful for accessing the field via an interface.
-func (v *__createTeamInput) GetInput() TeamCreateInput { return v.Input }
+// GetInput returns __createOrganizationDomainInput.Input, and is useful for accessing the field via an interface.
+func (v *__createOrganizationDomainInput) GetInput() OrganizationDomainCreateInput { return v.Input }
 
-// __createWorkflowStateInput is used internally by genqlient
-type __createWorkflowStateInput struct {
-	Input WorkflowStateCreateInput `json:"input"`
+// GetTriggerEmailVerification returns __createOrganizationDomainInput.TriggerEmailVerification, and is useful for accessing the field via an interface.
+func (v *__createOrganizationDomainInput) GetTriggerEmailVerification() bool {
+	return v.TriggerEmailVerification
 }
 
-// GetInput returns __createWorkflowStateInput.Input, and is useful for accessing the field via an interface.
-func (v *__createWorkflowStateInput) GetInput() WorkflowStateCreateInput { return v.Input }
+// __createOrganizationInviteInput is used internally by genqlient
+type __createOrganizationInviteInput struct {
+	Input OrganizationInviteCreateInput `json:"input"`
+}
+
+// GetInput returns __createOrganizationInviteInput.Input, and is useful for accessing the field via an interface.
+func (v *__createOrganizationInviteInput) GetInput() OrganizationInviteCreateInput { return v.Input }
+
+// __createProjectInput is used internally by genqlient
+type __createProjectInput struct {
+	Input ProjectCreateInput `json:"input"`
+}
+
+// GetInput returns __createProjectInput.Input, and is useful for accessing the field via an interface.
+func (v *__createProjectInput) GetInput() ProjectCreateInput { return v.Input }
+
+// __createProjectMilestoneInput is used internally by genqlient
+type __createProjectMilestoneInput struct {
+	Input ProjectMilestoneCreateInput `json:"input"`
+}
+
+// GetInput returns __createProjectMilestoneInput.Input, and is useful for accessing the field via an interface.
+func (v *__createProjectMilestoneInput) GetInput() ProjectMilestoneCreateInput { return v.Input }
+
+// __createProjectStatusInput is used internally by genqlient
+type __createProjectStatusInput struct {
+	Input ProjectStatusCreateInput `json:"input"`
+}
+
+// GetInput returns __createProjectStatusInput.Input, and is useful for accessing the field via an interface.
+func (v *__createProjectStatusInput) GetInput() ProjectStatusCreateInput { return v.Input }
+
+// __createTeamInput is used internally by genqlient
+type __createTeamInput struct {
+	Input TeamCreateInput `json:"input"`
+}
+
+// GetInput returns __createTeamInput.Input, and is useful for accessing the field via an interface.
+func (v *__createTeamInput) GetInput() TeamCreateInput { return v.Input }
+
+// __createTeamMembershipInput is used internally by genqlient
+type __createTeamMembershipInput struct {
+	Input TeamMembershipCreateInput `json:"input"`
+}
+
+// GetInput returns __createTeamMembershipInput.Input, and is useful for accessing the field via an interface.
+func (v *__createTeamMembershipInput) GetInput() TeamMembershipCreateInput { return v.Input }
+
+// __createTimeScheduleInput is used internally by genqlient
+type __createTimeScheduleInput struct {
+	Input TimeScheduleCreateInput `json:"input"`
+}
+
+// GetInput returns __createTimeScheduleInput.Input, and is useful for accessing the field via an interface.
+func (v *__createTimeScheduleInput) GetInput() TimeScheduleCreateInput { return v.Input }
+
+// __createTriageResponsibilityInput is used internally by genqlient
+type __createTriageResponsibilityInput struct {
+	Input TriageResponsibilityCreateInput `json:"input"`
+}
+
+// GetInput returns __createTriageResponsibilityInput.Input, and is useful for accessing the field via an interface.
+func (v *__createTriageResponsibilityInput) GetInput() TriageResponsibilityCreateInput {
+	return v.Input
+}
+
+// __createWebhookInput is used internally by genqlient
+type __createWebhookInput struct {
+	Input WebhookCreateInput `json:"input"`
+}
+
+// GetInput returns __createWebhookInput.Input, and is useful for accessing the field via an interface.
+func (v *__createWebhookInput) GetInput() WebhookCreateInput { return v.Input }
+
+// __createWorkflowStateInput is used internally by genqlient
+type __createWorkflowStateInput struct {
+	Input WorkflowStateCreateInput `json:"input"`
+}
+
+// GetInput returns __createWorkflowStateInput.Input, and is useful for accessing the field via an interface.
+func (v *__createWorkflowStateInput) GetInput() WorkflowStateCreateInput { return v.Input }
+
+// __deleteCustomViewInput is used internally by genqlient
+type __deleteCustomViewInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __deleteCustomViewInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteCustomViewInput) GetId() string { return v.Id }
+
+// __deleteCycleInput is used internally by genqlient
+type __deleteCycleInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __deleteCycleInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteCycleInput) GetId() string { return v.Id }
+
+// __deleteEmailIntakeAddressInput is used internally by genqlient
+type __deleteEmailIntakeAddressInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __deleteEmailIntakeAddressInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteEmailIntakeAddressInput) GetId() string { return v.Id }
+
+// __deleteEmojiInput is used internally by genqlient
+type __deleteEmojiInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __deleteEmojiInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteEmojiInput) GetId() string { return v.Id }
 
 // __deleteGitAutomationStateInput is used internally by genqlient
 type __deleteGitAutomationStateInput struct {
@@ -1578,6 +4077,30 @@ type __deleteGitAutomationTargetBranchInput struct {
 // GetId returns __deleteGitAutomationTargetBranchInput.Id, and is useful for accessing the field via an interface.
 func (v *__deleteGitAutomationTargetBranchInput) GetId() string { return v.Id }
 
+// __deleteInitiativeInput is used internally by genqlient
+type __deleteInitiativeInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __deleteInitiativeInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteInitiativeInput) GetId() string { return v.Id }
+
+// __deleteInitiativeRelationInput is used internally by genqlient
+type __deleteInitiativeRelationInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __deleteInitiativeRelationInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteInitiativeRelationInput) GetId() string { return v.Id }
+
+// __deleteInitiativeToProjectInput is used internally by genqlient
+type __deleteInitiativeToProjectInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __deleteInitiativeToProjectInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteInitiativeToProjectInput) GetId() string { return v.Id }
+
 // __deleteLabelInput is used internally by genqlient
 type __deleteLabelInput struct {
 	Id string `json:"id"`
@@ -1586,6 +4109,46 @@ type __deleteLabelInput struct {
 // GetId returns __deleteLabelInput.Id, and is useful for accessing the field via an interface.
 func (v *__deleteLabelInput) GetId() string { return v.Id }
 
+// __deleteOrganizationDomainInput is used internally by genqlient
+type __deleteOrganizationDomainInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __deleteOrganizationDomainInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteOrganizationDomainInput) GetId() string { return v.Id }
+
+// __deleteOrganizationInviteInput is used internally by genqlient
+type __deleteOrganizationInviteInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __deleteOrganizationInviteInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteOrganizationInviteInput) GetId() string { return v.Id }
+
+// __deleteProjectInput is used internally by genqlient
+type __deleteProjectInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __deleteProjectInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteProjectInput) GetId() string { return v.Id }
+
+// __deleteProjectMilestoneInput is used internally by genqlient
+type __deleteProjectMilestoneInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __deleteProjectMilestoneInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteProjectMilestoneInput) GetId() string { return v.Id }
+
+// __deleteProjectStatusInput is used internally by genqlient
+type __deleteProjectStatusInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __deleteProjectStatusInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteProjectStatusInput) GetId() string { return v.Id }
+
 // __deleteTeamInput is used internally by genqlient
 type __deleteTeamInput struct {
 	Key string `json:"key"`
@@ -1594,6 +4157,38 @@ type __deleteTeamInput struct {
 // GetKey returns __deleteTeamInput.Key, and is useful for accessing the field via an interface.
 func (v *__deleteTeamInput) GetKey() string { return v.Key }
 
+// __deleteTeamMembershipInput is used internally by genqlient
+type __deleteTeamMembershipInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __deleteTeamMembershipInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteTeamMembershipInput) GetId() string { return v.Id }
+
+// __deleteTimeScheduleInput is used internally by genqlient
+type __deleteTimeScheduleInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __deleteTimeScheduleInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteTimeScheduleInput) GetId() string { return v.Id }
+
+// __deleteTriageResponsibilityInput is used internally by genqlient
+type __deleteTriageResponsibilityInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __deleteTriageResponsibilityInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteTriageResponsibilityInput) GetId() string { return v.Id }
+
+// __deleteWebhookInput is used internally by genqlient
+type __deleteWebhookInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __deleteWebhookInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteWebhookInput) GetId() string { return v.Id }
+
 // __deleteWorkflowStateInput is used internally by genqlient
 type __deleteWorkflowStateInput struct {
 	Id string `json:"id"`
@@ -1602,6 +4197,30 @@ type __deleteWorkflowStateInput struct {
 // GetId returns __deleteWorkflowStateInput.Id, and is useful for accessing the field via an interface.
 func (v *__deleteWorkflowStateInput) GetId() string { return v.Id }
 
+// __findCycleInput is used internally by genqlient
+type __findCycleInput struct {
+	Number float64 `json:"number"`
+	Key    string  `json:"key"`
+}
+
+// GetNumber returns __findCycleInput.Number, and is useful for accessing the field via an interface.
+func (v *__findCycleInput) GetNumber() float64 { return v.Number }
+
+// GetKey returns __findCycleInput.Key, and is useful for accessing the field via an interface.
+func (v *__findCycleInput) GetKey() string { return v.Key }
+
+// __findProjectMilestoneInput is used internally by genqlient
+type __findProjectMilestoneInput struct {
+	Name      string `json:"name"`
+	ProjectId string `json:"projectId"`
+}
+
+// GetName returns __findProjectMilestoneInput.Name, and is useful for accessing the field via an interface.
+func (v *__findProjectMilestoneInput) GetName() string { return v.Name }
+
+// GetProjectId returns __findProjectMilestoneInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *__findProjectMilestoneInput) GetProjectId() string { return v.ProjectId }
+
 // __findTeamLabelInput is used internally by genqlient
 type __findTeamLabelInput struct {
 	Name string `json:"name"`
@@ -1614,6 +4233,14 @@ func (v *__findTeamLabelInput) GetName() string { return v.Name }
 // GetKey returns __findTeamLabelInput.Key, and is useful for accessing the field via an interface.
 func (v *__findTeamLabelInput) GetKey() string { return v.Key }
 
+// __findTriageResponsibilityInput is used internally by genqlient
+type __findTriageResponsibilityInput struct {
+	Key string `json:"key"`
+}
+
+// GetKey returns __findTriageResponsibilityInput.Key, and is useful for accessing the field via an interface.
+func (v *__findTriageResponsibilityInput) GetKey() string { return v.Key }
+
 // __findWorkflowStateInput is used internally by genqlient
 type __findWorkflowStateInput struct {
 	Name string `json:"name"`
@@ -1634,6 +4261,62 @@ type __findWorkspaceLabelInput struct {
 // GetName returns __findWorkspaceLabelInput.Name, and is useful for accessing the field via an interface.
 func (v *__findWorkspaceLabelInput) GetName() string { return v.Name }
 
+// __getCustomViewInput is used internally by genqlient
+type __getCustomViewInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getCustomViewInput.Id, and is useful for accessing the field via an interface.
+func (v *__getCustomViewInput) GetId() string { return v.Id }
+
+// __getCycleInput is used internally by genqlient
+type __getCycleInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getCycleInput.Id, and is useful for accessing the field via an interface.
+func (v *__getCycleInput) GetId() string { return v.Id }
+
+// __getEmailIntakeAddressInput is used internally by genqlient
+type __getEmailIntakeAddressInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getEmailIntakeAddressInput.Id, and is useful for accessing the field via an interface.
+func (v *__getEmailIntakeAddressInput) GetId() string { return v.Id }
+
+// __getEmojiInput is used internally by genqlient
+type __getEmojiInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getEmojiInput.Id, and is useful for accessing the field via an interface.
+func (v *__getEmojiInput) GetId() string { return v.Id }
+
+// __getInitiativeInput is used internally by genqlient
+type __getInitiativeInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getInitiativeInput.Id, and is useful for accessing the field via an interface.
+func (v *__getInitiativeInput) GetId() string { return v.Id }
+
+// __getInitiativeRelationsInput is used internally by genqlient
+type __getInitiativeRelationsInput struct {
+	After string `json:"after,omitempty"`
+}
+
+// GetAfter returns __getInitiativeRelationsInput.After, and is useful for accessing the field via an interface.
+func (v *__getInitiativeRelationsInput) GetAfter() string { return v.After }
+
+// __getInitiativeToProjectInput is used internally by genqlient
+type __getInitiativeToProjectInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getInitiativeToProjectInput.Id, and is useful for accessing the field via an interface.
+func (v *__getInitiativeToProjectInput) GetId() string { return v.Id }
+
 // __getLabelInput is used internally by genqlient
 type __getLabelInput struct {
 	Id string `json:"id"`
@@ -1642,6 +4325,58 @@ type __getLabelInput struct {
 // GetId returns __getLabelInput.Id, and is useful for accessing the field via an interface.
 func (v *__getLabelInput) GetId() string { return v.Id }
 
+// __getOrganizationDomainClaimRequestInput is used internally by genqlient
+type __getOrganizationDomainClaimRequestInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getOrganizationDomainClaimRequestInput.Id, and is useful for accessing the field via an interface.
+func (v *__getOrganizationDomainClaimRequestInput) GetId() string { return v.Id }
+
+// __getOrganizationInviteInput is used internally by genqlient
+type __getOrganizationInviteInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getOrganizationInviteInput.Id, and is useful for accessing the field via an interface.
+func (v *__getOrganizationInviteInput) GetId() string { return v.Id }
+
+// __getProjectInput is used internally by genqlient
+type __getProjectInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getProjectInput.Id, and is useful for accessing the field via an interface.
+func (v *__getProjectInput) GetId() string { return v.Id }
+
+// __getProjectMilestoneInput is used internally by genqlient
+type __getProjectMilestoneInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getProjectMilestoneInput.Id, and is useful for accessing the field via an interface.
+func (v *__getProjectMilestoneInput) GetId() string { return v.Id }
+
+// __getProjectStatusInput is used internally by genqlient
+type __getProjectStatusInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getProjectStatusInput.Id, and is useful for accessing the field via an interface.
+func (v *__getProjectStatusInput) GetId() string { return v.Id }
+
+// __getTeamCyclesInput is used internally by genqlient
+type __getTeamCyclesInput struct {
+	TeamId string `json:"teamId"`
+	After  string `json:"after,omitempty"`
+}
+
+// GetTeamId returns __getTeamCyclesInput.TeamId, and is useful for accessing the field via an interface.
+func (v *__getTeamCyclesInput) GetTeamId() string { return v.TeamId }
+
+// GetAfter returns __getTeamCyclesInput.After, and is useful for accessing the field via an interface.
+func (v *__getTeamCyclesInput) GetAfter() string { return v.After }
+
 // __getTeamInput is used internally by genqlient
 type __getTeamInput struct {
 	Key string `json:"key"`
@@ -1650,6 +4385,34 @@ type __getTeamInput struct {
 // GetKey returns __getTeamInput.Key, and is useful for accessing the field via an interface.
 func (v *__getTeamInput) GetKey() string { return v.Key }
 
+// __getTeamLabelsInput is used internally by genqlient
+type __getTeamLabelsInput struct {
+	TeamId string `json:"teamId"`
+}
+
+// GetTeamId returns __getTeamLabelsInput.TeamId, and is useful for accessing the field via an interface.
+func (v *__getTeamLabelsInput) GetTeamId() string { return v.TeamId }
+
+// __getTeamMembershipInput is used internally by genqlient
+type __getTeamMembershipInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getTeamMembershipInput.Id, and is useful for accessing the field via an interface.
+func (v *__getTeamMembershipInput) GetId() string { return v.Id }
+
+// __getTeamMembershipsInput is used internally by genqlient
+type __getTeamMembershipsInput struct {
+	Key   string `json:"key"`
+	After string `json:"after,omitempty"`
+}
+
+// GetKey returns __getTeamMembershipsInput.Key, and is useful for accessing the field via an interface.
+func (v *__getTeamMembershipsInput) GetKey() string { return v.Key }
+
+// GetAfter returns __getTeamMembershipsInput.After, and is useful for accessing the field via an interface.
+func (v *__getTeamMembershipsInput) GetAfter() string { return v.After }
+
 // __getTeamWorkflowInput is used internally by genqlient
 type __getTeamWorkflowInput struct {
 	Key string `json:"key"`
@@ -1660,11 +4423,11 @@ func (v *__getTeamWorkflowInput) GetKey() string { return v.Key }
 
 // __getTeamWorkflowStatesInput is used internally by genqlient
 type __getTeamWorkflowStatesInput struct {
-	Key string `json:"key"`
+	TeamId string `json:"teamId"`
 }
 
-// GetKey returns __getTeamWorkflowStatesInput.Key, and is useful for accessing the field via an interface.
-func (v *__getTeamWorkflowStatesInput) GetKey() string { return v.Key }
+// GetTeamId returns __getTeamWorkflowStatesInput.TeamId, and is useful for accessing the field via an interface.
+func (v *__getTeamWorkflowStatesInput) GetTeamId() string { return v.TeamId }
 
 // __getTemplateInput is used internally by genqlient
 type __getTemplateInput struct {
@@ -1674,6 +4437,30 @@ type __getTemplateInput struct {
 // GetId returns __getTemplateInput.Id, and is useful for accessing the field via an interface.
 func (v *__getTemplateInput) GetId() string { return v.Id }
 
+// __getTimeScheduleInput is used internally by genqlient
+type __getTimeScheduleInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getTimeScheduleInput.Id, and is useful for accessing the field via an interface.
+func (v *__getTimeScheduleInput) GetId() string { return v.Id }
+
+// __getTriageResponsibilityInput is used internally by genqlient
+type __getTriageResponsibilityInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getTriageResponsibilityInput.Id, and is useful for accessing the field via an interface.
+func (v *__getTriageResponsibilityInput) GetId() string { return v.Id }
+
+// __getWebhookInput is used internally by genqlient
+type __getWebhookInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getWebhookInput.Id, and is useful for accessing the field via an interface.
+func (v *__getWebhookInput) GetId() string { return v.Id }
+
 // __getWorkflowStateInput is used internally by genqlient
 type __getWorkflowStateInput struct {
 	Id string `json:"id"`
@@ -1682,6 +4469,30 @@ type __getWorkflowStateInput struct {
 // GetId returns __getWorkflowStateInput.Id, and is useful for accessing the field via an interface.
 func (v *__getWorkflowStateInput) GetId() string { return v.Id }
 
+// __refreshOrganizationDomainInput is used internally by genqlient
+type __refreshOrganizationDomainInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __refreshOrganizationDomainInput.Id, and is useful for accessing the field via an interface.
+func (v *__refreshOrganizationDomainInput) GetId() string { return v.Id }
+
+// __removeTeamParentInput is used internally by genqlient
+type __removeTeamParentInput struct {
+	Key string `json:"key"`
+}
+
+// GetKey returns __removeTeamParentInput.Key, and is useful for accessing the field via an interface.
+func (v *__removeTeamParentInput) GetKey() string { return v.Key }
+
+// __rotateEmailIntakeAddressInput is used internally by genqlient
+type __rotateEmailIntakeAddressInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __rotateEmailIntakeAddressInput.Id, and is useful for accessing the field via an interface.
+func (v *__rotateEmailIntakeAddressInput) GetId() string { return v.Id }
+
 // __templateCreateInput is used internally by genqlient
 type __templateCreateInput struct {
 	Input TemplateCreateInput `json:"input"`
@@ -1710,6 +4521,58 @@ func (v *__templateUpdateInput) GetInput() TemplateUpdateInput { return v.Input
 // GetId returns __templateUpdateInput.Id, and is useful for accessing the field via an interface.
 func (v *__templateUpdateInput) GetId() string { return v.Id }
 
+// __unarchiveInitiativeInput is used internally by genqlient
+type __unarchiveInitiativeInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __unarchiveInitiativeInput.Id, and is useful for accessing the field via an interface.
+func (v *__unarchiveInitiativeInput) GetId() string { return v.Id }
+
+// __unarchiveProjectInput is used internally by genqlient
+type __unarchiveProjectInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __unarchiveProjectInput.Id, and is useful for accessing the field via an interface.
+func (v *__unarchiveProjectInput) GetId() string { return v.Id }
+
+// __updateCustomViewInput is used internally by genqlient
+type __updateCustomViewInput struct {
+	Input CustomViewUpdateInput `json:"input"`
+	Id    string                `json:"id"`
+}
+
+// GetInput returns __updateCustomViewInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateCustomViewInput) GetInput() CustomViewUpdateInput { return v.Input }
+
+// GetId returns __updateCustomViewInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateCustomViewInput) GetId() string { return v.Id }
+
+// __updateCycleInput is used internally by genqlient
+type __updateCycleInput struct {
+	Input CycleUpdateInput `json:"input"`
+	Id    string           `json:"id"`
+}
+
+// GetInput returns __updateCycleInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateCycleInput) GetInput() CycleUpdateInput { return v.Input }
+
+// GetId returns __updateCycleInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateCycleInput) GetId() string { return v.Id }
+
+// __updateEmailIntakeAddressInput is used internally by genqlient
+type __updateEmailIntakeAddressInput struct {
+	Input EmailIntakeAddressUpdateInput `json:"input"`
+	Id    string                        `json:"id"`
+}
+
+// GetInput returns __updateEmailIntakeAddressInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateEmailIntakeAddressInput) GetInput() EmailIntakeAddressUpdateInput { return v.Input }
+
+// GetId returns __updateEmailIntakeAddressInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateEmailIntakeAddressInput) GetId() string { return v.Id }
+
 // __updateGitAutomationStateInput is used internally by genqlient
 type __updateGitAutomationStateInput struct {
 	Id    string                        `json:"id"`
@@ -1722,6 +4585,18 @@ func (v *__updateGitAutomationStateInput) GetId() string { return v.Id }
 // GetInput returns __updateGitAutomationStateInput.Input, and is useful for accessing the field via an interface.
 func (v *__updateGitAutomationStateInput) GetInput() GitAutomationStateUpdateInput { return v.Input }
 
+// __updateInitiativeInput is used internally by genqlient
+type __updateInitiativeInput struct {
+	Input InitiativeUpdateInput `json:"input"`
+	Id    string                `json:"id"`
+}
+
+// GetInput returns __updateInitiativeInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateInitiativeInput) GetInput() InitiativeUpdateInput { return v.Input }
+
+// GetId returns __updateInitiativeInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateInitiativeInput) GetId() string { return v.Id }
+
 // __updateLabelInput is used internally by genqlient
 type __updateLabelInput struct {
 	Input IssueLabelUpdateInput `json:"input"`
@@ -1734,177 +4609,306 @@ func (v *__updateLabelInput) GetInput() IssueLabelUpdateInput { return v.Input }
 // GetId returns __updateLabelInput.Id, and is useful for accessing the field via an interface.
 func (v *__updateLabelInput) GetId() string { return v.Id }
 
-// __updateTeamInput is used internally by genqlient
-type __updateTeamInput struct {
-	Input TeamUpdateInput `json:"input"`
-	Id    string          `json:"id"`
+// __updateOrganizationDomainInput is used internally by genqlient
+type __updateOrganizationDomainInput struct {
+	Input OrganizationDomainUpdateInput `json:"input"`
+	Id    string                        `json:"id"`
 }
 
-// GetInput returns __updateTeamInput.Input, and is useful for accessing the field via an interface.
-func (v *__updateTeamInput) GetInput() TeamUpdateInput { return v.Input }
+// GetInput returns __updateOrganizationDomainInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateOrganizationDomainInput) GetInput() OrganizationDomainUpdateInput { return v.Input }
 
-// GetId returns __updateTeamInput.Id, and is useful for accessing the field via an interface.
-func (v *__updateTeamInput) GetId() string { return v.Id }
+// GetId returns __updateOrganizationDomainInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateOrganizationDomainInput) GetId() string { return v.Id }
 
-// __updateWorkflowStateInput is used internally by genqlient
-type __updateWorkflowStateInput struct {
-	Input WorkflowStateUpdateInput `json:"input"`
-	Id    string                   `json:"id"`
+// __updateOrganizationInviteInput is used internally by genqlient
+type __updateOrganizationInviteInput struct {
+	Input OrganizationInviteUpdateInput `json:"input"`
+	Id    string                        `json:"id"`
 }
 
-// GetInput returns __updateWorkflowStateInput.Input, and is useful for accessing the field via an interface.
-func (v *__updateWorkflowStateInput) GetInput() WorkflowStateUpdateInput { return v.Input }
+// GetInput returns __updateOrganizationInviteInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateOrganizationInviteInput) GetInput() OrganizationInviteUpdateInput { return v.Input }
 
-// GetId returns __updateWorkflowStateInput.Id, and is useful for accessing the field via an interface.
-func (v *__updateWorkflowStateInput) GetId() string { return v.Id }
+// GetId returns __updateOrganizationInviteInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateOrganizationInviteInput) GetId() string { return v.Id }
 
-// __updateWorkspaceSettingsInput is used internally by genqlient
-type __updateWorkspaceSettingsInput struct {
-	Input OrganizationUpdateInput `json:"input"`
+// __updateProjectInput is used internally by genqlient
+type __updateProjectInput struct {
+	Input ProjectUpdateInput `json:"input"`
+	Id    string             `json:"id"`
 }
 
-// GetInput returns __updateWorkspaceSettingsInput.Input, and is useful for accessing the field via an interface.
+// GetInput returns __updateProjectInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateProjectInput) GetInput() ProjectUpdateInput { return v.Input }
+
+// GetId returns __updateProjectInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateProjectInput) GetId() string { return v.Id }
+
+// __updateProjectMilestoneInput is used internally by genqlient
+type __updateProjectMilestoneInput struct {
+	Input ProjectMilestoneUpdateInput `json:"input"`
+	Id    string                      `json:"id"`
+}
+
+// GetInput returns __updateProjectMilestoneInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateProjectMilestoneInput) GetInput() ProjectMilestoneUpdateInput { return v.Input }
+
+// GetId returns __updateProjectMilestoneInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateProjectMilestoneInput) GetId() string { return v.Id }
+
+// __updateProjectStatusInput is used internally by genqlient
+type __updateProjectStatusInput struct {
+	Input ProjectStatusUpdateInput `json:"input"`
+	Id    string                   `json:"id"`
+}
+
+// GetInput returns __updateProjectStatusInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateProjectStatusInput) GetInput() ProjectStatusUpdateInput { return v.Input }
+
+// GetId returns __updateProjectStatusInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateProjectStatusInput) GetId() string { return v.Id }
+
+// __updateTeamInput is used internally by genqlient
+type __updateTeamInput struct {
+	Input TeamUpdateInput `json:"input"`
+	Id    string          `json:"id"`
+}
+
+// GetInput returns __updateTeamInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateTeamInput) GetInput() TeamUpdateInput { return v.Input }
+
+// GetId returns __updateTeamInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateTeamInput) GetId() string { return v.Id }
+
+// __updateTeamMembershipInput is used internally by genqlient
+type __updateTeamMembershipInput struct {
+	Input TeamMembershipUpdateInput `json:"input"`
+	Id    string                    `json:"id"`
+}
+
+// GetInput returns __updateTeamMembershipInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateTeamMembershipInput) GetInput() TeamMembershipUpdateInput { return v.Input }
+
+// GetId returns __updateTeamMembershipInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateTeamMembershipInput) GetId() string { return v.Id }
+
+// __updateTimeScheduleInput is used internally by genqlient
+type __updateTimeScheduleInput struct {
+	Input TimeScheduleUpdateInput `json:"input"`
+	Id    string                  `json:"id"`
+}
+
+// GetInput returns __updateTimeScheduleInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateTimeScheduleInput) GetInput() TimeScheduleUpdateInput { return v.Input }
+
+// GetId returns __updateTimeScheduleInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateTimeScheduleInput) GetId() string { return v.Id }
+
+// __updateTriageResponsibilityInput is used internally by genqlient
+type __updateTriageResponsibilityInput struct {
+	Input TriageResponsibilityUpdateInput `json:"input"`
+	Id    string                          `json:"id"`
+}
+
+// GetInput returns __updateTriageResponsibilityInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateTriageResponsibilityInput) GetInput() TriageResponsibilityUpdateInput {
+	return v.Input
+}
+
+// GetId returns __updateTriageResponsibilityInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateTriageResponsibilityInput) GetId() string { return v.Id }
+
+// __updateWebhookInput is used internally by genqlient
+type __updateWebhookInput struct {
+	Input WebhookUpdateInput `json:"input"`
+	Id    string             `json:"id"`
+}
+
+// GetInput returns __updateWebhookInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateWebhookInput) GetInput() WebhookUpdateInput { return v.Input }
+
+// GetId returns __updateWebhookInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateWebhookInput) GetId() string { return v.Id }
+
+// __updateWorkflowStateInput is used internally by genqlient
+type __updateWorkflowStateInput struct {
+	Input WorkflowStateUpdateInput `json:"input"`
+	Id    string                   `json:"id"`
+}
+
+// GetInput returns __updateWorkflowStateInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateWorkflowStateInput) GetInput() WorkflowStateUpdateInput { return v.Input }
+
+// GetId returns __updateWorkflowStateInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateWorkflowStateInput) GetId() string { return v.Id }
+
+// __updateWorkspaceSettingsInput is used internally by genqlient
+type __updateWorkspaceSettingsInput struct {
+	Input OrganizationUpdateInput `json:"input"`
+}
+
+// GetInput returns __updateWorkspaceSettingsInput.Input, and is useful for accessing the field via an interface.
 func (v *__updateWorkspaceSettingsInput) GetInput() OrganizationUpdateInput { return v.Input }
 
-// createGitAutomationStateGitAutomationStateCreateGitAutomationStatePayload includes the requested fields of the GraphQL type GitAutomationStatePayload.
-type createGitAutomationStateGitAutomationStateCreateGitAutomationStatePayload struct {
+// __verifyOrganizationDomainInput is used internally by genqlient
+type __verifyOrganizationDomainInput struct {
+	Input OrganizationDomainVerificationInput `json:"input"`
+}
+
+// GetInput returns __verifyOrganizationDomainInput.Input, and is useful for accessing the field via an interface.
+func (v *__verifyOrganizationDomainInput) GetInput() OrganizationDomainVerificationInput {
+	return v.Input
+}
+
+// archiveInitiativeInitiativeArchiveInitiativeArchivePayload includes the requested fields of the GraphQL type InitiativeArchivePayload.
+// The GraphQL type's documentation follows.
+//
+// A generic payload return from entity archive mutations.
+type archiveInitiativeInitiativeArchiveInitiativeArchivePayload struct {
 	// Whether the operation was successful.
 	Success bool `json:"success"`
 }
 
-// GetSuccess returns createGitAutomationStateGitAutomationStateCreateGitAutomationStatePayload.Success, and is useful for accessing the field via an interface.
-func (v *createGitAutomationStateGitAutomationStateCreateGitAutomationStatePayload) GetSuccess() bool {
+// GetSuccess returns archiveInitiativeInitiativeArchiveInitiativeArchivePayload.Success, and is useful for accessing the field via an interface.
+func (v *archiveInitiativeInitiativeArchiveInitiativeArchivePayload) GetSuccess() bool {
 	return v.Success
 }
 
-// createGitAutomationStateResponse is returned by createGitAutomationState on success.
-type createGitAutomationStateResponse struct {
-	// Creates a new automation state.
-	GitAutomationStateCreate createGitAutomationStateGitAutomationStateCreateGitAutomationStatePayload `json:"gitAutomationStateCreate"`
+// archiveInitiativeResponse is returned by archiveInitiative on success.
+type archiveInitiativeResponse struct {
+	// Archives a initiative.
+	InitiativeArchive archiveInitiativeInitiativeArchiveInitiativeArchivePayload `json:"initiativeArchive"`
 }
 
-// GetGitAutomationStateCreate returns createGitAutomationStateResponse.GitAutomationStateCreate, and is useful for accessing the field via an interface.
-func (v *createGitAutomationStateResponse) GetGitAutomationStateCreate() createGitAutomationStateGitAutomationStateCreateGitAutomationStatePayload {
-	return v.GitAutomationStateCreate
+// GetInitiativeArchive returns archiveInitiativeResponse.InitiativeArchive, and is useful for accessing the field via an interface.
+func (v *archiveInitiativeResponse) GetInitiativeArchive() archiveInitiativeInitiativeArchiveInitiativeArchivePayload {
+	return v.InitiativeArchive
 }
 
-// createGitAutomationTargetBranchGitAutomationTargetBranchCreateGitAutomationTargetBranchPayload includes the requested fields of the GraphQL type GitAutomationTargetBranchPayload.
-type createGitAutomationTargetBranchGitAutomationTargetBranchCreateGitAutomationTargetBranchPayload struct {
-	// The Git target branch automation that was created or updated.
-	TargetBranch createGitAutomationTargetBranchGitAutomationTargetBranchCreateGitAutomationTargetBranchPayloadTargetBranchGitAutomationTargetBranch `json:"targetBranch"`
+// archiveProjectProjectArchiveProjectArchivePayload includes the requested fields of the GraphQL type ProjectArchivePayload.
+// The GraphQL type's documentation follows.
+//
+// A generic payload return from entity archive mutations.
+type archiveProjectProjectArchiveProjectArchivePayload struct {
 	// Whether the operation was successful.
 	Success bool `json:"success"`
 }
 
-// GetTargetBranch returns createGitAutomationTargetBranchGitAutomationTargetBranchCreateGitAutomationTargetBranchPayload.TargetBranch, and is useful for accessing the field via an interface.
-func (v *createGitAutomationTargetBranchGitAutomationTargetBranchCreateGitAutomationTargetBranchPayload) GetTargetBranch() createGitAutomationTargetBranchGitAutomationTargetBranchCreateGitAutomationTargetBranchPayloadTargetBranchGitAutomationTargetBranch {
-	return v.TargetBranch
+// GetSuccess returns archiveProjectProjectArchiveProjectArchivePayload.Success, and is useful for accessing the field via an interface.
+func (v *archiveProjectProjectArchiveProjectArchivePayload) GetSuccess() bool { return v.Success }
+
+// archiveProjectResponse is returned by archiveProject on success.
+type archiveProjectResponse struct {
+	// Archives a project.
+	ProjectArchive archiveProjectProjectArchiveProjectArchivePayload `json:"projectArchive"`
 }
 
-// GetSuccess returns createGitAutomationTargetBranchGitAutomationTargetBranchCreateGitAutomationTargetBranchPayload.Success, and is useful for accessing the field via an interface.
-func (v *createGitAutomationTargetBranchGitAutomationTargetBranchCreateGitAutomationTargetBranchPayload) GetSuccess() bool {
-	return v.Success
+// GetProjectArchive returns archiveProjectResponse.ProjectArchive, and is useful for accessing the field via an interface.
+func (v *archiveProjectResponse) GetProjectArchive() archiveProjectProjectArchiveProjectArchivePayload {
+	return v.ProjectArchive
 }
 
-// createGitAutomationTargetBranchGitAutomationTargetBranchCreateGitAutomationTargetBranchPayloadTargetBranchGitAutomationTargetBranch includes the requested fields of the GraphQL type GitAutomationTargetBranch.
+// claimOrganizationDomainOrganizationDomainClaimOrganizationDomainSimplePayload includes the requested fields of the GraphQL type OrganizationDomainSimplePayload.
 // The GraphQL type's documentation follows.
 //
-// A Git target branch for which there are automations (GitAutomationState).
-type createGitAutomationTargetBranchGitAutomationTargetBranchCreateGitAutomationTargetBranchPayloadTargetBranchGitAutomationTargetBranch struct {
-	// The unique identifier of the entity.
-	Id string `json:"id"`
-	// The target branch pattern.
-	BranchPattern string `json:"branchPattern"`
-	// Whether the branch pattern is a regular expression.
-	IsRegex bool `json:"isRegex"`
+// [INTERNAL] Organization domain operation response.
+type claimOrganizationDomainOrganizationDomainClaimOrganizationDomainSimplePayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
 }
 
-// GetId returns createGitAutomationTargetBranchGitAutomationTargetBranchCreateGitAutomationTargetBranchPayloadTargetBranchGitAutomationTargetBranch.Id, and is useful for accessing the field via an interface.
-func (v *createGitAutomationTargetBranchGitAutomationTargetBranchCreateGitAutomationTargetBranchPayloadTargetBranchGitAutomationTargetBranch) GetId() string {
-	return v.Id
+// GetSuccess returns claimOrganizationDomainOrganizationDomainClaimOrganizationDomainSimplePayload.Success, and is useful for accessing the field via an interface.
+func (v *claimOrganizationDomainOrganizationDomainClaimOrganizationDomainSimplePayload) GetSuccess() bool {
+	return v.Success
 }
 
-// GetBranchPattern returns createGitAutomationTargetBranchGitAutomationTargetBranchCreateGitAutomationTargetBranchPayloadTargetBranchGitAutomationTargetBranch.BranchPattern, and is useful for accessing the field via an interface.
-func (v *createGitAutomationTargetBranchGitAutomationTargetBranchCreateGitAutomationTargetBranchPayloadTargetBranchGitAutomationTargetBranch) GetBranchPattern() string {
-	return v.BranchPattern
+// claimOrganizationDomainResponse is returned by claimOrganizationDomain on success.
+type claimOrganizationDomainResponse struct {
+	// [INTERNAL] Verifies a domain claim.
+	OrganizationDomainClaim claimOrganizationDomainOrganizationDomainClaimOrganizationDomainSimplePayload `json:"organizationDomainClaim"`
 }
 
-// GetIsRegex returns createGitAutomationTargetBranchGitAutomationTargetBranchCreateGitAutomationTargetBranchPayloadTargetBranchGitAutomationTargetBranch.IsRegex, and is useful for accessing the field via an interface.
-func (v *createGitAutomationTargetBranchGitAutomationTargetBranchCreateGitAutomationTargetBranchPayloadTargetBranchGitAutomationTargetBranch) GetIsRegex() bool {
-	return v.IsRegex
+// GetOrganizationDomainClaim returns claimOrganizationDomainResponse.OrganizationDomainClaim, and is useful for accessing the field via an interface.
+func (v *claimOrganizationDomainResponse) GetOrganizationDomainClaim() claimOrganizationDomainOrganizationDomainClaimOrganizationDomainSimplePayload {
+	return v.OrganizationDomainClaim
 }
 
-// createGitAutomationTargetBranchResponse is returned by createGitAutomationTargetBranch on success.
-type createGitAutomationTargetBranchResponse struct {
-	// Creates a Git target branch automation.
-	GitAutomationTargetBranchCreate createGitAutomationTargetBranchGitAutomationTargetBranchCreateGitAutomationTargetBranchPayload `json:"gitAutomationTargetBranchCreate"`
+// createCustomViewCustomViewCreateCustomViewPayload includes the requested fields of the GraphQL type CustomViewPayload.
+type createCustomViewCustomViewCreateCustomViewPayload struct {
+	// The custom view that was created or updated.
+	CustomView createCustomViewCustomViewCreateCustomViewPayloadCustomView `json:"customView"`
 }
 
-// GetGitAutomationTargetBranchCreate returns createGitAutomationTargetBranchResponse.GitAutomationTargetBranchCreate, and is useful for accessing the field via an interface.
-func (v *createGitAutomationTargetBranchResponse) GetGitAutomationTargetBranchCreate() createGitAutomationTargetBranchGitAutomationTargetBranchCreateGitAutomationTargetBranchPayload {
-	return v.GitAutomationTargetBranchCreate
+// GetCustomView returns createCustomViewCustomViewCreateCustomViewPayload.CustomView, and is useful for accessing the field via an interface.
+func (v *createCustomViewCustomViewCreateCustomViewPayload) GetCustomView() createCustomViewCustomViewCreateCustomViewPayloadCustomView {
+	return v.CustomView
 }
 
-// createLabelIssueLabelCreateIssueLabelPayload includes the requested fields of the GraphQL type IssueLabelPayload.
-type createLabelIssueLabelCreateIssueLabelPayload struct {
-	// The label that was created or updated.
-	IssueLabel createLabelIssueLabelCreateIssueLabelPayloadIssueLabel `json:"issueLabel"`
+// createCustomViewCustomViewCreateCustomViewPayloadCustomView includes the requested fields of the GraphQL type CustomView.
+// The GraphQL type's documentation follows.
+//
+// A custom view that has been saved by a user.
+type createCustomViewCustomViewCreateCustomViewPayloadCustomView struct {
+	CustomView `json:"-"`
 }
 
-// GetIssueLabel returns createLabelIssueLabelCreateIssueLabelPayload.IssueLabel, and is useful for accessing the field via an interface.
-func (v *createLabelIssueLabelCreateIssueLabelPayload) GetIssueLabel() createLabelIssueLabelCreateIssueLabelPayloadIssueLabel {
-	return v.IssueLabel
+// GetId returns createCustomViewCustomViewCreateCustomViewPayloadCustomView.Id, and is useful for accessing the field via an interface.
+func (v *createCustomViewCustomViewCreateCustomViewPayloadCustomView) GetId() string {
+	return v.CustomView.Id
 }
 
-// createLabelIssueLabelCreateIssueLabelPayloadIssueLabel includes the requested fields of the GraphQL type IssueLabel.
-// The GraphQL type's documentation follows.
-//
-// Labels that can be associated with issues.
-type createLabelIssueLabelCreateIssueLabelPayloadIssueLabel struct {
-	IssueLabel `json:"-"`
+// GetName returns createCustomViewCustomViewCreateCustomViewPayloadCustomView.Name, and is useful for accessing the field via an interface.
+func (v *createCustomViewCustomViewCreateCustomViewPayloadCustomView) GetName() string {
+	return v.CustomView.Name
 }
 
-// GetId returns createLabelIssueLabelCreateIssueLabelPayloadIssueLabel.Id, and is useful for accessing the field via an interface.
-func (v *createLabelIssueLabelCreateIssueLabelPayloadIssueLabel) GetId() string {
-	return v.IssueLabel.Id
+// GetDescription returns createCustomViewCustomViewCreateCustomViewPayloadCustomView.Description, and is useful for accessing the field via an interface.
+func (v *createCustomViewCustomViewCreateCustomViewPayloadCustomView) GetDescription() *string {
+	return v.CustomView.Description
 }
 
-// GetName returns createLabelIssueLabelCreateIssueLabelPayloadIssueLabel.Name, and is useful for accessing the field via an interface.
-func (v *createLabelIssueLabelCreateIssueLabelPayloadIssueLabel) GetName() string {
-	return v.IssueLabel.Name
+// GetIcon returns createCustomViewCustomViewCreateCustomViewPayloadCustomView.Icon, and is useful for accessing the field via an interface.
+func (v *createCustomViewCustomViewCreateCustomViewPayloadCustomView) GetIcon() *string {
+	return v.CustomView.Icon
 }
 
-// GetDescription returns createLabelIssueLabelCreateIssueLabelPayloadIssueLabel.Description, and is useful for accessing the field via an interface.
-func (v *createLabelIssueLabelCreateIssueLabelPayloadIssueLabel) GetDescription() *string {
-	return v.IssueLabel.Description
+// GetColor returns createCustomViewCustomViewCreateCustomViewPayloadCustomView.Color, and is useful for accessing the field via an interface.
+func (v *createCustomViewCustomViewCreateCustomViewPayloadCustomView) GetColor() *string {
+	return v.CustomView.Color
 }
 
-// GetColor returns createLabelIssueLabelCreateIssueLabelPayloadIssueLabel.Color, and is useful for accessing the field via an interface.
-func (v *createLabelIssueLabelCreateIssueLabelPayloadIssueLabel) GetColor() *string {
-	return v.IssueLabel.Color
+// GetTeam returns createCustomViewCustomViewCreateCustomViewPayloadCustomView.Team, and is useful for accessing the field via an interface.
+func (v *createCustomViewCustomViewCreateCustomViewPayloadCustomView) GetTeam() *CustomViewTeam {
+	return v.CustomView.Team
 }
 
-// GetParent returns createLabelIssueLabelCreateIssueLabelPayloadIssueLabel.Parent, and is useful for accessing the field via an interface.
-func (v *createLabelIssueLabelCreateIssueLabelPayloadIssueLabel) GetParent() *IssueLabelParentIssueLabel {
-	return v.IssueLabel.Parent
+// GetShared returns createCustomViewCustomViewCreateCustomViewPayloadCustomView.Shared, and is useful for accessing the field via an interface.
+func (v *createCustomViewCustomViewCreateCustomViewPayloadCustomView) GetShared() bool {
+	return v.CustomView.Shared
 }
 
-// GetTeam returns createLabelIssueLabelCreateIssueLabelPayloadIssueLabel.Team, and is useful for accessing the field via an interface.
-func (v *createLabelIssueLabelCreateIssueLabelPayloadIssueLabel) GetTeam() *IssueLabelTeam {
-	return v.IssueLabel.Team
+// GetFilterData returns createCustomViewCustomViewCreateCustomViewPayloadCustomView.FilterData, and is useful for accessing the field via an interface.
+func (v *createCustomViewCustomViewCreateCustomViewPayloadCustomView) GetFilterData() map[string]interface{} {
+	return v.CustomView.FilterData
 }
 
-func (v *createLabelIssueLabelCreateIssueLabelPayloadIssueLabel) UnmarshalJSON(b []byte) error {
+// GetProjectFilterData returns createCustomViewCustomViewCreateCustomViewPayloadCustomView.ProjectFilterData, and is useful for accessing the field via an interface.
+func (v *createCustomViewCustomViewCreateCustomViewPayloadCustomView) GetProjectFilterData() map[string]interface{} {
+	return v.CustomView.ProjectFilterData
+}
+
+func (v *createCustomViewCustomViewCreateCustomViewPayloadCustomView) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*createLabelIssueLabelCreateIssueLabelPayloadIssueLabel
+		*createCustomViewCustomViewCreateCustomViewPayloadCustomView
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.createLabelIssueLabelCreateIssueLabelPayloadIssueLabel = v
+	firstPass.createCustomViewCustomViewCreateCustomViewPayloadCustomView = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -1912,28 +4916,34 @@ func (v *createLabelIssueLabelCreateIssueLabelPayloadIssueLabel) UnmarshalJSON(b
 	}
 
 	err = json.Unmarshal(
-		b, &v.IssueLabel)
+		b, &v.CustomView)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-type __premarshalcreateLabelIssueLabelCreateIssueLabelPayloadIssueLabel struct {
+type __premarshalcreateCustomViewCustomViewCreateCustomViewPayloadCustomView struct {
 	Id string `json:"id"`
 
 	Name string `json:"name"`
 
 	Description *string `json:"description"`
 
+	Icon *string `json:"icon"`
+
 	Color *string `json:"color"`
 
-	Parent *IssueLabelParentIssueLabel `json:"parent"`
+	Team *CustomViewTeam `json:"team"`
 
-	Team *IssueLabelTeam `json:"team"`
+	Shared bool `json:"shared"`
+
+	FilterData map[string]interface{} `json:"filterData"`
+
+	ProjectFilterData map[string]interface{} `json:"projectFilterData"`
 }
 
-func (v *createLabelIssueLabelCreateIssueLabelPayloadIssueLabel) MarshalJSON() ([]byte, error) {
+func (v *createCustomViewCustomViewCreateCustomViewPayloadCustomView) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -1941,192 +4951,230 @@ func (v *createLabelIssueLabelCreateIssueLabelPayloadIssueLabel) MarshalJSON() (
 	return json.Marshal(premarshaled)
 }
 
-func (v *createLabelIssueLabelCreateIssueLabelPayloadIssueLabel) __premarshalJSON() (*__premarshalcreateLabelIssueLabelCreateIssueLabelPayloadIssueLabel, error) {
-	var retval __premarshalcreateLabelIssueLabelCreateIssueLabelPayloadIssueLabel
+func (v *createCustomViewCustomViewCreateCustomViewPayloadCustomView) __premarshalJSON() (*__premarshalcreateCustomViewCustomViewCreateCustomViewPayloadCustomView, error) {
+	var retval __premarshalcreateCustomViewCustomViewCreateCustomViewPayloadCustomView
 
-	retval.Id = v.IssueLabel.Id
-	retval.Name = v.IssueLabel.Name
-	retval.Description = v.IssueLabel.Description
-	retval.Color = v.IssueLabel.Color
-	retval.Parent = v.IssueLabel.Parent
-	retval.Team = v.IssueLabel.Team
+	retval.Id = v.CustomView.Id
+	retval.Name = v.CustomView.Name
+	retval.Description = v.CustomView.Description
+	retval.Icon = v.CustomView.Icon
+	retval.Color = v.CustomView.Color
+	retval.Team = v.CustomView.Team
+	retval.Shared = v.CustomView.Shared
+	retval.FilterData = v.CustomView.FilterData
+	retval.ProjectFilterData = v.CustomView.ProjectFilterData
 	return &retval, nil
 }
 
-// createLabelResponse is returned by createLabel on success.
-type createLabelResponse struct {
-	// Creates a new label.
-	IssueLabelCreate createLabelIssueLabelCreateIssueLabelPayload `json:"issueLabelCreate"`
-}
-
-// GetIssueLabelCreate returns createLabelResponse.IssueLabelCreate, and is useful for accessing the field via an interface.
-func (v *createLabelResponse) GetIssueLabelCreate() createLabelIssueLabelCreateIssueLabelPayload {
-	return v.IssueLabelCreate
+// createCustomViewResponse is returned by createCustomView on success.
+type createCustomViewResponse struct {
+	// Creates a new custom view.
+	CustomViewCreate createCustomViewCustomViewCreateCustomViewPayload `json:"customViewCreate"`
 }
 
-// createTeamResponse is returned by createTeam on success.
-type createTeamResponse struct {
-	// Creates a new team. The user who creates the team will automatically be added as a member to the newly created team.
-	TeamCreate createTeamTeamCreateTeamPayload `json:"teamCreate"`
+// GetCustomViewCreate returns createCustomViewResponse.CustomViewCreate, and is useful for accessing the field via an interface.
+func (v *createCustomViewResponse) GetCustomViewCreate() createCustomViewCustomViewCreateCustomViewPayload {
+	return v.CustomViewCreate
 }
 
-// GetTeamCreate returns createTeamResponse.TeamCreate, and is useful for accessing the field via an interface.
-func (v *createTeamResponse) GetTeamCreate() createTeamTeamCreateTeamPayload { return v.TeamCreate }
-
-// createTeamTeamCreateTeamPayload includes the requested fields of the GraphQL type TeamPayload.
-type createTeamTeamCreateTeamPayload struct {
-	// The team that was created or updated.
-	Team createTeamTeamCreateTeamPayloadTeam `json:"team"`
+// createCycleCycleCreateCyclePayload includes the requested fields of the GraphQL type CyclePayload.
+type createCycleCycleCreateCyclePayload struct {
+	// The Cycle that was created or updated.
+	Cycle createCycleCycleCreateCyclePayloadCycle `json:"cycle"`
 }
 
-// GetTeam returns createTeamTeamCreateTeamPayload.Team, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayload) GetTeam() createTeamTeamCreateTeamPayloadTeam {
-	return v.Team
+// GetCycle returns createCycleCycleCreateCyclePayload.Cycle, and is useful for accessing the field via an interface.
+func (v *createCycleCycleCreateCyclePayload) GetCycle() createCycleCycleCreateCyclePayloadCycle {
+	return v.Cycle
 }
 
-// createTeamTeamCreateTeamPayloadTeam includes the requested fields of the GraphQL type Team.
+// createCycleCycleCreateCyclePayloadCycle includes the requested fields of the GraphQL type Cycle.
 // The GraphQL type's documentation follows.
 //
-// An organizational unit that contains issues.
-type createTeamTeamCreateTeamPayloadTeam struct {
-	Team `json:"-"`
+// A set of issues to be resolved in a specified amount of time.
+type createCycleCycleCreateCyclePayloadCycle struct {
+	Cycle `json:"-"`
 }
 
-// GetId returns createTeamTeamCreateTeamPayloadTeam.Id, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetId() string { return v.Team.Id }
+// GetId returns createCycleCycleCreateCyclePayloadCycle.Id, and is useful for accessing the field via an interface.
+func (v *createCycleCycleCreateCyclePayloadCycle) GetId() string { return v.Cycle.Id }
 
-// GetName returns createTeamTeamCreateTeamPayloadTeam.Name, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetName() string { return v.Team.Name }
+// GetNumber returns createCycleCycleCreateCyclePayloadCycle.Number, and is useful for accessing the field via an interface.
+func (v *createCycleCycleCreateCyclePayloadCycle) GetNumber() float64 { return v.Cycle.Number }
 
-// GetKey returns createTeamTeamCreateTeamPayloadTeam.Key, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetKey() string { return v.Team.Key }
+// GetName returns createCycleCycleCreateCyclePayloadCycle.Name, and is useful for accessing the field via an interface.
+func (v *createCycleCycleCreateCyclePayloadCycle) GetName() *string { return v.Cycle.Name }
 
-// GetPrivate returns createTeamTeamCreateTeamPayloadTeam.Private, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetPrivate() bool { return v.Team.Private }
+// GetDescription returns createCycleCycleCreateCyclePayloadCycle.Description, and is useful for accessing the field via an interface.
+func (v *createCycleCycleCreateCyclePayloadCycle) GetDescription() *string {
+	return v.Cycle.Description
+}
 
-// GetDescription returns createTeamTeamCreateTeamPayloadTeam.Description, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetDescription() *string { return v.Team.Description }
+// GetStartsAt returns createCycleCycleCreateCyclePayloadCycle.StartsAt, and is useful for accessing the field via an interface.
+func (v *createCycleCycleCreateCyclePayloadCycle) GetStartsAt() time.Time { return v.Cycle.StartsAt }
 
-// GetIcon returns createTeamTeamCreateTeamPayloadTeam.Icon, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetIcon() *string { return v.Team.Icon }
+// GetEndsAt returns createCycleCycleCreateCyclePayloadCycle.EndsAt, and is useful for accessing the field via an interface.
+func (v *createCycleCycleCreateCyclePayloadCycle) GetEndsAt() time.Time { return v.Cycle.EndsAt }
 
-// GetColor returns createTeamTeamCreateTeamPayloadTeam.Color, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetColor() *string { return v.Team.Color }
+// GetTeam returns createCycleCycleCreateCyclePayloadCycle.Team, and is useful for accessing the field via an interface.
+func (v *createCycleCycleCreateCyclePayloadCycle) GetTeam() CycleTeam { return v.Cycle.Team }
 
-// GetParent returns createTeamTeamCreateTeamPayloadTeam.Parent, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetParent() *TeamParentTeam { return v.Team.Parent }
+// GetArchivedAt returns createCycleCycleCreateCyclePayloadCycle.ArchivedAt, and is useful for accessing the field via an interface.
+func (v *createCycleCycleCreateCyclePayloadCycle) GetArchivedAt() *time.Time {
+	return v.Cycle.ArchivedAt
+}
 
-// GetTimezone returns createTeamTeamCreateTeamPayloadTeam.Timezone, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetTimezone() string { return v.Team.Timezone }
+func (v *createCycleCycleCreateCyclePayloadCycle) UnmarshalJSON(b []byte) error {
 
-// GetGroupIssueHistory returns createTeamTeamCreateTeamPayloadTeam.GroupIssueHistory, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetGroupIssueHistory() bool {
-	return v.Team.GroupIssueHistory
-}
+	if string(b) == "null" {
+		return nil
+	}
 
-// GetSetIssueSortOrderOnStateChange returns createTeamTeamCreateTeamPayloadTeam.SetIssueSortOrderOnStateChange, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetSetIssueSortOrderOnStateChange() string {
-	return v.Team.SetIssueSortOrderOnStateChange
-}
+	var firstPass struct {
+		*createCycleCycleCreateCyclePayloadCycle
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.createCycleCycleCreateCyclePayloadCycle = v
 
-// GetAiThreadSummariesEnabled returns createTeamTeamCreateTeamPayloadTeam.AiThreadSummariesEnabled, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetAiThreadSummariesEnabled() bool {
-	return v.Team.AiThreadSummariesEnabled
-}
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
 
-// GetAutoArchivePeriod returns createTeamTeamCreateTeamPayloadTeam.AutoArchivePeriod, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetAutoArchivePeriod() float64 {
-	return v.Team.AutoArchivePeriod
+	err = json.Unmarshal(
+		b, &v.Cycle)
+	if err != nil {
+		return err
+	}
+	return nil
 }
 
-// GetAutoClosePeriod returns createTeamTeamCreateTeamPayloadTeam.AutoClosePeriod, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetAutoClosePeriod() *float64 {
-	return v.Team.AutoClosePeriod
-}
+type __premarshalcreateCycleCycleCreateCyclePayloadCycle struct {
+	Id string `json:"id"`
 
-// GetAutoCloseParentIssues returns createTeamTeamCreateTeamPayloadTeam.AutoCloseParentIssues, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetAutoCloseParentIssues() bool {
-	return v.Team.AutoCloseParentIssues
+	Number float64 `json:"number"`
+
+	Name *string `json:"name"`
+
+	Description *string `json:"description"`
+
+	StartsAt time.Time `json:"startsAt"`
+
+	EndsAt time.Time `json:"endsAt"`
+
+	Team CycleTeam `json:"team"`
+
+	ArchivedAt *time.Time `json:"archivedAt"`
 }
 
-// GetAutoCloseChildIssues returns createTeamTeamCreateTeamPayloadTeam.AutoCloseChildIssues, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetAutoCloseChildIssues() bool {
-	return v.Team.AutoCloseChildIssues
+func (v *createCycleCycleCreateCyclePayloadCycle) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
 }
 
-// GetTriageEnabled returns createTeamTeamCreateTeamPayloadTeam.TriageEnabled, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetTriageEnabled() bool { return v.Team.TriageEnabled }
+func (v *createCycleCycleCreateCyclePayloadCycle) __premarshalJSON() (*__premarshalcreateCycleCycleCreateCyclePayloadCycle, error) {
+	var retval __premarshalcreateCycleCycleCreateCyclePayloadCycle
 
-// GetRequirePriorityToLeaveTriage returns createTeamTeamCreateTeamPayloadTeam.RequirePriorityToLeaveTriage, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetRequirePriorityToLeaveTriage() bool {
-	return v.Team.RequirePriorityToLeaveTriage
+	retval.Id = v.Cycle.Id
+	retval.Number = v.Cycle.Number
+	retval.Name = v.Cycle.Name
+	retval.Description = v.Cycle.Description
+	retval.StartsAt = v.Cycle.StartsAt
+	retval.EndsAt = v.Cycle.EndsAt
+	retval.Team = v.Cycle.Team
+	retval.ArchivedAt = v.Cycle.ArchivedAt
+	return &retval, nil
 }
 
-// GetCyclesEnabled returns createTeamTeamCreateTeamPayloadTeam.CyclesEnabled, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetCyclesEnabled() bool { return v.Team.CyclesEnabled }
+// createCycleResponse is returned by createCycle on success.
+type createCycleResponse struct {
+	// Creates a new cycle.
+	CycleCreate createCycleCycleCreateCyclePayload `json:"cycleCreate"`
+}
 
-// GetCycleStartDay returns createTeamTeamCreateTeamPayloadTeam.CycleStartDay, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetCycleStartDay() float64 { return v.Team.CycleStartDay }
+// GetCycleCreate returns createCycleResponse.CycleCreate, and is useful for accessing the field via an interface.
+func (v *createCycleResponse) GetCycleCreate() createCycleCycleCreateCyclePayload {
+	return v.CycleCreate
+}
 
-// GetCycleDuration returns createTeamTeamCreateTeamPayloadTeam.CycleDuration, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetCycleDuration() float64 { return v.Team.CycleDuration }
+// createEmailIntakeAddressEmailIntakeAddressCreateEmailIntakeAddressPayload includes the requested fields of the GraphQL type EmailIntakeAddressPayload.
+type createEmailIntakeAddressEmailIntakeAddressCreateEmailIntakeAddressPayload struct {
+	// The email address that was created or updated.
+	EmailIntakeAddress createEmailIntakeAddressEmailIntakeAddressCreateEmailIntakeAddressPayloadEmailIntakeAddress `json:"emailIntakeAddress"`
+}
 
-// GetCycleCooldownTime returns createTeamTeamCreateTeamPayloadTeam.CycleCooldownTime, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetCycleCooldownTime() float64 {
-	return v.Team.CycleCooldownTime
+// GetEmailIntakeAddress returns createEmailIntakeAddressEmailIntakeAddressCreateEmailIntakeAddressPayload.EmailIntakeAddress, and is useful for accessing the field via an interface.
+func (v *createEmailIntakeAddressEmailIntakeAddressCreateEmailIntakeAddressPayload) GetEmailIntakeAddress() createEmailIntakeAddressEmailIntakeAddressCreateEmailIntakeAddressPayloadEmailIntakeAddress {
+	return v.EmailIntakeAddress
 }
 
-// GetUpcomingCycleCount returns createTeamTeamCreateTeamPayloadTeam.UpcomingCycleCount, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetUpcomingCycleCount() float64 {
-	return v.Team.UpcomingCycleCount
+// createEmailIntakeAddressEmailIntakeAddressCreateEmailIntakeAddressPayloadEmailIntakeAddress includes the requested fields of the GraphQL type EmailIntakeAddress.
+// The GraphQL type's documentation follows.
+//
+// An email address that can be used for submitting issues.
+type createEmailIntakeAddressEmailIntakeAddressCreateEmailIntakeAddressPayloadEmailIntakeAddress struct {
+	EmailIntakeAddress `json:"-"`
 }
 
-// GetCycleIssueAutoAssignStarted returns createTeamTeamCreateTeamPayloadTeam.CycleIssueAutoAssignStarted, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetCycleIssueAutoAssignStarted() bool {
-	return v.Team.CycleIssueAutoAssignStarted
+// GetId returns createEmailIntakeAddressEmailIntakeAddressCreateEmailIntakeAddressPayloadEmailIntakeAddress.Id, and is useful for accessing the field via an interface.
+func (v *createEmailIntakeAddressEmailIntakeAddressCreateEmailIntakeAddressPayloadEmailIntakeAddress) GetId() string {
+	return v.EmailIntakeAddress.Id
 }
 
-// GetCycleIssueAutoAssignCompleted returns createTeamTeamCreateTeamPayloadTeam.CycleIssueAutoAssignCompleted, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetCycleIssueAutoAssignCompleted() bool {
-	return v.Team.CycleIssueAutoAssignCompleted
+// GetAddress returns createEmailIntakeAddressEmailIntakeAddressCreateEmailIntakeAddressPayloadEmailIntakeAddress.Address, and is useful for accessing the field via an interface.
+func (v *createEmailIntakeAddressEmailIntakeAddressCreateEmailIntakeAddressPayloadEmailIntakeAddress) GetAddress() string {
+	return v.EmailIntakeAddress.Address
 }
 
-// GetCycleLockToActive returns createTeamTeamCreateTeamPayloadTeam.CycleLockToActive, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetCycleLockToActive() bool {
-	return v.Team.CycleLockToActive
+// GetForwardingEmailAddress returns createEmailIntakeAddressEmailIntakeAddressCreateEmailIntakeAddressPayloadEmailIntakeAddress.ForwardingEmailAddress, and is useful for accessing the field via an interface.
+func (v *createEmailIntakeAddressEmailIntakeAddressCreateEmailIntakeAddressPayloadEmailIntakeAddress) GetForwardingEmailAddress() *string {
+	return v.EmailIntakeAddress.ForwardingEmailAddress
 }
 
-// GetIssueEstimationType returns createTeamTeamCreateTeamPayloadTeam.IssueEstimationType, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetIssueEstimationType() string {
-	return v.Team.IssueEstimationType
+// GetSenderName returns createEmailIntakeAddressEmailIntakeAddressCreateEmailIntakeAddressPayloadEmailIntakeAddress.SenderName, and is useful for accessing the field via an interface.
+func (v *createEmailIntakeAddressEmailIntakeAddressCreateEmailIntakeAddressPayloadEmailIntakeAddress) GetSenderName() *string {
+	return v.EmailIntakeAddress.SenderName
 }
 
-// GetIssueEstimationAllowZero returns createTeamTeamCreateTeamPayloadTeam.IssueEstimationAllowZero, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetIssueEstimationAllowZero() bool {
-	return v.Team.IssueEstimationAllowZero
+// GetEnabled returns createEmailIntakeAddressEmailIntakeAddressCreateEmailIntakeAddressPayloadEmailIntakeAddress.Enabled, and is useful for accessing the field via an interface.
+func (v *createEmailIntakeAddressEmailIntakeAddressCreateEmailIntakeAddressPayloadEmailIntakeAddress) GetEnabled() bool {
+	return v.EmailIntakeAddress.Enabled
 }
 
-// GetIssueEstimationExtended returns createTeamTeamCreateTeamPayloadTeam.IssueEstimationExtended, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetIssueEstimationExtended() bool {
-	return v.Team.IssueEstimationExtended
+// GetRepliesEnabled returns createEmailIntakeAddressEmailIntakeAddressCreateEmailIntakeAddressPayloadEmailIntakeAddress.RepliesEnabled, and is useful for accessing the field via an interface.
+func (v *createEmailIntakeAddressEmailIntakeAddressCreateEmailIntakeAddressPayloadEmailIntakeAddress) GetRepliesEnabled() bool {
+	return v.EmailIntakeAddress.RepliesEnabled
 }
 
-// GetDefaultIssueEstimate returns createTeamTeamCreateTeamPayloadTeam.DefaultIssueEstimate, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetDefaultIssueEstimate() float64 {
-	return v.Team.DefaultIssueEstimate
+// GetTeam returns createEmailIntakeAddressEmailIntakeAddressCreateEmailIntakeAddressPayloadEmailIntakeAddress.Team, and is useful for accessing the field via an interface.
+func (v *createEmailIntakeAddressEmailIntakeAddressCreateEmailIntakeAddressPayloadEmailIntakeAddress) GetTeam() *EmailIntakeAddressTeam {
+	return v.EmailIntakeAddress.Team
 }
 
-func (v *createTeamTeamCreateTeamPayloadTeam) UnmarshalJSON(b []byte) error {
+// GetTemplate returns createEmailIntakeAddressEmailIntakeAddressCreateEmailIntakeAddressPayloadEmailIntakeAddress.Template, and is useful for accessing the field via an interface.
+func (v *createEmailIntakeAddressEmailIntakeAddressCreateEmailIntakeAddressPayloadEmailIntakeAddress) GetTemplate() *EmailIntakeAddressTemplate {
+	return v.EmailIntakeAddress.Template
+}
+
+// GetArchivedAt returns createEmailIntakeAddressEmailIntakeAddressCreateEmailIntakeAddressPayloadEmailIntakeAddress.ArchivedAt, and is useful for accessing the field via an interface.
+func (v *createEmailIntakeAddressEmailIntakeAddressCreateEmailIntakeAddressPayloadEmailIntakeAddress) GetArchivedAt() *time.Time {
+	return v.EmailIntakeAddress.ArchivedAt
+}
+
+func (v *createEmailIntakeAddressEmailIntakeAddressCreateEmailIntakeAddressPayloadEmailIntakeAddress) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*createTeamTeamCreateTeamPayloadTeam
+		*createEmailIntakeAddressEmailIntakeAddressCreateEmailIntakeAddressPayloadEmailIntakeAddress
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.createTeamTeamCreateTeamPayloadTeam = v
+	firstPass.createEmailIntakeAddressEmailIntakeAddressCreateEmailIntakeAddressPayloadEmailIntakeAddress = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -2134,76 +5182,34 @@ func (v *createTeamTeamCreateTeamPayloadTeam) UnmarshalJSON(b []byte) error {
 	}
 
 	err = json.Unmarshal(
-		b, &v.Team)
+		b, &v.EmailIntakeAddress)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-type __premarshalcreateTeamTeamCreateTeamPayloadTeam struct {
+type __premarshalcreateEmailIntakeAddressEmailIntakeAddressCreateEmailIntakeAddressPayloadEmailIntakeAddress struct {
 	Id string `json:"id"`
 
-	Name string `json:"name"`
-
-	Key string `json:"key"`
-
-	Private bool `json:"private"`
-
-	Description *string `json:"description"`
-
-	Icon *string `json:"icon"`
-
-	Color *string `json:"color"`
-
-	Parent *TeamParentTeam `json:"parent"`
-
-	Timezone string `json:"timezone"`
-
-	GroupIssueHistory bool `json:"groupIssueHistory"`
-
-	SetIssueSortOrderOnStateChange string `json:"setIssueSortOrderOnStateChange"`
-
-	AiThreadSummariesEnabled bool `json:"aiThreadSummariesEnabled"`
-
-	AutoArchivePeriod float64 `json:"autoArchivePeriod"`
-
-	AutoClosePeriod *float64 `json:"autoClosePeriod"`
-
-	AutoCloseParentIssues bool `json:"autoCloseParentIssues"`
-
-	AutoCloseChildIssues bool `json:"autoCloseChildIssues"`
-
-	TriageEnabled bool `json:"triageEnabled"`
-
-	RequirePriorityToLeaveTriage bool `json:"requirePriorityToLeaveTriage"`
-
-	CyclesEnabled bool `json:"cyclesEnabled"`
-
-	CycleStartDay float64 `json:"cycleStartDay"`
-
-	CycleDuration float64 `json:"cycleDuration"`
-
-	CycleCooldownTime float64 `json:"cycleCooldownTime"`
-
-	UpcomingCycleCount float64 `json:"upcomingCycleCount"`
+	Address string `json:"address"`
 
-	CycleIssueAutoAssignStarted bool `json:"cycleIssueAutoAssignStarted"`
+	ForwardingEmailAddress *string `json:"forwardingEmailAddress"`
 
-	CycleIssueAutoAssignCompleted bool `json:"cycleIssueAutoAssignCompleted"`
+	SenderName *string `json:"senderName"`
 
-	CycleLockToActive bool `json:"cycleLockToActive"`
+	Enabled bool `json:"enabled"`
 
-	IssueEstimationType string `json:"issueEstimationType"`
+	RepliesEnabled bool `json:"repliesEnabled"`
 
-	IssueEstimationAllowZero bool `json:"issueEstimationAllowZero"`
+	Team *EmailIntakeAddressTeam `json:"team"`
 
-	IssueEstimationExtended bool `json:"issueEstimationExtended"`
+	Template *EmailIntakeAddressTemplate `json:"template"`
 
-	DefaultIssueEstimate float64 `json:"defaultIssueEstimate"`
+	ArchivedAt *time.Time `json:"archivedAt"`
 }
 
-func (v *createTeamTeamCreateTeamPayloadTeam) MarshalJSON() ([]byte, error) {
+func (v *createEmailIntakeAddressEmailIntakeAddressCreateEmailIntakeAddressPayloadEmailIntakeAddress) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -2211,118 +5217,76 @@ func (v *createTeamTeamCreateTeamPayloadTeam) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *createTeamTeamCreateTeamPayloadTeam) __premarshalJSON() (*__premarshalcreateTeamTeamCreateTeamPayloadTeam, error) {
-	var retval __premarshalcreateTeamTeamCreateTeamPayloadTeam
+func (v *createEmailIntakeAddressEmailIntakeAddressCreateEmailIntakeAddressPayloadEmailIntakeAddress) __premarshalJSON() (*__premarshalcreateEmailIntakeAddressEmailIntakeAddressCreateEmailIntakeAddressPayloadEmailIntakeAddress, error) {
+	var retval __premarshalcreateEmailIntakeAddressEmailIntakeAddressCreateEmailIntakeAddressPayloadEmailIntakeAddress
 
-	retval.Id = v.Team.Id
-	retval.Name = v.Team.Name
-	retval.Key = v.Team.Key
-	retval.Private = v.Team.Private
-	retval.Description = v.Team.Description
-	retval.Icon = v.Team.Icon
-	retval.Color = v.Team.Color
-	retval.Parent = v.Team.Parent
-	retval.Timezone = v.Team.Timezone
-	retval.GroupIssueHistory = v.Team.GroupIssueHistory
-	retval.SetIssueSortOrderOnStateChange = v.Team.SetIssueSortOrderOnStateChange
-	retval.AiThreadSummariesEnabled = v.Team.AiThreadSummariesEnabled
-	retval.AutoArchivePeriod = v.Team.AutoArchivePeriod
-	retval.AutoClosePeriod = v.Team.AutoClosePeriod
-	retval.AutoCloseParentIssues = v.Team.AutoCloseParentIssues
-	retval.AutoCloseChildIssues = v.Team.AutoCloseChildIssues
-	retval.TriageEnabled = v.Team.TriageEnabled
-	retval.RequirePriorityToLeaveTriage = v.Team.RequirePriorityToLeaveTriage
-	retval.CyclesEnabled = v.Team.CyclesEnabled
-	retval.CycleStartDay = v.Team.CycleStartDay
-	retval.CycleDuration = v.Team.CycleDuration
-	retval.CycleCooldownTime = v.Team.CycleCooldownTime
-	retval.UpcomingCycleCount = v.Team.UpcomingCycleCount
-	retval.CycleIssueAutoAssignStarted = v.Team.CycleIssueAutoAssignStarted
-	retval.CycleIssueAutoAssignCompleted = v.Team.CycleIssueAutoAssignCompleted
-	retval.CycleLockToActive = v.Team.CycleLockToActive
-	retval.IssueEstimationType = v.Team.IssueEstimationType
-	retval.IssueEstimationAllowZero = v.Team.IssueEstimationAllowZero
-	retval.IssueEstimationExtended = v.Team.IssueEstimationExtended
-	retval.DefaultIssueEstimate = v.Team.DefaultIssueEstimate
+	retval.Id = v.EmailIntakeAddress.Id
+	retval.Address = v.EmailIntakeAddress.Address
+	retval.ForwardingEmailAddress = v.EmailIntakeAddress.ForwardingEmailAddress
+	retval.SenderName = v.EmailIntakeAddress.SenderName
+	retval.Enabled = v.EmailIntakeAddress.Enabled
+	retval.RepliesEnabled = v.EmailIntakeAddress.RepliesEnabled
+	retval.Team = v.EmailIntakeAddress.Team
+	retval.Template = v.EmailIntakeAddress.Template
+	retval.ArchivedAt = v.EmailIntakeAddress.ArchivedAt
 	return &retval, nil
 }
 
-// createWorkflowStateResponse is returned by createWorkflowState on success.
-type createWorkflowStateResponse struct {
-	// Creates a new state, adding it to the workflow of a team.
-	WorkflowStateCreate createWorkflowStateWorkflowStateCreateWorkflowStatePayload `json:"workflowStateCreate"`
+// createEmailIntakeAddressResponse is returned by createEmailIntakeAddress on success.
+type createEmailIntakeAddressResponse struct {
+	// Creates a new email intake address.
+	EmailIntakeAddressCreate createEmailIntakeAddressEmailIntakeAddressCreateEmailIntakeAddressPayload `json:"emailIntakeAddressCreate"`
 }
 
-// GetWorkflowStateCreate returns createWorkflowStateResponse.WorkflowStateCreate, and is useful for accessing the field via an interface.
-func (v *createWorkflowStateResponse) GetWorkflowStateCreate() createWorkflowStateWorkflowStateCreateWorkflowStatePayload {
-	return v.WorkflowStateCreate
+// GetEmailIntakeAddressCreate returns createEmailIntakeAddressResponse.EmailIntakeAddressCreate, and is useful for accessing the field via an interface.
+func (v *createEmailIntakeAddressResponse) GetEmailIntakeAddressCreate() createEmailIntakeAddressEmailIntakeAddressCreateEmailIntakeAddressPayload {
+	return v.EmailIntakeAddressCreate
 }
 
-// createWorkflowStateWorkflowStateCreateWorkflowStatePayload includes the requested fields of the GraphQL type WorkflowStatePayload.
-type createWorkflowStateWorkflowStateCreateWorkflowStatePayload struct {
-	// The state that was created or updated.
-	WorkflowState createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState `json:"workflowState"`
+// createEmojiEmojiCreateEmojiPayload includes the requested fields of the GraphQL type EmojiPayload.
+type createEmojiEmojiCreateEmojiPayload struct {
+	// The emoji that was created.
+	Emoji createEmojiEmojiCreateEmojiPayloadEmoji `json:"emoji"`
 }
 
-// GetWorkflowState returns createWorkflowStateWorkflowStateCreateWorkflowStatePayload.WorkflowState, and is useful for accessing the field via an interface.
-func (v *createWorkflowStateWorkflowStateCreateWorkflowStatePayload) GetWorkflowState() createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState {
-	return v.WorkflowState
+// GetEmoji returns createEmojiEmojiCreateEmojiPayload.Emoji, and is useful for accessing the field via an interface.
+func (v *createEmojiEmojiCreateEmojiPayload) GetEmoji() createEmojiEmojiCreateEmojiPayloadEmoji {
+	return v.Emoji
 }
 
-// createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState includes the requested fields of the GraphQL type WorkflowState.
+// createEmojiEmojiCreateEmojiPayloadEmoji includes the requested fields of the GraphQL type Emoji.
 // The GraphQL type's documentation follows.
 //
-// A state in a team workflow.
-type createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState struct {
-	WorkflowState `json:"-"`
-}
-
-// GetId returns createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState.Id, and is useful for accessing the field via an interface.
-func (v *createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState) GetId() string {
-	return v.WorkflowState.Id
-}
-
-// GetName returns createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState.Name, and is useful for accessing the field via an interface.
-func (v *createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState) GetName() string {
-	return v.WorkflowState.Name
+// A custom emoji.
+type createEmojiEmojiCreateEmojiPayloadEmoji struct {
+	Emoji `json:"-"`
 }
 
-// GetColor returns createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState.Color, and is useful for accessing the field via an interface.
-func (v *createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState) GetColor() string {
-	return v.WorkflowState.Color
-}
-
-// GetDescription returns createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState.Description, and is useful for accessing the field via an interface.
-func (v *createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState) GetDescription() *string {
-	return v.WorkflowState.Description
-}
+// GetId returns createEmojiEmojiCreateEmojiPayloadEmoji.Id, and is useful for accessing the field via an interface.
+func (v *createEmojiEmojiCreateEmojiPayloadEmoji) GetId() string { return v.Emoji.Id }
 
-// GetType returns createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState.Type, and is useful for accessing the field via an interface.
-func (v *createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState) GetType() string {
-	return v.WorkflowState.Type
-}
+// GetName returns createEmojiEmojiCreateEmojiPayloadEmoji.Name, and is useful for accessing the field via an interface.
+func (v *createEmojiEmojiCreateEmojiPayloadEmoji) GetName() string { return v.Emoji.Name }
 
-// GetPosition returns createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState.Position, and is useful for accessing the field via an interface.
-func (v *createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState) GetPosition() float64 {
-	return v.WorkflowState.Position
-}
+// GetUrl returns createEmojiEmojiCreateEmojiPayloadEmoji.Url, and is useful for accessing the field via an interface.
+func (v *createEmojiEmojiCreateEmojiPayloadEmoji) GetUrl() string { return v.Emoji.Url }
 
-// GetTeam returns createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState.Team, and is useful for accessing the field via an interface.
-func (v *createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState) GetTeam() WorkflowStateTeam {
-	return v.WorkflowState.Team
+// GetArchivedAt returns createEmojiEmojiCreateEmojiPayloadEmoji.ArchivedAt, and is useful for accessing the field via an interface.
+func (v *createEmojiEmojiCreateEmojiPayloadEmoji) GetArchivedAt() *time.Time {
+	return v.Emoji.ArchivedAt
 }
 
-func (v *createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState) UnmarshalJSON(b []byte) error {
+func (v *createEmojiEmojiCreateEmojiPayloadEmoji) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState
+		*createEmojiEmojiCreateEmojiPayloadEmoji
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState = v
+	firstPass.createEmojiEmojiCreateEmojiPayloadEmoji = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -2330,30 +5294,24 @@ func (v *createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState
 	}
 
 	err = json.Unmarshal(
-		b, &v.WorkflowState)
+		b, &v.Emoji)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-type __premarshalcreateWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState struct {
+type __premarshalcreateEmojiEmojiCreateEmojiPayloadEmoji struct {
 	Id string `json:"id"`
 
 	Name string `json:"name"`
 
-	Color string `json:"color"`
-
-	Description *string `json:"description"`
-
-	Type string `json:"type"`
-
-	Position float64 `json:"position"`
+	Url string `json:"url"`
 
-	Team WorkflowStateTeam `json:"team"`
+	ArchivedAt *time.Time `json:"archivedAt"`
 }
 
-func (v *createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState) MarshalJSON() ([]byte, error) {
+func (v *createEmojiEmojiCreateEmojiPayloadEmoji) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -2361,299 +5319,262 @@ func (v *createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState
 	return json.Marshal(premarshaled)
 }
 
-func (v *createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState) __premarshalJSON() (*__premarshalcreateWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState, error) {
-	var retval __premarshalcreateWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState
+func (v *createEmojiEmojiCreateEmojiPayloadEmoji) __premarshalJSON() (*__premarshalcreateEmojiEmojiCreateEmojiPayloadEmoji, error) {
+	var retval __premarshalcreateEmojiEmojiCreateEmojiPayloadEmoji
 
-	retval.Id = v.WorkflowState.Id
-	retval.Name = v.WorkflowState.Name
-	retval.Color = v.WorkflowState.Color
-	retval.Description = v.WorkflowState.Description
-	retval.Type = v.WorkflowState.Type
-	retval.Position = v.WorkflowState.Position
-	retval.Team = v.WorkflowState.Team
+	retval.Id = v.Emoji.Id
+	retval.Name = v.Emoji.Name
+	retval.Url = v.Emoji.Url
+	retval.ArchivedAt = v.Emoji.ArchivedAt
 	return &retval, nil
 }
 
-// deleteGitAutomationStateGitAutomationStateDeleteDeletePayload includes the requested fields of the GraphQL type DeletePayload.
-// The GraphQL type's documentation follows.
-//
-// A generic payload return from entity deletion mutations.
-type deleteGitAutomationStateGitAutomationStateDeleteDeletePayload struct {
-	// Whether the operation was successful.
-	Success bool `json:"success"`
+// createEmojiResponse is returned by createEmoji on success.
+type createEmojiResponse struct {
+	// Creates a custom emoji.
+	EmojiCreate createEmojiEmojiCreateEmojiPayload `json:"emojiCreate"`
 }
 
-// GetSuccess returns deleteGitAutomationStateGitAutomationStateDeleteDeletePayload.Success, and is useful for accessing the field via an interface.
-func (v *deleteGitAutomationStateGitAutomationStateDeleteDeletePayload) GetSuccess() bool {
-	return v.Success
+// GetEmojiCreate returns createEmojiResponse.EmojiCreate, and is useful for accessing the field via an interface.
+func (v *createEmojiResponse) GetEmojiCreate() createEmojiEmojiCreateEmojiPayload {
+	return v.EmojiCreate
 }
 
-// deleteGitAutomationStateResponse is returned by deleteGitAutomationState on success.
-type deleteGitAutomationStateResponse struct {
-	// Archives an automation state.
-	GitAutomationStateDelete deleteGitAutomationStateGitAutomationStateDeleteDeletePayload `json:"gitAutomationStateDelete"`
+// createFileUploadFileUploadUploadPayload includes the requested fields of the GraphQL type UploadPayload.
+type createFileUploadFileUploadUploadPayload struct {
+	// Object describing the file to be uploaded.
+	UploadFile *createFileUploadFileUploadUploadPayloadUploadFile `json:"uploadFile"`
 }
 
-// GetGitAutomationStateDelete returns deleteGitAutomationStateResponse.GitAutomationStateDelete, and is useful for accessing the field via an interface.
-func (v *deleteGitAutomationStateResponse) GetGitAutomationStateDelete() deleteGitAutomationStateGitAutomationStateDeleteDeletePayload {
-	return v.GitAutomationStateDelete
+// GetUploadFile returns createFileUploadFileUploadUploadPayload.UploadFile, and is useful for accessing the field via an interface.
+func (v *createFileUploadFileUploadUploadPayload) GetUploadFile() *createFileUploadFileUploadUploadPayloadUploadFile {
+	return v.UploadFile
 }
 
-// deleteGitAutomationTargetBranchGitAutomationTargetBranchDeleteDeletePayload includes the requested fields of the GraphQL type DeletePayload.
+// createFileUploadFileUploadUploadPayloadUploadFile includes the requested fields of the GraphQL type UploadFile.
 // The GraphQL type's documentation follows.
 //
-// A generic payload return from entity deletion mutations.
-type deleteGitAutomationTargetBranchGitAutomationTargetBranchDeleteDeletePayload struct {
-	// Whether the operation was successful.
-	Success bool `json:"success"`
+// Object representing Google Cloud upload policy, plus additional data.
+type createFileUploadFileUploadUploadPayloadUploadFile struct {
+	// The signed URL the for the uploaded file. (assigned automatically).
+	UploadUrl string `json:"uploadUrl"`
+	// The asset URL for the uploaded file. (assigned automatically).
+	AssetUrl string                                                                     `json:"assetUrl"`
+	Headers  []createFileUploadFileUploadUploadPayloadUploadFileHeadersUploadFileHeader `json:"headers"`
 }
 
-// GetSuccess returns deleteGitAutomationTargetBranchGitAutomationTargetBranchDeleteDeletePayload.Success, and is useful for accessing the field via an interface.
-func (v *deleteGitAutomationTargetBranchGitAutomationTargetBranchDeleteDeletePayload) GetSuccess() bool {
-	return v.Success
+// GetUploadUrl returns createFileUploadFileUploadUploadPayloadUploadFile.UploadUrl, and is useful for accessing the field via an interface.
+func (v *createFileUploadFileUploadUploadPayloadUploadFile) GetUploadUrl() string { return v.UploadUrl }
+
+// GetAssetUrl returns createFileUploadFileUploadUploadPayloadUploadFile.AssetUrl, and is useful for accessing the field via an interface.
+func (v *createFileUploadFileUploadUploadPayloadUploadFile) GetAssetUrl() string { return v.AssetUrl }
+
+// GetHeaders returns createFileUploadFileUploadUploadPayloadUploadFile.Headers, and is useful for accessing the field via an interface.
+func (v *createFileUploadFileUploadUploadPayloadUploadFile) GetHeaders() []createFileUploadFileUploadUploadPayloadUploadFileHeadersUploadFileHeader {
+	return v.Headers
 }
 
-// deleteGitAutomationTargetBranchResponse is returned by deleteGitAutomationTargetBranch on success.
-type deleteGitAutomationTargetBranchResponse struct {
-	// Archives a Git target branch automation.
-	GitAutomationTargetBranchDelete deleteGitAutomationTargetBranchGitAutomationTargetBranchDeleteDeletePayload `json:"gitAutomationTargetBranchDelete"`
-}
-
-// GetGitAutomationTargetBranchDelete returns deleteGitAutomationTargetBranchResponse.GitAutomationTargetBranchDelete, and is useful for accessing the field via an interface.
-func (v *deleteGitAutomationTargetBranchResponse) GetGitAutomationTargetBranchDelete() deleteGitAutomationTargetBranchGitAutomationTargetBranchDeleteDeletePayload {
-	return v.GitAutomationTargetBranchDelete
+// createFileUploadFileUploadUploadPayloadUploadFileHeadersUploadFileHeader includes the requested fields of the GraphQL type UploadFileHeader.
+type createFileUploadFileUploadUploadPayloadUploadFileHeadersUploadFileHeader struct {
+	// Upload file header key.
+	Key string `json:"key"`
+	// Upload file header value.
+	Value string `json:"value"`
 }
 
-// deleteLabelIssueLabelDeleteDeletePayload includes the requested fields of the GraphQL type DeletePayload.
-// The GraphQL type's documentation follows.
-//
-// A generic payload return from entity deletion mutations.
-type deleteLabelIssueLabelDeleteDeletePayload struct {
-	// Whether the operation was successful.
-	Success bool `json:"success"`
+// GetKey returns createFileUploadFileUploadUploadPayloadUploadFileHeadersUploadFileHeader.Key, and is useful for accessing the field via an interface.
+func (v *createFileUploadFileUploadUploadPayloadUploadFileHeadersUploadFileHeader) GetKey() string {
+	return v.Key
 }
 
-// GetSuccess returns deleteLabelIssueLabelDeleteDeletePayload.Success, and is useful for accessing the field via an interface.
-func (v *deleteLabelIssueLabelDeleteDeletePayload) GetSuccess() bool { return v.Success }
-
-// deleteLabelResponse is returned by deleteLabel on success.
-type deleteLabelResponse struct {
-	// Deletes an issue label.
-	IssueLabelDelete deleteLabelIssueLabelDeleteDeletePayload `json:"issueLabelDelete"`
+// GetValue returns createFileUploadFileUploadUploadPayloadUploadFileHeadersUploadFileHeader.Value, and is useful for accessing the field via an interface.
+func (v *createFileUploadFileUploadUploadPayloadUploadFileHeadersUploadFileHeader) GetValue() string {
+	return v.Value
 }
 
-// GetIssueLabelDelete returns deleteLabelResponse.IssueLabelDelete, and is useful for accessing the field via an interface.
-func (v *deleteLabelResponse) GetIssueLabelDelete() deleteLabelIssueLabelDeleteDeletePayload {
-	return v.IssueLabelDelete
+// createFileUploadResponse is returned by createFileUpload on success.
+type createFileUploadResponse struct {
+	// XHR request payload to upload an images, video and other attachments directly to Linear's cloud storage.
+	FileUpload createFileUploadFileUploadUploadPayload `json:"fileUpload"`
 }
 
-// deleteTeamResponse is returned by deleteTeam on success.
-type deleteTeamResponse struct {
-	// Deletes a team.
-	TeamDelete deleteTeamTeamDeleteDeletePayload `json:"teamDelete"`
+// GetFileUpload returns createFileUploadResponse.FileUpload, and is useful for accessing the field via an interface.
+func (v *createFileUploadResponse) GetFileUpload() createFileUploadFileUploadUploadPayload {
+	return v.FileUpload
 }
 
-// GetTeamDelete returns deleteTeamResponse.TeamDelete, and is useful for accessing the field via an interface.
-func (v *deleteTeamResponse) GetTeamDelete() deleteTeamTeamDeleteDeletePayload { return v.TeamDelete }
-
-// deleteTeamTeamDeleteDeletePayload includes the requested fields of the GraphQL type DeletePayload.
-// The GraphQL type's documentation follows.
-//
-// A generic payload return from entity deletion mutations.
-type deleteTeamTeamDeleteDeletePayload struct {
+// createGitAutomationStateGitAutomationStateCreateGitAutomationStatePayload includes the requested fields of the GraphQL type GitAutomationStatePayload.
+type createGitAutomationStateGitAutomationStateCreateGitAutomationStatePayload struct {
 	// Whether the operation was successful.
 	Success bool `json:"success"`
 }
 
-// GetSuccess returns deleteTeamTeamDeleteDeletePayload.Success, and is useful for accessing the field via an interface.
-func (v *deleteTeamTeamDeleteDeletePayload) GetSuccess() bool { return v.Success }
+// GetSuccess returns createGitAutomationStateGitAutomationStateCreateGitAutomationStatePayload.Success, and is useful for accessing the field via an interface.
+func (v *createGitAutomationStateGitAutomationStateCreateGitAutomationStatePayload) GetSuccess() bool {
+	return v.Success
+}
 
-// deleteWorkflowStateResponse is returned by deleteWorkflowState on success.
-type deleteWorkflowStateResponse struct {
-	// Archives a state. Only states with issues that have all been archived can be archived.
-	WorkflowStateArchive deleteWorkflowStateWorkflowStateArchiveWorkflowStateArchivePayload `json:"workflowStateArchive"`
+// createGitAutomationStateResponse is returned by createGitAutomationState on success.
+type createGitAutomationStateResponse struct {
+	// Creates a new automation state.
+	GitAutomationStateCreate createGitAutomationStateGitAutomationStateCreateGitAutomationStatePayload `json:"gitAutomationStateCreate"`
 }
 
-// GetWorkflowStateArchive returns deleteWorkflowStateResponse.WorkflowStateArchive, and is useful for accessing the field via an interface.
-func (v *deleteWorkflowStateResponse) GetWorkflowStateArchive() deleteWorkflowStateWorkflowStateArchiveWorkflowStateArchivePayload {
-	return v.WorkflowStateArchive
+// GetGitAutomationStateCreate returns createGitAutomationStateResponse.GitAutomationStateCreate, and is useful for accessing the field via an interface.
+func (v *createGitAutomationStateResponse) GetGitAutomationStateCreate() createGitAutomationStateGitAutomationStateCreateGitAutomationStatePayload {
+	return v.GitAutomationStateCreate
 }
 
-// deleteWorkflowStateWorkflowStateArchiveWorkflowStateArchivePayload includes the requested fields of the GraphQL type WorkflowStateArchivePayload.
-// The GraphQL type's documentation follows.
-//
-// A generic payload return from entity archive mutations.
-type deleteWorkflowStateWorkflowStateArchiveWorkflowStateArchivePayload struct {
+// createGitAutomationTargetBranchGitAutomationTargetBranchCreateGitAutomationTargetBranchPayload includes the requested fields of the GraphQL type GitAutomationTargetBranchPayload.
+type createGitAutomationTargetBranchGitAutomationTargetBranchCreateGitAutomationTargetBranchPayload struct {
+	// The Git target branch automation that was created or updated.
+	TargetBranch createGitAutomationTargetBranchGitAutomationTargetBranchCreateGitAutomationTargetBranchPayloadTargetBranchGitAutomationTargetBranch `json:"targetBranch"`
 	// Whether the operation was successful.
 	Success bool `json:"success"`
 }
 
-// GetSuccess returns deleteWorkflowStateWorkflowStateArchiveWorkflowStateArchivePayload.Success, and is useful for accessing the field via an interface.
-func (v *deleteWorkflowStateWorkflowStateArchiveWorkflowStateArchivePayload) GetSuccess() bool {
-	return v.Success
-}
-
-// findTeamLabelIssueLabelsIssueLabelConnection includes the requested fields of the GraphQL type IssueLabelConnection.
-type findTeamLabelIssueLabelsIssueLabelConnection struct {
-	Nodes []findTeamLabelIssueLabelsIssueLabelConnectionNodesIssueLabel `json:"nodes"`
+// GetTargetBranch returns createGitAutomationTargetBranchGitAutomationTargetBranchCreateGitAutomationTargetBranchPayload.TargetBranch, and is useful for accessing the field via an interface.
+func (v *createGitAutomationTargetBranchGitAutomationTargetBranchCreateGitAutomationTargetBranchPayload) GetTargetBranch() createGitAutomationTargetBranchGitAutomationTargetBranchCreateGitAutomationTargetBranchPayloadTargetBranchGitAutomationTargetBranch {
+	return v.TargetBranch
 }
 
-// GetNodes returns findTeamLabelIssueLabelsIssueLabelConnection.Nodes, and is useful for accessing the field via an interface.
-func (v *findTeamLabelIssueLabelsIssueLabelConnection) GetNodes() []findTeamLabelIssueLabelsIssueLabelConnectionNodesIssueLabel {
-	return v.Nodes
+// GetSuccess returns createGitAutomationTargetBranchGitAutomationTargetBranchCreateGitAutomationTargetBranchPayload.Success, and is useful for accessing the field via an interface.
+func (v *createGitAutomationTargetBranchGitAutomationTargetBranchCreateGitAutomationTargetBranchPayload) GetSuccess() bool {
+	return v.Success
 }
 
-// findTeamLabelIssueLabelsIssueLabelConnectionNodesIssueLabel includes the requested fields of the GraphQL type IssueLabel.
+// createGitAutomationTargetBranchGitAutomationTargetBranchCreateGitAutomationTargetBranchPayloadTargetBranchGitAutomationTargetBranch includes the requested fields of the GraphQL type GitAutomationTargetBranch.
 // The GraphQL type's documentation follows.
 //
-// Labels that can be associated with issues.
-type findTeamLabelIssueLabelsIssueLabelConnectionNodesIssueLabel struct {
+// A Git target branch for which there are automations (GitAutomationState).
+type createGitAutomationTargetBranchGitAutomationTargetBranchCreateGitAutomationTargetBranchPayloadTargetBranchGitAutomationTargetBranch struct {
 	// The unique identifier of the entity.
 	Id string `json:"id"`
+	// The target branch pattern.
+	BranchPattern string `json:"branchPattern"`
+	// Whether the branch pattern is a regular expression.
+	IsRegex bool `json:"isRegex"`
 }
 
-// GetId returns findTeamLabelIssueLabelsIssueLabelConnectionNodesIssueLabel.Id, and is useful for accessing the field via an interface.
-func (v *findTeamLabelIssueLabelsIssueLabelConnectionNodesIssueLabel) GetId() string { return v.Id }
+// GetId returns createGitAutomationTargetBranchGitAutomationTargetBranchCreateGitAutomationTargetBranchPayloadTargetBranchGitAutomationTargetBranch.Id, and is useful for accessing the field via an interface.
+func (v *createGitAutomationTargetBranchGitAutomationTargetBranchCreateGitAutomationTargetBranchPayloadTargetBranchGitAutomationTargetBranch) GetId() string {
+	return v.Id
+}
 
-// findTeamLabelResponse is returned by findTeamLabel on success.
-type findTeamLabelResponse struct {
-	// All issue labels.
-	IssueLabels findTeamLabelIssueLabelsIssueLabelConnection `json:"issueLabels"`
+// GetBranchPattern returns createGitAutomationTargetBranchGitAutomationTargetBranchCreateGitAutomationTargetBranchPayloadTargetBranchGitAutomationTargetBranch.BranchPattern, and is useful for accessing the field via an interface.
+func (v *createGitAutomationTargetBranchGitAutomationTargetBranchCreateGitAutomationTargetBranchPayloadTargetBranchGitAutomationTargetBranch) GetBranchPattern() string {
+	return v.BranchPattern
 }
 
-// GetIssueLabels returns findTeamLabelResponse.IssueLabels, and is useful for accessing the field via an interface.
-func (v *findTeamLabelResponse) GetIssueLabels() findTeamLabelIssueLabelsIssueLabelConnection {
-	return v.IssueLabels
+// GetIsRegex returns createGitAutomationTargetBranchGitAutomationTargetBranchCreateGitAutomationTargetBranchPayloadTargetBranchGitAutomationTargetBranch.IsRegex, and is useful for accessing the field via an interface.
+func (v *createGitAutomationTargetBranchGitAutomationTargetBranchCreateGitAutomationTargetBranchPayloadTargetBranchGitAutomationTargetBranch) GetIsRegex() bool {
+	return v.IsRegex
 }
 
-// findWorkflowStateResponse is returned by findWorkflowState on success.
-type findWorkflowStateResponse struct {
-	// All issue workflow states.
-	WorkflowStates findWorkflowStateWorkflowStatesWorkflowStateConnection `json:"workflowStates"`
+// createGitAutomationTargetBranchResponse is returned by createGitAutomationTargetBranch on success.
+type createGitAutomationTargetBranchResponse struct {
+	// Creates a Git target branch automation.
+	GitAutomationTargetBranchCreate createGitAutomationTargetBranchGitAutomationTargetBranchCreateGitAutomationTargetBranchPayload `json:"gitAutomationTargetBranchCreate"`
 }
 
-// GetWorkflowStates returns findWorkflowStateResponse.WorkflowStates, and is useful for accessing the field via an interface.
-func (v *findWorkflowStateResponse) GetWorkflowStates() findWorkflowStateWorkflowStatesWorkflowStateConnection {
-	return v.WorkflowStates
+// GetGitAutomationTargetBranchCreate returns createGitAutomationTargetBranchResponse.GitAutomationTargetBranchCreate, and is useful for accessing the field via an interface.
+func (v *createGitAutomationTargetBranchResponse) GetGitAutomationTargetBranchCreate() createGitAutomationTargetBranchGitAutomationTargetBranchCreateGitAutomationTargetBranchPayload {
+	return v.GitAutomationTargetBranchCreate
 }
 
-// findWorkflowStateWorkflowStatesWorkflowStateConnection includes the requested fields of the GraphQL type WorkflowStateConnection.
-type findWorkflowStateWorkflowStatesWorkflowStateConnection struct {
-	Nodes []findWorkflowStateWorkflowStatesWorkflowStateConnectionNodesWorkflowState `json:"nodes"`
+// createInitiativeInitiativeCreateInitiativePayload includes the requested fields of the GraphQL type InitiativePayload.
+// The GraphQL type's documentation follows.
+//
+// The payload returned by the initiative mutations.
+type createInitiativeInitiativeCreateInitiativePayload struct {
+	// The initiative that was created or updated.
+	Initiative createInitiativeInitiativeCreateInitiativePayloadInitiative `json:"initiative"`
 }
 
-// GetNodes returns findWorkflowStateWorkflowStatesWorkflowStateConnection.Nodes, and is useful for accessing the field via an interface.
-func (v *findWorkflowStateWorkflowStatesWorkflowStateConnection) GetNodes() []findWorkflowStateWorkflowStatesWorkflowStateConnectionNodesWorkflowState {
-	return v.Nodes
+// GetInitiative returns createInitiativeInitiativeCreateInitiativePayload.Initiative, and is useful for accessing the field via an interface.
+func (v *createInitiativeInitiativeCreateInitiativePayload) GetInitiative() createInitiativeInitiativeCreateInitiativePayloadInitiative {
+	return v.Initiative
 }
 
-// findWorkflowStateWorkflowStatesWorkflowStateConnectionNodesWorkflowState includes the requested fields of the GraphQL type WorkflowState.
+// createInitiativeInitiativeCreateInitiativePayloadInitiative includes the requested fields of the GraphQL type Initiative.
 // The GraphQL type's documentation follows.
 //
-// A state in a team workflow.
-type findWorkflowStateWorkflowStatesWorkflowStateConnectionNodesWorkflowState struct {
-	// The unique identifier of the entity.
-	Id string `json:"id"`
+// An initiative to group projects.
+type createInitiativeInitiativeCreateInitiativePayloadInitiative struct {
+	Initiative `json:"-"`
 }
 
-// GetId returns findWorkflowStateWorkflowStatesWorkflowStateConnectionNodesWorkflowState.Id, and is useful for accessing the field via an interface.
-func (v *findWorkflowStateWorkflowStatesWorkflowStateConnectionNodesWorkflowState) GetId() string {
-	return v.Id
+// GetId returns createInitiativeInitiativeCreateInitiativePayloadInitiative.Id, and is useful for accessing the field via an interface.
+func (v *createInitiativeInitiativeCreateInitiativePayloadInitiative) GetId() string {
+	return v.Initiative.Id
 }
 
-// findWorkspaceLabelIssueLabelsIssueLabelConnection includes the requested fields of the GraphQL type IssueLabelConnection.
-type findWorkspaceLabelIssueLabelsIssueLabelConnection struct {
-	Nodes []findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabel `json:"nodes"`
+// GetName returns createInitiativeInitiativeCreateInitiativePayloadInitiative.Name, and is useful for accessing the field via an interface.
+func (v *createInitiativeInitiativeCreateInitiativePayloadInitiative) GetName() string {
+	return v.Initiative.Name
 }
 
-// GetNodes returns findWorkspaceLabelIssueLabelsIssueLabelConnection.Nodes, and is useful for accessing the field via an interface.
-func (v *findWorkspaceLabelIssueLabelsIssueLabelConnection) GetNodes() []findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabel {
-	return v.Nodes
+// GetSlugId returns createInitiativeInitiativeCreateInitiativePayloadInitiative.SlugId, and is useful for accessing the field via an interface.
+func (v *createInitiativeInitiativeCreateInitiativePayloadInitiative) GetSlugId() string {
+	return v.Initiative.SlugId
 }
 
-// findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabel includes the requested fields of the GraphQL type IssueLabel.
-// The GraphQL type's documentation follows.
-//
-// Labels that can be associated with issues.
-type findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabel struct {
-	// The unique identifier of the entity.
-	Id string `json:"id"`
-	// The team that the label is associated with. If null, the label is associated with the global workspace.
-	Team findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabelTeam `json:"team"`
+// GetUrl returns createInitiativeInitiativeCreateInitiativePayloadInitiative.Url, and is useful for accessing the field via an interface.
+func (v *createInitiativeInitiativeCreateInitiativePayloadInitiative) GetUrl() string {
+	return v.Initiative.Url
 }
 
-// GetId returns findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabel.Id, and is useful for accessing the field via an interface.
-func (v *findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabel) GetId() string {
-	return v.Id
+// GetDescription returns createInitiativeInitiativeCreateInitiativePayloadInitiative.Description, and is useful for accessing the field via an interface.
+func (v *createInitiativeInitiativeCreateInitiativePayloadInitiative) GetDescription() *string {
+	return v.Initiative.Description
 }
 
-// GetTeam returns findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabel.Team, and is useful for accessing the field via an interface.
-func (v *findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabel) GetTeam() findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabelTeam {
-	return v.Team
+// GetOwner returns createInitiativeInitiativeCreateInitiativePayloadInitiative.Owner, and is useful for accessing the field via an interface.
+func (v *createInitiativeInitiativeCreateInitiativePayloadInitiative) GetOwner() *InitiativeOwnerUser {
+	return v.Initiative.Owner
 }
 
-// findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabelTeam includes the requested fields of the GraphQL type Team.
-// The GraphQL type's documentation follows.
-//
-// An organizational unit that contains issues.
-type findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabelTeam struct {
-	// The unique identifier of the entity.
-	Id string `json:"id"`
+// GetColor returns createInitiativeInitiativeCreateInitiativePayloadInitiative.Color, and is useful for accessing the field via an interface.
+func (v *createInitiativeInitiativeCreateInitiativePayloadInitiative) GetColor() *string {
+	return v.Initiative.Color
 }
 
-// GetId returns findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabelTeam.Id, and is useful for accessing the field via an interface.
-func (v *findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabelTeam) GetId() string {
-	return v.Id
+// GetIcon returns createInitiativeInitiativeCreateInitiativePayloadInitiative.Icon, and is useful for accessing the field via an interface.
+func (v *createInitiativeInitiativeCreateInitiativePayloadInitiative) GetIcon() *string {
+	return v.Initiative.Icon
 }
 
-// findWorkspaceLabelResponse is returned by findWorkspaceLabel on success.
-type findWorkspaceLabelResponse struct {
-	// All issue labels.
-	IssueLabels findWorkspaceLabelIssueLabelsIssueLabelConnection `json:"issueLabels"`
+// GetStatus returns createInitiativeInitiativeCreateInitiativePayloadInitiative.Status, and is useful for accessing the field via an interface.
+func (v *createInitiativeInitiativeCreateInitiativePayloadInitiative) GetStatus() InitiativeStatus {
+	return v.Initiative.Status
 }
 
-// GetIssueLabels returns findWorkspaceLabelResponse.IssueLabels, and is useful for accessing the field via an interface.
-func (v *findWorkspaceLabelResponse) GetIssueLabels() findWorkspaceLabelIssueLabelsIssueLabelConnection {
-	return v.IssueLabels
+// GetTargetDate returns createInitiativeInitiativeCreateInitiativePayloadInitiative.TargetDate, and is useful for accessing the field via an interface.
+func (v *createInitiativeInitiativeCreateInitiativePayloadInitiative) GetTargetDate() *string {
+	return v.Initiative.TargetDate
 }
 
-// getLabelIssueLabel includes the requested fields of the GraphQL type IssueLabel.
-// The GraphQL type's documentation follows.
-//
-// Labels that can be associated with issues.
-type getLabelIssueLabel struct {
-	IssueLabel `json:"-"`
+// GetTrashed returns createInitiativeInitiativeCreateInitiativePayloadInitiative.Trashed, and is useful for accessing the field via an interface.
+func (v *createInitiativeInitiativeCreateInitiativePayloadInitiative) GetTrashed() bool {
+	return v.Initiative.Trashed
 }
 
-// GetId returns getLabelIssueLabel.Id, and is useful for accessing the field via an interface.
-func (v *getLabelIssueLabel) GetId() string { return v.IssueLabel.Id }
-
-// GetName returns getLabelIssueLabel.Name, and is useful for accessing the field via an interface.
-func (v *getLabelIssueLabel) GetName() string { return v.IssueLabel.Name }
-
-// GetDescription returns getLabelIssueLabel.Description, and is useful for accessing the field via an interface.
-func (v *getLabelIssueLabel) GetDescription() *string { return v.IssueLabel.Description }
-
-// GetColor returns getLabelIssueLabel.Color, and is useful for accessing the field via an interface.
-func (v *getLabelIssueLabel) GetColor() *string { return v.IssueLabel.Color }
-
-// GetParent returns getLabelIssueLabel.Parent, and is useful for accessing the field via an interface.
-func (v *getLabelIssueLabel) GetParent() *IssueLabelParentIssueLabel { return v.IssueLabel.Parent }
-
-// GetTeam returns getLabelIssueLabel.Team, and is useful for accessing the field via an interface.
-func (v *getLabelIssueLabel) GetTeam() *IssueLabelTeam { return v.IssueLabel.Team }
+// GetArchivedAt returns createInitiativeInitiativeCreateInitiativePayloadInitiative.ArchivedAt, and is useful for accessing the field via an interface.
+func (v *createInitiativeInitiativeCreateInitiativePayloadInitiative) GetArchivedAt() *time.Time {
+	return v.Initiative.ArchivedAt
+}
 
-func (v *getLabelIssueLabel) UnmarshalJSON(b []byte) error {
+func (v *createInitiativeInitiativeCreateInitiativePayloadInitiative) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*getLabelIssueLabel
+		*createInitiativeInitiativeCreateInitiativePayloadInitiative
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.getLabelIssueLabel = v
+	firstPass.createInitiativeInitiativeCreateInitiativePayloadInitiative = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -2661,28 +5582,40 @@ func (v *getLabelIssueLabel) UnmarshalJSON(b []byte) error {
 	}
 
 	err = json.Unmarshal(
-		b, &v.IssueLabel)
+		b, &v.Initiative)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-type __premarshalgetLabelIssueLabel struct {
+type __premarshalcreateInitiativeInitiativeCreateInitiativePayloadInitiative struct {
 	Id string `json:"id"`
 
 	Name string `json:"name"`
 
+	SlugId string `json:"slugId"`
+
+	Url string `json:"url"`
+
 	Description *string `json:"description"`
 
+	Owner *InitiativeOwnerUser `json:"owner"`
+
 	Color *string `json:"color"`
 
-	Parent *IssueLabelParentIssueLabel `json:"parent"`
+	Icon *string `json:"icon"`
 
-	Team *IssueLabelTeam `json:"team"`
+	Status InitiativeStatus `json:"status"`
+
+	TargetDate *string `json:"targetDate"`
+
+	Trashed bool `json:"trashed"`
+
+	ArchivedAt *time.Time `json:"archivedAt"`
 }
 
-func (v *getLabelIssueLabel) MarshalJSON() ([]byte, error) {
+func (v *createInitiativeInitiativeCreateInitiativePayloadInitiative) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -2690,153 +5623,69 @@ func (v *getLabelIssueLabel) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *getLabelIssueLabel) __premarshalJSON() (*__premarshalgetLabelIssueLabel, error) {
-	var retval __premarshalgetLabelIssueLabel
-
-	retval.Id = v.IssueLabel.Id
-	retval.Name = v.IssueLabel.Name
-	retval.Description = v.IssueLabel.Description
-	retval.Color = v.IssueLabel.Color
-	retval.Parent = v.IssueLabel.Parent
-	retval.Team = v.IssueLabel.Team
+func (v *createInitiativeInitiativeCreateInitiativePayloadInitiative) __premarshalJSON() (*__premarshalcreateInitiativeInitiativeCreateInitiativePayloadInitiative, error) {
+	var retval __premarshalcreateInitiativeInitiativeCreateInitiativePayloadInitiative
+
+	retval.Id = v.Initiative.Id
+	retval.Name = v.Initiative.Name
+	retval.SlugId = v.Initiative.SlugId
+	retval.Url = v.Initiative.Url
+	retval.Description = v.Initiative.Description
+	retval.Owner = v.Initiative.Owner
+	retval.Color = v.Initiative.Color
+	retval.Icon = v.Initiative.Icon
+	retval.Status = v.Initiative.Status
+	retval.TargetDate = v.Initiative.TargetDate
+	retval.Trashed = v.Initiative.Trashed
+	retval.ArchivedAt = v.Initiative.ArchivedAt
 	return &retval, nil
 }
 
-// getLabelResponse is returned by getLabel on success.
-type getLabelResponse struct {
-	// One specific label.
-	IssueLabel getLabelIssueLabel `json:"issueLabel"`
+// createInitiativeRelationInitiativeRelationCreateInitiativeRelationPayload includes the requested fields of the GraphQL type InitiativeRelationPayload.
+type createInitiativeRelationInitiativeRelationCreateInitiativeRelationPayload struct {
+	// The initiative relation that was created or updated.
+	InitiativeRelation createInitiativeRelationInitiativeRelationCreateInitiativeRelationPayloadInitiativeRelation `json:"initiativeRelation"`
 }
 
-// GetIssueLabel returns getLabelResponse.IssueLabel, and is useful for accessing the field via an interface.
-func (v *getLabelResponse) GetIssueLabel() getLabelIssueLabel { return v.IssueLabel }
-
-// getTeamResponse is returned by getTeam on success.
-type getTeamResponse struct {
-	// One specific team.
-	Team getTeamTeam `json:"team"`
+// GetInitiativeRelation returns createInitiativeRelationInitiativeRelationCreateInitiativeRelationPayload.InitiativeRelation, and is useful for accessing the field via an interface.
+func (v *createInitiativeRelationInitiativeRelationCreateInitiativeRelationPayload) GetInitiativeRelation() createInitiativeRelationInitiativeRelationCreateInitiativeRelationPayloadInitiativeRelation {
+	return v.InitiativeRelation
 }
 
-// GetTeam returns getTeamResponse.Team, and is useful for accessing the field via an interface.
-func (v *getTeamResponse) GetTeam() getTeamTeam { return v.Team }
-
-// getTeamTeam includes the requested fields of the GraphQL type Team.
+// createInitiativeRelationInitiativeRelationCreateInitiativeRelationPayloadInitiativeRelation includes the requested fields of the GraphQL type InitiativeRelation.
 // The GraphQL type's documentation follows.
 //
-// An organizational unit that contains issues.
-type getTeamTeam struct {
-	Team `json:"-"`
+// A relation representing the dependency between two initiatives.
+type createInitiativeRelationInitiativeRelationCreateInitiativeRelationPayloadInitiativeRelation struct {
+	InitiativeRelation `json:"-"`
 }
 
-// GetId returns getTeamTeam.Id, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetId() string { return v.Team.Id }
+// GetId returns createInitiativeRelationInitiativeRelationCreateInitiativeRelationPayloadInitiativeRelation.Id, and is useful for accessing the field via an interface.
+func (v *createInitiativeRelationInitiativeRelationCreateInitiativeRelationPayloadInitiativeRelation) GetId() string {
+	return v.InitiativeRelation.Id
+}
 
-// GetName returns getTeamTeam.Name, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetName() string { return v.Team.Name }
+// GetInitiative returns createInitiativeRelationInitiativeRelationCreateInitiativeRelationPayloadInitiativeRelation.Initiative, and is useful for accessing the field via an interface.
+func (v *createInitiativeRelationInitiativeRelationCreateInitiativeRelationPayloadInitiativeRelation) GetInitiative() InitiativeRelationInitiative {
+	return v.InitiativeRelation.Initiative
+}
 
-// GetKey returns getTeamTeam.Key, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetKey() string { return v.Team.Key }
-
-// GetPrivate returns getTeamTeam.Private, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetPrivate() bool { return v.Team.Private }
-
-// GetDescription returns getTeamTeam.Description, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetDescription() *string { return v.Team.Description }
-
-// GetIcon returns getTeamTeam.Icon, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetIcon() *string { return v.Team.Icon }
-
-// GetColor returns getTeamTeam.Color, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetColor() *string { return v.Team.Color }
-
-// GetParent returns getTeamTeam.Parent, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetParent() *TeamParentTeam { return v.Team.Parent }
-
-// GetTimezone returns getTeamTeam.Timezone, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetTimezone() string { return v.Team.Timezone }
-
-// GetGroupIssueHistory returns getTeamTeam.GroupIssueHistory, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetGroupIssueHistory() bool { return v.Team.GroupIssueHistory }
-
-// GetSetIssueSortOrderOnStateChange returns getTeamTeam.SetIssueSortOrderOnStateChange, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetSetIssueSortOrderOnStateChange() string {
-	return v.Team.SetIssueSortOrderOnStateChange
-}
-
-// GetAiThreadSummariesEnabled returns getTeamTeam.AiThreadSummariesEnabled, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetAiThreadSummariesEnabled() bool { return v.Team.AiThreadSummariesEnabled }
-
-// GetAutoArchivePeriod returns getTeamTeam.AutoArchivePeriod, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetAutoArchivePeriod() float64 { return v.Team.AutoArchivePeriod }
-
-// GetAutoClosePeriod returns getTeamTeam.AutoClosePeriod, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetAutoClosePeriod() *float64 { return v.Team.AutoClosePeriod }
-
-// GetAutoCloseParentIssues returns getTeamTeam.AutoCloseParentIssues, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetAutoCloseParentIssues() bool { return v.Team.AutoCloseParentIssues }
-
-// GetAutoCloseChildIssues returns getTeamTeam.AutoCloseChildIssues, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetAutoCloseChildIssues() bool { return v.Team.AutoCloseChildIssues }
-
-// GetTriageEnabled returns getTeamTeam.TriageEnabled, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetTriageEnabled() bool { return v.Team.TriageEnabled }
-
-// GetRequirePriorityToLeaveTriage returns getTeamTeam.RequirePriorityToLeaveTriage, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetRequirePriorityToLeaveTriage() bool {
-	return v.Team.RequirePriorityToLeaveTriage
-}
-
-// GetCyclesEnabled returns getTeamTeam.CyclesEnabled, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetCyclesEnabled() bool { return v.Team.CyclesEnabled }
-
-// GetCycleStartDay returns getTeamTeam.CycleStartDay, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetCycleStartDay() float64 { return v.Team.CycleStartDay }
-
-// GetCycleDuration returns getTeamTeam.CycleDuration, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetCycleDuration() float64 { return v.Team.CycleDuration }
-
-// GetCycleCooldownTime returns getTeamTeam.CycleCooldownTime, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetCycleCooldownTime() float64 { return v.Team.CycleCooldownTime }
-
-// GetUpcomingCycleCount returns getTeamTeam.UpcomingCycleCount, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetUpcomingCycleCount() float64 { return v.Team.UpcomingCycleCount }
-
-// GetCycleIssueAutoAssignStarted returns getTeamTeam.CycleIssueAutoAssignStarted, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetCycleIssueAutoAssignStarted() bool {
-	return v.Team.CycleIssueAutoAssignStarted
-}
-
-// GetCycleIssueAutoAssignCompleted returns getTeamTeam.CycleIssueAutoAssignCompleted, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetCycleIssueAutoAssignCompleted() bool {
-	return v.Team.CycleIssueAutoAssignCompleted
+// GetRelatedInitiative returns createInitiativeRelationInitiativeRelationCreateInitiativeRelationPayloadInitiativeRelation.RelatedInitiative, and is useful for accessing the field via an interface.
+func (v *createInitiativeRelationInitiativeRelationCreateInitiativeRelationPayloadInitiativeRelation) GetRelatedInitiative() InitiativeRelationRelatedInitiative {
+	return v.InitiativeRelation.RelatedInitiative
 }
 
-// GetCycleLockToActive returns getTeamTeam.CycleLockToActive, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetCycleLockToActive() bool { return v.Team.CycleLockToActive }
-
-// GetIssueEstimationType returns getTeamTeam.IssueEstimationType, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetIssueEstimationType() string { return v.Team.IssueEstimationType }
-
-// GetIssueEstimationAllowZero returns getTeamTeam.IssueEstimationAllowZero, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetIssueEstimationAllowZero() bool { return v.Team.IssueEstimationAllowZero }
-
-// GetIssueEstimationExtended returns getTeamTeam.IssueEstimationExtended, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetIssueEstimationExtended() bool { return v.Team.IssueEstimationExtended }
-
-// GetDefaultIssueEstimate returns getTeamTeam.DefaultIssueEstimate, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetDefaultIssueEstimate() float64 { return v.Team.DefaultIssueEstimate }
-
-func (v *getTeamTeam) UnmarshalJSON(b []byte) error {
+func (v *createInitiativeRelationInitiativeRelationCreateInitiativeRelationPayloadInitiativeRelation) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*getTeamTeam
+		*createInitiativeRelationInitiativeRelationCreateInitiativeRelationPayloadInitiativeRelation
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.getTeamTeam = v
+	firstPass.createInitiativeRelationInitiativeRelationCreateInitiativeRelationPayloadInitiativeRelation = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -2844,76 +5693,22 @@ func (v *getTeamTeam) UnmarshalJSON(b []byte) error {
 	}
 
 	err = json.Unmarshal(
-		b, &v.Team)
+		b, &v.InitiativeRelation)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-type __premarshalgetTeamTeam struct {
+type __premarshalcreateInitiativeRelationInitiativeRelationCreateInitiativeRelationPayloadInitiativeRelation struct {
 	Id string `json:"id"`
 
-	Name string `json:"name"`
-
-	Key string `json:"key"`
-
-	Private bool `json:"private"`
-
-	Description *string `json:"description"`
-
-	Icon *string `json:"icon"`
-
-	Color *string `json:"color"`
-
-	Parent *TeamParentTeam `json:"parent"`
-
-	Timezone string `json:"timezone"`
-
-	GroupIssueHistory bool `json:"groupIssueHistory"`
-
-	SetIssueSortOrderOnStateChange string `json:"setIssueSortOrderOnStateChange"`
-
-	AiThreadSummariesEnabled bool `json:"aiThreadSummariesEnabled"`
-
-	AutoArchivePeriod float64 `json:"autoArchivePeriod"`
-
-	AutoClosePeriod *float64 `json:"autoClosePeriod"`
-
-	AutoCloseParentIssues bool `json:"autoCloseParentIssues"`
-
-	AutoCloseChildIssues bool `json:"autoCloseChildIssues"`
-
-	TriageEnabled bool `json:"triageEnabled"`
-
-	RequirePriorityToLeaveTriage bool `json:"requirePriorityToLeaveTriage"`
-
-	CyclesEnabled bool `json:"cyclesEnabled"`
-
-	CycleStartDay float64 `json:"cycleStartDay"`
-
-	CycleDuration float64 `json:"cycleDuration"`
-
-	CycleCooldownTime float64 `json:"cycleCooldownTime"`
-
-	UpcomingCycleCount float64 `json:"upcomingCycleCount"`
-
-	CycleIssueAutoAssignStarted bool `json:"cycleIssueAutoAssignStarted"`
-
-	CycleIssueAutoAssignCompleted bool `json:"cycleIssueAutoAssignCompleted"`
-
-	CycleLockToActive bool `json:"cycleLockToActive"`
-
-	IssueEstimationType string `json:"issueEstimationType"`
-
-	IssueEstimationAllowZero bool `json:"issueEstimationAllowZero"`
-
-	IssueEstimationExtended bool `json:"issueEstimationExtended"`
+	Initiative InitiativeRelationInitiative `json:"initiative"`
 
-	DefaultIssueEstimate float64 `json:"defaultIssueEstimate"`
+	RelatedInitiative InitiativeRelationRelatedInitiative `json:"relatedInitiative"`
 }
 
-func (v *getTeamTeam) MarshalJSON() ([]byte, error) {
+func (v *createInitiativeRelationInitiativeRelationCreateInitiativeRelationPayloadInitiativeRelation) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -2921,126 +5716,85 @@ func (v *getTeamTeam) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *getTeamTeam) __premarshalJSON() (*__premarshalgetTeamTeam, error) {
-	var retval __premarshalgetTeamTeam
+func (v *createInitiativeRelationInitiativeRelationCreateInitiativeRelationPayloadInitiativeRelation) __premarshalJSON() (*__premarshalcreateInitiativeRelationInitiativeRelationCreateInitiativeRelationPayloadInitiativeRelation, error) {
+	var retval __premarshalcreateInitiativeRelationInitiativeRelationCreateInitiativeRelationPayloadInitiativeRelation
 
-	retval.Id = v.Team.Id
-	retval.Name = v.Team.Name
-	retval.Key = v.Team.Key
-	retval.Private = v.Team.Private
-	retval.Description = v.Team.Description
-	retval.Icon = v.Team.Icon
-	retval.Color = v.Team.Color
-	retval.Parent = v.Team.Parent
-	retval.Timezone = v.Team.Timezone
-	retval.GroupIssueHistory = v.Team.GroupIssueHistory
-	retval.SetIssueSortOrderOnStateChange = v.Team.SetIssueSortOrderOnStateChange
-	retval.AiThreadSummariesEnabled = v.Team.AiThreadSummariesEnabled
-	retval.AutoArchivePeriod = v.Team.AutoArchivePeriod
-	retval.AutoClosePeriod = v.Team.AutoClosePeriod
-	retval.AutoCloseParentIssues = v.Team.AutoCloseParentIssues
-	retval.AutoCloseChildIssues = v.Team.AutoCloseChildIssues
-	retval.TriageEnabled = v.Team.TriageEnabled
-	retval.RequirePriorityToLeaveTriage = v.Team.RequirePriorityToLeaveTriage
-	retval.CyclesEnabled = v.Team.CyclesEnabled
-	retval.CycleStartDay = v.Team.CycleStartDay
-	retval.CycleDuration = v.Team.CycleDuration
-	retval.CycleCooldownTime = v.Team.CycleCooldownTime
-	retval.UpcomingCycleCount = v.Team.UpcomingCycleCount
-	retval.CycleIssueAutoAssignStarted = v.Team.CycleIssueAutoAssignStarted
-	retval.CycleIssueAutoAssignCompleted = v.Team.CycleIssueAutoAssignCompleted
-	retval.CycleLockToActive = v.Team.CycleLockToActive
-	retval.IssueEstimationType = v.Team.IssueEstimationType
-	retval.IssueEstimationAllowZero = v.Team.IssueEstimationAllowZero
-	retval.IssueEstimationExtended = v.Team.IssueEstimationExtended
-	retval.DefaultIssueEstimate = v.Team.DefaultIssueEstimate
+	retval.Id = v.InitiativeRelation.Id
+	retval.Initiative = v.InitiativeRelation.Initiative
+	retval.RelatedInitiative = v.InitiativeRelation.RelatedInitiative
 	return &retval, nil
 }
 
-// getTeamWorkflowResponse is returned by getTeamWorkflow on success.
-type getTeamWorkflowResponse struct {
-	// One specific team.
-	Team getTeamWorkflowTeam `json:"team"`
-}
-
-// GetTeam returns getTeamWorkflowResponse.Team, and is useful for accessing the field via an interface.
-func (v *getTeamWorkflowResponse) GetTeam() getTeamWorkflowTeam { return v.Team }
-
-// getTeamWorkflowStatesResponse is returned by getTeamWorkflowStates on success.
-type getTeamWorkflowStatesResponse struct {
-	// All issue workflow states.
-	WorkflowStates getTeamWorkflowStatesWorkflowStatesWorkflowStateConnection `json:"workflowStates"`
+// createInitiativeRelationResponse is returned by createInitiativeRelation on success.
+type createInitiativeRelationResponse struct {
+	// Creates a new initiative relation.
+	InitiativeRelationCreate createInitiativeRelationInitiativeRelationCreateInitiativeRelationPayload `json:"initiativeRelationCreate"`
 }
 
-// GetWorkflowStates returns getTeamWorkflowStatesResponse.WorkflowStates, and is useful for accessing the field via an interface.
-func (v *getTeamWorkflowStatesResponse) GetWorkflowStates() getTeamWorkflowStatesWorkflowStatesWorkflowStateConnection {
-	return v.WorkflowStates
+// GetInitiativeRelationCreate returns createInitiativeRelationResponse.InitiativeRelationCreate, and is useful for accessing the field via an interface.
+func (v *createInitiativeRelationResponse) GetInitiativeRelationCreate() createInitiativeRelationInitiativeRelationCreateInitiativeRelationPayload {
+	return v.InitiativeRelationCreate
 }
 
-// getTeamWorkflowStatesWorkflowStatesWorkflowStateConnection includes the requested fields of the GraphQL type WorkflowStateConnection.
-type getTeamWorkflowStatesWorkflowStatesWorkflowStateConnection struct {
-	Nodes []getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState `json:"nodes"`
+// createInitiativeResponse is returned by createInitiative on success.
+type createInitiativeResponse struct {
+	// Creates a new initiative.
+	InitiativeCreate createInitiativeInitiativeCreateInitiativePayload `json:"initiativeCreate"`
 }
 
-// GetNodes returns getTeamWorkflowStatesWorkflowStatesWorkflowStateConnection.Nodes, and is useful for accessing the field via an interface.
-func (v *getTeamWorkflowStatesWorkflowStatesWorkflowStateConnection) GetNodes() []getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState {
-	return v.Nodes
+// GetInitiativeCreate returns createInitiativeResponse.InitiativeCreate, and is useful for accessing the field via an interface.
+func (v *createInitiativeResponse) GetInitiativeCreate() createInitiativeInitiativeCreateInitiativePayload {
+	return v.InitiativeCreate
 }
 
-// getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState includes the requested fields of the GraphQL type WorkflowState.
+// createInitiativeToProjectInitiativeToProjectCreateInitiativeToProjectPayload includes the requested fields of the GraphQL type InitiativeToProjectPayload.
 // The GraphQL type's documentation follows.
 //
-// A state in a team workflow.
-type getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState struct {
-	WorkflowState `json:"-"`
+// The result of a initiativeToProject mutation.
+type createInitiativeToProjectInitiativeToProjectCreateInitiativeToProjectPayload struct {
+	// The initiativeToProject that was created or updated.
+	InitiativeToProject createInitiativeToProjectInitiativeToProjectCreateInitiativeToProjectPayloadInitiativeToProject `json:"initiativeToProject"`
 }
 
-// GetId returns getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState.Id, and is useful for accessing the field via an interface.
-func (v *getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) GetId() string {
-	return v.WorkflowState.Id
-}
-
-// GetName returns getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState.Name, and is useful for accessing the field via an interface.
-func (v *getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) GetName() string {
-	return v.WorkflowState.Name
+// GetInitiativeToProject returns createInitiativeToProjectInitiativeToProjectCreateInitiativeToProjectPayload.InitiativeToProject, and is useful for accessing the field via an interface.
+func (v *createInitiativeToProjectInitiativeToProjectCreateInitiativeToProjectPayload) GetInitiativeToProject() createInitiativeToProjectInitiativeToProjectCreateInitiativeToProjectPayloadInitiativeToProject {
+	return v.InitiativeToProject
 }
 
-// GetColor returns getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState.Color, and is useful for accessing the field via an interface.
-func (v *getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) GetColor() string {
-	return v.WorkflowState.Color
-}
-
-// GetDescription returns getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState.Description, and is useful for accessing the field via an interface.
-func (v *getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) GetDescription() *string {
-	return v.WorkflowState.Description
+// createInitiativeToProjectInitiativeToProjectCreateInitiativeToProjectPayloadInitiativeToProject includes the requested fields of the GraphQL type InitiativeToProject.
+// The GraphQL type's documentation follows.
+//
+// Join table between projects and initiatives.
+type createInitiativeToProjectInitiativeToProjectCreateInitiativeToProjectPayloadInitiativeToProject struct {
+	InitiativeToProject `json:"-"`
 }
 
-// GetType returns getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState.Type, and is useful for accessing the field via an interface.
-func (v *getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) GetType() string {
-	return v.WorkflowState.Type
+// GetId returns createInitiativeToProjectInitiativeToProjectCreateInitiativeToProjectPayloadInitiativeToProject.Id, and is useful for accessing the field via an interface.
+func (v *createInitiativeToProjectInitiativeToProjectCreateInitiativeToProjectPayloadInitiativeToProject) GetId() string {
+	return v.InitiativeToProject.Id
 }
 
-// GetPosition returns getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState.Position, and is useful for accessing the field via an interface.
-func (v *getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) GetPosition() float64 {
-	return v.WorkflowState.Position
+// GetInitiative returns createInitiativeToProjectInitiativeToProjectCreateInitiativeToProjectPayloadInitiativeToProject.Initiative, and is useful for accessing the field via an interface.
+func (v *createInitiativeToProjectInitiativeToProjectCreateInitiativeToProjectPayloadInitiativeToProject) GetInitiative() InitiativeToProjectInitiative {
+	return v.InitiativeToProject.Initiative
 }
 
-// GetTeam returns getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState.Team, and is useful for accessing the field via an interface.
-func (v *getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) GetTeam() WorkflowStateTeam {
-	return v.WorkflowState.Team
+// GetProject returns createInitiativeToProjectInitiativeToProjectCreateInitiativeToProjectPayloadInitiativeToProject.Project, and is useful for accessing the field via an interface.
+func (v *createInitiativeToProjectInitiativeToProjectCreateInitiativeToProjectPayloadInitiativeToProject) GetProject() InitiativeToProjectProject {
+	return v.InitiativeToProject.Project
 }
 
-func (v *getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) UnmarshalJSON(b []byte) error {
+func (v *createInitiativeToProjectInitiativeToProjectCreateInitiativeToProjectPayloadInitiativeToProject) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState
+		*createInitiativeToProjectInitiativeToProjectCreateInitiativeToProjectPayloadInitiativeToProject
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState = v
+	firstPass.createInitiativeToProjectInitiativeToProjectCreateInitiativeToProjectPayloadInitiativeToProject = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -3048,30 +5802,22 @@ func (v *getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflow
 	}
 
 	err = json.Unmarshal(
-		b, &v.WorkflowState)
+		b, &v.InitiativeToProject)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-type __premarshalgetTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState struct {
+type __premarshalcreateInitiativeToProjectInitiativeToProjectCreateInitiativeToProjectPayloadInitiativeToProject struct {
 	Id string `json:"id"`
 
-	Name string `json:"name"`
-
-	Color string `json:"color"`
-
-	Description *string `json:"description"`
-
-	Type string `json:"type"`
-
-	Position float64 `json:"position"`
+	Initiative InitiativeToProjectInitiative `json:"initiative"`
 
-	Team WorkflowStateTeam `json:"team"`
+	Project InitiativeToProjectProject `json:"project"`
 }
 
-func (v *getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) MarshalJSON() ([]byte, error) {
+func (v *createInitiativeToProjectInitiativeToProjectCreateInitiativeToProjectPayloadInitiativeToProject) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -3079,49 +5825,86 @@ func (v *getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflow
 	return json.Marshal(premarshaled)
 }
 
-func (v *getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) __premarshalJSON() (*__premarshalgetTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState, error) {
-	var retval __premarshalgetTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState
+func (v *createInitiativeToProjectInitiativeToProjectCreateInitiativeToProjectPayloadInitiativeToProject) __premarshalJSON() (*__premarshalcreateInitiativeToProjectInitiativeToProjectCreateInitiativeToProjectPayloadInitiativeToProject, error) {
+	var retval __premarshalcreateInitiativeToProjectInitiativeToProjectCreateInitiativeToProjectPayloadInitiativeToProject
 
-	retval.Id = v.WorkflowState.Id
-	retval.Name = v.WorkflowState.Name
-	retval.Color = v.WorkflowState.Color
-	retval.Description = v.WorkflowState.Description
-	retval.Type = v.WorkflowState.Type
-	retval.Position = v.WorkflowState.Position
-	retval.Team = v.WorkflowState.Team
+	retval.Id = v.InitiativeToProject.Id
+	retval.Initiative = v.InitiativeToProject.Initiative
+	retval.Project = v.InitiativeToProject.Project
 	return &retval, nil
 }
 
-// getTeamWorkflowTeam includes the requested fields of the GraphQL type Team.
+// createInitiativeToProjectResponse is returned by createInitiativeToProject on success.
+type createInitiativeToProjectResponse struct {
+	// Creates a new initiativeToProject join.
+	InitiativeToProjectCreate createInitiativeToProjectInitiativeToProjectCreateInitiativeToProjectPayload `json:"initiativeToProjectCreate"`
+}
+
+// GetInitiativeToProjectCreate returns createInitiativeToProjectResponse.InitiativeToProjectCreate, and is useful for accessing the field via an interface.
+func (v *createInitiativeToProjectResponse) GetInitiativeToProjectCreate() createInitiativeToProjectInitiativeToProjectCreateInitiativeToProjectPayload {
+	return v.InitiativeToProjectCreate
+}
+
+// createLabelIssueLabelCreateIssueLabelPayload includes the requested fields of the GraphQL type IssueLabelPayload.
+type createLabelIssueLabelCreateIssueLabelPayload struct {
+	// The label that was created or updated.
+	IssueLabel createLabelIssueLabelCreateIssueLabelPayloadIssueLabel `json:"issueLabel"`
+}
+
+// GetIssueLabel returns createLabelIssueLabelCreateIssueLabelPayload.IssueLabel, and is useful for accessing the field via an interface.
+func (v *createLabelIssueLabelCreateIssueLabelPayload) GetIssueLabel() createLabelIssueLabelCreateIssueLabelPayloadIssueLabel {
+	return v.IssueLabel
+}
+
+// createLabelIssueLabelCreateIssueLabelPayloadIssueLabel includes the requested fields of the GraphQL type IssueLabel.
 // The GraphQL type's documentation follows.
 //
-// An organizational unit that contains issues.
-type getTeamWorkflowTeam struct {
-	TeamWorkflow `json:"-"`
+// Labels that can be associated with issues.
+type createLabelIssueLabelCreateIssueLabelPayloadIssueLabel struct {
+	IssueLabel `json:"-"`
 }
 
-// GetId returns getTeamWorkflowTeam.Id, and is useful for accessing the field via an interface.
-func (v *getTeamWorkflowTeam) GetId() string { return v.TeamWorkflow.Id }
+// GetId returns createLabelIssueLabelCreateIssueLabelPayloadIssueLabel.Id, and is useful for accessing the field via an interface.
+func (v *createLabelIssueLabelCreateIssueLabelPayloadIssueLabel) GetId() string {
+	return v.IssueLabel.Id
+}
 
-// GetKey returns getTeamWorkflowTeam.Key, and is useful for accessing the field via an interface.
-func (v *getTeamWorkflowTeam) GetKey() string { return v.TeamWorkflow.Key }
+// GetName returns createLabelIssueLabelCreateIssueLabelPayloadIssueLabel.Name, and is useful for accessing the field via an interface.
+func (v *createLabelIssueLabelCreateIssueLabelPayloadIssueLabel) GetName() string {
+	return v.IssueLabel.Name
+}
 
-// GetGitAutomationStates returns getTeamWorkflowTeam.GitAutomationStates, and is useful for accessing the field via an interface.
-func (v *getTeamWorkflowTeam) GetGitAutomationStates() TeamWorkflowGitAutomationStatesGitAutomationStateConnection {
-	return v.TeamWorkflow.GitAutomationStates
+// GetDescription returns createLabelIssueLabelCreateIssueLabelPayloadIssueLabel.Description, and is useful for accessing the field via an interface.
+func (v *createLabelIssueLabelCreateIssueLabelPayloadIssueLabel) GetDescription() *string {
+	return v.IssueLabel.Description
 }
 
-func (v *getTeamWorkflowTeam) UnmarshalJSON(b []byte) error {
+// GetColor returns createLabelIssueLabelCreateIssueLabelPayloadIssueLabel.Color, and is useful for accessing the field via an interface.
+func (v *createLabelIssueLabelCreateIssueLabelPayloadIssueLabel) GetColor() *string {
+	return v.IssueLabel.Color
+}
+
+// GetParent returns createLabelIssueLabelCreateIssueLabelPayloadIssueLabel.Parent, and is useful for accessing the field via an interface.
+func (v *createLabelIssueLabelCreateIssueLabelPayloadIssueLabel) GetParent() *IssueLabelParentIssueLabel {
+	return v.IssueLabel.Parent
+}
+
+// GetTeam returns createLabelIssueLabelCreateIssueLabelPayloadIssueLabel.Team, and is useful for accessing the field via an interface.
+func (v *createLabelIssueLabelCreateIssueLabelPayloadIssueLabel) GetTeam() *IssueLabelTeam {
+	return v.IssueLabel.Team
+}
+
+func (v *createLabelIssueLabelCreateIssueLabelPayloadIssueLabel) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*getTeamWorkflowTeam
+		*createLabelIssueLabelCreateIssueLabelPayloadIssueLabel
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.getTeamWorkflowTeam = v
+	firstPass.createLabelIssueLabelCreateIssueLabelPayloadIssueLabel = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -3129,22 +5912,28 @@ func (v *getTeamWorkflowTeam) UnmarshalJSON(b []byte) error {
 	}
 
 	err = json.Unmarshal(
-		b, &v.TeamWorkflow)
+		b, &v.IssueLabel)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-type __premarshalgetTeamWorkflowTeam struct {
+type __premarshalcreateLabelIssueLabelCreateIssueLabelPayloadIssueLabel struct {
 	Id string `json:"id"`
 
-	Key string `json:"key"`
+	Name string `json:"name"`
 
-	GitAutomationStates TeamWorkflowGitAutomationStatesGitAutomationStateConnection `json:"gitAutomationStates"`
+	Description *string `json:"description"`
+
+	Color *string `json:"color"`
+
+	Parent *IssueLabelParentIssueLabel `json:"parent"`
+
+	Team *IssueLabelTeam `json:"team"`
 }
 
-func (v *getTeamWorkflowTeam) MarshalJSON() ([]byte, error) {
+func (v *createLabelIssueLabelCreateIssueLabelPayloadIssueLabel) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -3152,61 +5941,97 @@ func (v *getTeamWorkflowTeam) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *getTeamWorkflowTeam) __premarshalJSON() (*__premarshalgetTeamWorkflowTeam, error) {
-	var retval __premarshalgetTeamWorkflowTeam
+func (v *createLabelIssueLabelCreateIssueLabelPayloadIssueLabel) __premarshalJSON() (*__premarshalcreateLabelIssueLabelCreateIssueLabelPayloadIssueLabel, error) {
+	var retval __premarshalcreateLabelIssueLabelCreateIssueLabelPayloadIssueLabel
 
-	retval.Id = v.TeamWorkflow.Id
-	retval.Key = v.TeamWorkflow.Key
-	retval.GitAutomationStates = v.TeamWorkflow.GitAutomationStates
+	retval.Id = v.IssueLabel.Id
+	retval.Name = v.IssueLabel.Name
+	retval.Description = v.IssueLabel.Description
+	retval.Color = v.IssueLabel.Color
+	retval.Parent = v.IssueLabel.Parent
+	retval.Team = v.IssueLabel.Team
 	return &retval, nil
 }
 
-// getTemplateResponse is returned by getTemplate on success.
-type getTemplateResponse struct {
-	// A specific template.
-	Template getTemplateTemplate `json:"template"`
+// createLabelResponse is returned by createLabel on success.
+type createLabelResponse struct {
+	// Creates a new label.
+	IssueLabelCreate createLabelIssueLabelCreateIssueLabelPayload `json:"issueLabelCreate"`
 }
 
-// GetTemplate returns getTemplateResponse.Template, and is useful for accessing the field via an interface.
-func (v *getTemplateResponse) GetTemplate() getTemplateTemplate { return v.Template }
+// GetIssueLabelCreate returns createLabelResponse.IssueLabelCreate, and is useful for accessing the field via an interface.
+func (v *createLabelResponse) GetIssueLabelCreate() createLabelIssueLabelCreateIssueLabelPayload {
+	return v.IssueLabelCreate
+}
 
-// getTemplateTemplate includes the requested fields of the GraphQL type Template.
+// createOrganizationDomainOrganizationDomainCreateOrganizationDomainPayload includes the requested fields of the GraphQL type OrganizationDomainPayload.
 // The GraphQL type's documentation follows.
 //
-// A template object used for creating entities faster.
-type getTemplateTemplate struct {
-	Template `json:"-"`
+// [INTERNAL] Organization domain operation response.
+type createOrganizationDomainOrganizationDomainCreateOrganizationDomainPayload struct {
+	// The organization domain that was created or updated.
+	OrganizationDomain createOrganizationDomainOrganizationDomainCreateOrganizationDomainPayloadOrganizationDomain `json:"organizationDomain"`
 }
 
-// GetId returns getTemplateTemplate.Id, and is useful for accessing the field via an interface.
-func (v *getTemplateTemplate) GetId() string { return v.Template.Id }
+// GetOrganizationDomain returns createOrganizationDomainOrganizationDomainCreateOrganizationDomainPayload.OrganizationDomain, and is useful for accessing the field via an interface.
+func (v *createOrganizationDomainOrganizationDomainCreateOrganizationDomainPayload) GetOrganizationDomain() createOrganizationDomainOrganizationDomainCreateOrganizationDomainPayloadOrganizationDomain {
+	return v.OrganizationDomain
+}
 
-// GetName returns getTemplateTemplate.Name, and is useful for accessing the field via an interface.
-func (v *getTemplateTemplate) GetName() string { return v.Template.Name }
+// createOrganizationDomainOrganizationDomainCreateOrganizationDomainPayloadOrganizationDomain includes the requested fields of the GraphQL type OrganizationDomain.
+// The GraphQL type's documentation follows.
+//
+// Defines the use of a domain by an organization.
+type createOrganizationDomainOrganizationDomainCreateOrganizationDomainPayloadOrganizationDomain struct {
+	OrganizationDomain `json:"-"`
+}
 
-// GetDescription returns getTemplateTemplate.Description, and is useful for accessing the field via an interface.
-func (v *getTemplateTemplate) GetDescription() *string { return v.Template.Description }
+// GetId returns createOrganizationDomainOrganizationDomainCreateOrganizationDomainPayloadOrganizationDomain.Id, and is useful for accessing the field via an interface.
+func (v *createOrganizationDomainOrganizationDomainCreateOrganizationDomainPayloadOrganizationDomain) GetId() string {
+	return v.OrganizationDomain.Id
+}
 
-// GetType returns getTemplateTemplate.Type, and is useful for accessing the field via an interface.
-func (v *getTemplateTemplate) GetType() string { return v.Template.Type }
+// GetName returns createOrganizationDomainOrganizationDomainCreateOrganizationDomainPayloadOrganizationDomain.Name, and is useful for accessing the field via an interface.
+func (v *createOrganizationDomainOrganizationDomainCreateOrganizationDomainPayloadOrganizationDomain) GetName() string {
+	return v.OrganizationDomain.Name
+}
 
-// GetTeam returns getTemplateTemplate.Team, and is useful for accessing the field via an interface.
-func (v *getTemplateTemplate) GetTeam() *TemplateTeam { return v.Template.Team }
+// GetVerificationEmail returns createOrganizationDomainOrganizationDomainCreateOrganizationDomainPayloadOrganizationDomain.VerificationEmail, and is useful for accessing the field via an interface.
+func (v *createOrganizationDomainOrganizationDomainCreateOrganizationDomainPayloadOrganizationDomain) GetVerificationEmail() *string {
+	return v.OrganizationDomain.VerificationEmail
+}
 
-// GetTemplateData returns getTemplateTemplate.TemplateData, and is useful for accessing the field via an interface.
-func (v *getTemplateTemplate) GetTemplateData() string { return v.Template.TemplateData }
+// GetAuthType returns createOrganizationDomainOrganizationDomainCreateOrganizationDomainPayloadOrganizationDomain.AuthType, and is useful for accessing the field via an interface.
+func (v *createOrganizationDomainOrganizationDomainCreateOrganizationDomainPayloadOrganizationDomain) GetAuthType() OrganizationDomainAuthType {
+	return v.OrganizationDomain.AuthType
+}
 
-func (v *getTemplateTemplate) UnmarshalJSON(b []byte) error {
+// GetVerified returns createOrganizationDomainOrganizationDomainCreateOrganizationDomainPayloadOrganizationDomain.Verified, and is useful for accessing the field via an interface.
+func (v *createOrganizationDomainOrganizationDomainCreateOrganizationDomainPayloadOrganizationDomain) GetVerified() bool {
+	return v.OrganizationDomain.Verified
+}
+
+// GetClaimed returns createOrganizationDomainOrganizationDomainCreateOrganizationDomainPayloadOrganizationDomain.Claimed, and is useful for accessing the field via an interface.
+func (v *createOrganizationDomainOrganizationDomainCreateOrganizationDomainPayloadOrganizationDomain) GetClaimed() *bool {
+	return v.OrganizationDomain.Claimed
+}
+
+// GetDisableOrganizationCreation returns createOrganizationDomainOrganizationDomainCreateOrganizationDomainPayloadOrganizationDomain.DisableOrganizationCreation, and is useful for accessing the field via an interface.
+func (v *createOrganizationDomainOrganizationDomainCreateOrganizationDomainPayloadOrganizationDomain) GetDisableOrganizationCreation() *bool {
+	return v.OrganizationDomain.DisableOrganizationCreation
+}
+
+func (v *createOrganizationDomainOrganizationDomainCreateOrganizationDomainPayloadOrganizationDomain) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*getTemplateTemplate
+		*createOrganizationDomainOrganizationDomainCreateOrganizationDomainPayloadOrganizationDomain
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.getTemplateTemplate = v
+	firstPass.createOrganizationDomainOrganizationDomainCreateOrganizationDomainPayloadOrganizationDomain = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -3214,28 +6039,30 @@ func (v *getTemplateTemplate) UnmarshalJSON(b []byte) error {
 	}
 
 	err = json.Unmarshal(
-		b, &v.Template)
+		b, &v.OrganizationDomain)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-type __premarshalgetTemplateTemplate struct {
+type __premarshalcreateOrganizationDomainOrganizationDomainCreateOrganizationDomainPayloadOrganizationDomain struct {
 	Id string `json:"id"`
 
 	Name string `json:"name"`
 
-	Description *string `json:"description"`
+	VerificationEmail *string `json:"verificationEmail"`
 
-	Type string `json:"type"`
+	AuthType OrganizationDomainAuthType `json:"authType"`
 
-	Team *TemplateTeam `json:"team"`
+	Verified bool `json:"verified"`
 
-	TemplateData string `json:"templateData"`
+	Claimed *bool `json:"claimed"`
+
+	DisableOrganizationCreation *bool `json:"disableOrganizationCreation"`
 }
 
-func (v *getTemplateTemplate) MarshalJSON() ([]byte, error) {
+func (v *createOrganizationDomainOrganizationDomainCreateOrganizationDomainPayloadOrganizationDomain) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -3243,69 +6070,90 @@ func (v *getTemplateTemplate) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *getTemplateTemplate) __premarshalJSON() (*__premarshalgetTemplateTemplate, error) {
-	var retval __premarshalgetTemplateTemplate
+func (v *createOrganizationDomainOrganizationDomainCreateOrganizationDomainPayloadOrganizationDomain) __premarshalJSON() (*__premarshalcreateOrganizationDomainOrganizationDomainCreateOrganizationDomainPayloadOrganizationDomain, error) {
+	var retval __premarshalcreateOrganizationDomainOrganizationDomainCreateOrganizationDomainPayloadOrganizationDomain
 
-	retval.Id = v.Template.Id
-	retval.Name = v.Template.Name
-	retval.Description = v.Template.Description
-	retval.Type = v.Template.Type
-	retval.Team = v.Template.Team
-	retval.TemplateData = v.Template.TemplateData
+	retval.Id = v.OrganizationDomain.Id
+	retval.Name = v.OrganizationDomain.Name
+	retval.VerificationEmail = v.OrganizationDomain.VerificationEmail
+	retval.AuthType = v.OrganizationDomain.AuthType
+	retval.Verified = v.OrganizationDomain.Verified
+	retval.Claimed = v.OrganizationDomain.Claimed
+	retval.DisableOrganizationCreation = v.OrganizationDomain.DisableOrganizationCreation
 	return &retval, nil
 }
 
-// getWorkflowStateResponse is returned by getWorkflowState on success.
-type getWorkflowStateResponse struct {
-	// One specific state.
-	WorkflowState getWorkflowStateWorkflowState `json:"workflowState"`
+// createOrganizationDomainResponse is returned by createOrganizationDomain on success.
+type createOrganizationDomainResponse struct {
+	// [INTERNAL] Adds a domain to be allowed for an organization.
+	OrganizationDomainCreate createOrganizationDomainOrganizationDomainCreateOrganizationDomainPayload `json:"organizationDomainCreate"`
 }
 
-// GetWorkflowState returns getWorkflowStateResponse.WorkflowState, and is useful for accessing the field via an interface.
-func (v *getWorkflowStateResponse) GetWorkflowState() getWorkflowStateWorkflowState {
-	return v.WorkflowState
+// GetOrganizationDomainCreate returns createOrganizationDomainResponse.OrganizationDomainCreate, and is useful for accessing the field via an interface.
+func (v *createOrganizationDomainResponse) GetOrganizationDomainCreate() createOrganizationDomainOrganizationDomainCreateOrganizationDomainPayload {
+	return v.OrganizationDomainCreate
 }
 
-// getWorkflowStateWorkflowState includes the requested fields of the GraphQL type WorkflowState.
+// createOrganizationInviteOrganizationInviteCreateOrganizationInvitePayload includes the requested fields of the GraphQL type OrganizationInvitePayload.
+type createOrganizationInviteOrganizationInviteCreateOrganizationInvitePayload struct {
+	// The organization invite that was created or updated.
+	OrganizationInvite createOrganizationInviteOrganizationInviteCreateOrganizationInvitePayloadOrganizationInvite `json:"organizationInvite"`
+}
+
+// GetOrganizationInvite returns createOrganizationInviteOrganizationInviteCreateOrganizationInvitePayload.OrganizationInvite, and is useful for accessing the field via an interface.
+func (v *createOrganizationInviteOrganizationInviteCreateOrganizationInvitePayload) GetOrganizationInvite() createOrganizationInviteOrganizationInviteCreateOrganizationInvitePayloadOrganizationInvite {
+	return v.OrganizationInvite
+}
+
+// createOrganizationInviteOrganizationInviteCreateOrganizationInvitePayloadOrganizationInvite includes the requested fields of the GraphQL type OrganizationInvite.
 // The GraphQL type's documentation follows.
 //
-// A state in a team workflow.
-type getWorkflowStateWorkflowState struct {
-	WorkflowState `json:"-"`
+// An invitation to the organization that has been sent via email.
+type createOrganizationInviteOrganizationInviteCreateOrganizationInvitePayloadOrganizationInvite struct {
+	OrganizationInvite `json:"-"`
 }
 
-// GetId returns getWorkflowStateWorkflowState.Id, and is useful for accessing the field via an interface.
-func (v *getWorkflowStateWorkflowState) GetId() string { return v.WorkflowState.Id }
-
-// GetName returns getWorkflowStateWorkflowState.Name, and is useful for accessing the field via an interface.
-func (v *getWorkflowStateWorkflowState) GetName() string { return v.WorkflowState.Name }
+// GetId returns createOrganizationInviteOrganizationInviteCreateOrganizationInvitePayloadOrganizationInvite.Id, and is useful for accessing the field via an interface.
+func (v *createOrganizationInviteOrganizationInviteCreateOrganizationInvitePayloadOrganizationInvite) GetId() string {
+	return v.OrganizationInvite.Id
+}
 
-// GetColor returns getWorkflowStateWorkflowState.Color, and is useful for accessing the field via an interface.
-func (v *getWorkflowStateWorkflowState) GetColor() string { return v.WorkflowState.Color }
+// GetEmail returns createOrganizationInviteOrganizationInviteCreateOrganizationInvitePayloadOrganizationInvite.Email, and is useful for accessing the field via an interface.
+func (v *createOrganizationInviteOrganizationInviteCreateOrganizationInvitePayloadOrganizationInvite) GetEmail() string {
+	return v.OrganizationInvite.Email
+}
 
-// GetDescription returns getWorkflowStateWorkflowState.Description, and is useful for accessing the field via an interface.
-func (v *getWorkflowStateWorkflowState) GetDescription() *string { return v.WorkflowState.Description }
+// GetRole returns createOrganizationInviteOrganizationInviteCreateOrganizationInvitePayloadOrganizationInvite.Role, and is useful for accessing the field via an interface.
+func (v *createOrganizationInviteOrganizationInviteCreateOrganizationInvitePayloadOrganizationInvite) GetRole() UserRoleType {
+	return v.OrganizationInvite.Role
+}
 
-// GetType returns getWorkflowStateWorkflowState.Type, and is useful for accessing the field via an interface.
-func (v *getWorkflowStateWorkflowState) GetType() string { return v.WorkflowState.Type }
+// GetMetadata returns createOrganizationInviteOrganizationInviteCreateOrganizationInvitePayloadOrganizationInvite.Metadata, and is useful for accessing the field via an interface.
+func (v *createOrganizationInviteOrganizationInviteCreateOrganizationInvitePayloadOrganizationInvite) GetMetadata() map[string]interface{} {
+	return v.OrganizationInvite.Metadata
+}
 
-// GetPosition returns getWorkflowStateWorkflowState.Position, and is useful for accessing the field via an interface.
-func (v *getWorkflowStateWorkflowState) GetPosition() float64 { return v.WorkflowState.Position }
+// GetAcceptedAt returns createOrganizationInviteOrganizationInviteCreateOrganizationInvitePayloadOrganizationInvite.AcceptedAt, and is useful for accessing the field via an interface.
+func (v *createOrganizationInviteOrganizationInviteCreateOrganizationInvitePayloadOrganizationInvite) GetAcceptedAt() *time.Time {
+	return v.OrganizationInvite.AcceptedAt
+}
 
-// GetTeam returns getWorkflowStateWorkflowState.Team, and is useful for accessing the field via an interface.
-func (v *getWorkflowStateWorkflowState) GetTeam() WorkflowStateTeam { return v.WorkflowState.Team }
+// GetExpiresAt returns createOrganizationInviteOrganizationInviteCreateOrganizationInvitePayloadOrganizationInvite.ExpiresAt, and is useful for accessing the field via an interface.
+func (v *createOrganizationInviteOrganizationInviteCreateOrganizationInvitePayloadOrganizationInvite) GetExpiresAt() *time.Time {
+	return v.OrganizationInvite.ExpiresAt
+}
 
-func (v *getWorkflowStateWorkflowState) UnmarshalJSON(b []byte) error {
+func (v *createOrganizationInviteOrganizationInviteCreateOrganizationInvitePayloadOrganizationInvite) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*getWorkflowStateWorkflowState
+		*createOrganizationInviteOrganizationInviteCreateOrganizationInvitePayloadOrganizationInvite
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.getWorkflowStateWorkflowState = v
+	firstPass.createOrganizationInviteOrganizationInviteCreateOrganizationInvitePayloadOrganizationInvite = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -3313,30 +6161,28 @@ func (v *getWorkflowStateWorkflowState) UnmarshalJSON(b []byte) error {
 	}
 
 	err = json.Unmarshal(
-		b, &v.WorkflowState)
+		b, &v.OrganizationInvite)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-type __premarshalgetWorkflowStateWorkflowState struct {
+type __premarshalcreateOrganizationInviteOrganizationInviteCreateOrganizationInvitePayloadOrganizationInvite struct {
 	Id string `json:"id"`
 
-	Name string `json:"name"`
-
-	Color string `json:"color"`
+	Email string `json:"email"`
 
-	Description *string `json:"description"`
+	Role UserRoleType `json:"role"`
 
-	Type string `json:"type"`
+	Metadata map[string]interface{} `json:"metadata"`
 
-	Position float64 `json:"position"`
+	AcceptedAt *time.Time `json:"acceptedAt"`
 
-	Team WorkflowStateTeam `json:"team"`
+	ExpiresAt *time.Time `json:"expiresAt"`
 }
 
-func (v *getWorkflowStateWorkflowState) MarshalJSON() ([]byte, error) {
+func (v *createOrganizationInviteOrganizationInviteCreateOrganizationInvitePayloadOrganizationInvite) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -3344,150 +6190,244 @@ func (v *getWorkflowStateWorkflowState) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *getWorkflowStateWorkflowState) __premarshalJSON() (*__premarshalgetWorkflowStateWorkflowState, error) {
-	var retval __premarshalgetWorkflowStateWorkflowState
+func (v *createOrganizationInviteOrganizationInviteCreateOrganizationInvitePayloadOrganizationInvite) __premarshalJSON() (*__premarshalcreateOrganizationInviteOrganizationInviteCreateOrganizationInvitePayloadOrganizationInvite, error) {
+	var retval __premarshalcreateOrganizationInviteOrganizationInviteCreateOrganizationInvitePayloadOrganizationInvite
 
-	retval.Id = v.WorkflowState.Id
-	retval.Name = v.WorkflowState.Name
-	retval.Color = v.WorkflowState.Color
-	retval.Description = v.WorkflowState.Description
-	retval.Type = v.WorkflowState.Type
-	retval.Position = v.WorkflowState.Position
-	retval.Team = v.WorkflowState.Team
+	retval.Id = v.OrganizationInvite.Id
+	retval.Email = v.OrganizationInvite.Email
+	retval.Role = v.OrganizationInvite.Role
+	retval.Metadata = v.OrganizationInvite.Metadata
+	retval.AcceptedAt = v.OrganizationInvite.AcceptedAt
+	retval.ExpiresAt = v.OrganizationInvite.ExpiresAt
 	return &retval, nil
 }
 
-// getWorkspaceOrganization includes the requested fields of the GraphQL type Organization.
-// The GraphQL type's documentation follows.
-//
-// An organization. Organizations are root-level objects that contain user accounts and teams.
-type getWorkspaceOrganization struct {
-	// The unique identifier of the entity.
-	Id string `json:"id"`
-	// The organization's unique URL key.
-	UrlKey string `json:"urlKey"`
-	// The organization's name.
-	Name string `json:"name"`
+// createOrganizationInviteResponse is returned by createOrganizationInvite on success.
+type createOrganizationInviteResponse struct {
+	// Creates a new organization invite.
+	OrganizationInviteCreate createOrganizationInviteOrganizationInviteCreateOrganizationInvitePayload `json:"organizationInviteCreate"`
 }
 
-// GetId returns getWorkspaceOrganization.Id, and is useful for accessing the field via an interface.
-func (v *getWorkspaceOrganization) GetId() string { return v.Id }
-
-// GetUrlKey returns getWorkspaceOrganization.UrlKey, and is useful for accessing the field via an interface.
-func (v *getWorkspaceOrganization) GetUrlKey() string { return v.UrlKey }
-
-// GetName returns getWorkspaceOrganization.Name, and is useful for accessing the field via an interface.
-func (v *getWorkspaceOrganization) GetName() string { return v.Name }
+// GetOrganizationInviteCreate returns createOrganizationInviteResponse.OrganizationInviteCreate, and is useful for accessing the field via an interface.
+func (v *createOrganizationInviteResponse) GetOrganizationInviteCreate() createOrganizationInviteOrganizationInviteCreateOrganizationInvitePayload {
+	return v.OrganizationInviteCreate
+}
 
-// getWorkspaceResponse is returned by getWorkspace on success.
-type getWorkspaceResponse struct {
-	// The user's organization.
-	Organization getWorkspaceOrganization `json:"organization"`
+// createProjectMilestoneProjectMilestoneCreateProjectMilestonePayload includes the requested fields of the GraphQL type ProjectMilestonePayload.
+type createProjectMilestoneProjectMilestoneCreateProjectMilestonePayload struct {
+	// The project milestone that was created or updated.
+	ProjectMilestone createProjectMilestoneProjectMilestoneCreateProjectMilestonePayloadProjectMilestone `json:"projectMilestone"`
 }
 
-// GetOrganization returns getWorkspaceResponse.Organization, and is useful for accessing the field via an interface.
-func (v *getWorkspaceResponse) GetOrganization() getWorkspaceOrganization { return v.Organization }
+// GetProjectMilestone returns createProjectMilestoneProjectMilestoneCreateProjectMilestonePayload.ProjectMilestone, and is useful for accessing the field via an interface.
+func (v *createProjectMilestoneProjectMilestoneCreateProjectMilestonePayload) GetProjectMilestone() createProjectMilestoneProjectMilestoneCreateProjectMilestonePayloadProjectMilestone {
+	return v.ProjectMilestone
+}
 
-// getWorkspaceSettingsOrganization includes the requested fields of the GraphQL type Organization.
+// createProjectMilestoneProjectMilestoneCreateProjectMilestonePayloadProjectMilestone includes the requested fields of the GraphQL type ProjectMilestone.
 // The GraphQL type's documentation follows.
 //
-// An organization. Organizations are root-level objects that contain user accounts and teams.
-type getWorkspaceSettingsOrganization struct {
-	Organization `json:"-"`
+// A milestone for a project.
+type createProjectMilestoneProjectMilestoneCreateProjectMilestonePayloadProjectMilestone struct {
+	ProjectMilestone `json:"-"`
 }
 
-// GetId returns getWorkspaceSettingsOrganization.Id, and is useful for accessing the field via an interface.
-func (v *getWorkspaceSettingsOrganization) GetId() string { return v.Organization.Id }
+// GetId returns createProjectMilestoneProjectMilestoneCreateProjectMilestonePayloadProjectMilestone.Id, and is useful for accessing the field via an interface.
+func (v *createProjectMilestoneProjectMilestoneCreateProjectMilestonePayloadProjectMilestone) GetId() string {
+	return v.ProjectMilestone.Id
+}
 
-// GetAllowMembersToInvite returns getWorkspaceSettingsOrganization.AllowMembersToInvite, and is useful for accessing the field via an interface.
-func (v *getWorkspaceSettingsOrganization) GetAllowMembersToInvite() bool {
-	return v.Organization.AllowMembersToInvite
+// GetName returns createProjectMilestoneProjectMilestoneCreateProjectMilestonePayloadProjectMilestone.Name, and is useful for accessing the field via an interface.
+func (v *createProjectMilestoneProjectMilestoneCreateProjectMilestonePayloadProjectMilestone) GetName() string {
+	return v.ProjectMilestone.Name
 }
 
-// GetRestrictTeamCreationToAdmins returns getWorkspaceSettingsOrganization.RestrictTeamCreationToAdmins, and is useful for accessing the field via an interface.
-func (v *getWorkspaceSettingsOrganization) GetRestrictTeamCreationToAdmins() bool {
-	return v.Organization.RestrictTeamCreationToAdmins
+// GetDescription returns createProjectMilestoneProjectMilestoneCreateProjectMilestonePayloadProjectMilestone.Description, and is useful for accessing the field via an interface.
+func (v *createProjectMilestoneProjectMilestoneCreateProjectMilestonePayloadProjectMilestone) GetDescription() *string {
+	return v.ProjectMilestone.Description
 }
 
-// GetRestrictLabelManagementToAdmins returns getWorkspaceSettingsOrganization.RestrictLabelManagementToAdmins, and is useful for accessing the field via an interface.
-func (v *getWorkspaceSettingsOrganization) GetRestrictLabelManagementToAdmins() bool {
-	return v.Organization.RestrictLabelManagementToAdmins
+// GetTargetDate returns createProjectMilestoneProjectMilestoneCreateProjectMilestonePayloadProjectMilestone.TargetDate, and is useful for accessing the field via an interface.
+func (v *createProjectMilestoneProjectMilestoneCreateProjectMilestonePayloadProjectMilestone) GetTargetDate() *string {
+	return v.ProjectMilestone.TargetDate
 }
 
-// GetGitLinkbackMessagesEnabled returns getWorkspaceSettingsOrganization.GitLinkbackMessagesEnabled, and is useful for accessing the field via an interface.
-func (v *getWorkspaceSettingsOrganization) GetGitLinkbackMessagesEnabled() bool {
-	return v.Organization.GitLinkbackMessagesEnabled
+// GetSortOrder returns createProjectMilestoneProjectMilestoneCreateProjectMilestonePayloadProjectMilestone.SortOrder, and is useful for accessing the field via an interface.
+func (v *createProjectMilestoneProjectMilestoneCreateProjectMilestonePayloadProjectMilestone) GetSortOrder() float64 {
+	return v.ProjectMilestone.SortOrder
 }
 
-// GetGitPublicLinkbackMessagesEnabled returns getWorkspaceSettingsOrganization.GitPublicLinkbackMessagesEnabled, and is useful for accessing the field via an interface.
-func (v *getWorkspaceSettingsOrganization) GetGitPublicLinkbackMessagesEnabled() bool {
-	return v.Organization.GitPublicLinkbackMessagesEnabled
+// GetProject returns createProjectMilestoneProjectMilestoneCreateProjectMilestonePayloadProjectMilestone.Project, and is useful for accessing the field via an interface.
+func (v *createProjectMilestoneProjectMilestoneCreateProjectMilestonePayloadProjectMilestone) GetProject() ProjectMilestoneProject {
+	return v.ProjectMilestone.Project
 }
 
-// GetFiscalYearStartMonth returns getWorkspaceSettingsOrganization.FiscalYearStartMonth, and is useful for accessing the field via an interface.
-func (v *getWorkspaceSettingsOrganization) GetFiscalYearStartMonth() float64 {
-	return v.Organization.FiscalYearStartMonth
+func (v *createProjectMilestoneProjectMilestoneCreateProjectMilestonePayloadProjectMilestone) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*createProjectMilestoneProjectMilestoneCreateProjectMilestonePayloadProjectMilestone
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.createProjectMilestoneProjectMilestoneCreateProjectMilestonePayloadProjectMilestone = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ProjectMilestone)
+	if err != nil {
+		return err
+	}
+	return nil
 }
 
-// GetProjectUpdateReminderFrequencyInWeeks returns getWorkspaceSettingsOrganization.ProjectUpdateReminderFrequencyInWeeks, and is useful for accessing the field via an interface.
-func (v *getWorkspaceSettingsOrganization) GetProjectUpdateReminderFrequencyInWeeks() float64 {
-	return v.Organization.ProjectUpdateReminderFrequencyInWeeks
+type __premarshalcreateProjectMilestoneProjectMilestoneCreateProjectMilestonePayloadProjectMilestone struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description *string `json:"description"`
+
+	TargetDate *string `json:"targetDate"`
+
+	SortOrder float64 `json:"sortOrder"`
+
+	Project ProjectMilestoneProject `json:"project"`
 }
 
-// GetProjectUpdateRemindersDay returns getWorkspaceSettingsOrganization.ProjectUpdateRemindersDay, and is useful for accessing the field via an interface.
-func (v *getWorkspaceSettingsOrganization) GetProjectUpdateRemindersDay() Day {
-	return v.Organization.ProjectUpdateRemindersDay
+func (v *createProjectMilestoneProjectMilestoneCreateProjectMilestonePayloadProjectMilestone) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
 }
 
-// GetProjectUpdateRemindersHour returns getWorkspaceSettingsOrganization.ProjectUpdateRemindersHour, and is useful for accessing the field via an interface.
-func (v *getWorkspaceSettingsOrganization) GetProjectUpdateRemindersHour() float64 {
-	return v.Organization.ProjectUpdateRemindersHour
+func (v *createProjectMilestoneProjectMilestoneCreateProjectMilestonePayloadProjectMilestone) __premarshalJSON() (*__premarshalcreateProjectMilestoneProjectMilestoneCreateProjectMilestonePayloadProjectMilestone, error) {
+	var retval __premarshalcreateProjectMilestoneProjectMilestoneCreateProjectMilestonePayloadProjectMilestone
+
+	retval.Id = v.ProjectMilestone.Id
+	retval.Name = v.ProjectMilestone.Name
+	retval.Description = v.ProjectMilestone.Description
+	retval.TargetDate = v.ProjectMilestone.TargetDate
+	retval.SortOrder = v.ProjectMilestone.SortOrder
+	retval.Project = v.ProjectMilestone.Project
+	return &retval, nil
 }
 
-// GetRoadmapEnabled returns getWorkspaceSettingsOrganization.RoadmapEnabled, and is useful for accessing the field via an interface.
-func (v *getWorkspaceSettingsOrganization) GetRoadmapEnabled() bool {
-	return v.Organization.RoadmapEnabled
+// createProjectMilestoneResponse is returned by createProjectMilestone on success.
+type createProjectMilestoneResponse struct {
+	// Creates a new project milestone.
+	ProjectMilestoneCreate createProjectMilestoneProjectMilestoneCreateProjectMilestonePayload `json:"projectMilestoneCreate"`
 }
 
-// GetInitiativeUpdateReminderFrequencyInWeeks returns getWorkspaceSettingsOrganization.InitiativeUpdateReminderFrequencyInWeeks, and is useful for accessing the field via an interface.
-func (v *getWorkspaceSettingsOrganization) GetInitiativeUpdateReminderFrequencyInWeeks() float64 {
-	return v.Organization.InitiativeUpdateReminderFrequencyInWeeks
+// GetProjectMilestoneCreate returns createProjectMilestoneResponse.ProjectMilestoneCreate, and is useful for accessing the field via an interface.
+func (v *createProjectMilestoneResponse) GetProjectMilestoneCreate() createProjectMilestoneProjectMilestoneCreateProjectMilestonePayload {
+	return v.ProjectMilestoneCreate
 }
 
-// GetInitiativeUpdateRemindersDay returns getWorkspaceSettingsOrganization.InitiativeUpdateRemindersDay, and is useful for accessing the field via an interface.
-func (v *getWorkspaceSettingsOrganization) GetInitiativeUpdateRemindersDay() Day {
-	return v.Organization.InitiativeUpdateRemindersDay
+// createProjectProjectCreateProjectPayload includes the requested fields of the GraphQL type ProjectPayload.
+type createProjectProjectCreateProjectPayload struct {
+	// The project that was created or updated.
+	Project createProjectProjectCreateProjectPayloadProject `json:"project"`
 }
 
-// GetInitiativeUpdateRemindersHour returns getWorkspaceSettingsOrganization.InitiativeUpdateRemindersHour, and is useful for accessing the field via an interface.
-func (v *getWorkspaceSettingsOrganization) GetInitiativeUpdateRemindersHour() float64 {
-	return v.Organization.InitiativeUpdateRemindersHour
+// GetProject returns createProjectProjectCreateProjectPayload.Project, and is useful for accessing the field via an interface.
+func (v *createProjectProjectCreateProjectPayload) GetProject() createProjectProjectCreateProjectPayloadProject {
+	return v.Project
 }
 
-// GetFeedEnabled returns getWorkspaceSettingsOrganization.FeedEnabled, and is useful for accessing the field via an interface.
-func (v *getWorkspaceSettingsOrganization) GetFeedEnabled() bool { return v.Organization.FeedEnabled }
+// createProjectProjectCreateProjectPayloadProject includes the requested fields of the GraphQL type Project.
+// The GraphQL type's documentation follows.
+//
+// A project.
+type createProjectProjectCreateProjectPayloadProject struct {
+	Project `json:"-"`
+}
 
-// GetDefaultFeedSummarySchedule returns getWorkspaceSettingsOrganization.DefaultFeedSummarySchedule, and is useful for accessing the field via an interface.
-func (v *getWorkspaceSettingsOrganization) GetDefaultFeedSummarySchedule() FeedSummarySchedule {
-	return v.Organization.DefaultFeedSummarySchedule
+// GetId returns createProjectProjectCreateProjectPayloadProject.Id, and is useful for accessing the field via an interface.
+func (v *createProjectProjectCreateProjectPayloadProject) GetId() string { return v.Project.Id }
+
+// GetName returns createProjectProjectCreateProjectPayloadProject.Name, and is useful for accessing the field via an interface.
+func (v *createProjectProjectCreateProjectPayloadProject) GetName() string { return v.Project.Name }
+
+// GetSlugId returns createProjectProjectCreateProjectPayloadProject.SlugId, and is useful for accessing the field via an interface.
+func (v *createProjectProjectCreateProjectPayloadProject) GetSlugId() string { return v.Project.SlugId }
+
+// GetUrl returns createProjectProjectCreateProjectPayloadProject.Url, and is useful for accessing the field via an interface.
+func (v *createProjectProjectCreateProjectPayloadProject) GetUrl() string { return v.Project.Url }
+
+// GetDescription returns createProjectProjectCreateProjectPayloadProject.Description, and is useful for accessing the field via an interface.
+func (v *createProjectProjectCreateProjectPayloadProject) GetDescription() string {
+	return v.Project.Description
 }
 
-// GetCustomersEnabled returns getWorkspaceSettingsOrganization.CustomersEnabled, and is useful for accessing the field via an interface.
-func (v *getWorkspaceSettingsOrganization) GetCustomersEnabled() bool {
-	return v.Organization.CustomersEnabled
+// GetIcon returns createProjectProjectCreateProjectPayloadProject.Icon, and is useful for accessing the field via an interface.
+func (v *createProjectProjectCreateProjectPayloadProject) GetIcon() *string { return v.Project.Icon }
+
+// GetColor returns createProjectProjectCreateProjectPayloadProject.Color, and is useful for accessing the field via an interface.
+func (v *createProjectProjectCreateProjectPayloadProject) GetColor() string { return v.Project.Color }
+
+// GetStatus returns createProjectProjectCreateProjectPayloadProject.Status, and is useful for accessing the field via an interface.
+func (v *createProjectProjectCreateProjectPayloadProject) GetStatus() ProjectStatusProjectStatus {
+	return v.Project.Status
 }
 
-func (v *getWorkspaceSettingsOrganization) UnmarshalJSON(b []byte) error {
+// GetLead returns createProjectProjectCreateProjectPayloadProject.Lead, and is useful for accessing the field via an interface.
+func (v *createProjectProjectCreateProjectPayloadProject) GetLead() *ProjectLeadUser {
+	return v.Project.Lead
+}
+
+// GetTeams returns createProjectProjectCreateProjectPayloadProject.Teams, and is useful for accessing the field via an interface.
+func (v *createProjectProjectCreateProjectPayloadProject) GetTeams() ProjectTeamsTeamConnection {
+	return v.Project.Teams
+}
+
+// GetPriority returns createProjectProjectCreateProjectPayloadProject.Priority, and is useful for accessing the field via an interface.
+func (v *createProjectProjectCreateProjectPayloadProject) GetPriority() int {
+	return v.Project.Priority
+}
+
+// GetStartDate returns createProjectProjectCreateProjectPayloadProject.StartDate, and is useful for accessing the field via an interface.
+func (v *createProjectProjectCreateProjectPayloadProject) GetStartDate() *string {
+	return v.Project.StartDate
+}
+
+// GetTargetDate returns createProjectProjectCreateProjectPayloadProject.TargetDate, and is useful for accessing the field via an interface.
+func (v *createProjectProjectCreateProjectPayloadProject) GetTargetDate() *string {
+	return v.Project.TargetDate
+}
+
+// GetLabelIds returns createProjectProjectCreateProjectPayloadProject.LabelIds, and is useful for accessing the field via an interface.
+func (v *createProjectProjectCreateProjectPayloadProject) GetLabelIds() []string {
+	return v.Project.LabelIds
+}
+
+// GetTrashed returns createProjectProjectCreateProjectPayloadProject.Trashed, and is useful for accessing the field via an interface.
+func (v *createProjectProjectCreateProjectPayloadProject) GetTrashed() bool { return v.Project.Trashed }
+
+// GetArchivedAt returns createProjectProjectCreateProjectPayloadProject.ArchivedAt, and is useful for accessing the field via an interface.
+func (v *createProjectProjectCreateProjectPayloadProject) GetArchivedAt() *time.Time {
+	return v.Project.ArchivedAt
+}
+
+func (v *createProjectProjectCreateProjectPayloadProject) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*getWorkspaceSettingsOrganization
+		*createProjectProjectCreateProjectPayloadProject
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.getWorkspaceSettingsOrganization = v
+	firstPass.createProjectProjectCreateProjectPayloadProject = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -3495,50 +6435,48 @@ func (v *getWorkspaceSettingsOrganization) UnmarshalJSON(b []byte) error {
 	}
 
 	err = json.Unmarshal(
-		b, &v.Organization)
+		b, &v.Project)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-type __premarshalgetWorkspaceSettingsOrganization struct {
+type __premarshalcreateProjectProjectCreateProjectPayloadProject struct {
 	Id string `json:"id"`
 
-	AllowMembersToInvite bool `json:"allowMembersToInvite"`
-
-	RestrictTeamCreationToAdmins bool `json:"restrictTeamCreationToAdmins"`
+	Name string `json:"name"`
 
-	RestrictLabelManagementToAdmins bool `json:"restrictLabelManagementToAdmins"`
+	SlugId string `json:"slugId"`
 
-	GitLinkbackMessagesEnabled bool `json:"gitLinkbackMessagesEnabled"`
+	Url string `json:"url"`
 
-	GitPublicLinkbackMessagesEnabled bool `json:"gitPublicLinkbackMessagesEnabled"`
+	Description string `json:"description"`
 
-	FiscalYearStartMonth float64 `json:"fiscalYearStartMonth"`
+	Icon *string `json:"icon"`
 
-	ProjectUpdateReminderFrequencyInWeeks float64 `json:"projectUpdateReminderFrequencyInWeeks"`
+	Color string `json:"color"`
 
-	ProjectUpdateRemindersDay Day `json:"projectUpdateRemindersDay"`
+	Status ProjectStatusProjectStatus `json:"status"`
 
-	ProjectUpdateRemindersHour float64 `json:"projectUpdateRemindersHour"`
+	Lead *ProjectLeadUser `json:"lead"`
 
-	RoadmapEnabled bool `json:"roadmapEnabled"`
+	Teams ProjectTeamsTeamConnection `json:"teams"`
 
-	InitiativeUpdateReminderFrequencyInWeeks float64 `json:"initiativeUpdateReminderFrequencyInWeeks"`
+	Priority int `json:"priority"`
 
-	InitiativeUpdateRemindersDay Day `json:"initiativeUpdateRemindersDay"`
+	StartDate *string `json:"startDate"`
 
-	InitiativeUpdateRemindersHour float64 `json:"initiativeUpdateRemindersHour"`
+	TargetDate *string `json:"targetDate"`
 
-	FeedEnabled bool `json:"feedEnabled"`
+	LabelIds []string `json:"labelIds"`
 
-	DefaultFeedSummarySchedule FeedSummarySchedule `json:"defaultFeedSummarySchedule"`
+	Trashed bool `json:"trashed"`
 
-	CustomersEnabled bool `json:"customersEnabled"`
+	ArchivedAt *time.Time `json:"archivedAt"`
 }
 
-func (v *getWorkspaceSettingsOrganization) MarshalJSON() ([]byte, error) {
+func (v *createProjectProjectCreateProjectPayloadProject) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -3546,109 +6484,109 @@ func (v *getWorkspaceSettingsOrganization) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *getWorkspaceSettingsOrganization) __premarshalJSON() (*__premarshalgetWorkspaceSettingsOrganization, error) {
-	var retval __premarshalgetWorkspaceSettingsOrganization
-
-	retval.Id = v.Organization.Id
-	retval.AllowMembersToInvite = v.Organization.AllowMembersToInvite
-	retval.RestrictTeamCreationToAdmins = v.Organization.RestrictTeamCreationToAdmins
-	retval.RestrictLabelManagementToAdmins = v.Organization.RestrictLabelManagementToAdmins
-	retval.GitLinkbackMessagesEnabled = v.Organization.GitLinkbackMessagesEnabled
-	retval.GitPublicLinkbackMessagesEnabled = v.Organization.GitPublicLinkbackMessagesEnabled
-	retval.FiscalYearStartMonth = v.Organization.FiscalYearStartMonth
-	retval.ProjectUpdateReminderFrequencyInWeeks = v.Organization.ProjectUpdateReminderFrequencyInWeeks
-	retval.ProjectUpdateRemindersDay = v.Organization.ProjectUpdateRemindersDay
-	retval.ProjectUpdateRemindersHour = v.Organization.ProjectUpdateRemindersHour
-	retval.RoadmapEnabled = v.Organization.RoadmapEnabled
-	retval.InitiativeUpdateReminderFrequencyInWeeks = v.Organization.InitiativeUpdateReminderFrequencyInWeeks
-	retval.InitiativeUpdateRemindersDay = v.Organization.InitiativeUpdateRemindersDay
-	retval.InitiativeUpdateRemindersHour = v.Organization.InitiativeUpdateRemindersHour
-	retval.FeedEnabled = v.Organization.FeedEnabled
-	retval.DefaultFeedSummarySchedule = v.Organization.DefaultFeedSummarySchedule
-	retval.CustomersEnabled = v.Organization.CustomersEnabled
+func (v *createProjectProjectCreateProjectPayloadProject) __premarshalJSON() (*__premarshalcreateProjectProjectCreateProjectPayloadProject, error) {
+	var retval __premarshalcreateProjectProjectCreateProjectPayloadProject
+
+	retval.Id = v.Project.Id
+	retval.Name = v.Project.Name
+	retval.SlugId = v.Project.SlugId
+	retval.Url = v.Project.Url
+	retval.Description = v.Project.Description
+	retval.Icon = v.Project.Icon
+	retval.Color = v.Project.Color
+	retval.Status = v.Project.Status
+	retval.Lead = v.Project.Lead
+	retval.Teams = v.Project.Teams
+	retval.Priority = v.Project.Priority
+	retval.StartDate = v.Project.StartDate
+	retval.TargetDate = v.Project.TargetDate
+	retval.LabelIds = v.Project.LabelIds
+	retval.Trashed = v.Project.Trashed
+	retval.ArchivedAt = v.Project.ArchivedAt
 	return &retval, nil
 }
 
-// getWorkspaceSettingsResponse is returned by getWorkspaceSettings on success.
-type getWorkspaceSettingsResponse struct {
-	// The user's organization.
-	Organization getWorkspaceSettingsOrganization `json:"organization"`
+// createProjectResponse is returned by createProject on success.
+type createProjectResponse struct {
+	// Creates a new project.
+	ProjectCreate createProjectProjectCreateProjectPayload `json:"projectCreate"`
 }
 
-// GetOrganization returns getWorkspaceSettingsResponse.Organization, and is useful for accessing the field via an interface.
-func (v *getWorkspaceSettingsResponse) GetOrganization() getWorkspaceSettingsOrganization {
-	return v.Organization
+// GetProjectCreate returns createProjectResponse.ProjectCreate, and is useful for accessing the field via an interface.
+func (v *createProjectResponse) GetProjectCreate() createProjectProjectCreateProjectPayload {
+	return v.ProjectCreate
 }
 
-// templateCreateResponse is returned by templateCreate on success.
-type templateCreateResponse struct {
-	// Creates a new template.
-	TemplateCreate templateCreateTemplateCreateTemplatePayload `json:"templateCreate"`
+// createProjectStatusProjectStatusCreateProjectStatusPayload includes the requested fields of the GraphQL type ProjectStatusPayload.
+type createProjectStatusProjectStatusCreateProjectStatusPayload struct {
+	// The project status that was created or updated.
+	Status createProjectStatusProjectStatusCreateProjectStatusPayloadStatusProjectStatus `json:"status"`
 }
 
-// GetTemplateCreate returns templateCreateResponse.TemplateCreate, and is useful for accessing the field via an interface.
-func (v *templateCreateResponse) GetTemplateCreate() templateCreateTemplateCreateTemplatePayload {
-	return v.TemplateCreate
+// GetStatus returns createProjectStatusProjectStatusCreateProjectStatusPayload.Status, and is useful for accessing the field via an interface.
+func (v *createProjectStatusProjectStatusCreateProjectStatusPayload) GetStatus() createProjectStatusProjectStatusCreateProjectStatusPayloadStatusProjectStatus {
+	return v.Status
 }
 
-// templateCreateTemplateCreateTemplatePayload includes the requested fields of the GraphQL type TemplatePayload.
-type templateCreateTemplateCreateTemplatePayload struct {
-	// The template that was created or updated.
-	Template templateCreateTemplateCreateTemplatePayloadTemplate `json:"template"`
+// createProjectStatusProjectStatusCreateProjectStatusPayloadStatusProjectStatus includes the requested fields of the GraphQL type ProjectStatus.
+// The GraphQL type's documentation follows.
+//
+// A project status.
+type createProjectStatusProjectStatusCreateProjectStatusPayloadStatusProjectStatus struct {
+	ProjectStatus `json:"-"`
 }
 
-// GetTemplate returns templateCreateTemplateCreateTemplatePayload.Template, and is useful for accessing the field via an interface.
-func (v *templateCreateTemplateCreateTemplatePayload) GetTemplate() templateCreateTemplateCreateTemplatePayloadTemplate {
-	return v.Template
+// GetId returns createProjectStatusProjectStatusCreateProjectStatusPayloadStatusProjectStatus.Id, and is useful for accessing the field via an interface.
+func (v *createProjectStatusProjectStatusCreateProjectStatusPayloadStatusProjectStatus) GetId() string {
+	return v.ProjectStatus.Id
 }
 
-// templateCreateTemplateCreateTemplatePayloadTemplate includes the requested fields of the GraphQL type Template.
-// The GraphQL type's documentation follows.
-//
-// A template object used for creating entities faster.
-type templateCreateTemplateCreateTemplatePayloadTemplate struct {
-	Template `json:"-"`
+// GetName returns createProjectStatusProjectStatusCreateProjectStatusPayloadStatusProjectStatus.Name, and is useful for accessing the field via an interface.
+func (v *createProjectStatusProjectStatusCreateProjectStatusPayloadStatusProjectStatus) GetName() string {
+	return v.ProjectStatus.Name
 }
 
-// GetId returns templateCreateTemplateCreateTemplatePayloadTemplate.Id, and is useful for accessing the field via an interface.
-func (v *templateCreateTemplateCreateTemplatePayloadTemplate) GetId() string { return v.Template.Id }
+// GetColor returns createProjectStatusProjectStatusCreateProjectStatusPayloadStatusProjectStatus.Color, and is useful for accessing the field via an interface.
+func (v *createProjectStatusProjectStatusCreateProjectStatusPayloadStatusProjectStatus) GetColor() string {
+	return v.ProjectStatus.Color
+}
 
-// GetName returns templateCreateTemplateCreateTemplatePayloadTemplate.Name, and is useful for accessing the field via an interface.
-func (v *templateCreateTemplateCreateTemplatePayloadTemplate) GetName() string {
-	return v.Template.Name
+// GetDescription returns createProjectStatusProjectStatusCreateProjectStatusPayloadStatusProjectStatus.Description, and is useful for accessing the field via an interface.
+func (v *createProjectStatusProjectStatusCreateProjectStatusPayloadStatusProjectStatus) GetDescription() *string {
+	return v.ProjectStatus.Description
 }
 
-// GetDescription returns templateCreateTemplateCreateTemplatePayloadTemplate.Description, and is useful for accessing the field via an interface.
-func (v *templateCreateTemplateCreateTemplatePayloadTemplate) GetDescription() *string {
-	return v.Template.Description
+// GetType returns createProjectStatusProjectStatusCreateProjectStatusPayloadStatusProjectStatus.Type, and is useful for accessing the field via an interface.
+func (v *createProjectStatusProjectStatusCreateProjectStatusPayloadStatusProjectStatus) GetType() ProjectStatusType {
+	return v.ProjectStatus.Type
 }
 
-// GetType returns templateCreateTemplateCreateTemplatePayloadTemplate.Type, and is useful for accessing the field via an interface.
-func (v *templateCreateTemplateCreateTemplatePayloadTemplate) GetType() string {
-	return v.Template.Type
+// GetPosition returns createProjectStatusProjectStatusCreateProjectStatusPayloadStatusProjectStatus.Position, and is useful for accessing the field via an interface.
+func (v *createProjectStatusProjectStatusCreateProjectStatusPayloadStatusProjectStatus) GetPosition() float64 {
+	return v.ProjectStatus.Position
 }
 
-// GetTeam returns templateCreateTemplateCreateTemplatePayloadTemplate.Team, and is useful for accessing the field via an interface.
-func (v *templateCreateTemplateCreateTemplatePayloadTemplate) GetTeam() *TemplateTeam {
-	return v.Template.Team
+// GetIndefinite returns createProjectStatusProjectStatusCreateProjectStatusPayloadStatusProjectStatus.Indefinite, and is useful for accessing the field via an interface.
+func (v *createProjectStatusProjectStatusCreateProjectStatusPayloadStatusProjectStatus) GetIndefinite() bool {
+	return v.ProjectStatus.Indefinite
 }
 
-// GetTemplateData returns templateCreateTemplateCreateTemplatePayloadTemplate.TemplateData, and is useful for accessing the field via an interface.
-func (v *templateCreateTemplateCreateTemplatePayloadTemplate) GetTemplateData() string {
-	return v.Template.TemplateData
+// GetArchivedAt returns createProjectStatusProjectStatusCreateProjectStatusPayloadStatusProjectStatus.ArchivedAt, and is useful for accessing the field via an interface.
+func (v *createProjectStatusProjectStatusCreateProjectStatusPayloadStatusProjectStatus) GetArchivedAt() *time.Time {
+	return v.ProjectStatus.ArchivedAt
 }
 
-func (v *templateCreateTemplateCreateTemplatePayloadTemplate) UnmarshalJSON(b []byte) error {
+func (v *createProjectStatusProjectStatusCreateProjectStatusPayloadStatusProjectStatus) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*templateCreateTemplateCreateTemplatePayloadTemplate
+		*createProjectStatusProjectStatusCreateProjectStatusPayloadStatusProjectStatus
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.templateCreateTemplateCreateTemplatePayloadTemplate = v
+	firstPass.createProjectStatusProjectStatusCreateProjectStatusPayloadStatusProjectStatus = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -3656,28 +6594,32 @@ func (v *templateCreateTemplateCreateTemplatePayloadTemplate) UnmarshalJSON(b []
 	}
 
 	err = json.Unmarshal(
-		b, &v.Template)
+		b, &v.ProjectStatus)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-type __premarshaltemplateCreateTemplateCreateTemplatePayloadTemplate struct {
+type __premarshalcreateProjectStatusProjectStatusCreateProjectStatusPayloadStatusProjectStatus struct {
 	Id string `json:"id"`
 
 	Name string `json:"name"`
 
+	Color string `json:"color"`
+
 	Description *string `json:"description"`
 
-	Type string `json:"type"`
+	Type ProjectStatusType `json:"type"`
 
-	Team *TemplateTeam `json:"team"`
+	Position float64 `json:"position"`
 
-	TemplateData string `json:"templateData"`
+	Indefinite bool `json:"indefinite"`
+
+	ArchivedAt *time.Time `json:"archivedAt"`
 }
 
-func (v *templateCreateTemplateCreateTemplatePayloadTemplate) MarshalJSON() ([]byte, error) {
+func (v *createProjectStatusProjectStatusCreateProjectStatusPayloadStatusProjectStatus) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -3685,110 +6627,102 @@ func (v *templateCreateTemplateCreateTemplatePayloadTemplate) MarshalJSON() ([]b
 	return json.Marshal(premarshaled)
 }
 
-func (v *templateCreateTemplateCreateTemplatePayloadTemplate) __premarshalJSON() (*__premarshaltemplateCreateTemplateCreateTemplatePayloadTemplate, error) {
-	var retval __premarshaltemplateCreateTemplateCreateTemplatePayloadTemplate
+func (v *createProjectStatusProjectStatusCreateProjectStatusPayloadStatusProjectStatus) __premarshalJSON() (*__premarshalcreateProjectStatusProjectStatusCreateProjectStatusPayloadStatusProjectStatus, error) {
+	var retval __premarshalcreateProjectStatusProjectStatusCreateProjectStatusPayloadStatusProjectStatus
 
-	retval.Id = v.Template.Id
-	retval.Name = v.Template.Name
-	retval.Description = v.Template.Description
-	retval.Type = v.Template.Type
-	retval.Team = v.Template.Team
-	retval.TemplateData = v.Template.TemplateData
+	retval.Id = v.ProjectStatus.Id
+	retval.Name = v.ProjectStatus.Name
+	retval.Color = v.ProjectStatus.Color
+	retval.Description = v.ProjectStatus.Description
+	retval.Type = v.ProjectStatus.Type
+	retval.Position = v.ProjectStatus.Position
+	retval.Indefinite = v.ProjectStatus.Indefinite
+	retval.ArchivedAt = v.ProjectStatus.ArchivedAt
 	return &retval, nil
 }
 
-// templateDeleteResponse is returned by templateDelete on success.
-type templateDeleteResponse struct {
-	// Deletes a template.
-	TemplateDelete templateDeleteTemplateDeleteDeletePayload `json:"templateDelete"`
+// createProjectStatusResponse is returned by createProjectStatus on success.
+type createProjectStatusResponse struct {
+	// Creates a new project status.
+	ProjectStatusCreate createProjectStatusProjectStatusCreateProjectStatusPayload `json:"projectStatusCreate"`
 }
 
-// GetTemplateDelete returns templateDeleteResponse.TemplateDelete, and is useful for accessing the field via an interface.
-func (v *templateDeleteResponse) GetTemplateDelete() templateDeleteTemplateDeleteDeletePayload {
-	return v.TemplateDelete
+// GetProjectStatusCreate returns createProjectStatusResponse.ProjectStatusCreate, and is useful for accessing the field via an interface.
+func (v *createProjectStatusResponse) GetProjectStatusCreate() createProjectStatusProjectStatusCreateProjectStatusPayload {
+	return v.ProjectStatusCreate
 }
 
-// templateDeleteTemplateDeleteDeletePayload includes the requested fields of the GraphQL type DeletePayload.
-// The GraphQL type's documentation follows.
-//
-// A generic payload return from entity deletion mutations.
-type templateDeleteTemplateDeleteDeletePayload struct {
-	// Whether the operation was successful.
-	Success bool `json:"success"`
+// createTeamMembershipResponse is returned by createTeamMembership on success.
+type createTeamMembershipResponse struct {
+	// Creates a new team membership.
+	TeamMembershipCreate createTeamMembershipTeamMembershipCreateTeamMembershipPayload `json:"teamMembershipCreate"`
 }
 
-// GetSuccess returns templateDeleteTemplateDeleteDeletePayload.Success, and is useful for accessing the field via an interface.
-func (v *templateDeleteTemplateDeleteDeletePayload) GetSuccess() bool { return v.Success }
+// GetTeamMembershipCreate returns createTeamMembershipResponse.TeamMembershipCreate, and is useful for accessing the field via an interface.
+func (v *createTeamMembershipResponse) GetTeamMembershipCreate() createTeamMembershipTeamMembershipCreateTeamMembershipPayload {
+	return v.TeamMembershipCreate
+}
 
-// templateUpdateResponse is returned by templateUpdate on success.
-type templateUpdateResponse struct {
-	// Updates an existing template.
-	TemplateUpdate templateUpdateTemplateUpdateTemplatePayload `json:"templateUpdate"`
+// createTeamMembershipTeamMembershipCreateTeamMembershipPayload includes the requested fields of the GraphQL type TeamMembershipPayload.
+type createTeamMembershipTeamMembershipCreateTeamMembershipPayload struct {
+	// The team membership that was created or updated.
+	TeamMembership createTeamMembershipTeamMembershipCreateTeamMembershipPayloadTeamMembership `json:"teamMembership"`
 }
 
-// GetTemplateUpdate returns templateUpdateResponse.TemplateUpdate, and is useful for accessing the field via an interface.
-func (v *templateUpdateResponse) GetTemplateUpdate() templateUpdateTemplateUpdateTemplatePayload {
-	return v.TemplateUpdate
+// GetTeamMembership returns createTeamMembershipTeamMembershipCreateTeamMembershipPayload.TeamMembership, and is useful for accessing the field via an interface.
+func (v *createTeamMembershipTeamMembershipCreateTeamMembershipPayload) GetTeamMembership() createTeamMembershipTeamMembershipCreateTeamMembershipPayloadTeamMembership {
+	return v.TeamMembership
 }
 
-// templateUpdateTemplateUpdateTemplatePayload includes the requested fields of the GraphQL type TemplatePayload.
-type templateUpdateTemplateUpdateTemplatePayload struct {
-	// The template that was created or updated.
-	Template templateUpdateTemplateUpdateTemplatePayloadTemplate `json:"template"`
+// createTeamMembershipTeamMembershipCreateTeamMembershipPayloadTeamMembership includes the requested fields of the GraphQL type TeamMembership.
+// The GraphQL type's documentation follows.
+//
+// Defines the membership of a user to a team.
+type createTeamMembershipTeamMembershipCreateTeamMembershipPayloadTeamMembership struct {
+	TeamMembership `json:"-"`
 }
 
-// GetTemplate returns templateUpdateTemplateUpdateTemplatePayload.Template, and is useful for accessing the field via an interface.
-func (v *templateUpdateTemplateUpdateTemplatePayload) GetTemplate() templateUpdateTemplateUpdateTemplatePayloadTemplate {
-	return v.Template
+// GetId returns createTeamMembershipTeamMembershipCreateTeamMembershipPayloadTeamMembership.Id, and is useful for accessing the field via an interface.
+func (v *createTeamMembershipTeamMembershipCreateTeamMembershipPayloadTeamMembership) GetId() string {
+	return v.TeamMembership.Id
 }
 
-// templateUpdateTemplateUpdateTemplatePayloadTemplate includes the requested fields of the GraphQL type Template.
-// The GraphQL type's documentation follows.
-//
-// A template object used for creating entities faster.
-type templateUpdateTemplateUpdateTemplatePayloadTemplate struct {
-	Template `json:"-"`
+// GetOwner returns createTeamMembershipTeamMembershipCreateTeamMembershipPayloadTeamMembership.Owner, and is useful for accessing the field via an interface.
+func (v *createTeamMembershipTeamMembershipCreateTeamMembershipPayloadTeamMembership) GetOwner() bool {
+	return v.TeamMembership.Owner
 }
 
-// GetId returns templateUpdateTemplateUpdateTemplatePayloadTemplate.Id, and is useful for accessing the field via an interface.
-func (v *templateUpdateTemplateUpdateTemplatePayloadTemplate) GetId() string { return v.Template.Id }
+// GetSortOrder returns createTeamMembershipTeamMembershipCreateTeamMembershipPayloadTeamMembership.SortOrder, and is useful for accessing the field via an interface.
+func (v *createTeamMembershipTeamMembershipCreateTeamMembershipPayloadTeamMembership) GetSortOrder() float64 {
+	return v.TeamMembership.SortOrder
+}
 
-// GetName returns templateUpdateTemplateUpdateTemplatePayloadTemplate.Name, and is useful for accessing the field via an interface.
-func (v *templateUpdateTemplateUpdateTemplatePayloadTemplate) GetName() string {
-	return v.Template.Name
-}
-
-// GetDescription returns templateUpdateTemplateUpdateTemplatePayloadTemplate.Description, and is useful for accessing the field via an interface.
-func (v *templateUpdateTemplateUpdateTemplatePayloadTemplate) GetDescription() *string {
-	return v.Template.Description
+// GetTeam returns createTeamMembershipTeamMembershipCreateTeamMembershipPayloadTeamMembership.Team, and is useful for accessing the field via an interface.
+func (v *createTeamMembershipTeamMembershipCreateTeamMembershipPayloadTeamMembership) GetTeam() TeamMembershipTeam {
+	return v.TeamMembership.Team
 }
 
-// GetType returns templateUpdateTemplateUpdateTemplatePayloadTemplate.Type, and is useful for accessing the field via an interface.
-func (v *templateUpdateTemplateUpdateTemplatePayloadTemplate) GetType() string {
-	return v.Template.Type
-}
-
-// GetTeam returns templateUpdateTemplateUpdateTemplatePayloadTemplate.Team, and is useful for accessing the field via an interface.
-func (v *templateUpdateTemplateUpdateTemplatePayloadTemplate) GetTeam() *TemplateTeam {
-	return v.Template.Team
+// GetUser returns createTeamMembershipTeamMembershipCreateTeamMembershipPayloadTeamMembership.User, and is useful for accessing the field via an interface.
+func (v *createTeamMembershipTeamMembershipCreateTeamMembershipPayloadTeamMembership) GetUser() TeamMembershipUser {
+	return v.TeamMembership.User
 }
 
-// GetTemplateData returns templateUpdateTemplateUpdateTemplatePayloadTemplate.TemplateData, and is useful for accessing the field via an interface.
-func (v *templateUpdateTemplateUpdateTemplatePayloadTemplate) GetTemplateData() string {
-	return v.Template.TemplateData
+// GetArchivedAt returns createTeamMembershipTeamMembershipCreateTeamMembershipPayloadTeamMembership.ArchivedAt, and is useful for accessing the field via an interface.
+func (v *createTeamMembershipTeamMembershipCreateTeamMembershipPayloadTeamMembership) GetArchivedAt() *time.Time {
+	return v.TeamMembership.ArchivedAt
 }
 
-func (v *templateUpdateTemplateUpdateTemplatePayloadTemplate) UnmarshalJSON(b []byte) error {
+func (v *createTeamMembershipTeamMembershipCreateTeamMembershipPayloadTeamMembership) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*templateUpdateTemplateUpdateTemplatePayloadTemplate
+		*createTeamMembershipTeamMembershipCreateTeamMembershipPayloadTeamMembership
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.templateUpdateTemplateUpdateTemplatePayloadTemplate = v
+	firstPass.createTeamMembershipTeamMembershipCreateTeamMembershipPayloadTeamMembership = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -3796,28 +6730,28 @@ func (v *templateUpdateTemplateUpdateTemplatePayloadTemplate) UnmarshalJSON(b []
 	}
 
 	err = json.Unmarshal(
-		b, &v.Template)
+		b, &v.TeamMembership)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-type __premarshaltemplateUpdateTemplateUpdateTemplatePayloadTemplate struct {
+type __premarshalcreateTeamMembershipTeamMembershipCreateTeamMembershipPayloadTeamMembership struct {
 	Id string `json:"id"`
 
-	Name string `json:"name"`
+	Owner bool `json:"owner"`
 
-	Description *string `json:"description"`
+	SortOrder float64 `json:"sortOrder"`
 
-	Type string `json:"type"`
+	Team TeamMembershipTeam `json:"team"`
 
-	Team *TemplateTeam `json:"team"`
+	User TeamMembershipUser `json:"user"`
 
-	TemplateData string `json:"templateData"`
+	ArchivedAt *time.Time `json:"archivedAt"`
 }
 
-func (v *templateUpdateTemplateUpdateTemplatePayloadTemplate) MarshalJSON() ([]byte, error) {
+func (v *createTeamMembershipTeamMembershipCreateTeamMembershipPayloadTeamMembership) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -3825,100 +6759,181 @@ func (v *templateUpdateTemplateUpdateTemplatePayloadTemplate) MarshalJSON() ([]b
 	return json.Marshal(premarshaled)
 }
 
-func (v *templateUpdateTemplateUpdateTemplatePayloadTemplate) __premarshalJSON() (*__premarshaltemplateUpdateTemplateUpdateTemplatePayloadTemplate, error) {
-	var retval __premarshaltemplateUpdateTemplateUpdateTemplatePayloadTemplate
+func (v *createTeamMembershipTeamMembershipCreateTeamMembershipPayloadTeamMembership) __premarshalJSON() (*__premarshalcreateTeamMembershipTeamMembershipCreateTeamMembershipPayloadTeamMembership, error) {
+	var retval __premarshalcreateTeamMembershipTeamMembershipCreateTeamMembershipPayloadTeamMembership
 
-	retval.Id = v.Template.Id
-	retval.Name = v.Template.Name
-	retval.Description = v.Template.Description
-	retval.Type = v.Template.Type
-	retval.Team = v.Template.Team
-	retval.TemplateData = v.Template.TemplateData
+	retval.Id = v.TeamMembership.Id
+	retval.Owner = v.TeamMembership.Owner
+	retval.SortOrder = v.TeamMembership.SortOrder
+	retval.Team = v.TeamMembership.Team
+	retval.User = v.TeamMembership.User
+	retval.ArchivedAt = v.TeamMembership.ArchivedAt
 	return &retval, nil
 }
 
-// updateGitAutomationStateGitAutomationStateUpdateGitAutomationStatePayload includes the requested fields of the GraphQL type GitAutomationStatePayload.
-type updateGitAutomationStateGitAutomationStateUpdateGitAutomationStatePayload struct {
-	// Whether the operation was successful.
-	Success bool `json:"success"`
+// createTeamResponse is returned by createTeam on success.
+type createTeamResponse struct {
+	// Creates a new team. The user who creates the team will automatically be added as a member to the newly created team.
+	TeamCreate createTeamTeamCreateTeamPayload `json:"teamCreate"`
 }
 
-// GetSuccess returns updateGitAutomationStateGitAutomationStateUpdateGitAutomationStatePayload.Success, and is useful for accessing the field via an interface.
-func (v *updateGitAutomationStateGitAutomationStateUpdateGitAutomationStatePayload) GetSuccess() bool {
-	return v.Success
+// GetTeamCreate returns createTeamResponse.TeamCreate, and is useful for accessing the field via an interface.
+func (v *createTeamResponse) GetTeamCreate() createTeamTeamCreateTeamPayload { return v.TeamCreate }
+
+// createTeamTeamCreateTeamPayload includes the requested fields of the GraphQL type TeamPayload.
+type createTeamTeamCreateTeamPayload struct {
+	// The team that was created or updated.
+	Team createTeamTeamCreateTeamPayloadTeam `json:"team"`
 }
 
-// updateGitAutomationStateResponse is returned by updateGitAutomationState on success.
-type updateGitAutomationStateResponse struct {
-	// Updates an existing state.
-	GitAutomationStateUpdate updateGitAutomationStateGitAutomationStateUpdateGitAutomationStatePayload `json:"gitAutomationStateUpdate"`
+// GetTeam returns createTeamTeamCreateTeamPayload.Team, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayload) GetTeam() createTeamTeamCreateTeamPayloadTeam {
+	return v.Team
 }
 
-// GetGitAutomationStateUpdate returns updateGitAutomationStateResponse.GitAutomationStateUpdate, and is useful for accessing the field via an interface.
-func (v *updateGitAutomationStateResponse) GetGitAutomationStateUpdate() updateGitAutomationStateGitAutomationStateUpdateGitAutomationStatePayload {
-	return v.GitAutomationStateUpdate
+// createTeamTeamCreateTeamPayloadTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type createTeamTeamCreateTeamPayloadTeam struct {
+	Team `json:"-"`
 }
 
-// updateLabelIssueLabelUpdateIssueLabelPayload includes the requested fields of the GraphQL type IssueLabelPayload.
-type updateLabelIssueLabelUpdateIssueLabelPayload struct {
-	// The label that was created or updated.
-	IssueLabel updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel `json:"issueLabel"`
+// GetId returns createTeamTeamCreateTeamPayloadTeam.Id, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetId() string { return v.Team.Id }
+
+// GetName returns createTeamTeamCreateTeamPayloadTeam.Name, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetName() string { return v.Team.Name }
+
+// GetKey returns createTeamTeamCreateTeamPayloadTeam.Key, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetKey() string { return v.Team.Key }
+
+// GetPrivate returns createTeamTeamCreateTeamPayloadTeam.Private, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetPrivate() bool { return v.Team.Private }
+
+// GetDescription returns createTeamTeamCreateTeamPayloadTeam.Description, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetDescription() *string { return v.Team.Description }
+
+// GetIcon returns createTeamTeamCreateTeamPayloadTeam.Icon, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetIcon() *string { return v.Team.Icon }
+
+// GetColor returns createTeamTeamCreateTeamPayloadTeam.Color, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetColor() *string { return v.Team.Color }
+
+// GetParent returns createTeamTeamCreateTeamPayloadTeam.Parent, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetParent() *TeamParentTeam { return v.Team.Parent }
+
+// GetTimezone returns createTeamTeamCreateTeamPayloadTeam.Timezone, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetTimezone() string { return v.Team.Timezone }
+
+// GetGroupIssueHistory returns createTeamTeamCreateTeamPayloadTeam.GroupIssueHistory, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetGroupIssueHistory() bool {
+	return v.Team.GroupIssueHistory
 }
 
-// GetIssueLabel returns updateLabelIssueLabelUpdateIssueLabelPayload.IssueLabel, and is useful for accessing the field via an interface.
-func (v *updateLabelIssueLabelUpdateIssueLabelPayload) GetIssueLabel() updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel {
-	return v.IssueLabel
+// GetSetIssueSortOrderOnStateChange returns createTeamTeamCreateTeamPayloadTeam.SetIssueSortOrderOnStateChange, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetSetIssueSortOrderOnStateChange() string {
+	return v.Team.SetIssueSortOrderOnStateChange
 }
 
-// updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel includes the requested fields of the GraphQL type IssueLabel.
-// The GraphQL type's documentation follows.
-//
-// Labels that can be associated with issues.
-type updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel struct {
-	IssueLabel `json:"-"`
+// GetAiThreadSummariesEnabled returns createTeamTeamCreateTeamPayloadTeam.AiThreadSummariesEnabled, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetAiThreadSummariesEnabled() bool {
+	return v.Team.AiThreadSummariesEnabled
 }
 
-// GetId returns updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel.Id, and is useful for accessing the field via an interface.
-func (v *updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel) GetId() string {
-	return v.IssueLabel.Id
+// GetAutoArchivePeriod returns createTeamTeamCreateTeamPayloadTeam.AutoArchivePeriod, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetAutoArchivePeriod() float64 {
+	return v.Team.AutoArchivePeriod
 }
 
-// GetName returns updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel.Name, and is useful for accessing the field via an interface.
-func (v *updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel) GetName() string {
-	return v.IssueLabel.Name
+// GetAutoClosePeriod returns createTeamTeamCreateTeamPayloadTeam.AutoClosePeriod, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetAutoClosePeriod() *float64 {
+	return v.Team.AutoClosePeriod
 }
 
-// GetDescription returns updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel.Description, and is useful for accessing the field via an interface.
-func (v *updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel) GetDescription() *string {
-	return v.IssueLabel.Description
+// GetAutoCloseParentIssues returns createTeamTeamCreateTeamPayloadTeam.AutoCloseParentIssues, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetAutoCloseParentIssues() bool {
+	return v.Team.AutoCloseParentIssues
 }
 
-// GetColor returns updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel.Color, and is useful for accessing the field via an interface.
-func (v *updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel) GetColor() *string {
-	return v.IssueLabel.Color
+// GetAutoCloseChildIssues returns createTeamTeamCreateTeamPayloadTeam.AutoCloseChildIssues, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetAutoCloseChildIssues() bool {
+	return v.Team.AutoCloseChildIssues
 }
 
-// GetParent returns updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel.Parent, and is useful for accessing the field via an interface.
-func (v *updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel) GetParent() *IssueLabelParentIssueLabel {
-	return v.IssueLabel.Parent
+// GetTriageEnabled returns createTeamTeamCreateTeamPayloadTeam.TriageEnabled, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetTriageEnabled() bool { return v.Team.TriageEnabled }
+
+// GetRequirePriorityToLeaveTriage returns createTeamTeamCreateTeamPayloadTeam.RequirePriorityToLeaveTriage, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetRequirePriorityToLeaveTriage() bool {
+	return v.Team.RequirePriorityToLeaveTriage
 }
 
-// GetTeam returns updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel.Team, and is useful for accessing the field via an interface.
-func (v *updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel) GetTeam() *IssueLabelTeam {
-	return v.IssueLabel.Team
+// GetCyclesEnabled returns createTeamTeamCreateTeamPayloadTeam.CyclesEnabled, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetCyclesEnabled() bool { return v.Team.CyclesEnabled }
+
+// GetCycleStartDay returns createTeamTeamCreateTeamPayloadTeam.CycleStartDay, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetCycleStartDay() float64 { return v.Team.CycleStartDay }
+
+// GetCycleDuration returns createTeamTeamCreateTeamPayloadTeam.CycleDuration, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetCycleDuration() float64 { return v.Team.CycleDuration }
+
+// GetCycleCooldownTime returns createTeamTeamCreateTeamPayloadTeam.CycleCooldownTime, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetCycleCooldownTime() float64 {
+	return v.Team.CycleCooldownTime
 }
 
-func (v *updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel) UnmarshalJSON(b []byte) error {
+// GetUpcomingCycleCount returns createTeamTeamCreateTeamPayloadTeam.UpcomingCycleCount, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetUpcomingCycleCount() float64 {
+	return v.Team.UpcomingCycleCount
+}
+
+// GetCycleIssueAutoAssignStarted returns createTeamTeamCreateTeamPayloadTeam.CycleIssueAutoAssignStarted, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetCycleIssueAutoAssignStarted() bool {
+	return v.Team.CycleIssueAutoAssignStarted
+}
+
+// GetCycleIssueAutoAssignCompleted returns createTeamTeamCreateTeamPayloadTeam.CycleIssueAutoAssignCompleted, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetCycleIssueAutoAssignCompleted() bool {
+	return v.Team.CycleIssueAutoAssignCompleted
+}
+
+// GetCycleLockToActive returns createTeamTeamCreateTeamPayloadTeam.CycleLockToActive, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetCycleLockToActive() bool {
+	return v.Team.CycleLockToActive
+}
+
+// GetIssueEstimationType returns createTeamTeamCreateTeamPayloadTeam.IssueEstimationType, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetIssueEstimationType() string {
+	return v.Team.IssueEstimationType
+}
+
+// GetIssueEstimationAllowZero returns createTeamTeamCreateTeamPayloadTeam.IssueEstimationAllowZero, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetIssueEstimationAllowZero() bool {
+	return v.Team.IssueEstimationAllowZero
+}
+
+// GetIssueEstimationExtended returns createTeamTeamCreateTeamPayloadTeam.IssueEstimationExtended, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetIssueEstimationExtended() bool {
+	return v.Team.IssueEstimationExtended
+}
+
+// GetDefaultIssueEstimate returns createTeamTeamCreateTeamPayloadTeam.DefaultIssueEstimate, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetDefaultIssueEstimate() float64 {
+	return v.Team.DefaultIssueEstimate
+}
+
+func (v *createTeamTeamCreateTeamPayloadTeam) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel
+		*createTeamTeamCreateTeamPayloadTeam
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel = v
+	firstPass.createTeamTeamCreateTeamPayloadTeam = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -3926,221 +6941,179 @@ func (v *updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel) UnmarshalJSON(b
 	}
 
 	err = json.Unmarshal(
-		b, &v.IssueLabel)
+		b, &v.Team)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-type __premarshalupdateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel struct {
+type __premarshalcreateTeamTeamCreateTeamPayloadTeam struct {
 	Id string `json:"id"`
 
 	Name string `json:"name"`
 
+	Key string `json:"key"`
+
+	Private bool `json:"private"`
+
 	Description *string `json:"description"`
 
+	Icon *string `json:"icon"`
+
 	Color *string `json:"color"`
 
-	Parent *IssueLabelParentIssueLabel `json:"parent"`
+	Parent *TeamParentTeam `json:"parent"`
 
-	Team *IssueLabelTeam `json:"team"`
-}
+	Timezone string `json:"timezone"`
 
-func (v *updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-	return json.Marshal(premarshaled)
-}
+	GroupIssueHistory bool `json:"groupIssueHistory"`
 
-func (v *updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel) __premarshalJSON() (*__premarshalupdateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel, error) {
-	var retval __premarshalupdateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel
+	SetIssueSortOrderOnStateChange string `json:"setIssueSortOrderOnStateChange"`
 
-	retval.Id = v.IssueLabel.Id
-	retval.Name = v.IssueLabel.Name
-	retval.Description = v.IssueLabel.Description
-	retval.Color = v.IssueLabel.Color
-	retval.Parent = v.IssueLabel.Parent
-	retval.Team = v.IssueLabel.Team
-	return &retval, nil
-}
+	AiThreadSummariesEnabled bool `json:"aiThreadSummariesEnabled"`
 
-// updateLabelResponse is returned by updateLabel on success.
-type updateLabelResponse struct {
-	// Updates an label.
-	IssueLabelUpdate updateLabelIssueLabelUpdateIssueLabelPayload `json:"issueLabelUpdate"`
-}
+	AutoArchivePeriod float64 `json:"autoArchivePeriod"`
 
-// GetIssueLabelUpdate returns updateLabelResponse.IssueLabelUpdate, and is useful for accessing the field via an interface.
-func (v *updateLabelResponse) GetIssueLabelUpdate() updateLabelIssueLabelUpdateIssueLabelPayload {
-	return v.IssueLabelUpdate
-}
-
-// updateTeamResponse is returned by updateTeam on success.
-type updateTeamResponse struct {
-	// Updates a team.
-	TeamUpdate updateTeamTeamUpdateTeamPayload `json:"teamUpdate"`
-}
-
-// GetTeamUpdate returns updateTeamResponse.TeamUpdate, and is useful for accessing the field via an interface.
-func (v *updateTeamResponse) GetTeamUpdate() updateTeamTeamUpdateTeamPayload { return v.TeamUpdate }
-
-// updateTeamTeamUpdateTeamPayload includes the requested fields of the GraphQL type TeamPayload.
-type updateTeamTeamUpdateTeamPayload struct {
-	// The team that was created or updated.
-	Team updateTeamTeamUpdateTeamPayloadTeam `json:"team"`
-}
-
-// GetTeam returns updateTeamTeamUpdateTeamPayload.Team, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayload) GetTeam() updateTeamTeamUpdateTeamPayloadTeam {
-	return v.Team
-}
+	AutoClosePeriod *float64 `json:"autoClosePeriod"`
 
-// updateTeamTeamUpdateTeamPayloadTeam includes the requested fields of the GraphQL type Team.
-// The GraphQL type's documentation follows.
-//
-// An organizational unit that contains issues.
-type updateTeamTeamUpdateTeamPayloadTeam struct {
-	Team `json:"-"`
-}
+	AutoCloseParentIssues bool `json:"autoCloseParentIssues"`
 
-// GetId returns updateTeamTeamUpdateTeamPayloadTeam.Id, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetId() string { return v.Team.Id }
+	AutoCloseChildIssues bool `json:"autoCloseChildIssues"`
 
-// GetName returns updateTeamTeamUpdateTeamPayloadTeam.Name, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetName() string { return v.Team.Name }
+	TriageEnabled bool `json:"triageEnabled"`
 
-// GetKey returns updateTeamTeamUpdateTeamPayloadTeam.Key, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetKey() string { return v.Team.Key }
+	RequirePriorityToLeaveTriage bool `json:"requirePriorityToLeaveTriage"`
 
-// GetPrivate returns updateTeamTeamUpdateTeamPayloadTeam.Private, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetPrivate() bool { return v.Team.Private }
+	CyclesEnabled bool `json:"cyclesEnabled"`
 
-// GetDescription returns updateTeamTeamUpdateTeamPayloadTeam.Description, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetDescription() *string { return v.Team.Description }
+	CycleStartDay float64 `json:"cycleStartDay"`
 
-// GetIcon returns updateTeamTeamUpdateTeamPayloadTeam.Icon, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetIcon() *string { return v.Team.Icon }
+	CycleDuration float64 `json:"cycleDuration"`
 
-// GetColor returns updateTeamTeamUpdateTeamPayloadTeam.Color, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetColor() *string { return v.Team.Color }
+	CycleCooldownTime float64 `json:"cycleCooldownTime"`
 
-// GetParent returns updateTeamTeamUpdateTeamPayloadTeam.Parent, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetParent() *TeamParentTeam { return v.Team.Parent }
+	UpcomingCycleCount float64 `json:"upcomingCycleCount"`
 
-// GetTimezone returns updateTeamTeamUpdateTeamPayloadTeam.Timezone, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetTimezone() string { return v.Team.Timezone }
+	CycleIssueAutoAssignStarted bool `json:"cycleIssueAutoAssignStarted"`
 
-// GetGroupIssueHistory returns updateTeamTeamUpdateTeamPayloadTeam.GroupIssueHistory, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetGroupIssueHistory() bool {
-	return v.Team.GroupIssueHistory
-}
+	CycleIssueAutoAssignCompleted bool `json:"cycleIssueAutoAssignCompleted"`
 
-// GetSetIssueSortOrderOnStateChange returns updateTeamTeamUpdateTeamPayloadTeam.SetIssueSortOrderOnStateChange, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetSetIssueSortOrderOnStateChange() string {
-	return v.Team.SetIssueSortOrderOnStateChange
-}
+	CycleLockToActive bool `json:"cycleLockToActive"`
 
-// GetAiThreadSummariesEnabled returns updateTeamTeamUpdateTeamPayloadTeam.AiThreadSummariesEnabled, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetAiThreadSummariesEnabled() bool {
-	return v.Team.AiThreadSummariesEnabled
-}
+	IssueEstimationType string `json:"issueEstimationType"`
 
-// GetAutoArchivePeriod returns updateTeamTeamUpdateTeamPayloadTeam.AutoArchivePeriod, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetAutoArchivePeriod() float64 {
-	return v.Team.AutoArchivePeriod
-}
+	IssueEstimationAllowZero bool `json:"issueEstimationAllowZero"`
 
-// GetAutoClosePeriod returns updateTeamTeamUpdateTeamPayloadTeam.AutoClosePeriod, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetAutoClosePeriod() *float64 {
-	return v.Team.AutoClosePeriod
-}
+	IssueEstimationExtended bool `json:"issueEstimationExtended"`
 
-// GetAutoCloseParentIssues returns updateTeamTeamUpdateTeamPayloadTeam.AutoCloseParentIssues, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetAutoCloseParentIssues() bool {
-	return v.Team.AutoCloseParentIssues
+	DefaultIssueEstimate float64 `json:"defaultIssueEstimate"`
 }
 
-// GetAutoCloseChildIssues returns updateTeamTeamUpdateTeamPayloadTeam.AutoCloseChildIssues, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetAutoCloseChildIssues() bool {
-	return v.Team.AutoCloseChildIssues
+func (v *createTeamTeamCreateTeamPayloadTeam) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
 }
 
-// GetTriageEnabled returns updateTeamTeamUpdateTeamPayloadTeam.TriageEnabled, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetTriageEnabled() bool { return v.Team.TriageEnabled }
+func (v *createTeamTeamCreateTeamPayloadTeam) __premarshalJSON() (*__premarshalcreateTeamTeamCreateTeamPayloadTeam, error) {
+	var retval __premarshalcreateTeamTeamCreateTeamPayloadTeam
 
-// GetRequirePriorityToLeaveTriage returns updateTeamTeamUpdateTeamPayloadTeam.RequirePriorityToLeaveTriage, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetRequirePriorityToLeaveTriage() bool {
-	return v.Team.RequirePriorityToLeaveTriage
+	retval.Id = v.Team.Id
+	retval.Name = v.Team.Name
+	retval.Key = v.Team.Key
+	retval.Private = v.Team.Private
+	retval.Description = v.Team.Description
+	retval.Icon = v.Team.Icon
+	retval.Color = v.Team.Color
+	retval.Parent = v.Team.Parent
+	retval.Timezone = v.Team.Timezone
+	retval.GroupIssueHistory = v.Team.GroupIssueHistory
+	retval.SetIssueSortOrderOnStateChange = v.Team.SetIssueSortOrderOnStateChange
+	retval.AiThreadSummariesEnabled = v.Team.AiThreadSummariesEnabled
+	retval.AutoArchivePeriod = v.Team.AutoArchivePeriod
+	retval.AutoClosePeriod = v.Team.AutoClosePeriod
+	retval.AutoCloseParentIssues = v.Team.AutoCloseParentIssues
+	retval.AutoCloseChildIssues = v.Team.AutoCloseChildIssues
+	retval.TriageEnabled = v.Team.TriageEnabled
+	retval.RequirePriorityToLeaveTriage = v.Team.RequirePriorityToLeaveTriage
+	retval.CyclesEnabled = v.Team.CyclesEnabled
+	retval.CycleStartDay = v.Team.CycleStartDay
+	retval.CycleDuration = v.Team.CycleDuration
+	retval.CycleCooldownTime = v.Team.CycleCooldownTime
+	retval.UpcomingCycleCount = v.Team.UpcomingCycleCount
+	retval.CycleIssueAutoAssignStarted = v.Team.CycleIssueAutoAssignStarted
+	retval.CycleIssueAutoAssignCompleted = v.Team.CycleIssueAutoAssignCompleted
+	retval.CycleLockToActive = v.Team.CycleLockToActive
+	retval.IssueEstimationType = v.Team.IssueEstimationType
+	retval.IssueEstimationAllowZero = v.Team.IssueEstimationAllowZero
+	retval.IssueEstimationExtended = v.Team.IssueEstimationExtended
+	retval.DefaultIssueEstimate = v.Team.DefaultIssueEstimate
+	return &retval, nil
 }
 
-// GetCyclesEnabled returns updateTeamTeamUpdateTeamPayloadTeam.CyclesEnabled, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetCyclesEnabled() bool { return v.Team.CyclesEnabled }
-
-// GetCycleStartDay returns updateTeamTeamUpdateTeamPayloadTeam.CycleStartDay, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetCycleStartDay() float64 { return v.Team.CycleStartDay }
-
-// GetCycleDuration returns updateTeamTeamUpdateTeamPayloadTeam.CycleDuration, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetCycleDuration() float64 { return v.Team.CycleDuration }
-
-// GetCycleCooldownTime returns updateTeamTeamUpdateTeamPayloadTeam.CycleCooldownTime, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetCycleCooldownTime() float64 {
-	return v.Team.CycleCooldownTime
+// createTimeScheduleResponse is returned by createTimeSchedule on success.
+type createTimeScheduleResponse struct {
+	// Creates a new time schedule.
+	TimeScheduleCreate createTimeScheduleTimeScheduleCreateTimeSchedulePayload `json:"timeScheduleCreate"`
 }
 
-// GetUpcomingCycleCount returns updateTeamTeamUpdateTeamPayloadTeam.UpcomingCycleCount, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetUpcomingCycleCount() float64 {
-	return v.Team.UpcomingCycleCount
+// GetTimeScheduleCreate returns createTimeScheduleResponse.TimeScheduleCreate, and is useful for accessing the field via an interface.
+func (v *createTimeScheduleResponse) GetTimeScheduleCreate() createTimeScheduleTimeScheduleCreateTimeSchedulePayload {
+	return v.TimeScheduleCreate
 }
 
-// GetCycleIssueAutoAssignStarted returns updateTeamTeamUpdateTeamPayloadTeam.CycleIssueAutoAssignStarted, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetCycleIssueAutoAssignStarted() bool {
-	return v.Team.CycleIssueAutoAssignStarted
+// createTimeScheduleTimeScheduleCreateTimeSchedulePayload includes the requested fields of the GraphQL type TimeSchedulePayload.
+type createTimeScheduleTimeScheduleCreateTimeSchedulePayload struct {
+	TimeSchedule createTimeScheduleTimeScheduleCreateTimeSchedulePayloadTimeSchedule `json:"timeSchedule"`
 }
 
-// GetCycleIssueAutoAssignCompleted returns updateTeamTeamUpdateTeamPayloadTeam.CycleIssueAutoAssignCompleted, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetCycleIssueAutoAssignCompleted() bool {
-	return v.Team.CycleIssueAutoAssignCompleted
+// GetTimeSchedule returns createTimeScheduleTimeScheduleCreateTimeSchedulePayload.TimeSchedule, and is useful for accessing the field via an interface.
+func (v *createTimeScheduleTimeScheduleCreateTimeSchedulePayload) GetTimeSchedule() createTimeScheduleTimeScheduleCreateTimeSchedulePayloadTimeSchedule {
+	return v.TimeSchedule
 }
 
-// GetCycleLockToActive returns updateTeamTeamUpdateTeamPayloadTeam.CycleLockToActive, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetCycleLockToActive() bool {
-	return v.Team.CycleLockToActive
+// createTimeScheduleTimeScheduleCreateTimeSchedulePayloadTimeSchedule includes the requested fields of the GraphQL type TimeSchedule.
+// The GraphQL type's documentation follows.
+//
+// A time schedule.
+type createTimeScheduleTimeScheduleCreateTimeSchedulePayloadTimeSchedule struct {
+	TimeSchedule `json:"-"`
 }
 
-// GetIssueEstimationType returns updateTeamTeamUpdateTeamPayloadTeam.IssueEstimationType, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetIssueEstimationType() string {
-	return v.Team.IssueEstimationType
+// GetId returns createTimeScheduleTimeScheduleCreateTimeSchedulePayloadTimeSchedule.Id, and is useful for accessing the field via an interface.
+func (v *createTimeScheduleTimeScheduleCreateTimeSchedulePayloadTimeSchedule) GetId() string {
+	return v.TimeSchedule.Id
 }
 
-// GetIssueEstimationAllowZero returns updateTeamTeamUpdateTeamPayloadTeam.IssueEstimationAllowZero, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetIssueEstimationAllowZero() bool {
-	return v.Team.IssueEstimationAllowZero
+// GetName returns createTimeScheduleTimeScheduleCreateTimeSchedulePayloadTimeSchedule.Name, and is useful for accessing the field via an interface.
+func (v *createTimeScheduleTimeScheduleCreateTimeSchedulePayloadTimeSchedule) GetName() string {
+	return v.TimeSchedule.Name
 }
 
-// GetIssueEstimationExtended returns updateTeamTeamUpdateTeamPayloadTeam.IssueEstimationExtended, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetIssueEstimationExtended() bool {
-	return v.Team.IssueEstimationExtended
+// GetEntries returns createTimeScheduleTimeScheduleCreateTimeSchedulePayloadTimeSchedule.Entries, and is useful for accessing the field via an interface.
+func (v *createTimeScheduleTimeScheduleCreateTimeSchedulePayloadTimeSchedule) GetEntries() []TimeScheduleEntriesTimeScheduleEntry {
+	return v.TimeSchedule.Entries
 }
 
-// GetDefaultIssueEstimate returns updateTeamTeamUpdateTeamPayloadTeam.DefaultIssueEstimate, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetDefaultIssueEstimate() float64 {
-	return v.Team.DefaultIssueEstimate
+// GetArchivedAt returns createTimeScheduleTimeScheduleCreateTimeSchedulePayloadTimeSchedule.ArchivedAt, and is useful for accessing the field via an interface.
+func (v *createTimeScheduleTimeScheduleCreateTimeSchedulePayloadTimeSchedule) GetArchivedAt() *time.Time {
+	return v.TimeSchedule.ArchivedAt
 }
 
-func (v *updateTeamTeamUpdateTeamPayloadTeam) UnmarshalJSON(b []byte) error {
+func (v *createTimeScheduleTimeScheduleCreateTimeSchedulePayloadTimeSchedule) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*updateTeamTeamUpdateTeamPayloadTeam
+		*createTimeScheduleTimeScheduleCreateTimeSchedulePayloadTimeSchedule
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.updateTeamTeamUpdateTeamPayloadTeam = v
+	firstPass.createTimeScheduleTimeScheduleCreateTimeSchedulePayloadTimeSchedule = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -4148,76 +7121,140 @@ func (v *updateTeamTeamUpdateTeamPayloadTeam) UnmarshalJSON(b []byte) error {
 	}
 
 	err = json.Unmarshal(
-		b, &v.Team)
+		b, &v.TimeSchedule)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-type __premarshalupdateTeamTeamUpdateTeamPayloadTeam struct {
+type __premarshalcreateTimeScheduleTimeScheduleCreateTimeSchedulePayloadTimeSchedule struct {
 	Id string `json:"id"`
 
 	Name string `json:"name"`
 
-	Key string `json:"key"`
-
-	Private bool `json:"private"`
+	Entries []TimeScheduleEntriesTimeScheduleEntry `json:"entries"`
 
-	Description *string `json:"description"`
+	ArchivedAt *time.Time `json:"archivedAt"`
+}
 
-	Icon *string `json:"icon"`
+func (v *createTimeScheduleTimeScheduleCreateTimeSchedulePayloadTimeSchedule) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
 
-	Color *string `json:"color"`
+func (v *createTimeScheduleTimeScheduleCreateTimeSchedulePayloadTimeSchedule) __premarshalJSON() (*__premarshalcreateTimeScheduleTimeScheduleCreateTimeSchedulePayloadTimeSchedule, error) {
+	var retval __premarshalcreateTimeScheduleTimeScheduleCreateTimeSchedulePayloadTimeSchedule
 
-	Parent *TeamParentTeam `json:"parent"`
+	retval.Id = v.TimeSchedule.Id
+	retval.Name = v.TimeSchedule.Name
+	retval.Entries = v.TimeSchedule.Entries
+	retval.ArchivedAt = v.TimeSchedule.ArchivedAt
+	return &retval, nil
+}
 
-	Timezone string `json:"timezone"`
+// createTriageResponsibilityResponse is returned by createTriageResponsibility on success.
+type createTriageResponsibilityResponse struct {
+	// Creates a new triage responsibility.
+	TriageResponsibilityCreate createTriageResponsibilityTriageResponsibilityCreateTriageResponsibilityPayload `json:"triageResponsibilityCreate"`
+}
 
-	GroupIssueHistory bool `json:"groupIssueHistory"`
+// GetTriageResponsibilityCreate returns createTriageResponsibilityResponse.TriageResponsibilityCreate, and is useful for accessing the field via an interface.
+func (v *createTriageResponsibilityResponse) GetTriageResponsibilityCreate() createTriageResponsibilityTriageResponsibilityCreateTriageResponsibilityPayload {
+	return v.TriageResponsibilityCreate
+}
 
-	SetIssueSortOrderOnStateChange string `json:"setIssueSortOrderOnStateChange"`
+// createTriageResponsibilityTriageResponsibilityCreateTriageResponsibilityPayload includes the requested fields of the GraphQL type TriageResponsibilityPayload.
+type createTriageResponsibilityTriageResponsibilityCreateTriageResponsibilityPayload struct {
+	TriageResponsibility createTriageResponsibilityTriageResponsibilityCreateTriageResponsibilityPayloadTriageResponsibility `json:"triageResponsibility"`
+}
 
-	AiThreadSummariesEnabled bool `json:"aiThreadSummariesEnabled"`
+// GetTriageResponsibility returns createTriageResponsibilityTriageResponsibilityCreateTriageResponsibilityPayload.TriageResponsibility, and is useful for accessing the field via an interface.
+func (v *createTriageResponsibilityTriageResponsibilityCreateTriageResponsibilityPayload) GetTriageResponsibility() createTriageResponsibilityTriageResponsibilityCreateTriageResponsibilityPayloadTriageResponsibility {
+	return v.TriageResponsibility
+}
 
-	AutoArchivePeriod float64 `json:"autoArchivePeriod"`
+// createTriageResponsibilityTriageResponsibilityCreateTriageResponsibilityPayloadTriageResponsibility includes the requested fields of the GraphQL type TriageResponsibility.
+// The GraphQL type's documentation follows.
+//
+// A team's triage responsibility.
+type createTriageResponsibilityTriageResponsibilityCreateTriageResponsibilityPayloadTriageResponsibility struct {
+	TriageResponsibility `json:"-"`
+}
 
-	AutoClosePeriod *float64 `json:"autoClosePeriod"`
+// GetId returns createTriageResponsibilityTriageResponsibilityCreateTriageResponsibilityPayloadTriageResponsibility.Id, and is useful for accessing the field via an interface.
+func (v *createTriageResponsibilityTriageResponsibilityCreateTriageResponsibilityPayloadTriageResponsibility) GetId() string {
+	return v.TriageResponsibility.Id
+}
 
-	AutoCloseParentIssues bool `json:"autoCloseParentIssues"`
+// GetAction returns createTriageResponsibilityTriageResponsibilityCreateTriageResponsibilityPayloadTriageResponsibility.Action, and is useful for accessing the field via an interface.
+func (v *createTriageResponsibilityTriageResponsibilityCreateTriageResponsibilityPayloadTriageResponsibility) GetAction() TriageResponsibilityAction {
+	return v.TriageResponsibility.Action
+}
 
-	AutoCloseChildIssues bool `json:"autoCloseChildIssues"`
+// GetManualSelection returns createTriageResponsibilityTriageResponsibilityCreateTriageResponsibilityPayloadTriageResponsibility.ManualSelection, and is useful for accessing the field via an interface.
+func (v *createTriageResponsibilityTriageResponsibilityCreateTriageResponsibilityPayloadTriageResponsibility) GetManualSelection() *TriageResponsibilityManualSelection {
+	return v.TriageResponsibility.ManualSelection
+}
 
-	TriageEnabled bool `json:"triageEnabled"`
+// GetTimeSchedule returns createTriageResponsibilityTriageResponsibilityCreateTriageResponsibilityPayloadTriageResponsibility.TimeSchedule, and is useful for accessing the field via an interface.
+func (v *createTriageResponsibilityTriageResponsibilityCreateTriageResponsibilityPayloadTriageResponsibility) GetTimeSchedule() *TriageResponsibilityTimeSchedule {
+	return v.TriageResponsibility.TimeSchedule
+}
 
-	RequirePriorityToLeaveTriage bool `json:"requirePriorityToLeaveTriage"`
+// GetTeam returns createTriageResponsibilityTriageResponsibilityCreateTriageResponsibilityPayloadTriageResponsibility.Team, and is useful for accessing the field via an interface.
+func (v *createTriageResponsibilityTriageResponsibilityCreateTriageResponsibilityPayloadTriageResponsibility) GetTeam() TriageResponsibilityTeam {
+	return v.TriageResponsibility.Team
+}
 
-	CyclesEnabled bool `json:"cyclesEnabled"`
+// GetArchivedAt returns createTriageResponsibilityTriageResponsibilityCreateTriageResponsibilityPayloadTriageResponsibility.ArchivedAt, and is useful for accessing the field via an interface.
+func (v *createTriageResponsibilityTriageResponsibilityCreateTriageResponsibilityPayloadTriageResponsibility) GetArchivedAt() *time.Time {
+	return v.TriageResponsibility.ArchivedAt
+}
 
-	CycleStartDay float64 `json:"cycleStartDay"`
+func (v *createTriageResponsibilityTriageResponsibilityCreateTriageResponsibilityPayloadTriageResponsibility) UnmarshalJSON(b []byte) error {
 
-	CycleDuration float64 `json:"cycleDuration"`
+	if string(b) == "null" {
+		return nil
+	}
 
-	CycleCooldownTime float64 `json:"cycleCooldownTime"`
+	var firstPass struct {
+		*createTriageResponsibilityTriageResponsibilityCreateTriageResponsibilityPayloadTriageResponsibility
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.createTriageResponsibilityTriageResponsibilityCreateTriageResponsibilityPayloadTriageResponsibility = v
 
-	UpcomingCycleCount float64 `json:"upcomingCycleCount"`
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
 
-	CycleIssueAutoAssignStarted bool `json:"cycleIssueAutoAssignStarted"`
+	err = json.Unmarshal(
+		b, &v.TriageResponsibility)
+	if err != nil {
+		return err
+	}
+	return nil
+}
 
-	CycleIssueAutoAssignCompleted bool `json:"cycleIssueAutoAssignCompleted"`
+type __premarshalcreateTriageResponsibilityTriageResponsibilityCreateTriageResponsibilityPayloadTriageResponsibility struct {
+	Id string `json:"id"`
 
-	CycleLockToActive bool `json:"cycleLockToActive"`
+	Action TriageResponsibilityAction `json:"action"`
 
-	IssueEstimationType string `json:"issueEstimationType"`
+	ManualSelection *TriageResponsibilityManualSelection `json:"manualSelection"`
 
-	IssueEstimationAllowZero bool `json:"issueEstimationAllowZero"`
+	TimeSchedule *TriageResponsibilityTimeSchedule `json:"timeSchedule"`
 
-	IssueEstimationExtended bool `json:"issueEstimationExtended"`
+	Team TriageResponsibilityTeam `json:"team"`
 
-	DefaultIssueEstimate float64 `json:"defaultIssueEstimate"`
+	ArchivedAt *time.Time `json:"archivedAt"`
 }
 
-func (v *updateTeamTeamUpdateTeamPayloadTeam) MarshalJSON() ([]byte, error) {
+func (v *createTriageResponsibilityTriageResponsibilityCreateTriageResponsibilityPayloadTriageResponsibility) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -4225,118 +7262,213 @@ func (v *updateTeamTeamUpdateTeamPayloadTeam) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *updateTeamTeamUpdateTeamPayloadTeam) __premarshalJSON() (*__premarshalupdateTeamTeamUpdateTeamPayloadTeam, error) {
-	var retval __premarshalupdateTeamTeamUpdateTeamPayloadTeam
+func (v *createTriageResponsibilityTriageResponsibilityCreateTriageResponsibilityPayloadTriageResponsibility) __premarshalJSON() (*__premarshalcreateTriageResponsibilityTriageResponsibilityCreateTriageResponsibilityPayloadTriageResponsibility, error) {
+	var retval __premarshalcreateTriageResponsibilityTriageResponsibilityCreateTriageResponsibilityPayloadTriageResponsibility
 
-	retval.Id = v.Team.Id
-	retval.Name = v.Team.Name
-	retval.Key = v.Team.Key
-	retval.Private = v.Team.Private
-	retval.Description = v.Team.Description
-	retval.Icon = v.Team.Icon
-	retval.Color = v.Team.Color
-	retval.Parent = v.Team.Parent
-	retval.Timezone = v.Team.Timezone
-	retval.GroupIssueHistory = v.Team.GroupIssueHistory
-	retval.SetIssueSortOrderOnStateChange = v.Team.SetIssueSortOrderOnStateChange
-	retval.AiThreadSummariesEnabled = v.Team.AiThreadSummariesEnabled
-	retval.AutoArchivePeriod = v.Team.AutoArchivePeriod
-	retval.AutoClosePeriod = v.Team.AutoClosePeriod
-	retval.AutoCloseParentIssues = v.Team.AutoCloseParentIssues
-	retval.AutoCloseChildIssues = v.Team.AutoCloseChildIssues
-	retval.TriageEnabled = v.Team.TriageEnabled
-	retval.RequirePriorityToLeaveTriage = v.Team.RequirePriorityToLeaveTriage
-	retval.CyclesEnabled = v.Team.CyclesEnabled
-	retval.CycleStartDay = v.Team.CycleStartDay
-	retval.CycleDuration = v.Team.CycleDuration
-	retval.CycleCooldownTime = v.Team.CycleCooldownTime
-	retval.UpcomingCycleCount = v.Team.UpcomingCycleCount
-	retval.CycleIssueAutoAssignStarted = v.Team.CycleIssueAutoAssignStarted
-	retval.CycleIssueAutoAssignCompleted = v.Team.CycleIssueAutoAssignCompleted
-	retval.CycleLockToActive = v.Team.CycleLockToActive
-	retval.IssueEstimationType = v.Team.IssueEstimationType
-	retval.IssueEstimationAllowZero = v.Team.IssueEstimationAllowZero
-	retval.IssueEstimationExtended = v.Team.IssueEstimationExtended
-	retval.DefaultIssueEstimate = v.Team.DefaultIssueEstimate
+	retval.Id = v.TriageResponsibility.Id
+	retval.Action = v.TriageResponsibility.Action
+	retval.ManualSelection = v.TriageResponsibility.ManualSelection
+	retval.TimeSchedule = v.TriageResponsibility.TimeSchedule
+	retval.Team = v.TriageResponsibility.Team
+	retval.ArchivedAt = v.TriageResponsibility.ArchivedAt
 	return &retval, nil
 }
 
-// updateWorkflowStateResponse is returned by updateWorkflowState on success.
-type updateWorkflowStateResponse struct {
-	// Updates a state.
-	WorkflowStateUpdate updateWorkflowStateWorkflowStateUpdateWorkflowStatePayload `json:"workflowStateUpdate"`
+// createWebhookResponse is returned by createWebhook on success.
+type createWebhookResponse struct {
+	// Creates a new webhook.
+	WebhookCreate createWebhookWebhookCreateWebhookPayload `json:"webhookCreate"`
 }
 
-// GetWorkflowStateUpdate returns updateWorkflowStateResponse.WorkflowStateUpdate, and is useful for accessing the field via an interface.
-func (v *updateWorkflowStateResponse) GetWorkflowStateUpdate() updateWorkflowStateWorkflowStateUpdateWorkflowStatePayload {
-	return v.WorkflowStateUpdate
+// GetWebhookCreate returns createWebhookResponse.WebhookCreate, and is useful for accessing the field via an interface.
+func (v *createWebhookResponse) GetWebhookCreate() createWebhookWebhookCreateWebhookPayload {
+	return v.WebhookCreate
 }
 
-// updateWorkflowStateWorkflowStateUpdateWorkflowStatePayload includes the requested fields of the GraphQL type WorkflowStatePayload.
-type updateWorkflowStateWorkflowStateUpdateWorkflowStatePayload struct {
+// createWebhookWebhookCreateWebhookPayload includes the requested fields of the GraphQL type WebhookPayload.
+type createWebhookWebhookCreateWebhookPayload struct {
+	// The webhook entity being mutated.
+	Webhook createWebhookWebhookCreateWebhookPayloadWebhook `json:"webhook"`
+}
+
+// GetWebhook returns createWebhookWebhookCreateWebhookPayload.Webhook, and is useful for accessing the field via an interface.
+func (v *createWebhookWebhookCreateWebhookPayload) GetWebhook() createWebhookWebhookCreateWebhookPayloadWebhook {
+	return v.Webhook
+}
+
+// createWebhookWebhookCreateWebhookPayloadWebhook includes the requested fields of the GraphQL type Webhook.
+// The GraphQL type's documentation follows.
+//
+// A webhook used to send HTTP notifications over data updates.
+type createWebhookWebhookCreateWebhookPayloadWebhook struct {
+	Webhook `json:"-"`
+}
+
+// GetId returns createWebhookWebhookCreateWebhookPayloadWebhook.Id, and is useful for accessing the field via an interface.
+func (v *createWebhookWebhookCreateWebhookPayloadWebhook) GetId() string { return v.Webhook.Id }
+
+// GetLabel returns createWebhookWebhookCreateWebhookPayloadWebhook.Label, and is useful for accessing the field via an interface.
+func (v *createWebhookWebhookCreateWebhookPayloadWebhook) GetLabel() *string { return v.Webhook.Label }
+
+// GetUrl returns createWebhookWebhookCreateWebhookPayloadWebhook.Url, and is useful for accessing the field via an interface.
+func (v *createWebhookWebhookCreateWebhookPayloadWebhook) GetUrl() string { return v.Webhook.Url }
+
+// GetEnabled returns createWebhookWebhookCreateWebhookPayloadWebhook.Enabled, and is useful for accessing the field via an interface.
+func (v *createWebhookWebhookCreateWebhookPayloadWebhook) GetEnabled() bool { return v.Webhook.Enabled }
+
+// GetTeam returns createWebhookWebhookCreateWebhookPayloadWebhook.Team, and is useful for accessing the field via an interface.
+func (v *createWebhookWebhookCreateWebhookPayloadWebhook) GetTeam() *WebhookTeam {
+	return v.Webhook.Team
+}
+
+// GetAllPublicTeams returns createWebhookWebhookCreateWebhookPayloadWebhook.AllPublicTeams, and is useful for accessing the field via an interface.
+func (v *createWebhookWebhookCreateWebhookPayloadWebhook) GetAllPublicTeams() bool {
+	return v.Webhook.AllPublicTeams
+}
+
+// GetResourceTypes returns createWebhookWebhookCreateWebhookPayloadWebhook.ResourceTypes, and is useful for accessing the field via an interface.
+func (v *createWebhookWebhookCreateWebhookPayloadWebhook) GetResourceTypes() []string {
+	return v.Webhook.ResourceTypes
+}
+
+func (v *createWebhookWebhookCreateWebhookPayloadWebhook) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*createWebhookWebhookCreateWebhookPayloadWebhook
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.createWebhookWebhookCreateWebhookPayloadWebhook = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Webhook)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalcreateWebhookWebhookCreateWebhookPayloadWebhook struct {
+	Id string `json:"id"`
+
+	Label *string `json:"label"`
+
+	Url string `json:"url"`
+
+	Enabled bool `json:"enabled"`
+
+	Team *WebhookTeam `json:"team"`
+
+	AllPublicTeams bool `json:"allPublicTeams"`
+
+	ResourceTypes []string `json:"resourceTypes"`
+}
+
+func (v *createWebhookWebhookCreateWebhookPayloadWebhook) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *createWebhookWebhookCreateWebhookPayloadWebhook) __premarshalJSON() (*__premarshalcreateWebhookWebhookCreateWebhookPayloadWebhook, error) {
+	var retval __premarshalcreateWebhookWebhookCreateWebhookPayloadWebhook
+
+	retval.Id = v.Webhook.Id
+	retval.Label = v.Webhook.Label
+	retval.Url = v.Webhook.Url
+	retval.Enabled = v.Webhook.Enabled
+	retval.Team = v.Webhook.Team
+	retval.AllPublicTeams = v.Webhook.AllPublicTeams
+	retval.ResourceTypes = v.Webhook.ResourceTypes
+	return &retval, nil
+}
+
+// createWorkflowStateResponse is returned by createWorkflowState on success.
+type createWorkflowStateResponse struct {
+	// Creates a new state, adding it to the workflow of a team.
+	WorkflowStateCreate createWorkflowStateWorkflowStateCreateWorkflowStatePayload `json:"workflowStateCreate"`
+}
+
+// GetWorkflowStateCreate returns createWorkflowStateResponse.WorkflowStateCreate, and is useful for accessing the field via an interface.
+func (v *createWorkflowStateResponse) GetWorkflowStateCreate() createWorkflowStateWorkflowStateCreateWorkflowStatePayload {
+	return v.WorkflowStateCreate
+}
+
+// createWorkflowStateWorkflowStateCreateWorkflowStatePayload includes the requested fields of the GraphQL type WorkflowStatePayload.
+type createWorkflowStateWorkflowStateCreateWorkflowStatePayload struct {
 	// The state that was created or updated.
-	WorkflowState updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState `json:"workflowState"`
+	WorkflowState createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState `json:"workflowState"`
 }
 
-// GetWorkflowState returns updateWorkflowStateWorkflowStateUpdateWorkflowStatePayload.WorkflowState, and is useful for accessing the field via an interface.
-func (v *updateWorkflowStateWorkflowStateUpdateWorkflowStatePayload) GetWorkflowState() updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState {
+// GetWorkflowState returns createWorkflowStateWorkflowStateCreateWorkflowStatePayload.WorkflowState, and is useful for accessing the field via an interface.
+func (v *createWorkflowStateWorkflowStateCreateWorkflowStatePayload) GetWorkflowState() createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState {
 	return v.WorkflowState
 }
 
-// updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState includes the requested fields of the GraphQL type WorkflowState.
+// createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState includes the requested fields of the GraphQL type WorkflowState.
 // The GraphQL type's documentation follows.
 //
 // A state in a team workflow.
-type updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState struct {
+type createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState struct {
 	WorkflowState `json:"-"`
 }
 
-// GetId returns updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState.Id, and is useful for accessing the field via an interface.
-func (v *updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState) GetId() string {
+// GetId returns createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState.Id, and is useful for accessing the field via an interface.
+func (v *createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState) GetId() string {
 	return v.WorkflowState.Id
 }
 
-// GetName returns updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState.Name, and is useful for accessing the field via an interface.
-func (v *updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState) GetName() string {
+// GetName returns createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState.Name, and is useful for accessing the field via an interface.
+func (v *createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState) GetName() string {
 	return v.WorkflowState.Name
 }
 
-// GetColor returns updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState.Color, and is useful for accessing the field via an interface.
-func (v *updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState) GetColor() string {
+// GetColor returns createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState.Color, and is useful for accessing the field via an interface.
+func (v *createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState) GetColor() string {
 	return v.WorkflowState.Color
 }
 
-// GetDescription returns updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState.Description, and is useful for accessing the field via an interface.
-func (v *updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState) GetDescription() *string {
+// GetDescription returns createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState.Description, and is useful for accessing the field via an interface.
+func (v *createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState) GetDescription() *string {
 	return v.WorkflowState.Description
 }
 
-// GetType returns updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState.Type, and is useful for accessing the field via an interface.
-func (v *updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState) GetType() string {
+// GetType returns createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState.Type, and is useful for accessing the field via an interface.
+func (v *createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState) GetType() string {
 	return v.WorkflowState.Type
 }
 
-// GetPosition returns updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState.Position, and is useful for accessing the field via an interface.
-func (v *updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState) GetPosition() float64 {
+// GetPosition returns createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState.Position, and is useful for accessing the field via an interface.
+func (v *createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState) GetPosition() float64 {
 	return v.WorkflowState.Position
 }
 
-// GetTeam returns updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState.Team, and is useful for accessing the field via an interface.
-func (v *updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState) GetTeam() WorkflowStateTeam {
+// GetTeam returns createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState.Team, and is useful for accessing the field via an interface.
+func (v *createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState) GetTeam() WorkflowStateTeam {
 	return v.WorkflowState.Team
 }
 
-func (v *updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState) UnmarshalJSON(b []byte) error {
+func (v *createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState
+		*createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState = v
+	firstPass.createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -4351,7 +7483,7 @@ func (v *updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState
 	return nil
 }
 
-type __premarshalupdateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState struct {
+type __premarshalcreateWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState struct {
 	Id string `json:"id"`
 
 	Name string `json:"name"`
@@ -4367,7 +7499,7 @@ type __premarshalupdateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkf
 	Team WorkflowStateTeam `json:"team"`
 }
 
-func (v *updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState) MarshalJSON() ([]byte, error) {
+func (v *createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -4375,8 +7507,8 @@ func (v *updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState
 	return json.Marshal(premarshaled)
 }
 
-func (v *updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState) __premarshalJSON() (*__premarshalupdateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState, error) {
-	var retval __premarshalupdateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState
+func (v *createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState) __premarshalJSON() (*__premarshalcreateWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState, error) {
+	var retval __premarshalcreateWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState
 
 	retval.Id = v.WorkflowState.Id
 	retval.Name = v.WorkflowState.Name
@@ -4388,234 +7520,10719 @@ func (v *updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState
 	return &retval, nil
 }
 
-// updateWorkspaceSettingsOrganizationUpdateOrganizationPayload includes the requested fields of the GraphQL type OrganizationPayload.
-type updateWorkspaceSettingsOrganizationUpdateOrganizationPayload struct {
-	// The organization that was created or updated.
-	Organization updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization `json:"organization"`
+// deleteCustomViewCustomViewDeleteDeletePayload includes the requested fields of the GraphQL type DeletePayload.
+// The GraphQL type's documentation follows.
+//
+// A generic payload return from entity deletion mutations.
+type deleteCustomViewCustomViewDeleteDeletePayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
 }
 
-// GetOrganization returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayload.Organization, and is useful for accessing the field via an interface.
-func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayload) GetOrganization() updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization {
-	return v.Organization
+// GetSuccess returns deleteCustomViewCustomViewDeleteDeletePayload.Success, and is useful for accessing the field via an interface.
+func (v *deleteCustomViewCustomViewDeleteDeletePayload) GetSuccess() bool { return v.Success }
+
+// deleteCustomViewResponse is returned by deleteCustomView on success.
+type deleteCustomViewResponse struct {
+	// Deletes a custom view.
+	CustomViewDelete deleteCustomViewCustomViewDeleteDeletePayload `json:"customViewDelete"`
 }
 
-// updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization includes the requested fields of the GraphQL type Organization.
+// GetCustomViewDelete returns deleteCustomViewResponse.CustomViewDelete, and is useful for accessing the field via an interface.
+func (v *deleteCustomViewResponse) GetCustomViewDelete() deleteCustomViewCustomViewDeleteDeletePayload {
+	return v.CustomViewDelete
+}
+
+// deleteCycleCycleArchiveCycleArchivePayload includes the requested fields of the GraphQL type CycleArchivePayload.
 // The GraphQL type's documentation follows.
 //
-// An organization. Organizations are root-level objects that contain user accounts and teams.
-type updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization struct {
-	Organization `json:"-"`
+// A generic payload return from entity archive mutations.
+type deleteCycleCycleArchiveCycleArchivePayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
 }
 
-// GetId returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization.Id, and is useful for accessing the field via an interface.
-func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) GetId() string {
-	return v.Organization.Id
-}
+// GetSuccess returns deleteCycleCycleArchiveCycleArchivePayload.Success, and is useful for accessing the field via an interface.
+func (v *deleteCycleCycleArchiveCycleArchivePayload) GetSuccess() bool { return v.Success }
 
-// GetAllowMembersToInvite returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization.AllowMembersToInvite, and is useful for accessing the field via an interface.
-func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) GetAllowMembersToInvite() bool {
-	return v.Organization.AllowMembersToInvite
+// deleteCycleResponse is returned by deleteCycle on success.
+type deleteCycleResponse struct {
+	// Archives a cycle.
+	CycleArchive deleteCycleCycleArchiveCycleArchivePayload `json:"cycleArchive"`
 }
 
-// GetRestrictTeamCreationToAdmins returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization.RestrictTeamCreationToAdmins, and is useful for accessing the field via an interface.
-func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) GetRestrictTeamCreationToAdmins() bool {
-	return v.Organization.RestrictTeamCreationToAdmins
+// GetCycleArchive returns deleteCycleResponse.CycleArchive, and is useful for accessing the field via an interface.
+func (v *deleteCycleResponse) GetCycleArchive() deleteCycleCycleArchiveCycleArchivePayload {
+	return v.CycleArchive
 }
 
-// GetRestrictLabelManagementToAdmins returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization.RestrictLabelManagementToAdmins, and is useful for accessing the field via an interface.
-func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) GetRestrictLabelManagementToAdmins() bool {
-	return v.Organization.RestrictLabelManagementToAdmins
+// deleteEmailIntakeAddressEmailIntakeAddressDeleteDeletePayload includes the requested fields of the GraphQL type DeletePayload.
+// The GraphQL type's documentation follows.
+//
+// A generic payload return from entity deletion mutations.
+type deleteEmailIntakeAddressEmailIntakeAddressDeleteDeletePayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
 }
 
-// GetGitLinkbackMessagesEnabled returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization.GitLinkbackMessagesEnabled, and is useful for accessing the field via an interface.
-func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) GetGitLinkbackMessagesEnabled() bool {
-	return v.Organization.GitLinkbackMessagesEnabled
+// GetSuccess returns deleteEmailIntakeAddressEmailIntakeAddressDeleteDeletePayload.Success, and is useful for accessing the field via an interface.
+func (v *deleteEmailIntakeAddressEmailIntakeAddressDeleteDeletePayload) GetSuccess() bool {
+	return v.Success
 }
 
-// GetGitPublicLinkbackMessagesEnabled returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization.GitPublicLinkbackMessagesEnabled, and is useful for accessing the field via an interface.
-func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) GetGitPublicLinkbackMessagesEnabled() bool {
-	return v.Organization.GitPublicLinkbackMessagesEnabled
+// deleteEmailIntakeAddressResponse is returned by deleteEmailIntakeAddress on success.
+type deleteEmailIntakeAddressResponse struct {
+	// Deletes an email intake address object.
+	EmailIntakeAddressDelete deleteEmailIntakeAddressEmailIntakeAddressDeleteDeletePayload `json:"emailIntakeAddressDelete"`
 }
 
-// GetFiscalYearStartMonth returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization.FiscalYearStartMonth, and is useful for accessing the field via an interface.
-func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) GetFiscalYearStartMonth() float64 {
-	return v.Organization.FiscalYearStartMonth
+// GetEmailIntakeAddressDelete returns deleteEmailIntakeAddressResponse.EmailIntakeAddressDelete, and is useful for accessing the field via an interface.
+func (v *deleteEmailIntakeAddressResponse) GetEmailIntakeAddressDelete() deleteEmailIntakeAddressEmailIntakeAddressDeleteDeletePayload {
+	return v.EmailIntakeAddressDelete
 }
 
-// GetProjectUpdateReminderFrequencyInWeeks returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization.ProjectUpdateReminderFrequencyInWeeks, and is useful for accessing the field via an interface.
-func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) GetProjectUpdateReminderFrequencyInWeeks() float64 {
-	return v.Organization.ProjectUpdateReminderFrequencyInWeeks
+// deleteEmojiEmojiDeleteDeletePayload includes the requested fields of the GraphQL type DeletePayload.
+// The GraphQL type's documentation follows.
+//
+// A generic payload return from entity deletion mutations.
+type deleteEmojiEmojiDeleteDeletePayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
 }
 
-// GetProjectUpdateRemindersDay returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization.ProjectUpdateRemindersDay, and is useful for accessing the field via an interface.
-func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) GetProjectUpdateRemindersDay() Day {
-	return v.Organization.ProjectUpdateRemindersDay
-}
+// GetSuccess returns deleteEmojiEmojiDeleteDeletePayload.Success, and is useful for accessing the field via an interface.
+func (v *deleteEmojiEmojiDeleteDeletePayload) GetSuccess() bool { return v.Success }
 
-// GetProjectUpdateRemindersHour returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization.ProjectUpdateRemindersHour, and is useful for accessing the field via an interface.
-func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) GetProjectUpdateRemindersHour() float64 {
-	return v.Organization.ProjectUpdateRemindersHour
+// deleteEmojiResponse is returned by deleteEmoji on success.
+type deleteEmojiResponse struct {
+	// Deletes an emoji.
+	EmojiDelete deleteEmojiEmojiDeleteDeletePayload `json:"emojiDelete"`
 }
 
-// GetRoadmapEnabled returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization.RoadmapEnabled, and is useful for accessing the field via an interface.
-func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) GetRoadmapEnabled() bool {
-	return v.Organization.RoadmapEnabled
+// GetEmojiDelete returns deleteEmojiResponse.EmojiDelete, and is useful for accessing the field via an interface.
+func (v *deleteEmojiResponse) GetEmojiDelete() deleteEmojiEmojiDeleteDeletePayload {
+	return v.EmojiDelete
 }
 
-// GetInitiativeUpdateReminderFrequencyInWeeks returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization.InitiativeUpdateReminderFrequencyInWeeks, and is useful for accessing the field via an interface.
-func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) GetInitiativeUpdateReminderFrequencyInWeeks() float64 {
-	return v.Organization.InitiativeUpdateReminderFrequencyInWeeks
+// deleteGitAutomationStateGitAutomationStateDeleteDeletePayload includes the requested fields of the GraphQL type DeletePayload.
+// The GraphQL type's documentation follows.
+//
+// A generic payload return from entity deletion mutations.
+type deleteGitAutomationStateGitAutomationStateDeleteDeletePayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
 }
 
-// GetInitiativeUpdateRemindersDay returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization.InitiativeUpdateRemindersDay, and is useful for accessing the field via an interface.
-func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) GetInitiativeUpdateRemindersDay() Day {
-	return v.Organization.InitiativeUpdateRemindersDay
+// GetSuccess returns deleteGitAutomationStateGitAutomationStateDeleteDeletePayload.Success, and is useful for accessing the field via an interface.
+func (v *deleteGitAutomationStateGitAutomationStateDeleteDeletePayload) GetSuccess() bool {
+	return v.Success
 }
 
-// GetInitiativeUpdateRemindersHour returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization.InitiativeUpdateRemindersHour, and is useful for accessing the field via an interface.
-func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) GetInitiativeUpdateRemindersHour() float64 {
-	return v.Organization.InitiativeUpdateRemindersHour
+// deleteGitAutomationStateResponse is returned by deleteGitAutomationState on success.
+type deleteGitAutomationStateResponse struct {
+	// Archives an automation state.
+	GitAutomationStateDelete deleteGitAutomationStateGitAutomationStateDeleteDeletePayload `json:"gitAutomationStateDelete"`
 }
 
-// GetFeedEnabled returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization.FeedEnabled, and is useful for accessing the field via an interface.
-func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) GetFeedEnabled() bool {
-	return v.Organization.FeedEnabled
+// GetGitAutomationStateDelete returns deleteGitAutomationStateResponse.GitAutomationStateDelete, and is useful for accessing the field via an interface.
+func (v *deleteGitAutomationStateResponse) GetGitAutomationStateDelete() deleteGitAutomationStateGitAutomationStateDeleteDeletePayload {
+	return v.GitAutomationStateDelete
 }
 
-// GetDefaultFeedSummarySchedule returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization.DefaultFeedSummarySchedule, and is useful for accessing the field via an interface.
-func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) GetDefaultFeedSummarySchedule() FeedSummarySchedule {
-	return v.Organization.DefaultFeedSummarySchedule
+// deleteGitAutomationTargetBranchGitAutomationTargetBranchDeleteDeletePayload includes the requested fields of the GraphQL type DeletePayload.
+// The GraphQL type's documentation follows.
+//
+// A generic payload return from entity deletion mutations.
+type deleteGitAutomationTargetBranchGitAutomationTargetBranchDeleteDeletePayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
 }
 
-// GetCustomersEnabled returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization.CustomersEnabled, and is useful for accessing the field via an interface.
-func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) GetCustomersEnabled() bool {
-	return v.Organization.CustomersEnabled
+// GetSuccess returns deleteGitAutomationTargetBranchGitAutomationTargetBranchDeleteDeletePayload.Success, and is useful for accessing the field via an interface.
+func (v *deleteGitAutomationTargetBranchGitAutomationTargetBranchDeleteDeletePayload) GetSuccess() bool {
+	return v.Success
 }
 
-func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) UnmarshalJSON(b []byte) error {
+// deleteGitAutomationTargetBranchResponse is returned by deleteGitAutomationTargetBranch on success.
+type deleteGitAutomationTargetBranchResponse struct {
+	// Archives a Git target branch automation.
+	GitAutomationTargetBranchDelete deleteGitAutomationTargetBranchGitAutomationTargetBranchDeleteDeletePayload `json:"gitAutomationTargetBranchDelete"`
+}
 
-	if string(b) == "null" {
-		return nil
-	}
+// GetGitAutomationTargetBranchDelete returns deleteGitAutomationTargetBranchResponse.GitAutomationTargetBranchDelete, and is useful for accessing the field via an interface.
+func (v *deleteGitAutomationTargetBranchResponse) GetGitAutomationTargetBranchDelete() deleteGitAutomationTargetBranchGitAutomationTargetBranchDeleteDeletePayload {
+	return v.GitAutomationTargetBranchDelete
+}
 
-	var firstPass struct {
-		*updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization
-		graphql.NoUnmarshalJSON
-	}
-	firstPass.updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization = v
+// deleteInitiativeInitiativeDeleteDeletePayload includes the requested fields of the GraphQL type DeletePayload.
+// The GraphQL type's documentation follows.
+//
+// A generic payload return from entity deletion mutations.
+type deleteInitiativeInitiativeDeleteDeletePayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
+}
 
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
-	}
+// GetSuccess returns deleteInitiativeInitiativeDeleteDeletePayload.Success, and is useful for accessing the field via an interface.
+func (v *deleteInitiativeInitiativeDeleteDeletePayload) GetSuccess() bool { return v.Success }
 
-	err = json.Unmarshal(
-		b, &v.Organization)
-	if err != nil {
-		return err
-	}
-	return nil
+// deleteInitiativeRelationInitiativeRelationDeleteDeletePayload includes the requested fields of the GraphQL type DeletePayload.
+// The GraphQL type's documentation follows.
+//
+// A generic payload return from entity deletion mutations.
+type deleteInitiativeRelationInitiativeRelationDeleteDeletePayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
 }
 
-type __premarshalupdateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization struct {
-	Id string `json:"id"`
+// GetSuccess returns deleteInitiativeRelationInitiativeRelationDeleteDeletePayload.Success, and is useful for accessing the field via an interface.
+func (v *deleteInitiativeRelationInitiativeRelationDeleteDeletePayload) GetSuccess() bool {
+	return v.Success
+}
 
-	AllowMembersToInvite bool `json:"allowMembersToInvite"`
+// deleteInitiativeRelationResponse is returned by deleteInitiativeRelation on success.
+type deleteInitiativeRelationResponse struct {
+	// Deletes an initiative relation.
+	InitiativeRelationDelete deleteInitiativeRelationInitiativeRelationDeleteDeletePayload `json:"initiativeRelationDelete"`
+}
 
-	RestrictTeamCreationToAdmins bool `json:"restrictTeamCreationToAdmins"`
+// GetInitiativeRelationDelete returns deleteInitiativeRelationResponse.InitiativeRelationDelete, and is useful for accessing the field via an interface.
+func (v *deleteInitiativeRelationResponse) GetInitiativeRelationDelete() deleteInitiativeRelationInitiativeRelationDeleteDeletePayload {
+	return v.InitiativeRelationDelete
+}
 
-	RestrictLabelManagementToAdmins bool `json:"restrictLabelManagementToAdmins"`
+// deleteInitiativeResponse is returned by deleteInitiative on success.
+type deleteInitiativeResponse struct {
+	// Deletes (trashes) an initiative.
+	InitiativeDelete deleteInitiativeInitiativeDeleteDeletePayload `json:"initiativeDelete"`
+}
 
-	GitLinkbackMessagesEnabled bool `json:"gitLinkbackMessagesEnabled"`
+// GetInitiativeDelete returns deleteInitiativeResponse.InitiativeDelete, and is useful for accessing the field via an interface.
+func (v *deleteInitiativeResponse) GetInitiativeDelete() deleteInitiativeInitiativeDeleteDeletePayload {
+	return v.InitiativeDelete
+}
 
-	GitPublicLinkbackMessagesEnabled bool `json:"gitPublicLinkbackMessagesEnabled"`
+// deleteInitiativeToProjectInitiativeToProjectDeleteDeletePayload includes the requested fields of the GraphQL type DeletePayload.
+// The GraphQL type's documentation follows.
+//
+// A generic payload return from entity deletion mutations.
+type deleteInitiativeToProjectInitiativeToProjectDeleteDeletePayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
+}
 
-	FiscalYearStartMonth float64 `json:"fiscalYearStartMonth"`
+// GetSuccess returns deleteInitiativeToProjectInitiativeToProjectDeleteDeletePayload.Success, and is useful for accessing the field via an interface.
+func (v *deleteInitiativeToProjectInitiativeToProjectDeleteDeletePayload) GetSuccess() bool {
+	return v.Success
+}
 
-	ProjectUpdateReminderFrequencyInWeeks float64 `json:"projectUpdateReminderFrequencyInWeeks"`
+// deleteInitiativeToProjectResponse is returned by deleteInitiativeToProject on success.
+type deleteInitiativeToProjectResponse struct {
+	// Deletes a initiativeToProject.
+	InitiativeToProjectDelete deleteInitiativeToProjectInitiativeToProjectDeleteDeletePayload `json:"initiativeToProjectDelete"`
+}
 
-	ProjectUpdateRemindersDay Day `json:"projectUpdateRemindersDay"`
+// GetInitiativeToProjectDelete returns deleteInitiativeToProjectResponse.InitiativeToProjectDelete, and is useful for accessing the field via an interface.
+func (v *deleteInitiativeToProjectResponse) GetInitiativeToProjectDelete() deleteInitiativeToProjectInitiativeToProjectDeleteDeletePayload {
+	return v.InitiativeToProjectDelete
+}
 
-	ProjectUpdateRemindersHour float64 `json:"projectUpdateRemindersHour"`
+// deleteLabelIssueLabelDeleteDeletePayload includes the requested fields of the GraphQL type DeletePayload.
+// The GraphQL type's documentation follows.
+//
+// A generic payload return from entity deletion mutations.
+type deleteLabelIssueLabelDeleteDeletePayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
+}
 
-	RoadmapEnabled bool `json:"roadmapEnabled"`
+// GetSuccess returns deleteLabelIssueLabelDeleteDeletePayload.Success, and is useful for accessing the field via an interface.
+func (v *deleteLabelIssueLabelDeleteDeletePayload) GetSuccess() bool { return v.Success }
 
-	InitiativeUpdateReminderFrequencyInWeeks float64 `json:"initiativeUpdateReminderFrequencyInWeeks"`
+// deleteLabelResponse is returned by deleteLabel on success.
+type deleteLabelResponse struct {
+	// Deletes an issue label.
+	IssueLabelDelete deleteLabelIssueLabelDeleteDeletePayload `json:"issueLabelDelete"`
+}
 
-	InitiativeUpdateRemindersDay Day `json:"initiativeUpdateRemindersDay"`
+// GetIssueLabelDelete returns deleteLabelResponse.IssueLabelDelete, and is useful for accessing the field via an interface.
+func (v *deleteLabelResponse) GetIssueLabelDelete() deleteLabelIssueLabelDeleteDeletePayload {
+	return v.IssueLabelDelete
+}
 
-	InitiativeUpdateRemindersHour float64 `json:"initiativeUpdateRemindersHour"`
+// deleteOrganizationDomainOrganizationDomainDeleteDeletePayload includes the requested fields of the GraphQL type DeletePayload.
+// The GraphQL type's documentation follows.
+//
+// A generic payload return from entity deletion mutations.
+type deleteOrganizationDomainOrganizationDomainDeleteDeletePayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
+}
 
-	FeedEnabled bool `json:"feedEnabled"`
+// GetSuccess returns deleteOrganizationDomainOrganizationDomainDeleteDeletePayload.Success, and is useful for accessing the field via an interface.
+func (v *deleteOrganizationDomainOrganizationDomainDeleteDeletePayload) GetSuccess() bool {
+	return v.Success
+}
 
-	DefaultFeedSummarySchedule FeedSummarySchedule `json:"defaultFeedSummarySchedule"`
+// deleteOrganizationDomainResponse is returned by deleteOrganizationDomain on success.
+type deleteOrganizationDomainResponse struct {
+	// Deletes a domain.
+	OrganizationDomainDelete deleteOrganizationDomainOrganizationDomainDeleteDeletePayload `json:"organizationDomainDelete"`
+}
 
-	CustomersEnabled bool `json:"customersEnabled"`
+// GetOrganizationDomainDelete returns deleteOrganizationDomainResponse.OrganizationDomainDelete, and is useful for accessing the field via an interface.
+func (v *deleteOrganizationDomainResponse) GetOrganizationDomainDelete() deleteOrganizationDomainOrganizationDomainDeleteDeletePayload {
+	return v.OrganizationDomainDelete
 }
 
-func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-	return json.Marshal(premarshaled)
+// deleteOrganizationInviteOrganizationInviteDeleteDeletePayload includes the requested fields of the GraphQL type DeletePayload.
+// The GraphQL type's documentation follows.
+//
+// A generic payload return from entity deletion mutations.
+type deleteOrganizationInviteOrganizationInviteDeleteDeletePayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
 }
 
-func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) __premarshalJSON() (*__premarshalupdateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization, error) {
-	var retval __premarshalupdateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization
+// GetSuccess returns deleteOrganizationInviteOrganizationInviteDeleteDeletePayload.Success, and is useful for accessing the field via an interface.
+func (v *deleteOrganizationInviteOrganizationInviteDeleteDeletePayload) GetSuccess() bool {
+	return v.Success
+}
 
-	retval.Id = v.Organization.Id
-	retval.AllowMembersToInvite = v.Organization.AllowMembersToInvite
-	retval.RestrictTeamCreationToAdmins = v.Organization.RestrictTeamCreationToAdmins
-	retval.RestrictLabelManagementToAdmins = v.Organization.RestrictLabelManagementToAdmins
-	retval.GitLinkbackMessagesEnabled = v.Organization.GitLinkbackMessagesEnabled
-	retval.GitPublicLinkbackMessagesEnabled = v.Organization.GitPublicLinkbackMessagesEnabled
-	retval.FiscalYearStartMonth = v.Organization.FiscalYearStartMonth
-	retval.ProjectUpdateReminderFrequencyInWeeks = v.Organization.ProjectUpdateReminderFrequencyInWeeks
-	retval.ProjectUpdateRemindersDay = v.Organization.ProjectUpdateRemindersDay
-	retval.ProjectUpdateRemindersHour = v.Organization.ProjectUpdateRemindersHour
-	retval.RoadmapEnabled = v.Organization.RoadmapEnabled
-	retval.InitiativeUpdateReminderFrequencyInWeeks = v.Organization.InitiativeUpdateReminderFrequencyInWeeks
-	retval.InitiativeUpdateRemindersDay = v.Organization.InitiativeUpdateRemindersDay
-	retval.InitiativeUpdateRemindersHour = v.Organization.InitiativeUpdateRemindersHour
-	retval.FeedEnabled = v.Organization.FeedEnabled
-	retval.DefaultFeedSummarySchedule = v.Organization.DefaultFeedSummarySchedule
-	retval.CustomersEnabled = v.Organization.CustomersEnabled
-	return &retval, nil
+// deleteOrganizationInviteResponse is returned by deleteOrganizationInvite on success.
+type deleteOrganizationInviteResponse struct {
+	// Deletes an organization invite.
+	OrganizationInviteDelete deleteOrganizationInviteOrganizationInviteDeleteDeletePayload `json:"organizationInviteDelete"`
 }
 
-// updateWorkspaceSettingsResponse is returned by updateWorkspaceSettings on success.
-type updateWorkspaceSettingsResponse struct {
-	// Updates the user's organization.
-	OrganizationUpdate updateWorkspaceSettingsOrganizationUpdateOrganizationPayload `json:"organizationUpdate"`
+// GetOrganizationInviteDelete returns deleteOrganizationInviteResponse.OrganizationInviteDelete, and is useful for accessing the field via an interface.
+func (v *deleteOrganizationInviteResponse) GetOrganizationInviteDelete() deleteOrganizationInviteOrganizationInviteDeleteDeletePayload {
+	return v.OrganizationInviteDelete
 }
 
-// GetOrganizationUpdate returns updateWorkspaceSettingsResponse.OrganizationUpdate, and is useful for accessing the field via an interface.
-func (v *updateWorkspaceSettingsResponse) GetOrganizationUpdate() updateWorkspaceSettingsOrganizationUpdateOrganizationPayload {
-	return v.OrganizationUpdate
+// deleteProjectMilestoneProjectMilestoneDeleteDeletePayload includes the requested fields of the GraphQL type DeletePayload.
+// The GraphQL type's documentation follows.
+//
+// A generic payload return from entity deletion mutations.
+type deleteProjectMilestoneProjectMilestoneDeleteDeletePayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
 }
 
-func createGitAutomationState(
-	ctx context.Context,
-	client graphql.Client,
-	input GitAutomationStateCreateInput,
-) (*createGitAutomationStateResponse, error) {
-	req := &graphql.Request{
-		OpName: "createGitAutomationState",
-		Query: `
-mutation createGitAutomationState ($input: GitAutomationStateCreateInput!) {
-	gitAutomationStateCreate(input: $input) {
-		success
+// GetSuccess returns deleteProjectMilestoneProjectMilestoneDeleteDeletePayload.Success, and is useful for accessing the field via an interface.
+func (v *deleteProjectMilestoneProjectMilestoneDeleteDeletePayload) GetSuccess() bool {
+	return v.Success
+}
+
+// deleteProjectMilestoneResponse is returned by deleteProjectMilestone on success.
+type deleteProjectMilestoneResponse struct {
+	// Deletes a project milestone.
+	ProjectMilestoneDelete deleteProjectMilestoneProjectMilestoneDeleteDeletePayload `json:"projectMilestoneDelete"`
+}
+
+// GetProjectMilestoneDelete returns deleteProjectMilestoneResponse.ProjectMilestoneDelete, and is useful for accessing the field via an interface.
+func (v *deleteProjectMilestoneResponse) GetProjectMilestoneDelete() deleteProjectMilestoneProjectMilestoneDeleteDeletePayload {
+	return v.ProjectMilestoneDelete
+}
+
+// deleteProjectProjectDeleteProjectArchivePayload includes the requested fields of the GraphQL type ProjectArchivePayload.
+// The GraphQL type's documentation follows.
+//
+// A generic payload return from entity archive mutations.
+type deleteProjectProjectDeleteProjectArchivePayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
+}
+
+// GetSuccess returns deleteProjectProjectDeleteProjectArchivePayload.Success, and is useful for accessing the field via an interface.
+func (v *deleteProjectProjectDeleteProjectArchivePayload) GetSuccess() bool { return v.Success }
+
+// deleteProjectResponse is returned by deleteProject on success.
+type deleteProjectResponse struct {
+	// Deletes (trashes) a project.
+	ProjectDelete deleteProjectProjectDeleteProjectArchivePayload `json:"projectDelete"`
+}
+
+// GetProjectDelete returns deleteProjectResponse.ProjectDelete, and is useful for accessing the field via an interface.
+func (v *deleteProjectResponse) GetProjectDelete() deleteProjectProjectDeleteProjectArchivePayload {
+	return v.ProjectDelete
+}
+
+// deleteProjectStatusProjectStatusArchiveProjectStatusArchivePayload includes the requested fields of the GraphQL type ProjectStatusArchivePayload.
+// The GraphQL type's documentation follows.
+//
+// A generic payload return from entity archive mutations.
+type deleteProjectStatusProjectStatusArchiveProjectStatusArchivePayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
+}
+
+// GetSuccess returns deleteProjectStatusProjectStatusArchiveProjectStatusArchivePayload.Success, and is useful for accessing the field via an interface.
+func (v *deleteProjectStatusProjectStatusArchiveProjectStatusArchivePayload) GetSuccess() bool {
+	return v.Success
+}
+
+// deleteProjectStatusResponse is returned by deleteProjectStatus on success.
+type deleteProjectStatusResponse struct {
+	// Archives a project status.
+	ProjectStatusArchive deleteProjectStatusProjectStatusArchiveProjectStatusArchivePayload `json:"projectStatusArchive"`
+}
+
+// GetProjectStatusArchive returns deleteProjectStatusResponse.ProjectStatusArchive, and is useful for accessing the field via an interface.
+func (v *deleteProjectStatusResponse) GetProjectStatusArchive() deleteProjectStatusProjectStatusArchiveProjectStatusArchivePayload {
+	return v.ProjectStatusArchive
+}
+
+// deleteTeamMembershipResponse is returned by deleteTeamMembership on success.
+type deleteTeamMembershipResponse struct {
+	// Deletes a team membership.
+	TeamMembershipDelete deleteTeamMembershipTeamMembershipDeleteDeletePayload `json:"teamMembershipDelete"`
+}
+
+// GetTeamMembershipDelete returns deleteTeamMembershipResponse.TeamMembershipDelete, and is useful for accessing the field via an interface.
+func (v *deleteTeamMembershipResponse) GetTeamMembershipDelete() deleteTeamMembershipTeamMembershipDeleteDeletePayload {
+	return v.TeamMembershipDelete
+}
+
+// deleteTeamMembershipTeamMembershipDeleteDeletePayload includes the requested fields of the GraphQL type DeletePayload.
+// The GraphQL type's documentation follows.
+//
+// A generic payload return from entity deletion mutations.
+type deleteTeamMembershipTeamMembershipDeleteDeletePayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
+}
+
+// GetSuccess returns deleteTeamMembershipTeamMembershipDeleteDeletePayload.Success, and is useful for accessing the field via an interface.
+func (v *deleteTeamMembershipTeamMembershipDeleteDeletePayload) GetSuccess() bool { return v.Success }
+
+// deleteTeamResponse is returned by deleteTeam on success.
+type deleteTeamResponse struct {
+	// Deletes a team.
+	TeamDelete deleteTeamTeamDeleteDeletePayload `json:"teamDelete"`
+}
+
+// GetTeamDelete returns deleteTeamResponse.TeamDelete, and is useful for accessing the field via an interface.
+func (v *deleteTeamResponse) GetTeamDelete() deleteTeamTeamDeleteDeletePayload { return v.TeamDelete }
+
+// deleteTeamTeamDeleteDeletePayload includes the requested fields of the GraphQL type DeletePayload.
+// The GraphQL type's documentation follows.
+//
+// A generic payload return from entity deletion mutations.
+type deleteTeamTeamDeleteDeletePayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
+}
+
+// GetSuccess returns deleteTeamTeamDeleteDeletePayload.Success, and is useful for accessing the field via an interface.
+func (v *deleteTeamTeamDeleteDeletePayload) GetSuccess() bool { return v.Success }
+
+// deleteTimeScheduleResponse is returned by deleteTimeSchedule on success.
+type deleteTimeScheduleResponse struct {
+	// Deletes a time schedule.
+	TimeScheduleDelete deleteTimeScheduleTimeScheduleDeleteDeletePayload `json:"timeScheduleDelete"`
+}
+
+// GetTimeScheduleDelete returns deleteTimeScheduleResponse.TimeScheduleDelete, and is useful for accessing the field via an interface.
+func (v *deleteTimeScheduleResponse) GetTimeScheduleDelete() deleteTimeScheduleTimeScheduleDeleteDeletePayload {
+	return v.TimeScheduleDelete
+}
+
+// deleteTimeScheduleTimeScheduleDeleteDeletePayload includes the requested fields of the GraphQL type DeletePayload.
+// The GraphQL type's documentation follows.
+//
+// A generic payload return from entity deletion mutations.
+type deleteTimeScheduleTimeScheduleDeleteDeletePayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
+}
+
+// GetSuccess returns deleteTimeScheduleTimeScheduleDeleteDeletePayload.Success, and is useful for accessing the field via an interface.
+func (v *deleteTimeScheduleTimeScheduleDeleteDeletePayload) GetSuccess() bool { return v.Success }
+
+// deleteTriageResponsibilityResponse is returned by deleteTriageResponsibility on success.
+type deleteTriageResponsibilityResponse struct {
+	// Deletes a triage responsibility.
+	TriageResponsibilityDelete deleteTriageResponsibilityTriageResponsibilityDeleteDeletePayload `json:"triageResponsibilityDelete"`
+}
+
+// GetTriageResponsibilityDelete returns deleteTriageResponsibilityResponse.TriageResponsibilityDelete, and is useful for accessing the field via an interface.
+func (v *deleteTriageResponsibilityResponse) GetTriageResponsibilityDelete() deleteTriageResponsibilityTriageResponsibilityDeleteDeletePayload {
+	return v.TriageResponsibilityDelete
+}
+
+// deleteTriageResponsibilityTriageResponsibilityDeleteDeletePayload includes the requested fields of the GraphQL type DeletePayload.
+// The GraphQL type's documentation follows.
+//
+// A generic payload return from entity deletion mutations.
+type deleteTriageResponsibilityTriageResponsibilityDeleteDeletePayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
+}
+
+// GetSuccess returns deleteTriageResponsibilityTriageResponsibilityDeleteDeletePayload.Success, and is useful for accessing the field via an interface.
+func (v *deleteTriageResponsibilityTriageResponsibilityDeleteDeletePayload) GetSuccess() bool {
+	return v.Success
+}
+
+// deleteWebhookResponse is returned by deleteWebhook on success.
+type deleteWebhookResponse struct {
+	// Deletes a Webhook.
+	WebhookDelete deleteWebhookWebhookDeleteDeletePayload `json:"webhookDelete"`
+}
+
+// GetWebhookDelete returns deleteWebhookResponse.WebhookDelete, and is useful for accessing the field via an interface.
+func (v *deleteWebhookResponse) GetWebhookDelete() deleteWebhookWebhookDeleteDeletePayload {
+	return v.WebhookDelete
+}
+
+// deleteWebhookWebhookDeleteDeletePayload includes the requested fields of the GraphQL type DeletePayload.
+// The GraphQL type's documentation follows.
+//
+// A generic payload return from entity deletion mutations.
+type deleteWebhookWebhookDeleteDeletePayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
+}
+
+// GetSuccess returns deleteWebhookWebhookDeleteDeletePayload.Success, and is useful for accessing the field via an interface.
+func (v *deleteWebhookWebhookDeleteDeletePayload) GetSuccess() bool { return v.Success }
+
+// deleteWorkflowStateResponse is returned by deleteWorkflowState on success.
+type deleteWorkflowStateResponse struct {
+	// Archives a state. Only states with issues that have all been archived can be archived.
+	WorkflowStateArchive deleteWorkflowStateWorkflowStateArchiveWorkflowStateArchivePayload `json:"workflowStateArchive"`
+}
+
+// GetWorkflowStateArchive returns deleteWorkflowStateResponse.WorkflowStateArchive, and is useful for accessing the field via an interface.
+func (v *deleteWorkflowStateResponse) GetWorkflowStateArchive() deleteWorkflowStateWorkflowStateArchiveWorkflowStateArchivePayload {
+	return v.WorkflowStateArchive
+}
+
+// deleteWorkflowStateWorkflowStateArchiveWorkflowStateArchivePayload includes the requested fields of the GraphQL type WorkflowStateArchivePayload.
+// The GraphQL type's documentation follows.
+//
+// A generic payload return from entity archive mutations.
+type deleteWorkflowStateWorkflowStateArchiveWorkflowStateArchivePayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
+}
+
+// GetSuccess returns deleteWorkflowStateWorkflowStateArchiveWorkflowStateArchivePayload.Success, and is useful for accessing the field via an interface.
+func (v *deleteWorkflowStateWorkflowStateArchiveWorkflowStateArchivePayload) GetSuccess() bool {
+	return v.Success
+}
+
+// findCycleCyclesCycleConnection includes the requested fields of the GraphQL type CycleConnection.
+type findCycleCyclesCycleConnection struct {
+	Nodes []findCycleCyclesCycleConnectionNodesCycle `json:"nodes"`
+}
+
+// GetNodes returns findCycleCyclesCycleConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *findCycleCyclesCycleConnection) GetNodes() []findCycleCyclesCycleConnectionNodesCycle {
+	return v.Nodes
+}
+
+// findCycleCyclesCycleConnectionNodesCycle includes the requested fields of the GraphQL type Cycle.
+// The GraphQL type's documentation follows.
+//
+// A set of issues to be resolved in a specified amount of time.
+type findCycleCyclesCycleConnectionNodesCycle struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
+
+// GetId returns findCycleCyclesCycleConnectionNodesCycle.Id, and is useful for accessing the field via an interface.
+func (v *findCycleCyclesCycleConnectionNodesCycle) GetId() string { return v.Id }
+
+// findCycleResponse is returned by findCycle on success.
+type findCycleResponse struct {
+	// All cycles.
+	Cycles findCycleCyclesCycleConnection `json:"cycles"`
+}
+
+// GetCycles returns findCycleResponse.Cycles, and is useful for accessing the field via an interface.
+func (v *findCycleResponse) GetCycles() findCycleCyclesCycleConnection { return v.Cycles }
+
+// findProjectMilestoneProject includes the requested fields of the GraphQL type Project.
+// The GraphQL type's documentation follows.
+//
+// A project.
+type findProjectMilestoneProject struct {
+	// Milestones associated with the project.
+	ProjectMilestones findProjectMilestoneProjectProjectMilestonesProjectMilestoneConnection `json:"projectMilestones"`
+}
+
+// GetProjectMilestones returns findProjectMilestoneProject.ProjectMilestones, and is useful for accessing the field via an interface.
+func (v *findProjectMilestoneProject) GetProjectMilestones() findProjectMilestoneProjectProjectMilestonesProjectMilestoneConnection {
+	return v.ProjectMilestones
+}
+
+// findProjectMilestoneProjectProjectMilestonesProjectMilestoneConnection includes the requested fields of the GraphQL type ProjectMilestoneConnection.
+type findProjectMilestoneProjectProjectMilestonesProjectMilestoneConnection struct {
+	Nodes []findProjectMilestoneProjectProjectMilestonesProjectMilestoneConnectionNodesProjectMilestone `json:"nodes"`
+}
+
+// GetNodes returns findProjectMilestoneProjectProjectMilestonesProjectMilestoneConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *findProjectMilestoneProjectProjectMilestonesProjectMilestoneConnection) GetNodes() []findProjectMilestoneProjectProjectMilestonesProjectMilestoneConnectionNodesProjectMilestone {
+	return v.Nodes
+}
+
+// findProjectMilestoneProjectProjectMilestonesProjectMilestoneConnectionNodesProjectMilestone includes the requested fields of the GraphQL type ProjectMilestone.
+// The GraphQL type's documentation follows.
+//
+// A milestone for a project.
+type findProjectMilestoneProjectProjectMilestonesProjectMilestoneConnectionNodesProjectMilestone struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
+
+// GetId returns findProjectMilestoneProjectProjectMilestonesProjectMilestoneConnectionNodesProjectMilestone.Id, and is useful for accessing the field via an interface.
+func (v *findProjectMilestoneProjectProjectMilestonesProjectMilestoneConnectionNodesProjectMilestone) GetId() string {
+	return v.Id
+}
+
+// findProjectMilestoneResponse is returned by findProjectMilestone on success.
+type findProjectMilestoneResponse struct {
+	// One specific project.
+	Project findProjectMilestoneProject `json:"project"`
+}
+
+// GetProject returns findProjectMilestoneResponse.Project, and is useful for accessing the field via an interface.
+func (v *findProjectMilestoneResponse) GetProject() findProjectMilestoneProject { return v.Project }
+
+// findTeamLabelIssueLabelsIssueLabelConnection includes the requested fields of the GraphQL type IssueLabelConnection.
+type findTeamLabelIssueLabelsIssueLabelConnection struct {
+	Nodes []findTeamLabelIssueLabelsIssueLabelConnectionNodesIssueLabel `json:"nodes"`
+}
+
+// GetNodes returns findTeamLabelIssueLabelsIssueLabelConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *findTeamLabelIssueLabelsIssueLabelConnection) GetNodes() []findTeamLabelIssueLabelsIssueLabelConnectionNodesIssueLabel {
+	return v.Nodes
+}
+
+// findTeamLabelIssueLabelsIssueLabelConnectionNodesIssueLabel includes the requested fields of the GraphQL type IssueLabel.
+// The GraphQL type's documentation follows.
+//
+// Labels that can be associated with issues.
+type findTeamLabelIssueLabelsIssueLabelConnectionNodesIssueLabel struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
+
+// GetId returns findTeamLabelIssueLabelsIssueLabelConnectionNodesIssueLabel.Id, and is useful for accessing the field via an interface.
+func (v *findTeamLabelIssueLabelsIssueLabelConnectionNodesIssueLabel) GetId() string { return v.Id }
+
+// findTeamLabelResponse is returned by findTeamLabel on success.
+type findTeamLabelResponse struct {
+	// All issue labels.
+	IssueLabels findTeamLabelIssueLabelsIssueLabelConnection `json:"issueLabels"`
+}
+
+// GetIssueLabels returns findTeamLabelResponse.IssueLabels, and is useful for accessing the field via an interface.
+func (v *findTeamLabelResponse) GetIssueLabels() findTeamLabelIssueLabelsIssueLabelConnection {
+	return v.IssueLabels
+}
+
+// findTriageResponsibilityResponse is returned by findTriageResponsibility on success.
+type findTriageResponsibilityResponse struct {
+	// One specific team.
+	Team findTriageResponsibilityTeam `json:"team"`
+}
+
+// GetTeam returns findTriageResponsibilityResponse.Team, and is useful for accessing the field via an interface.
+func (v *findTriageResponsibilityResponse) GetTeam() findTriageResponsibilityTeam { return v.Team }
+
+// findTriageResponsibilityTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type findTriageResponsibilityTeam struct {
+	// Team's triage responsibility.
+	TriageResponsibility *findTriageResponsibilityTeamTriageResponsibility `json:"triageResponsibility"`
+}
+
+// GetTriageResponsibility returns findTriageResponsibilityTeam.TriageResponsibility, and is useful for accessing the field via an interface.
+func (v *findTriageResponsibilityTeam) GetTriageResponsibility() *findTriageResponsibilityTeamTriageResponsibility {
+	return v.TriageResponsibility
+}
+
+// findTriageResponsibilityTeamTriageResponsibility includes the requested fields of the GraphQL type TriageResponsibility.
+// The GraphQL type's documentation follows.
+//
+// A team's triage responsibility.
+type findTriageResponsibilityTeamTriageResponsibility struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
+
+// GetId returns findTriageResponsibilityTeamTriageResponsibility.Id, and is useful for accessing the field via an interface.
+func (v *findTriageResponsibilityTeamTriageResponsibility) GetId() string { return v.Id }
+
+// findWorkflowStateResponse is returned by findWorkflowState on success.
+type findWorkflowStateResponse struct {
+	// All issue workflow states.
+	WorkflowStates findWorkflowStateWorkflowStatesWorkflowStateConnection `json:"workflowStates"`
+}
+
+// GetWorkflowStates returns findWorkflowStateResponse.WorkflowStates, and is useful for accessing the field via an interface.
+func (v *findWorkflowStateResponse) GetWorkflowStates() findWorkflowStateWorkflowStatesWorkflowStateConnection {
+	return v.WorkflowStates
+}
+
+// findWorkflowStateWorkflowStatesWorkflowStateConnection includes the requested fields of the GraphQL type WorkflowStateConnection.
+type findWorkflowStateWorkflowStatesWorkflowStateConnection struct {
+	Nodes []findWorkflowStateWorkflowStatesWorkflowStateConnectionNodesWorkflowState `json:"nodes"`
+}
+
+// GetNodes returns findWorkflowStateWorkflowStatesWorkflowStateConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *findWorkflowStateWorkflowStatesWorkflowStateConnection) GetNodes() []findWorkflowStateWorkflowStatesWorkflowStateConnectionNodesWorkflowState {
+	return v.Nodes
+}
+
+// findWorkflowStateWorkflowStatesWorkflowStateConnectionNodesWorkflowState includes the requested fields of the GraphQL type WorkflowState.
+// The GraphQL type's documentation follows.
+//
+// A state in a team workflow.
+type findWorkflowStateWorkflowStatesWorkflowStateConnectionNodesWorkflowState struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
+
+// GetId returns findWorkflowStateWorkflowStatesWorkflowStateConnectionNodesWorkflowState.Id, and is useful for accessing the field via an interface.
+func (v *findWorkflowStateWorkflowStatesWorkflowStateConnectionNodesWorkflowState) GetId() string {
+	return v.Id
+}
+
+// findWorkspaceLabelIssueLabelsIssueLabelConnection includes the requested fields of the GraphQL type IssueLabelConnection.
+type findWorkspaceLabelIssueLabelsIssueLabelConnection struct {
+	Nodes []findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabel `json:"nodes"`
+}
+
+// GetNodes returns findWorkspaceLabelIssueLabelsIssueLabelConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *findWorkspaceLabelIssueLabelsIssueLabelConnection) GetNodes() []findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabel {
+	return v.Nodes
+}
+
+// findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabel includes the requested fields of the GraphQL type IssueLabel.
+// The GraphQL type's documentation follows.
+//
+// Labels that can be associated with issues.
+type findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabel struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The team that the label is associated with. If null, the label is associated with the global workspace.
+	Team findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabelTeam `json:"team"`
+}
+
+// GetId returns findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabel.Id, and is useful for accessing the field via an interface.
+func (v *findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabel) GetId() string {
+	return v.Id
+}
+
+// GetTeam returns findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabel.Team, and is useful for accessing the field via an interface.
+func (v *findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabel) GetTeam() findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabelTeam {
+	return v.Team
+}
+
+// findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabelTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabelTeam struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
+
+// GetId returns findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabelTeam.Id, and is useful for accessing the field via an interface.
+func (v *findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabelTeam) GetId() string {
+	return v.Id
+}
+
+// findWorkspaceLabelResponse is returned by findWorkspaceLabel on success.
+type findWorkspaceLabelResponse struct {
+	// All issue labels.
+	IssueLabels findWorkspaceLabelIssueLabelsIssueLabelConnection `json:"issueLabels"`
+}
+
+// GetIssueLabels returns findWorkspaceLabelResponse.IssueLabels, and is useful for accessing the field via an interface.
+func (v *findWorkspaceLabelResponse) GetIssueLabels() findWorkspaceLabelIssueLabelsIssueLabelConnection {
+	return v.IssueLabels
+}
+
+// getCustomViewCustomView includes the requested fields of the GraphQL type CustomView.
+// The GraphQL type's documentation follows.
+//
+// A custom view that has been saved by a user.
+type getCustomViewCustomView struct {
+	CustomView `json:"-"`
+}
+
+// GetId returns getCustomViewCustomView.Id, and is useful for accessing the field via an interface.
+func (v *getCustomViewCustomView) GetId() string { return v.CustomView.Id }
+
+// GetName returns getCustomViewCustomView.Name, and is useful for accessing the field via an interface.
+func (v *getCustomViewCustomView) GetName() string { return v.CustomView.Name }
+
+// GetDescription returns getCustomViewCustomView.Description, and is useful for accessing the field via an interface.
+func (v *getCustomViewCustomView) GetDescription() *string { return v.CustomView.Description }
+
+// GetIcon returns getCustomViewCustomView.Icon, and is useful for accessing the field via an interface.
+func (v *getCustomViewCustomView) GetIcon() *string { return v.CustomView.Icon }
+
+// GetColor returns getCustomViewCustomView.Color, and is useful for accessing the field via an interface.
+func (v *getCustomViewCustomView) GetColor() *string { return v.CustomView.Color }
+
+// GetTeam returns getCustomViewCustomView.Team, and is useful for accessing the field via an interface.
+func (v *getCustomViewCustomView) GetTeam() *CustomViewTeam { return v.CustomView.Team }
+
+// GetShared returns getCustomViewCustomView.Shared, and is useful for accessing the field via an interface.
+func (v *getCustomViewCustomView) GetShared() bool { return v.CustomView.Shared }
+
+// GetFilterData returns getCustomViewCustomView.FilterData, and is useful for accessing the field via an interface.
+func (v *getCustomViewCustomView) GetFilterData() map[string]interface{} {
+	return v.CustomView.FilterData
+}
+
+// GetProjectFilterData returns getCustomViewCustomView.ProjectFilterData, and is useful for accessing the field via an interface.
+func (v *getCustomViewCustomView) GetProjectFilterData() map[string]interface{} {
+	return v.CustomView.ProjectFilterData
+}
+
+func (v *getCustomViewCustomView) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getCustomViewCustomView
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getCustomViewCustomView = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.CustomView)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetCustomViewCustomView struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description *string `json:"description"`
+
+	Icon *string `json:"icon"`
+
+	Color *string `json:"color"`
+
+	Team *CustomViewTeam `json:"team"`
+
+	Shared bool `json:"shared"`
+
+	FilterData map[string]interface{} `json:"filterData"`
+
+	ProjectFilterData map[string]interface{} `json:"projectFilterData"`
+}
+
+func (v *getCustomViewCustomView) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getCustomViewCustomView) __premarshalJSON() (*__premarshalgetCustomViewCustomView, error) {
+	var retval __premarshalgetCustomViewCustomView
+
+	retval.Id = v.CustomView.Id
+	retval.Name = v.CustomView.Name
+	retval.Description = v.CustomView.Description
+	retval.Icon = v.CustomView.Icon
+	retval.Color = v.CustomView.Color
+	retval.Team = v.CustomView.Team
+	retval.Shared = v.CustomView.Shared
+	retval.FilterData = v.CustomView.FilterData
+	retval.ProjectFilterData = v.CustomView.ProjectFilterData
+	return &retval, nil
+}
+
+// getCustomViewResponse is returned by getCustomView on success.
+type getCustomViewResponse struct {
+	// One specific custom view.
+	CustomView getCustomViewCustomView `json:"customView"`
+}
+
+// GetCustomView returns getCustomViewResponse.CustomView, and is useful for accessing the field via an interface.
+func (v *getCustomViewResponse) GetCustomView() getCustomViewCustomView { return v.CustomView }
+
+// getCycleCycle includes the requested fields of the GraphQL type Cycle.
+// The GraphQL type's documentation follows.
+//
+// A set of issues to be resolved in a specified amount of time.
+type getCycleCycle struct {
+	Cycle `json:"-"`
+}
+
+// GetId returns getCycleCycle.Id, and is useful for accessing the field via an interface.
+func (v *getCycleCycle) GetId() string { return v.Cycle.Id }
+
+// GetNumber returns getCycleCycle.Number, and is useful for accessing the field via an interface.
+func (v *getCycleCycle) GetNumber() float64 { return v.Cycle.Number }
+
+// GetName returns getCycleCycle.Name, and is useful for accessing the field via an interface.
+func (v *getCycleCycle) GetName() *string { return v.Cycle.Name }
+
+// GetDescription returns getCycleCycle.Description, and is useful for accessing the field via an interface.
+func (v *getCycleCycle) GetDescription() *string { return v.Cycle.Description }
+
+// GetStartsAt returns getCycleCycle.StartsAt, and is useful for accessing the field via an interface.
+func (v *getCycleCycle) GetStartsAt() time.Time { return v.Cycle.StartsAt }
+
+// GetEndsAt returns getCycleCycle.EndsAt, and is useful for accessing the field via an interface.
+func (v *getCycleCycle) GetEndsAt() time.Time { return v.Cycle.EndsAt }
+
+// GetTeam returns getCycleCycle.Team, and is useful for accessing the field via an interface.
+func (v *getCycleCycle) GetTeam() CycleTeam { return v.Cycle.Team }
+
+// GetArchivedAt returns getCycleCycle.ArchivedAt, and is useful for accessing the field via an interface.
+func (v *getCycleCycle) GetArchivedAt() *time.Time { return v.Cycle.ArchivedAt }
+
+func (v *getCycleCycle) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getCycleCycle
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getCycleCycle = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Cycle)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetCycleCycle struct {
+	Id string `json:"id"`
+
+	Number float64 `json:"number"`
+
+	Name *string `json:"name"`
+
+	Description *string `json:"description"`
+
+	StartsAt time.Time `json:"startsAt"`
+
+	EndsAt time.Time `json:"endsAt"`
+
+	Team CycleTeam `json:"team"`
+
+	ArchivedAt *time.Time `json:"archivedAt"`
+}
+
+func (v *getCycleCycle) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getCycleCycle) __premarshalJSON() (*__premarshalgetCycleCycle, error) {
+	var retval __premarshalgetCycleCycle
+
+	retval.Id = v.Cycle.Id
+	retval.Number = v.Cycle.Number
+	retval.Name = v.Cycle.Name
+	retval.Description = v.Cycle.Description
+	retval.StartsAt = v.Cycle.StartsAt
+	retval.EndsAt = v.Cycle.EndsAt
+	retval.Team = v.Cycle.Team
+	retval.ArchivedAt = v.Cycle.ArchivedAt
+	return &retval, nil
+}
+
+// getCycleResponse is returned by getCycle on success.
+type getCycleResponse struct {
+	// One specific cycle.
+	Cycle getCycleCycle `json:"cycle"`
+}
+
+// GetCycle returns getCycleResponse.Cycle, and is useful for accessing the field via an interface.
+func (v *getCycleResponse) GetCycle() getCycleCycle { return v.Cycle }
+
+// getEmailIntakeAddressEmailIntakeAddress includes the requested fields of the GraphQL type EmailIntakeAddress.
+// The GraphQL type's documentation follows.
+//
+// An email address that can be used for submitting issues.
+type getEmailIntakeAddressEmailIntakeAddress struct {
+	EmailIntakeAddress `json:"-"`
+}
+
+// GetId returns getEmailIntakeAddressEmailIntakeAddress.Id, and is useful for accessing the field via an interface.
+func (v *getEmailIntakeAddressEmailIntakeAddress) GetId() string { return v.EmailIntakeAddress.Id }
+
+// GetAddress returns getEmailIntakeAddressEmailIntakeAddress.Address, and is useful for accessing the field via an interface.
+func (v *getEmailIntakeAddressEmailIntakeAddress) GetAddress() string {
+	return v.EmailIntakeAddress.Address
+}
+
+// GetForwardingEmailAddress returns getEmailIntakeAddressEmailIntakeAddress.ForwardingEmailAddress, and is useful for accessing the field via an interface.
+func (v *getEmailIntakeAddressEmailIntakeAddress) GetForwardingEmailAddress() *string {
+	return v.EmailIntakeAddress.ForwardingEmailAddress
+}
+
+// GetSenderName returns getEmailIntakeAddressEmailIntakeAddress.SenderName, and is useful for accessing the field via an interface.
+func (v *getEmailIntakeAddressEmailIntakeAddress) GetSenderName() *string {
+	return v.EmailIntakeAddress.SenderName
+}
+
+// GetEnabled returns getEmailIntakeAddressEmailIntakeAddress.Enabled, and is useful for accessing the field via an interface.
+func (v *getEmailIntakeAddressEmailIntakeAddress) GetEnabled() bool {
+	return v.EmailIntakeAddress.Enabled
+}
+
+// GetRepliesEnabled returns getEmailIntakeAddressEmailIntakeAddress.RepliesEnabled, and is useful for accessing the field via an interface.
+func (v *getEmailIntakeAddressEmailIntakeAddress) GetRepliesEnabled() bool {
+	return v.EmailIntakeAddress.RepliesEnabled
+}
+
+// GetTeam returns getEmailIntakeAddressEmailIntakeAddress.Team, and is useful for accessing the field via an interface.
+func (v *getEmailIntakeAddressEmailIntakeAddress) GetTeam() *EmailIntakeAddressTeam {
+	return v.EmailIntakeAddress.Team
+}
+
+// GetTemplate returns getEmailIntakeAddressEmailIntakeAddress.Template, and is useful for accessing the field via an interface.
+func (v *getEmailIntakeAddressEmailIntakeAddress) GetTemplate() *EmailIntakeAddressTemplate {
+	return v.EmailIntakeAddress.Template
+}
+
+// GetArchivedAt returns getEmailIntakeAddressEmailIntakeAddress.ArchivedAt, and is useful for accessing the field via an interface.
+func (v *getEmailIntakeAddressEmailIntakeAddress) GetArchivedAt() *time.Time {
+	return v.EmailIntakeAddress.ArchivedAt
+}
+
+func (v *getEmailIntakeAddressEmailIntakeAddress) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getEmailIntakeAddressEmailIntakeAddress
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getEmailIntakeAddressEmailIntakeAddress = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.EmailIntakeAddress)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetEmailIntakeAddressEmailIntakeAddress struct {
+	Id string `json:"id"`
+
+	Address string `json:"address"`
+
+	ForwardingEmailAddress *string `json:"forwardingEmailAddress"`
+
+	SenderName *string `json:"senderName"`
+
+	Enabled bool `json:"enabled"`
+
+	RepliesEnabled bool `json:"repliesEnabled"`
+
+	Team *EmailIntakeAddressTeam `json:"team"`
+
+	Template *EmailIntakeAddressTemplate `json:"template"`
+
+	ArchivedAt *time.Time `json:"archivedAt"`
+}
+
+func (v *getEmailIntakeAddressEmailIntakeAddress) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getEmailIntakeAddressEmailIntakeAddress) __premarshalJSON() (*__premarshalgetEmailIntakeAddressEmailIntakeAddress, error) {
+	var retval __premarshalgetEmailIntakeAddressEmailIntakeAddress
+
+	retval.Id = v.EmailIntakeAddress.Id
+	retval.Address = v.EmailIntakeAddress.Address
+	retval.ForwardingEmailAddress = v.EmailIntakeAddress.ForwardingEmailAddress
+	retval.SenderName = v.EmailIntakeAddress.SenderName
+	retval.Enabled = v.EmailIntakeAddress.Enabled
+	retval.RepliesEnabled = v.EmailIntakeAddress.RepliesEnabled
+	retval.Team = v.EmailIntakeAddress.Team
+	retval.Template = v.EmailIntakeAddress.Template
+	retval.ArchivedAt = v.EmailIntakeAddress.ArchivedAt
+	return &retval, nil
+}
+
+// getEmailIntakeAddressResponse is returned by getEmailIntakeAddress on success.
+type getEmailIntakeAddressResponse struct {
+	// One specific email intake address.
+	EmailIntakeAddress getEmailIntakeAddressEmailIntakeAddress `json:"emailIntakeAddress"`
+}
+
+// GetEmailIntakeAddress returns getEmailIntakeAddressResponse.EmailIntakeAddress, and is useful for accessing the field via an interface.
+func (v *getEmailIntakeAddressResponse) GetEmailIntakeAddress() getEmailIntakeAddressEmailIntakeAddress {
+	return v.EmailIntakeAddress
+}
+
+// getEmojiEmoji includes the requested fields of the GraphQL type Emoji.
+// The GraphQL type's documentation follows.
+//
+// A custom emoji.
+type getEmojiEmoji struct {
+	Emoji `json:"-"`
+}
+
+// GetId returns getEmojiEmoji.Id, and is useful for accessing the field via an interface.
+func (v *getEmojiEmoji) GetId() string { return v.Emoji.Id }
+
+// GetName returns getEmojiEmoji.Name, and is useful for accessing the field via an interface.
+func (v *getEmojiEmoji) GetName() string { return v.Emoji.Name }
+
+// GetUrl returns getEmojiEmoji.Url, and is useful for accessing the field via an interface.
+func (v *getEmojiEmoji) GetUrl() string { return v.Emoji.Url }
+
+// GetArchivedAt returns getEmojiEmoji.ArchivedAt, and is useful for accessing the field via an interface.
+func (v *getEmojiEmoji) GetArchivedAt() *time.Time { return v.Emoji.ArchivedAt }
+
+func (v *getEmojiEmoji) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getEmojiEmoji
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getEmojiEmoji = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Emoji)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetEmojiEmoji struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Url string `json:"url"`
+
+	ArchivedAt *time.Time `json:"archivedAt"`
+}
+
+func (v *getEmojiEmoji) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getEmojiEmoji) __premarshalJSON() (*__premarshalgetEmojiEmoji, error) {
+	var retval __premarshalgetEmojiEmoji
+
+	retval.Id = v.Emoji.Id
+	retval.Name = v.Emoji.Name
+	retval.Url = v.Emoji.Url
+	retval.ArchivedAt = v.Emoji.ArchivedAt
+	return &retval, nil
+}
+
+// getEmojiResponse is returned by getEmoji on success.
+type getEmojiResponse struct {
+	// A specific emoji.
+	Emoji getEmojiEmoji `json:"emoji"`
+}
+
+// GetEmoji returns getEmojiResponse.Emoji, and is useful for accessing the field via an interface.
+func (v *getEmojiResponse) GetEmoji() getEmojiEmoji { return v.Emoji }
+
+// getInitiativeInitiative includes the requested fields of the GraphQL type Initiative.
+// The GraphQL type's documentation follows.
+//
+// An initiative to group projects.
+type getInitiativeInitiative struct {
+	Initiative `json:"-"`
+}
+
+// GetId returns getInitiativeInitiative.Id, and is useful for accessing the field via an interface.
+func (v *getInitiativeInitiative) GetId() string { return v.Initiative.Id }
+
+// GetName returns getInitiativeInitiative.Name, and is useful for accessing the field via an interface.
+func (v *getInitiativeInitiative) GetName() string { return v.Initiative.Name }
+
+// GetSlugId returns getInitiativeInitiative.SlugId, and is useful for accessing the field via an interface.
+func (v *getInitiativeInitiative) GetSlugId() string { return v.Initiative.SlugId }
+
+// GetUrl returns getInitiativeInitiative.Url, and is useful for accessing the field via an interface.
+func (v *getInitiativeInitiative) GetUrl() string { return v.Initiative.Url }
+
+// GetDescription returns getInitiativeInitiative.Description, and is useful for accessing the field via an interface.
+func (v *getInitiativeInitiative) GetDescription() *string { return v.Initiative.Description }
+
+// GetOwner returns getInitiativeInitiative.Owner, and is useful for accessing the field via an interface.
+func (v *getInitiativeInitiative) GetOwner() *InitiativeOwnerUser { return v.Initiative.Owner }
+
+// GetColor returns getInitiativeInitiative.Color, and is useful for accessing the field via an interface.
+func (v *getInitiativeInitiative) GetColor() *string { return v.Initiative.Color }
+
+// GetIcon returns getInitiativeInitiative.Icon, and is useful for accessing the field via an interface.
+func (v *getInitiativeInitiative) GetIcon() *string { return v.Initiative.Icon }
+
+// GetStatus returns getInitiativeInitiative.Status, and is useful for accessing the field via an interface.
+func (v *getInitiativeInitiative) GetStatus() InitiativeStatus { return v.Initiative.Status }
+
+// GetTargetDate returns getInitiativeInitiative.TargetDate, and is useful for accessing the field via an interface.
+func (v *getInitiativeInitiative) GetTargetDate() *string { return v.Initiative.TargetDate }
+
+// GetTrashed returns getInitiativeInitiative.Trashed, and is useful for accessing the field via an interface.
+func (v *getInitiativeInitiative) GetTrashed() bool { return v.Initiative.Trashed }
+
+// GetArchivedAt returns getInitiativeInitiative.ArchivedAt, and is useful for accessing the field via an interface.
+func (v *getInitiativeInitiative) GetArchivedAt() *time.Time { return v.Initiative.ArchivedAt }
+
+func (v *getInitiativeInitiative) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getInitiativeInitiative
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getInitiativeInitiative = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Initiative)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetInitiativeInitiative struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	SlugId string `json:"slugId"`
+
+	Url string `json:"url"`
+
+	Description *string `json:"description"`
+
+	Owner *InitiativeOwnerUser `json:"owner"`
+
+	Color *string `json:"color"`
+
+	Icon *string `json:"icon"`
+
+	Status InitiativeStatus `json:"status"`
+
+	TargetDate *string `json:"targetDate"`
+
+	Trashed bool `json:"trashed"`
+
+	ArchivedAt *time.Time `json:"archivedAt"`
+}
+
+func (v *getInitiativeInitiative) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getInitiativeInitiative) __premarshalJSON() (*__premarshalgetInitiativeInitiative, error) {
+	var retval __premarshalgetInitiativeInitiative
+
+	retval.Id = v.Initiative.Id
+	retval.Name = v.Initiative.Name
+	retval.SlugId = v.Initiative.SlugId
+	retval.Url = v.Initiative.Url
+	retval.Description = v.Initiative.Description
+	retval.Owner = v.Initiative.Owner
+	retval.Color = v.Initiative.Color
+	retval.Icon = v.Initiative.Icon
+	retval.Status = v.Initiative.Status
+	retval.TargetDate = v.Initiative.TargetDate
+	retval.Trashed = v.Initiative.Trashed
+	retval.ArchivedAt = v.Initiative.ArchivedAt
+	return &retval, nil
+}
+
+// getInitiativeRelationsInitiativeRelationsInitiativeRelationConnection includes the requested fields of the GraphQL type InitiativeRelationConnection.
+type getInitiativeRelationsInitiativeRelationsInitiativeRelationConnection struct {
+	Nodes    []getInitiativeRelationsInitiativeRelationsInitiativeRelationConnectionNodesInitiativeRelation `json:"nodes"`
+	PageInfo getInitiativeRelationsInitiativeRelationsInitiativeRelationConnectionPageInfo                  `json:"pageInfo"`
+}
+
+// GetNodes returns getInitiativeRelationsInitiativeRelationsInitiativeRelationConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *getInitiativeRelationsInitiativeRelationsInitiativeRelationConnection) GetNodes() []getInitiativeRelationsInitiativeRelationsInitiativeRelationConnectionNodesInitiativeRelation {
+	return v.Nodes
+}
+
+// GetPageInfo returns getInitiativeRelationsInitiativeRelationsInitiativeRelationConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *getInitiativeRelationsInitiativeRelationsInitiativeRelationConnection) GetPageInfo() getInitiativeRelationsInitiativeRelationsInitiativeRelationConnectionPageInfo {
+	return v.PageInfo
+}
+
+// getInitiativeRelationsInitiativeRelationsInitiativeRelationConnectionNodesInitiativeRelation includes the requested fields of the GraphQL type InitiativeRelation.
+// The GraphQL type's documentation follows.
+//
+// A relation representing the dependency between two initiatives.
+type getInitiativeRelationsInitiativeRelationsInitiativeRelationConnectionNodesInitiativeRelation struct {
+	InitiativeRelation `json:"-"`
+}
+
+// GetId returns getInitiativeRelationsInitiativeRelationsInitiativeRelationConnectionNodesInitiativeRelation.Id, and is useful for accessing the field via an interface.
+func (v *getInitiativeRelationsInitiativeRelationsInitiativeRelationConnectionNodesInitiativeRelation) GetId() string {
+	return v.InitiativeRelation.Id
+}
+
+// GetInitiative returns getInitiativeRelationsInitiativeRelationsInitiativeRelationConnectionNodesInitiativeRelation.Initiative, and is useful for accessing the field via an interface.
+func (v *getInitiativeRelationsInitiativeRelationsInitiativeRelationConnectionNodesInitiativeRelation) GetInitiative() InitiativeRelationInitiative {
+	return v.InitiativeRelation.Initiative
+}
+
+// GetRelatedInitiative returns getInitiativeRelationsInitiativeRelationsInitiativeRelationConnectionNodesInitiativeRelation.RelatedInitiative, and is useful for accessing the field via an interface.
+func (v *getInitiativeRelationsInitiativeRelationsInitiativeRelationConnectionNodesInitiativeRelation) GetRelatedInitiative() InitiativeRelationRelatedInitiative {
+	return v.InitiativeRelation.RelatedInitiative
+}
+
+func (v *getInitiativeRelationsInitiativeRelationsInitiativeRelationConnectionNodesInitiativeRelation) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getInitiativeRelationsInitiativeRelationsInitiativeRelationConnectionNodesInitiativeRelation
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getInitiativeRelationsInitiativeRelationsInitiativeRelationConnectionNodesInitiativeRelation = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.InitiativeRelation)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetInitiativeRelationsInitiativeRelationsInitiativeRelationConnectionNodesInitiativeRelation struct {
+	Id string `json:"id"`
+
+	Initiative InitiativeRelationInitiative `json:"initiative"`
+
+	RelatedInitiative InitiativeRelationRelatedInitiative `json:"relatedInitiative"`
+}
+
+func (v *getInitiativeRelationsInitiativeRelationsInitiativeRelationConnectionNodesInitiativeRelation) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getInitiativeRelationsInitiativeRelationsInitiativeRelationConnectionNodesInitiativeRelation) __premarshalJSON() (*__premarshalgetInitiativeRelationsInitiativeRelationsInitiativeRelationConnectionNodesInitiativeRelation, error) {
+	var retval __premarshalgetInitiativeRelationsInitiativeRelationsInitiativeRelationConnectionNodesInitiativeRelation
+
+	retval.Id = v.InitiativeRelation.Id
+	retval.Initiative = v.InitiativeRelation.Initiative
+	retval.RelatedInitiative = v.InitiativeRelation.RelatedInitiative
+	return &retval, nil
+}
+
+// getInitiativeRelationsInitiativeRelationsInitiativeRelationConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type getInitiativeRelationsInitiativeRelationsInitiativeRelationConnectionPageInfo struct {
+	// Indicates if there are more results when paginating forward.
+	HasNextPage bool `json:"hasNextPage"`
+	// Cursor representing the last result in the paginated results.
+	EndCursor string `json:"endCursor"`
+}
+
+// GetHasNextPage returns getInitiativeRelationsInitiativeRelationsInitiativeRelationConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *getInitiativeRelationsInitiativeRelationsInitiativeRelationConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns getInitiativeRelationsInitiativeRelationsInitiativeRelationConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *getInitiativeRelationsInitiativeRelationsInitiativeRelationConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// getInitiativeRelationsResponse is returned by getInitiativeRelations on success.
+type getInitiativeRelationsResponse struct {
+	// All initiative relationships.
+	InitiativeRelations getInitiativeRelationsInitiativeRelationsInitiativeRelationConnection `json:"initiativeRelations"`
+}
+
+// GetInitiativeRelations returns getInitiativeRelationsResponse.InitiativeRelations, and is useful for accessing the field via an interface.
+func (v *getInitiativeRelationsResponse) GetInitiativeRelations() getInitiativeRelationsInitiativeRelationsInitiativeRelationConnection {
+	return v.InitiativeRelations
+}
+
+// getInitiativeResponse is returned by getInitiative on success.
+type getInitiativeResponse struct {
+	// One specific initiative.
+	Initiative getInitiativeInitiative `json:"initiative"`
+}
+
+// GetInitiative returns getInitiativeResponse.Initiative, and is useful for accessing the field via an interface.
+func (v *getInitiativeResponse) GetInitiative() getInitiativeInitiative { return v.Initiative }
+
+// getInitiativeToProjectInitiativeToProject includes the requested fields of the GraphQL type InitiativeToProject.
+// The GraphQL type's documentation follows.
+//
+// Join table between projects and initiatives.
+type getInitiativeToProjectInitiativeToProject struct {
+	InitiativeToProject `json:"-"`
+}
+
+// GetId returns getInitiativeToProjectInitiativeToProject.Id, and is useful for accessing the field via an interface.
+func (v *getInitiativeToProjectInitiativeToProject) GetId() string { return v.InitiativeToProject.Id }
+
+// GetInitiative returns getInitiativeToProjectInitiativeToProject.Initiative, and is useful for accessing the field via an interface.
+func (v *getInitiativeToProjectInitiativeToProject) GetInitiative() InitiativeToProjectInitiative {
+	return v.InitiativeToProject.Initiative
+}
+
+// GetProject returns getInitiativeToProjectInitiativeToProject.Project, and is useful for accessing the field via an interface.
+func (v *getInitiativeToProjectInitiativeToProject) GetProject() InitiativeToProjectProject {
+	return v.InitiativeToProject.Project
+}
+
+func (v *getInitiativeToProjectInitiativeToProject) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getInitiativeToProjectInitiativeToProject
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getInitiativeToProjectInitiativeToProject = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.InitiativeToProject)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetInitiativeToProjectInitiativeToProject struct {
+	Id string `json:"id"`
+
+	Initiative InitiativeToProjectInitiative `json:"initiative"`
+
+	Project InitiativeToProjectProject `json:"project"`
+}
+
+func (v *getInitiativeToProjectInitiativeToProject) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getInitiativeToProjectInitiativeToProject) __premarshalJSON() (*__premarshalgetInitiativeToProjectInitiativeToProject, error) {
+	var retval __premarshalgetInitiativeToProjectInitiativeToProject
+
+	retval.Id = v.InitiativeToProject.Id
+	retval.Initiative = v.InitiativeToProject.Initiative
+	retval.Project = v.InitiativeToProject.Project
+	return &retval, nil
+}
+
+// getInitiativeToProjectResponse is returned by getInitiativeToProject on success.
+type getInitiativeToProjectResponse struct {
+	// One specific initiativeToProject.
+	InitiativeToProject getInitiativeToProjectInitiativeToProject `json:"initiativeToProject"`
+}
+
+// GetInitiativeToProject returns getInitiativeToProjectResponse.InitiativeToProject, and is useful for accessing the field via an interface.
+func (v *getInitiativeToProjectResponse) GetInitiativeToProject() getInitiativeToProjectInitiativeToProject {
+	return v.InitiativeToProject
+}
+
+// getInitiativeToProjectsInitiativeToProjectsInitiativeToProjectConnection includes the requested fields of the GraphQL type InitiativeToProjectConnection.
+type getInitiativeToProjectsInitiativeToProjectsInitiativeToProjectConnection struct {
+	Nodes []getInitiativeToProjectsInitiativeToProjectsInitiativeToProjectConnectionNodesInitiativeToProject `json:"nodes"`
+}
+
+// GetNodes returns getInitiativeToProjectsInitiativeToProjectsInitiativeToProjectConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *getInitiativeToProjectsInitiativeToProjectsInitiativeToProjectConnection) GetNodes() []getInitiativeToProjectsInitiativeToProjectsInitiativeToProjectConnectionNodesInitiativeToProject {
+	return v.Nodes
+}
+
+// getInitiativeToProjectsInitiativeToProjectsInitiativeToProjectConnectionNodesInitiativeToProject includes the requested fields of the GraphQL type InitiativeToProject.
+// The GraphQL type's documentation follows.
+//
+// Join table between projects and initiatives.
+type getInitiativeToProjectsInitiativeToProjectsInitiativeToProjectConnectionNodesInitiativeToProject struct {
+	InitiativeToProject `json:"-"`
+}
+
+// GetId returns getInitiativeToProjectsInitiativeToProjectsInitiativeToProjectConnectionNodesInitiativeToProject.Id, and is useful for accessing the field via an interface.
+func (v *getInitiativeToProjectsInitiativeToProjectsInitiativeToProjectConnectionNodesInitiativeToProject) GetId() string {
+	return v.InitiativeToProject.Id
+}
+
+// GetInitiative returns getInitiativeToProjectsInitiativeToProjectsInitiativeToProjectConnectionNodesInitiativeToProject.Initiative, and is useful for accessing the field via an interface.
+func (v *getInitiativeToProjectsInitiativeToProjectsInitiativeToProjectConnectionNodesInitiativeToProject) GetInitiative() InitiativeToProjectInitiative {
+	return v.InitiativeToProject.Initiative
+}
+
+// GetProject returns getInitiativeToProjectsInitiativeToProjectsInitiativeToProjectConnectionNodesInitiativeToProject.Project, and is useful for accessing the field via an interface.
+func (v *getInitiativeToProjectsInitiativeToProjectsInitiativeToProjectConnectionNodesInitiativeToProject) GetProject() InitiativeToProjectProject {
+	return v.InitiativeToProject.Project
+}
+
+func (v *getInitiativeToProjectsInitiativeToProjectsInitiativeToProjectConnectionNodesInitiativeToProject) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getInitiativeToProjectsInitiativeToProjectsInitiativeToProjectConnectionNodesInitiativeToProject
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getInitiativeToProjectsInitiativeToProjectsInitiativeToProjectConnectionNodesInitiativeToProject = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.InitiativeToProject)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetInitiativeToProjectsInitiativeToProjectsInitiativeToProjectConnectionNodesInitiativeToProject struct {
+	Id string `json:"id"`
+
+	Initiative InitiativeToProjectInitiative `json:"initiative"`
+
+	Project InitiativeToProjectProject `json:"project"`
+}
+
+func (v *getInitiativeToProjectsInitiativeToProjectsInitiativeToProjectConnectionNodesInitiativeToProject) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getInitiativeToProjectsInitiativeToProjectsInitiativeToProjectConnectionNodesInitiativeToProject) __premarshalJSON() (*__premarshalgetInitiativeToProjectsInitiativeToProjectsInitiativeToProjectConnectionNodesInitiativeToProject, error) {
+	var retval __premarshalgetInitiativeToProjectsInitiativeToProjectsInitiativeToProjectConnectionNodesInitiativeToProject
+
+	retval.Id = v.InitiativeToProject.Id
+	retval.Initiative = v.InitiativeToProject.Initiative
+	retval.Project = v.InitiativeToProject.Project
+	return &retval, nil
+}
+
+// getInitiativeToProjectsResponse is returned by getInitiativeToProjects on success.
+type getInitiativeToProjectsResponse struct {
+	// returns a list of initiative to project entities.
+	InitiativeToProjects getInitiativeToProjectsInitiativeToProjectsInitiativeToProjectConnection `json:"initiativeToProjects"`
+}
+
+// GetInitiativeToProjects returns getInitiativeToProjectsResponse.InitiativeToProjects, and is useful for accessing the field via an interface.
+func (v *getInitiativeToProjectsResponse) GetInitiativeToProjects() getInitiativeToProjectsInitiativeToProjectsInitiativeToProjectConnection {
+	return v.InitiativeToProjects
+}
+
+// getLabelIssueLabel includes the requested fields of the GraphQL type IssueLabel.
+// The GraphQL type's documentation follows.
+//
+// Labels that can be associated with issues.
+type getLabelIssueLabel struct {
+	IssueLabel `json:"-"`
+}
+
+// GetId returns getLabelIssueLabel.Id, and is useful for accessing the field via an interface.
+func (v *getLabelIssueLabel) GetId() string { return v.IssueLabel.Id }
+
+// GetName returns getLabelIssueLabel.Name, and is useful for accessing the field via an interface.
+func (v *getLabelIssueLabel) GetName() string { return v.IssueLabel.Name }
+
+// GetDescription returns getLabelIssueLabel.Description, and is useful for accessing the field via an interface.
+func (v *getLabelIssueLabel) GetDescription() *string { return v.IssueLabel.Description }
+
+// GetColor returns getLabelIssueLabel.Color, and is useful for accessing the field via an interface.
+func (v *getLabelIssueLabel) GetColor() *string { return v.IssueLabel.Color }
+
+// GetParent returns getLabelIssueLabel.Parent, and is useful for accessing the field via an interface.
+func (v *getLabelIssueLabel) GetParent() *IssueLabelParentIssueLabel { return v.IssueLabel.Parent }
+
+// GetTeam returns getLabelIssueLabel.Team, and is useful for accessing the field via an interface.
+func (v *getLabelIssueLabel) GetTeam() *IssueLabelTeam { return v.IssueLabel.Team }
+
+func (v *getLabelIssueLabel) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getLabelIssueLabel
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getLabelIssueLabel = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.IssueLabel)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetLabelIssueLabel struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description *string `json:"description"`
+
+	Color *string `json:"color"`
+
+	Parent *IssueLabelParentIssueLabel `json:"parent"`
+
+	Team *IssueLabelTeam `json:"team"`
+}
+
+func (v *getLabelIssueLabel) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getLabelIssueLabel) __premarshalJSON() (*__premarshalgetLabelIssueLabel, error) {
+	var retval __premarshalgetLabelIssueLabel
+
+	retval.Id = v.IssueLabel.Id
+	retval.Name = v.IssueLabel.Name
+	retval.Description = v.IssueLabel.Description
+	retval.Color = v.IssueLabel.Color
+	retval.Parent = v.IssueLabel.Parent
+	retval.Team = v.IssueLabel.Team
+	return &retval, nil
+}
+
+// getLabelResponse is returned by getLabel on success.
+type getLabelResponse struct {
+	// One specific label.
+	IssueLabel getLabelIssueLabel `json:"issueLabel"`
+}
+
+// GetIssueLabel returns getLabelResponse.IssueLabel, and is useful for accessing the field via an interface.
+func (v *getLabelResponse) GetIssueLabel() getLabelIssueLabel { return v.IssueLabel }
+
+// getOrganizationDomainClaimRequestOrganizationDomainClaimRequestOrganizationDomainClaimPayload includes the requested fields of the GraphQL type OrganizationDomainClaimPayload.
+// The GraphQL type's documentation follows.
+//
+// [INTERNAL] Domain claim request response.
+type getOrganizationDomainClaimRequestOrganizationDomainClaimRequestOrganizationDomainClaimPayload struct {
+	// String to put into DNS for verification.
+	VerificationString string `json:"verificationString"`
+}
+
+// GetVerificationString returns getOrganizationDomainClaimRequestOrganizationDomainClaimRequestOrganizationDomainClaimPayload.VerificationString, and is useful for accessing the field via an interface.
+func (v *getOrganizationDomainClaimRequestOrganizationDomainClaimRequestOrganizationDomainClaimPayload) GetVerificationString() string {
+	return v.VerificationString
+}
+
+// getOrganizationDomainClaimRequestResponse is returned by getOrganizationDomainClaimRequest on success.
+type getOrganizationDomainClaimRequestResponse struct {
+	// [INTERNAL] Checks whether the domain can be claimed.
+	OrganizationDomainClaimRequest getOrganizationDomainClaimRequestOrganizationDomainClaimRequestOrganizationDomainClaimPayload `json:"organizationDomainClaimRequest"`
+}
+
+// GetOrganizationDomainClaimRequest returns getOrganizationDomainClaimRequestResponse.OrganizationDomainClaimRequest, and is useful for accessing the field via an interface.
+func (v *getOrganizationDomainClaimRequestResponse) GetOrganizationDomainClaimRequest() getOrganizationDomainClaimRequestOrganizationDomainClaimRequestOrganizationDomainClaimPayload {
+	return v.OrganizationDomainClaimRequest
+}
+
+// getOrganizationInviteOrganizationInvite includes the requested fields of the GraphQL type OrganizationInvite.
+// The GraphQL type's documentation follows.
+//
+// An invitation to the organization that has been sent via email.
+type getOrganizationInviteOrganizationInvite struct {
+	OrganizationInvite `json:"-"`
+}
+
+// GetId returns getOrganizationInviteOrganizationInvite.Id, and is useful for accessing the field via an interface.
+func (v *getOrganizationInviteOrganizationInvite) GetId() string { return v.OrganizationInvite.Id }
+
+// GetEmail returns getOrganizationInviteOrganizationInvite.Email, and is useful for accessing the field via an interface.
+func (v *getOrganizationInviteOrganizationInvite) GetEmail() string {
+	return v.OrganizationInvite.Email
+}
+
+// GetRole returns getOrganizationInviteOrganizationInvite.Role, and is useful for accessing the field via an interface.
+func (v *getOrganizationInviteOrganizationInvite) GetRole() UserRoleType {
+	return v.OrganizationInvite.Role
+}
+
+// GetMetadata returns getOrganizationInviteOrganizationInvite.Metadata, and is useful for accessing the field via an interface.
+func (v *getOrganizationInviteOrganizationInvite) GetMetadata() map[string]interface{} {
+	return v.OrganizationInvite.Metadata
+}
+
+// GetAcceptedAt returns getOrganizationInviteOrganizationInvite.AcceptedAt, and is useful for accessing the field via an interface.
+func (v *getOrganizationInviteOrganizationInvite) GetAcceptedAt() *time.Time {
+	return v.OrganizationInvite.AcceptedAt
+}
+
+// GetExpiresAt returns getOrganizationInviteOrganizationInvite.ExpiresAt, and is useful for accessing the field via an interface.
+func (v *getOrganizationInviteOrganizationInvite) GetExpiresAt() *time.Time {
+	return v.OrganizationInvite.ExpiresAt
+}
+
+func (v *getOrganizationInviteOrganizationInvite) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getOrganizationInviteOrganizationInvite
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getOrganizationInviteOrganizationInvite = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.OrganizationInvite)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetOrganizationInviteOrganizationInvite struct {
+	Id string `json:"id"`
+
+	Email string `json:"email"`
+
+	Role UserRoleType `json:"role"`
+
+	Metadata map[string]interface{} `json:"metadata"`
+
+	AcceptedAt *time.Time `json:"acceptedAt"`
+
+	ExpiresAt *time.Time `json:"expiresAt"`
+}
+
+func (v *getOrganizationInviteOrganizationInvite) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getOrganizationInviteOrganizationInvite) __premarshalJSON() (*__premarshalgetOrganizationInviteOrganizationInvite, error) {
+	var retval __premarshalgetOrganizationInviteOrganizationInvite
+
+	retval.Id = v.OrganizationInvite.Id
+	retval.Email = v.OrganizationInvite.Email
+	retval.Role = v.OrganizationInvite.Role
+	retval.Metadata = v.OrganizationInvite.Metadata
+	retval.AcceptedAt = v.OrganizationInvite.AcceptedAt
+	retval.ExpiresAt = v.OrganizationInvite.ExpiresAt
+	return &retval, nil
+}
+
+// getOrganizationInviteResponse is returned by getOrganizationInvite on success.
+type getOrganizationInviteResponse struct {
+	// One specific organization invite.
+	OrganizationInvite getOrganizationInviteOrganizationInvite `json:"organizationInvite"`
+}
+
+// GetOrganizationInvite returns getOrganizationInviteResponse.OrganizationInvite, and is useful for accessing the field via an interface.
+func (v *getOrganizationInviteResponse) GetOrganizationInvite() getOrganizationInviteOrganizationInvite {
+	return v.OrganizationInvite
+}
+
+// getProjectMilestoneProjectMilestone includes the requested fields of the GraphQL type ProjectMilestone.
+// The GraphQL type's documentation follows.
+//
+// A milestone for a project.
+type getProjectMilestoneProjectMilestone struct {
+	ProjectMilestone `json:"-"`
+}
+
+// GetId returns getProjectMilestoneProjectMilestone.Id, and is useful for accessing the field via an interface.
+func (v *getProjectMilestoneProjectMilestone) GetId() string { return v.ProjectMilestone.Id }
+
+// GetName returns getProjectMilestoneProjectMilestone.Name, and is useful for accessing the field via an interface.
+func (v *getProjectMilestoneProjectMilestone) GetName() string { return v.ProjectMilestone.Name }
+
+// GetDescription returns getProjectMilestoneProjectMilestone.Description, and is useful for accessing the field via an interface.
+func (v *getProjectMilestoneProjectMilestone) GetDescription() *string {
+	return v.ProjectMilestone.Description
+}
+
+// GetTargetDate returns getProjectMilestoneProjectMilestone.TargetDate, and is useful for accessing the field via an interface.
+func (v *getProjectMilestoneProjectMilestone) GetTargetDate() *string {
+	return v.ProjectMilestone.TargetDate
+}
+
+// GetSortOrder returns getProjectMilestoneProjectMilestone.SortOrder, and is useful for accessing the field via an interface.
+func (v *getProjectMilestoneProjectMilestone) GetSortOrder() float64 {
+	return v.ProjectMilestone.SortOrder
+}
+
+// GetProject returns getProjectMilestoneProjectMilestone.Project, and is useful for accessing the field via an interface.
+func (v *getProjectMilestoneProjectMilestone) GetProject() ProjectMilestoneProject {
+	return v.ProjectMilestone.Project
+}
+
+func (v *getProjectMilestoneProjectMilestone) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getProjectMilestoneProjectMilestone
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getProjectMilestoneProjectMilestone = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ProjectMilestone)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetProjectMilestoneProjectMilestone struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description *string `json:"description"`
+
+	TargetDate *string `json:"targetDate"`
+
+	SortOrder float64 `json:"sortOrder"`
+
+	Project ProjectMilestoneProject `json:"project"`
+}
+
+func (v *getProjectMilestoneProjectMilestone) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getProjectMilestoneProjectMilestone) __premarshalJSON() (*__premarshalgetProjectMilestoneProjectMilestone, error) {
+	var retval __premarshalgetProjectMilestoneProjectMilestone
+
+	retval.Id = v.ProjectMilestone.Id
+	retval.Name = v.ProjectMilestone.Name
+	retval.Description = v.ProjectMilestone.Description
+	retval.TargetDate = v.ProjectMilestone.TargetDate
+	retval.SortOrder = v.ProjectMilestone.SortOrder
+	retval.Project = v.ProjectMilestone.Project
+	return &retval, nil
+}
+
+// getProjectMilestoneResponse is returned by getProjectMilestone on success.
+type getProjectMilestoneResponse struct {
+	// One specific project milestone.
+	ProjectMilestone getProjectMilestoneProjectMilestone `json:"projectMilestone"`
+}
+
+// GetProjectMilestone returns getProjectMilestoneResponse.ProjectMilestone, and is useful for accessing the field via an interface.
+func (v *getProjectMilestoneResponse) GetProjectMilestone() getProjectMilestoneProjectMilestone {
+	return v.ProjectMilestone
+}
+
+// getProjectProject includes the requested fields of the GraphQL type Project.
+// The GraphQL type's documentation follows.
+//
+// A project.
+type getProjectProject struct {
+	Project `json:"-"`
+}
+
+// GetId returns getProjectProject.Id, and is useful for accessing the field via an interface.
+func (v *getProjectProject) GetId() string { return v.Project.Id }
+
+// GetName returns getProjectProject.Name, and is useful for accessing the field via an interface.
+func (v *getProjectProject) GetName() string { return v.Project.Name }
+
+// GetSlugId returns getProjectProject.SlugId, and is useful for accessing the field via an interface.
+func (v *getProjectProject) GetSlugId() string { return v.Project.SlugId }
+
+// GetUrl returns getProjectProject.Url, and is useful for accessing the field via an interface.
+func (v *getProjectProject) GetUrl() string { return v.Project.Url }
+
+// GetDescription returns getProjectProject.Description, and is useful for accessing the field via an interface.
+func (v *getProjectProject) GetDescription() string { return v.Project.Description }
+
+// GetIcon returns getProjectProject.Icon, and is useful for accessing the field via an interface.
+func (v *getProjectProject) GetIcon() *string { return v.Project.Icon }
+
+// GetColor returns getProjectProject.Color, and is useful for accessing the field via an interface.
+func (v *getProjectProject) GetColor() string { return v.Project.Color }
+
+// GetStatus returns getProjectProject.Status, and is useful for accessing the field via an interface.
+func (v *getProjectProject) GetStatus() ProjectStatusProjectStatus { return v.Project.Status }
+
+// GetLead returns getProjectProject.Lead, and is useful for accessing the field via an interface.
+func (v *getProjectProject) GetLead() *ProjectLeadUser { return v.Project.Lead }
+
+// GetTeams returns getProjectProject.Teams, and is useful for accessing the field via an interface.
+func (v *getProjectProject) GetTeams() ProjectTeamsTeamConnection { return v.Project.Teams }
+
+// GetPriority returns getProjectProject.Priority, and is useful for accessing the field via an interface.
+func (v *getProjectProject) GetPriority() int { return v.Project.Priority }
+
+// GetStartDate returns getProjectProject.StartDate, and is useful for accessing the field via an interface.
+func (v *getProjectProject) GetStartDate() *string { return v.Project.StartDate }
+
+// GetTargetDate returns getProjectProject.TargetDate, and is useful for accessing the field via an interface.
+func (v *getProjectProject) GetTargetDate() *string { return v.Project.TargetDate }
+
+// GetLabelIds returns getProjectProject.LabelIds, and is useful for accessing the field via an interface.
+func (v *getProjectProject) GetLabelIds() []string { return v.Project.LabelIds }
+
+// GetTrashed returns getProjectProject.Trashed, and is useful for accessing the field via an interface.
+func (v *getProjectProject) GetTrashed() bool { return v.Project.Trashed }
+
+// GetArchivedAt returns getProjectProject.ArchivedAt, and is useful for accessing the field via an interface.
+func (v *getProjectProject) GetArchivedAt() *time.Time { return v.Project.ArchivedAt }
+
+func (v *getProjectProject) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getProjectProject
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getProjectProject = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Project)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetProjectProject struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	SlugId string `json:"slugId"`
+
+	Url string `json:"url"`
+
+	Description string `json:"description"`
+
+	Icon *string `json:"icon"`
+
+	Color string `json:"color"`
+
+	Status ProjectStatusProjectStatus `json:"status"`
+
+	Lead *ProjectLeadUser `json:"lead"`
+
+	Teams ProjectTeamsTeamConnection `json:"teams"`
+
+	Priority int `json:"priority"`
+
+	StartDate *string `json:"startDate"`
+
+	TargetDate *string `json:"targetDate"`
+
+	LabelIds []string `json:"labelIds"`
+
+	Trashed bool `json:"trashed"`
+
+	ArchivedAt *time.Time `json:"archivedAt"`
+}
+
+func (v *getProjectProject) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getProjectProject) __premarshalJSON() (*__premarshalgetProjectProject, error) {
+	var retval __premarshalgetProjectProject
+
+	retval.Id = v.Project.Id
+	retval.Name = v.Project.Name
+	retval.SlugId = v.Project.SlugId
+	retval.Url = v.Project.Url
+	retval.Description = v.Project.Description
+	retval.Icon = v.Project.Icon
+	retval.Color = v.Project.Color
+	retval.Status = v.Project.Status
+	retval.Lead = v.Project.Lead
+	retval.Teams = v.Project.Teams
+	retval.Priority = v.Project.Priority
+	retval.StartDate = v.Project.StartDate
+	retval.TargetDate = v.Project.TargetDate
+	retval.LabelIds = v.Project.LabelIds
+	retval.Trashed = v.Project.Trashed
+	retval.ArchivedAt = v.Project.ArchivedAt
+	return &retval, nil
+}
+
+// getProjectResponse is returned by getProject on success.
+type getProjectResponse struct {
+	// One specific project.
+	Project getProjectProject `json:"project"`
+}
+
+// GetProject returns getProjectResponse.Project, and is useful for accessing the field via an interface.
+func (v *getProjectResponse) GetProject() getProjectProject { return v.Project }
+
+// getProjectStatusProjectStatus includes the requested fields of the GraphQL type ProjectStatus.
+// The GraphQL type's documentation follows.
+//
+// A project status.
+type getProjectStatusProjectStatus struct {
+	ProjectStatus `json:"-"`
+}
+
+// GetId returns getProjectStatusProjectStatus.Id, and is useful for accessing the field via an interface.
+func (v *getProjectStatusProjectStatus) GetId() string { return v.ProjectStatus.Id }
+
+// GetName returns getProjectStatusProjectStatus.Name, and is useful for accessing the field via an interface.
+func (v *getProjectStatusProjectStatus) GetName() string { return v.ProjectStatus.Name }
+
+// GetColor returns getProjectStatusProjectStatus.Color, and is useful for accessing the field via an interface.
+func (v *getProjectStatusProjectStatus) GetColor() string { return v.ProjectStatus.Color }
+
+// GetDescription returns getProjectStatusProjectStatus.Description, and is useful for accessing the field via an interface.
+func (v *getProjectStatusProjectStatus) GetDescription() *string { return v.ProjectStatus.Description }
+
+// GetType returns getProjectStatusProjectStatus.Type, and is useful for accessing the field via an interface.
+func (v *getProjectStatusProjectStatus) GetType() ProjectStatusType { return v.ProjectStatus.Type }
+
+// GetPosition returns getProjectStatusProjectStatus.Position, and is useful for accessing the field via an interface.
+func (v *getProjectStatusProjectStatus) GetPosition() float64 { return v.ProjectStatus.Position }
+
+// GetIndefinite returns getProjectStatusProjectStatus.Indefinite, and is useful for accessing the field via an interface.
+func (v *getProjectStatusProjectStatus) GetIndefinite() bool { return v.ProjectStatus.Indefinite }
+
+// GetArchivedAt returns getProjectStatusProjectStatus.ArchivedAt, and is useful for accessing the field via an interface.
+func (v *getProjectStatusProjectStatus) GetArchivedAt() *time.Time { return v.ProjectStatus.ArchivedAt }
+
+func (v *getProjectStatusProjectStatus) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getProjectStatusProjectStatus
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getProjectStatusProjectStatus = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ProjectStatus)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetProjectStatusProjectStatus struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Color string `json:"color"`
+
+	Description *string `json:"description"`
+
+	Type ProjectStatusType `json:"type"`
+
+	Position float64 `json:"position"`
+
+	Indefinite bool `json:"indefinite"`
+
+	ArchivedAt *time.Time `json:"archivedAt"`
+}
+
+func (v *getProjectStatusProjectStatus) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getProjectStatusProjectStatus) __premarshalJSON() (*__premarshalgetProjectStatusProjectStatus, error) {
+	var retval __premarshalgetProjectStatusProjectStatus
+
+	retval.Id = v.ProjectStatus.Id
+	retval.Name = v.ProjectStatus.Name
+	retval.Color = v.ProjectStatus.Color
+	retval.Description = v.ProjectStatus.Description
+	retval.Type = v.ProjectStatus.Type
+	retval.Position = v.ProjectStatus.Position
+	retval.Indefinite = v.ProjectStatus.Indefinite
+	retval.ArchivedAt = v.ProjectStatus.ArchivedAt
+	return &retval, nil
+}
+
+// getProjectStatusResponse is returned by getProjectStatus on success.
+type getProjectStatusResponse struct {
+	// One specific project status.
+	ProjectStatus getProjectStatusProjectStatus `json:"projectStatus"`
+}
+
+// GetProjectStatus returns getProjectStatusResponse.ProjectStatus, and is useful for accessing the field via an interface.
+func (v *getProjectStatusResponse) GetProjectStatus() getProjectStatusProjectStatus {
+	return v.ProjectStatus
+}
+
+// getProjectStatusesProjectStatusesProjectStatusConnection includes the requested fields of the GraphQL type ProjectStatusConnection.
+type getProjectStatusesProjectStatusesProjectStatusConnection struct {
+	Nodes []getProjectStatusesProjectStatusesProjectStatusConnectionNodesProjectStatus `json:"nodes"`
+}
+
+// GetNodes returns getProjectStatusesProjectStatusesProjectStatusConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *getProjectStatusesProjectStatusesProjectStatusConnection) GetNodes() []getProjectStatusesProjectStatusesProjectStatusConnectionNodesProjectStatus {
+	return v.Nodes
+}
+
+// getProjectStatusesProjectStatusesProjectStatusConnectionNodesProjectStatus includes the requested fields of the GraphQL type ProjectStatus.
+// The GraphQL type's documentation follows.
+//
+// A project status.
+type getProjectStatusesProjectStatusesProjectStatusConnectionNodesProjectStatus struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The name of the status.
+	Name string `json:"name"`
+}
+
+// GetId returns getProjectStatusesProjectStatusesProjectStatusConnectionNodesProjectStatus.Id, and is useful for accessing the field via an interface.
+func (v *getProjectStatusesProjectStatusesProjectStatusConnectionNodesProjectStatus) GetId() string {
+	return v.Id
+}
+
+// GetName returns getProjectStatusesProjectStatusesProjectStatusConnectionNodesProjectStatus.Name, and is useful for accessing the field via an interface.
+func (v *getProjectStatusesProjectStatusesProjectStatusConnectionNodesProjectStatus) GetName() string {
+	return v.Name
+}
+
+// getProjectStatusesResponse is returned by getProjectStatuses on success.
+type getProjectStatusesResponse struct {
+	// All project statuses.
+	ProjectStatuses getProjectStatusesProjectStatusesProjectStatusConnection `json:"projectStatuses"`
+}
+
+// GetProjectStatuses returns getProjectStatusesResponse.ProjectStatuses, and is useful for accessing the field via an interface.
+func (v *getProjectStatusesResponse) GetProjectStatuses() getProjectStatusesProjectStatusesProjectStatusConnection {
+	return v.ProjectStatuses
+}
+
+// getTeamCyclesCyclesCycleConnection includes the requested fields of the GraphQL type CycleConnection.
+type getTeamCyclesCyclesCycleConnection struct {
+	Nodes    []getTeamCyclesCyclesCycleConnectionNodesCycle `json:"nodes"`
+	PageInfo getTeamCyclesCyclesCycleConnectionPageInfo     `json:"pageInfo"`
+}
+
+// GetNodes returns getTeamCyclesCyclesCycleConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *getTeamCyclesCyclesCycleConnection) GetNodes() []getTeamCyclesCyclesCycleConnectionNodesCycle {
+	return v.Nodes
+}
+
+// GetPageInfo returns getTeamCyclesCyclesCycleConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *getTeamCyclesCyclesCycleConnection) GetPageInfo() getTeamCyclesCyclesCycleConnectionPageInfo {
+	return v.PageInfo
+}
+
+// getTeamCyclesCyclesCycleConnectionNodesCycle includes the requested fields of the GraphQL type Cycle.
+// The GraphQL type's documentation follows.
+//
+// A set of issues to be resolved in a specified amount of time.
+type getTeamCyclesCyclesCycleConnectionNodesCycle struct {
+	Cycle `json:"-"`
+}
+
+// GetId returns getTeamCyclesCyclesCycleConnectionNodesCycle.Id, and is useful for accessing the field via an interface.
+func (v *getTeamCyclesCyclesCycleConnectionNodesCycle) GetId() string { return v.Cycle.Id }
+
+// GetNumber returns getTeamCyclesCyclesCycleConnectionNodesCycle.Number, and is useful for accessing the field via an interface.
+func (v *getTeamCyclesCyclesCycleConnectionNodesCycle) GetNumber() float64 { return v.Cycle.Number }
+
+// GetName returns getTeamCyclesCyclesCycleConnectionNodesCycle.Name, and is useful for accessing the field via an interface.
+func (v *getTeamCyclesCyclesCycleConnectionNodesCycle) GetName() *string { return v.Cycle.Name }
+
+// GetDescription returns getTeamCyclesCyclesCycleConnectionNodesCycle.Description, and is useful for accessing the field via an interface.
+func (v *getTeamCyclesCyclesCycleConnectionNodesCycle) GetDescription() *string {
+	return v.Cycle.Description
+}
+
+// GetStartsAt returns getTeamCyclesCyclesCycleConnectionNodesCycle.StartsAt, and is useful for accessing the field via an interface.
+func (v *getTeamCyclesCyclesCycleConnectionNodesCycle) GetStartsAt() time.Time {
+	return v.Cycle.StartsAt
+}
+
+// GetEndsAt returns getTeamCyclesCyclesCycleConnectionNodesCycle.EndsAt, and is useful for accessing the field via an interface.
+func (v *getTeamCyclesCyclesCycleConnectionNodesCycle) GetEndsAt() time.Time { return v.Cycle.EndsAt }
+
+// GetTeam returns getTeamCyclesCyclesCycleConnectionNodesCycle.Team, and is useful for accessing the field via an interface.
+func (v *getTeamCyclesCyclesCycleConnectionNodesCycle) GetTeam() CycleTeam { return v.Cycle.Team }
+
+// GetArchivedAt returns getTeamCyclesCyclesCycleConnectionNodesCycle.ArchivedAt, and is useful for accessing the field via an interface.
+func (v *getTeamCyclesCyclesCycleConnectionNodesCycle) GetArchivedAt() *time.Time {
+	return v.Cycle.ArchivedAt
+}
+
+func (v *getTeamCyclesCyclesCycleConnectionNodesCycle) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getTeamCyclesCyclesCycleConnectionNodesCycle
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getTeamCyclesCyclesCycleConnectionNodesCycle = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Cycle)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetTeamCyclesCyclesCycleConnectionNodesCycle struct {
+	Id string `json:"id"`
+
+	Number float64 `json:"number"`
+
+	Name *string `json:"name"`
+
+	Description *string `json:"description"`
+
+	StartsAt time.Time `json:"startsAt"`
+
+	EndsAt time.Time `json:"endsAt"`
+
+	Team CycleTeam `json:"team"`
+
+	ArchivedAt *time.Time `json:"archivedAt"`
+}
+
+func (v *getTeamCyclesCyclesCycleConnectionNodesCycle) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getTeamCyclesCyclesCycleConnectionNodesCycle) __premarshalJSON() (*__premarshalgetTeamCyclesCyclesCycleConnectionNodesCycle, error) {
+	var retval __premarshalgetTeamCyclesCyclesCycleConnectionNodesCycle
+
+	retval.Id = v.Cycle.Id
+	retval.Number = v.Cycle.Number
+	retval.Name = v.Cycle.Name
+	retval.Description = v.Cycle.Description
+	retval.StartsAt = v.Cycle.StartsAt
+	retval.EndsAt = v.Cycle.EndsAt
+	retval.Team = v.Cycle.Team
+	retval.ArchivedAt = v.Cycle.ArchivedAt
+	return &retval, nil
+}
+
+// getTeamCyclesCyclesCycleConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type getTeamCyclesCyclesCycleConnectionPageInfo struct {
+	// Indicates if there are more results when paginating forward.
+	HasNextPage bool `json:"hasNextPage"`
+	// Cursor representing the last result in the paginated results.
+	EndCursor string `json:"endCursor"`
+}
+
+// GetHasNextPage returns getTeamCyclesCyclesCycleConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *getTeamCyclesCyclesCycleConnectionPageInfo) GetHasNextPage() bool { return v.HasNextPage }
+
+// GetEndCursor returns getTeamCyclesCyclesCycleConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *getTeamCyclesCyclesCycleConnectionPageInfo) GetEndCursor() string { return v.EndCursor }
+
+// getTeamCyclesResponse is returned by getTeamCycles on success.
+type getTeamCyclesResponse struct {
+	// All cycles.
+	Cycles getTeamCyclesCyclesCycleConnection `json:"cycles"`
+}
+
+// GetCycles returns getTeamCyclesResponse.Cycles, and is useful for accessing the field via an interface.
+func (v *getTeamCyclesResponse) GetCycles() getTeamCyclesCyclesCycleConnection { return v.Cycles }
+
+// getTeamLabelsIssueLabelsIssueLabelConnection includes the requested fields of the GraphQL type IssueLabelConnection.
+type getTeamLabelsIssueLabelsIssueLabelConnection struct {
+	Nodes []getTeamLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel `json:"nodes"`
+}
+
+// GetNodes returns getTeamLabelsIssueLabelsIssueLabelConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *getTeamLabelsIssueLabelsIssueLabelConnection) GetNodes() []getTeamLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel {
+	return v.Nodes
+}
+
+// getTeamLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel includes the requested fields of the GraphQL type IssueLabel.
+// The GraphQL type's documentation follows.
+//
+// Labels that can be associated with issues.
+type getTeamLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel struct {
+	IssueLabel `json:"-"`
+}
+
+// GetId returns getTeamLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel.Id, and is useful for accessing the field via an interface.
+func (v *getTeamLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel) GetId() string {
+	return v.IssueLabel.Id
+}
+
+// GetName returns getTeamLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel.Name, and is useful for accessing the field via an interface.
+func (v *getTeamLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel) GetName() string {
+	return v.IssueLabel.Name
+}
+
+// GetDescription returns getTeamLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel.Description, and is useful for accessing the field via an interface.
+func (v *getTeamLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel) GetDescription() *string {
+	return v.IssueLabel.Description
+}
+
+// GetColor returns getTeamLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel.Color, and is useful for accessing the field via an interface.
+func (v *getTeamLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel) GetColor() *string {
+	return v.IssueLabel.Color
+}
+
+// GetParent returns getTeamLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel.Parent, and is useful for accessing the field via an interface.
+func (v *getTeamLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel) GetParent() *IssueLabelParentIssueLabel {
+	return v.IssueLabel.Parent
+}
+
+// GetTeam returns getTeamLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel.Team, and is useful for accessing the field via an interface.
+func (v *getTeamLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel) GetTeam() *IssueLabelTeam {
+	return v.IssueLabel.Team
+}
+
+func (v *getTeamLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getTeamLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getTeamLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.IssueLabel)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetTeamLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description *string `json:"description"`
+
+	Color *string `json:"color"`
+
+	Parent *IssueLabelParentIssueLabel `json:"parent"`
+
+	Team *IssueLabelTeam `json:"team"`
+}
+
+func (v *getTeamLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getTeamLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel) __premarshalJSON() (*__premarshalgetTeamLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel, error) {
+	var retval __premarshalgetTeamLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel
+
+	retval.Id = v.IssueLabel.Id
+	retval.Name = v.IssueLabel.Name
+	retval.Description = v.IssueLabel.Description
+	retval.Color = v.IssueLabel.Color
+	retval.Parent = v.IssueLabel.Parent
+	retval.Team = v.IssueLabel.Team
+	return &retval, nil
+}
+
+// getTeamLabelsResponse is returned by getTeamLabels on success.
+type getTeamLabelsResponse struct {
+	// All issue labels.
+	IssueLabels getTeamLabelsIssueLabelsIssueLabelConnection `json:"issueLabels"`
+}
+
+// GetIssueLabels returns getTeamLabelsResponse.IssueLabels, and is useful for accessing the field via an interface.
+func (v *getTeamLabelsResponse) GetIssueLabels() getTeamLabelsIssueLabelsIssueLabelConnection {
+	return v.IssueLabels
+}
+
+// getTeamMembershipResponse is returned by getTeamMembership on success.
+type getTeamMembershipResponse struct {
+	// One specific team membership.
+	TeamMembership getTeamMembershipTeamMembership `json:"teamMembership"`
+}
+
+// GetTeamMembership returns getTeamMembershipResponse.TeamMembership, and is useful for accessing the field via an interface.
+func (v *getTeamMembershipResponse) GetTeamMembership() getTeamMembershipTeamMembership {
+	return v.TeamMembership
+}
+
+// getTeamMembershipTeamMembership includes the requested fields of the GraphQL type TeamMembership.
+// The GraphQL type's documentation follows.
+//
+// Defines the membership of a user to a team.
+type getTeamMembershipTeamMembership struct {
+	TeamMembership `json:"-"`
+}
+
+// GetId returns getTeamMembershipTeamMembership.Id, and is useful for accessing the field via an interface.
+func (v *getTeamMembershipTeamMembership) GetId() string { return v.TeamMembership.Id }
+
+// GetOwner returns getTeamMembershipTeamMembership.Owner, and is useful for accessing the field via an interface.
+func (v *getTeamMembershipTeamMembership) GetOwner() bool { return v.TeamMembership.Owner }
+
+// GetSortOrder returns getTeamMembershipTeamMembership.SortOrder, and is useful for accessing the field via an interface.
+func (v *getTeamMembershipTeamMembership) GetSortOrder() float64 { return v.TeamMembership.SortOrder }
+
+// GetTeam returns getTeamMembershipTeamMembership.Team, and is useful for accessing the field via an interface.
+func (v *getTeamMembershipTeamMembership) GetTeam() TeamMembershipTeam { return v.TeamMembership.Team }
+
+// GetUser returns getTeamMembershipTeamMembership.User, and is useful for accessing the field via an interface.
+func (v *getTeamMembershipTeamMembership) GetUser() TeamMembershipUser { return v.TeamMembership.User }
+
+// GetArchivedAt returns getTeamMembershipTeamMembership.ArchivedAt, and is useful for accessing the field via an interface.
+func (v *getTeamMembershipTeamMembership) GetArchivedAt() *time.Time {
+	return v.TeamMembership.ArchivedAt
+}
+
+func (v *getTeamMembershipTeamMembership) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getTeamMembershipTeamMembership
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getTeamMembershipTeamMembership = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.TeamMembership)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetTeamMembershipTeamMembership struct {
+	Id string `json:"id"`
+
+	Owner bool `json:"owner"`
+
+	SortOrder float64 `json:"sortOrder"`
+
+	Team TeamMembershipTeam `json:"team"`
+
+	User TeamMembershipUser `json:"user"`
+
+	ArchivedAt *time.Time `json:"archivedAt"`
+}
+
+func (v *getTeamMembershipTeamMembership) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getTeamMembershipTeamMembership) __premarshalJSON() (*__premarshalgetTeamMembershipTeamMembership, error) {
+	var retval __premarshalgetTeamMembershipTeamMembership
+
+	retval.Id = v.TeamMembership.Id
+	retval.Owner = v.TeamMembership.Owner
+	retval.SortOrder = v.TeamMembership.SortOrder
+	retval.Team = v.TeamMembership.Team
+	retval.User = v.TeamMembership.User
+	retval.ArchivedAt = v.TeamMembership.ArchivedAt
+	return &retval, nil
+}
+
+// getTeamMembershipsResponse is returned by getTeamMemberships on success.
+type getTeamMembershipsResponse struct {
+	// One specific team.
+	Team getTeamMembershipsTeam `json:"team"`
+}
+
+// GetTeam returns getTeamMembershipsResponse.Team, and is useful for accessing the field via an interface.
+func (v *getTeamMembershipsResponse) GetTeam() getTeamMembershipsTeam { return v.Team }
+
+// getTeamMembershipsTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type getTeamMembershipsTeam struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// Memberships associated with the team. For easier access of the same data, use `members` query.
+	Memberships getTeamMembershipsTeamMembershipsTeamMembershipConnection `json:"memberships"`
+}
+
+// GetId returns getTeamMembershipsTeam.Id, and is useful for accessing the field via an interface.
+func (v *getTeamMembershipsTeam) GetId() string { return v.Id }
+
+// GetMemberships returns getTeamMembershipsTeam.Memberships, and is useful for accessing the field via an interface.
+func (v *getTeamMembershipsTeam) GetMemberships() getTeamMembershipsTeamMembershipsTeamMembershipConnection {
+	return v.Memberships
+}
+
+// getTeamMembershipsTeamMembershipsTeamMembershipConnection includes the requested fields of the GraphQL type TeamMembershipConnection.
+type getTeamMembershipsTeamMembershipsTeamMembershipConnection struct {
+	Nodes    []getTeamMembershipsTeamMembershipsTeamMembershipConnectionNodesTeamMembership `json:"nodes"`
+	PageInfo getTeamMembershipsTeamMembershipsTeamMembershipConnectionPageInfo              `json:"pageInfo"`
+}
+
+// GetNodes returns getTeamMembershipsTeamMembershipsTeamMembershipConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *getTeamMembershipsTeamMembershipsTeamMembershipConnection) GetNodes() []getTeamMembershipsTeamMembershipsTeamMembershipConnectionNodesTeamMembership {
+	return v.Nodes
+}
+
+// GetPageInfo returns getTeamMembershipsTeamMembershipsTeamMembershipConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *getTeamMembershipsTeamMembershipsTeamMembershipConnection) GetPageInfo() getTeamMembershipsTeamMembershipsTeamMembershipConnectionPageInfo {
+	return v.PageInfo
+}
+
+// getTeamMembershipsTeamMembershipsTeamMembershipConnectionNodesTeamMembership includes the requested fields of the GraphQL type TeamMembership.
+// The GraphQL type's documentation follows.
+//
+// Defines the membership of a user to a team.
+type getTeamMembershipsTeamMembershipsTeamMembershipConnectionNodesTeamMembership struct {
+	TeamMembership `json:"-"`
+}
+
+// GetId returns getTeamMembershipsTeamMembershipsTeamMembershipConnectionNodesTeamMembership.Id, and is useful for accessing the field via an interface.
+func (v *getTeamMembershipsTeamMembershipsTeamMembershipConnectionNodesTeamMembership) GetId() string {
+	return v.TeamMembership.Id
+}
+
+// GetOwner returns getTeamMembershipsTeamMembershipsTeamMembershipConnectionNodesTeamMembership.Owner, and is useful for accessing the field via an interface.
+func (v *getTeamMembershipsTeamMembershipsTeamMembershipConnectionNodesTeamMembership) GetOwner() bool {
+	return v.TeamMembership.Owner
+}
+
+// GetSortOrder returns getTeamMembershipsTeamMembershipsTeamMembershipConnectionNodesTeamMembership.SortOrder, and is useful for accessing the field via an interface.
+func (v *getTeamMembershipsTeamMembershipsTeamMembershipConnectionNodesTeamMembership) GetSortOrder() float64 {
+	return v.TeamMembership.SortOrder
+}
+
+// GetTeam returns getTeamMembershipsTeamMembershipsTeamMembershipConnectionNodesTeamMembership.Team, and is useful for accessing the field via an interface.
+func (v *getTeamMembershipsTeamMembershipsTeamMembershipConnectionNodesTeamMembership) GetTeam() TeamMembershipTeam {
+	return v.TeamMembership.Team
+}
+
+// GetUser returns getTeamMembershipsTeamMembershipsTeamMembershipConnectionNodesTeamMembership.User, and is useful for accessing the field via an interface.
+func (v *getTeamMembershipsTeamMembershipsTeamMembershipConnectionNodesTeamMembership) GetUser() TeamMembershipUser {
+	return v.TeamMembership.User
+}
+
+// GetArchivedAt returns getTeamMembershipsTeamMembershipsTeamMembershipConnectionNodesTeamMembership.ArchivedAt, and is useful for accessing the field via an interface.
+func (v *getTeamMembershipsTeamMembershipsTeamMembershipConnectionNodesTeamMembership) GetArchivedAt() *time.Time {
+	return v.TeamMembership.ArchivedAt
+}
+
+func (v *getTeamMembershipsTeamMembershipsTeamMembershipConnectionNodesTeamMembership) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getTeamMembershipsTeamMembershipsTeamMembershipConnectionNodesTeamMembership
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getTeamMembershipsTeamMembershipsTeamMembershipConnectionNodesTeamMembership = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.TeamMembership)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetTeamMembershipsTeamMembershipsTeamMembershipConnectionNodesTeamMembership struct {
+	Id string `json:"id"`
+
+	Owner bool `json:"owner"`
+
+	SortOrder float64 `json:"sortOrder"`
+
+	Team TeamMembershipTeam `json:"team"`
+
+	User TeamMembershipUser `json:"user"`
+
+	ArchivedAt *time.Time `json:"archivedAt"`
+}
+
+func (v *getTeamMembershipsTeamMembershipsTeamMembershipConnectionNodesTeamMembership) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getTeamMembershipsTeamMembershipsTeamMembershipConnectionNodesTeamMembership) __premarshalJSON() (*__premarshalgetTeamMembershipsTeamMembershipsTeamMembershipConnectionNodesTeamMembership, error) {
+	var retval __premarshalgetTeamMembershipsTeamMembershipsTeamMembershipConnectionNodesTeamMembership
+
+	retval.Id = v.TeamMembership.Id
+	retval.Owner = v.TeamMembership.Owner
+	retval.SortOrder = v.TeamMembership.SortOrder
+	retval.Team = v.TeamMembership.Team
+	retval.User = v.TeamMembership.User
+	retval.ArchivedAt = v.TeamMembership.ArchivedAt
+	return &retval, nil
+}
+
+// getTeamMembershipsTeamMembershipsTeamMembershipConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type getTeamMembershipsTeamMembershipsTeamMembershipConnectionPageInfo struct {
+	// Indicates if there are more results when paginating forward.
+	HasNextPage bool `json:"hasNextPage"`
+	// Cursor representing the last result in the paginated results.
+	EndCursor string `json:"endCursor"`
+}
+
+// GetHasNextPage returns getTeamMembershipsTeamMembershipsTeamMembershipConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *getTeamMembershipsTeamMembershipsTeamMembershipConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns getTeamMembershipsTeamMembershipsTeamMembershipConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *getTeamMembershipsTeamMembershipsTeamMembershipConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// getTeamResponse is returned by getTeam on success.
+type getTeamResponse struct {
+	// One specific team.
+	Team getTeamTeam `json:"team"`
+}
+
+// GetTeam returns getTeamResponse.Team, and is useful for accessing the field via an interface.
+func (v *getTeamResponse) GetTeam() getTeamTeam { return v.Team }
+
+// getTeamTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type getTeamTeam struct {
+	Team `json:"-"`
+}
+
+// GetId returns getTeamTeam.Id, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetId() string { return v.Team.Id }
+
+// GetName returns getTeamTeam.Name, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetName() string { return v.Team.Name }
+
+// GetKey returns getTeamTeam.Key, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetKey() string { return v.Team.Key }
+
+// GetPrivate returns getTeamTeam.Private, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetPrivate() bool { return v.Team.Private }
+
+// GetDescription returns getTeamTeam.Description, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetDescription() *string { return v.Team.Description }
+
+// GetIcon returns getTeamTeam.Icon, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetIcon() *string { return v.Team.Icon }
+
+// GetColor returns getTeamTeam.Color, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetColor() *string { return v.Team.Color }
+
+// GetParent returns getTeamTeam.Parent, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetParent() *TeamParentTeam { return v.Team.Parent }
+
+// GetTimezone returns getTeamTeam.Timezone, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetTimezone() string { return v.Team.Timezone }
+
+// GetGroupIssueHistory returns getTeamTeam.GroupIssueHistory, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetGroupIssueHistory() bool { return v.Team.GroupIssueHistory }
+
+// GetSetIssueSortOrderOnStateChange returns getTeamTeam.SetIssueSortOrderOnStateChange, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetSetIssueSortOrderOnStateChange() string {
+	return v.Team.SetIssueSortOrderOnStateChange
+}
+
+// GetAiThreadSummariesEnabled returns getTeamTeam.AiThreadSummariesEnabled, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetAiThreadSummariesEnabled() bool { return v.Team.AiThreadSummariesEnabled }
+
+// GetAutoArchivePeriod returns getTeamTeam.AutoArchivePeriod, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetAutoArchivePeriod() float64 { return v.Team.AutoArchivePeriod }
+
+// GetAutoClosePeriod returns getTeamTeam.AutoClosePeriod, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetAutoClosePeriod() *float64 { return v.Team.AutoClosePeriod }
+
+// GetAutoCloseParentIssues returns getTeamTeam.AutoCloseParentIssues, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetAutoCloseParentIssues() bool { return v.Team.AutoCloseParentIssues }
+
+// GetAutoCloseChildIssues returns getTeamTeam.AutoCloseChildIssues, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetAutoCloseChildIssues() bool { return v.Team.AutoCloseChildIssues }
+
+// GetTriageEnabled returns getTeamTeam.TriageEnabled, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetTriageEnabled() bool { return v.Team.TriageEnabled }
+
+// GetRequirePriorityToLeaveTriage returns getTeamTeam.RequirePriorityToLeaveTriage, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetRequirePriorityToLeaveTriage() bool {
+	return v.Team.RequirePriorityToLeaveTriage
+}
+
+// GetCyclesEnabled returns getTeamTeam.CyclesEnabled, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetCyclesEnabled() bool { return v.Team.CyclesEnabled }
+
+// GetCycleStartDay returns getTeamTeam.CycleStartDay, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetCycleStartDay() float64 { return v.Team.CycleStartDay }
+
+// GetCycleDuration returns getTeamTeam.CycleDuration, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetCycleDuration() float64 { return v.Team.CycleDuration }
+
+// GetCycleCooldownTime returns getTeamTeam.CycleCooldownTime, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetCycleCooldownTime() float64 { return v.Team.CycleCooldownTime }
+
+// GetUpcomingCycleCount returns getTeamTeam.UpcomingCycleCount, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetUpcomingCycleCount() float64 { return v.Team.UpcomingCycleCount }
+
+// GetCycleIssueAutoAssignStarted returns getTeamTeam.CycleIssueAutoAssignStarted, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetCycleIssueAutoAssignStarted() bool {
+	return v.Team.CycleIssueAutoAssignStarted
+}
+
+// GetCycleIssueAutoAssignCompleted returns getTeamTeam.CycleIssueAutoAssignCompleted, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetCycleIssueAutoAssignCompleted() bool {
+	return v.Team.CycleIssueAutoAssignCompleted
+}
+
+// GetCycleLockToActive returns getTeamTeam.CycleLockToActive, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetCycleLockToActive() bool { return v.Team.CycleLockToActive }
+
+// GetIssueEstimationType returns getTeamTeam.IssueEstimationType, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetIssueEstimationType() string { return v.Team.IssueEstimationType }
+
+// GetIssueEstimationAllowZero returns getTeamTeam.IssueEstimationAllowZero, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetIssueEstimationAllowZero() bool { return v.Team.IssueEstimationAllowZero }
+
+// GetIssueEstimationExtended returns getTeamTeam.IssueEstimationExtended, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetIssueEstimationExtended() bool { return v.Team.IssueEstimationExtended }
+
+// GetDefaultIssueEstimate returns getTeamTeam.DefaultIssueEstimate, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetDefaultIssueEstimate() float64 { return v.Team.DefaultIssueEstimate }
+
+func (v *getTeamTeam) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getTeamTeam
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getTeamTeam = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Team)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetTeamTeam struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Key string `json:"key"`
+
+	Private bool `json:"private"`
+
+	Description *string `json:"description"`
+
+	Icon *string `json:"icon"`
+
+	Color *string `json:"color"`
+
+	Parent *TeamParentTeam `json:"parent"`
+
+	Timezone string `json:"timezone"`
+
+	GroupIssueHistory bool `json:"groupIssueHistory"`
+
+	SetIssueSortOrderOnStateChange string `json:"setIssueSortOrderOnStateChange"`
+
+	AiThreadSummariesEnabled bool `json:"aiThreadSummariesEnabled"`
+
+	AutoArchivePeriod float64 `json:"autoArchivePeriod"`
+
+	AutoClosePeriod *float64 `json:"autoClosePeriod"`
+
+	AutoCloseParentIssues bool `json:"autoCloseParentIssues"`
+
+	AutoCloseChildIssues bool `json:"autoCloseChildIssues"`
+
+	TriageEnabled bool `json:"triageEnabled"`
+
+	RequirePriorityToLeaveTriage bool `json:"requirePriorityToLeaveTriage"`
+
+	CyclesEnabled bool `json:"cyclesEnabled"`
+
+	CycleStartDay float64 `json:"cycleStartDay"`
+
+	CycleDuration float64 `json:"cycleDuration"`
+
+	CycleCooldownTime float64 `json:"cycleCooldownTime"`
+
+	UpcomingCycleCount float64 `json:"upcomingCycleCount"`
+
+	CycleIssueAutoAssignStarted bool `json:"cycleIssueAutoAssignStarted"`
+
+	CycleIssueAutoAssignCompleted bool `json:"cycleIssueAutoAssignCompleted"`
+
+	CycleLockToActive bool `json:"cycleLockToActive"`
+
+	IssueEstimationType string `json:"issueEstimationType"`
+
+	IssueEstimationAllowZero bool `json:"issueEstimationAllowZero"`
+
+	IssueEstimationExtended bool `json:"issueEstimationExtended"`
+
+	DefaultIssueEstimate float64 `json:"defaultIssueEstimate"`
+}
+
+func (v *getTeamTeam) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getTeamTeam) __premarshalJSON() (*__premarshalgetTeamTeam, error) {
+	var retval __premarshalgetTeamTeam
+
+	retval.Id = v.Team.Id
+	retval.Name = v.Team.Name
+	retval.Key = v.Team.Key
+	retval.Private = v.Team.Private
+	retval.Description = v.Team.Description
+	retval.Icon = v.Team.Icon
+	retval.Color = v.Team.Color
+	retval.Parent = v.Team.Parent
+	retval.Timezone = v.Team.Timezone
+	retval.GroupIssueHistory = v.Team.GroupIssueHistory
+	retval.SetIssueSortOrderOnStateChange = v.Team.SetIssueSortOrderOnStateChange
+	retval.AiThreadSummariesEnabled = v.Team.AiThreadSummariesEnabled
+	retval.AutoArchivePeriod = v.Team.AutoArchivePeriod
+	retval.AutoClosePeriod = v.Team.AutoClosePeriod
+	retval.AutoCloseParentIssues = v.Team.AutoCloseParentIssues
+	retval.AutoCloseChildIssues = v.Team.AutoCloseChildIssues
+	retval.TriageEnabled = v.Team.TriageEnabled
+	retval.RequirePriorityToLeaveTriage = v.Team.RequirePriorityToLeaveTriage
+	retval.CyclesEnabled = v.Team.CyclesEnabled
+	retval.CycleStartDay = v.Team.CycleStartDay
+	retval.CycleDuration = v.Team.CycleDuration
+	retval.CycleCooldownTime = v.Team.CycleCooldownTime
+	retval.UpcomingCycleCount = v.Team.UpcomingCycleCount
+	retval.CycleIssueAutoAssignStarted = v.Team.CycleIssueAutoAssignStarted
+	retval.CycleIssueAutoAssignCompleted = v.Team.CycleIssueAutoAssignCompleted
+	retval.CycleLockToActive = v.Team.CycleLockToActive
+	retval.IssueEstimationType = v.Team.IssueEstimationType
+	retval.IssueEstimationAllowZero = v.Team.IssueEstimationAllowZero
+	retval.IssueEstimationExtended = v.Team.IssueEstimationExtended
+	retval.DefaultIssueEstimate = v.Team.DefaultIssueEstimate
+	return &retval, nil
+}
+
+// getTeamWorkflowResponse is returned by getTeamWorkflow on success.
+type getTeamWorkflowResponse struct {
+	// One specific team.
+	Team getTeamWorkflowTeam `json:"team"`
+}
+
+// GetTeam returns getTeamWorkflowResponse.Team, and is useful for accessing the field via an interface.
+func (v *getTeamWorkflowResponse) GetTeam() getTeamWorkflowTeam { return v.Team }
+
+// getTeamWorkflowStatesResponse is returned by getTeamWorkflowStates on success.
+type getTeamWorkflowStatesResponse struct {
+	// All issue workflow states.
+	WorkflowStates getTeamWorkflowStatesWorkflowStatesWorkflowStateConnection `json:"workflowStates"`
+}
+
+// GetWorkflowStates returns getTeamWorkflowStatesResponse.WorkflowStates, and is useful for accessing the field via an interface.
+func (v *getTeamWorkflowStatesResponse) GetWorkflowStates() getTeamWorkflowStatesWorkflowStatesWorkflowStateConnection {
+	return v.WorkflowStates
+}
+
+// getTeamWorkflowStatesWorkflowStatesWorkflowStateConnection includes the requested fields of the GraphQL type WorkflowStateConnection.
+type getTeamWorkflowStatesWorkflowStatesWorkflowStateConnection struct {
+	Nodes []getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState `json:"nodes"`
+}
+
+// GetNodes returns getTeamWorkflowStatesWorkflowStatesWorkflowStateConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *getTeamWorkflowStatesWorkflowStatesWorkflowStateConnection) GetNodes() []getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState {
+	return v.Nodes
+}
+
+// getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState includes the requested fields of the GraphQL type WorkflowState.
+// The GraphQL type's documentation follows.
+//
+// A state in a team workflow.
+type getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState struct {
+	WorkflowState `json:"-"`
+}
+
+// GetId returns getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState.Id, and is useful for accessing the field via an interface.
+func (v *getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) GetId() string {
+	return v.WorkflowState.Id
+}
+
+// GetName returns getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState.Name, and is useful for accessing the field via an interface.
+func (v *getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) GetName() string {
+	return v.WorkflowState.Name
+}
+
+// GetColor returns getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState.Color, and is useful for accessing the field via an interface.
+func (v *getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) GetColor() string {
+	return v.WorkflowState.Color
+}
+
+// GetDescription returns getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState.Description, and is useful for accessing the field via an interface.
+func (v *getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) GetDescription() *string {
+	return v.WorkflowState.Description
+}
+
+// GetType returns getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState.Type, and is useful for accessing the field via an interface.
+func (v *getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) GetType() string {
+	return v.WorkflowState.Type
+}
+
+// GetPosition returns getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState.Position, and is useful for accessing the field via an interface.
+func (v *getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) GetPosition() float64 {
+	return v.WorkflowState.Position
+}
+
+// GetTeam returns getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState.Team, and is useful for accessing the field via an interface.
+func (v *getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) GetTeam() WorkflowStateTeam {
+	return v.WorkflowState.Team
+}
+
+func (v *getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.WorkflowState)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Color string `json:"color"`
+
+	Description *string `json:"description"`
+
+	Type string `json:"type"`
+
+	Position float64 `json:"position"`
+
+	Team WorkflowStateTeam `json:"team"`
+}
+
+func (v *getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) __premarshalJSON() (*__premarshalgetTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState, error) {
+	var retval __premarshalgetTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState
+
+	retval.Id = v.WorkflowState.Id
+	retval.Name = v.WorkflowState.Name
+	retval.Color = v.WorkflowState.Color
+	retval.Description = v.WorkflowState.Description
+	retval.Type = v.WorkflowState.Type
+	retval.Position = v.WorkflowState.Position
+	retval.Team = v.WorkflowState.Team
+	return &retval, nil
+}
+
+// getTeamWorkflowTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type getTeamWorkflowTeam struct {
+	TeamWorkflow `json:"-"`
+}
+
+// GetId returns getTeamWorkflowTeam.Id, and is useful for accessing the field via an interface.
+func (v *getTeamWorkflowTeam) GetId() string { return v.TeamWorkflow.Id }
+
+// GetKey returns getTeamWorkflowTeam.Key, and is useful for accessing the field via an interface.
+func (v *getTeamWorkflowTeam) GetKey() string { return v.TeamWorkflow.Key }
+
+// GetGitAutomationStates returns getTeamWorkflowTeam.GitAutomationStates, and is useful for accessing the field via an interface.
+func (v *getTeamWorkflowTeam) GetGitAutomationStates() TeamWorkflowGitAutomationStatesGitAutomationStateConnection {
+	return v.TeamWorkflow.GitAutomationStates
+}
+
+func (v *getTeamWorkflowTeam) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getTeamWorkflowTeam
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getTeamWorkflowTeam = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.TeamWorkflow)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetTeamWorkflowTeam struct {
+	Id string `json:"id"`
+
+	Key string `json:"key"`
+
+	GitAutomationStates TeamWorkflowGitAutomationStatesGitAutomationStateConnection `json:"gitAutomationStates"`
+}
+
+func (v *getTeamWorkflowTeam) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getTeamWorkflowTeam) __premarshalJSON() (*__premarshalgetTeamWorkflowTeam, error) {
+	var retval __premarshalgetTeamWorkflowTeam
+
+	retval.Id = v.TeamWorkflow.Id
+	retval.Key = v.TeamWorkflow.Key
+	retval.GitAutomationStates = v.TeamWorkflow.GitAutomationStates
+	return &retval, nil
+}
+
+// getTemplateResponse is returned by getTemplate on success.
+type getTemplateResponse struct {
+	// A specific template.
+	Template getTemplateTemplate `json:"template"`
+}
+
+// GetTemplate returns getTemplateResponse.Template, and is useful for accessing the field via an interface.
+func (v *getTemplateResponse) GetTemplate() getTemplateTemplate { return v.Template }
+
+// getTemplateTemplate includes the requested fields of the GraphQL type Template.
+// The GraphQL type's documentation follows.
+//
+// A template object used for creating entities faster.
+type getTemplateTemplate struct {
+	Template `json:"-"`
+}
+
+// GetId returns getTemplateTemplate.Id, and is useful for accessing the field via an interface.
+func (v *getTemplateTemplate) GetId() string { return v.Template.Id }
+
+// GetName returns getTemplateTemplate.Name, and is useful for accessing the field via an interface.
+func (v *getTemplateTemplate) GetName() string { return v.Template.Name }
+
+// GetDescription returns getTemplateTemplate.Description, and is useful for accessing the field via an interface.
+func (v *getTemplateTemplate) GetDescription() *string { return v.Template.Description }
+
+// GetType returns getTemplateTemplate.Type, and is useful for accessing the field via an interface.
+func (v *getTemplateTemplate) GetType() string { return v.Template.Type }
+
+// GetTeam returns getTemplateTemplate.Team, and is useful for accessing the field via an interface.
+func (v *getTemplateTemplate) GetTeam() *TemplateTeam { return v.Template.Team }
+
+// GetTemplateData returns getTemplateTemplate.TemplateData, and is useful for accessing the field via an interface.
+func (v *getTemplateTemplate) GetTemplateData() string { return v.Template.TemplateData }
+
+func (v *getTemplateTemplate) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getTemplateTemplate
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getTemplateTemplate = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Template)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetTemplateTemplate struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description *string `json:"description"`
+
+	Type string `json:"type"`
+
+	Team *TemplateTeam `json:"team"`
+
+	TemplateData string `json:"templateData"`
+}
+
+func (v *getTemplateTemplate) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getTemplateTemplate) __premarshalJSON() (*__premarshalgetTemplateTemplate, error) {
+	var retval __premarshalgetTemplateTemplate
+
+	retval.Id = v.Template.Id
+	retval.Name = v.Template.Name
+	retval.Description = v.Template.Description
+	retval.Type = v.Template.Type
+	retval.Team = v.Template.Team
+	retval.TemplateData = v.Template.TemplateData
+	return &retval, nil
+}
+
+// getTimeScheduleResponse is returned by getTimeSchedule on success.
+type getTimeScheduleResponse struct {
+	// A specific time schedule.
+	TimeSchedule getTimeScheduleTimeSchedule `json:"timeSchedule"`
+}
+
+// GetTimeSchedule returns getTimeScheduleResponse.TimeSchedule, and is useful for accessing the field via an interface.
+func (v *getTimeScheduleResponse) GetTimeSchedule() getTimeScheduleTimeSchedule {
+	return v.TimeSchedule
+}
+
+// getTimeScheduleTimeSchedule includes the requested fields of the GraphQL type TimeSchedule.
+// The GraphQL type's documentation follows.
+//
+// A time schedule.
+type getTimeScheduleTimeSchedule struct {
+	TimeSchedule `json:"-"`
+}
+
+// GetId returns getTimeScheduleTimeSchedule.Id, and is useful for accessing the field via an interface.
+func (v *getTimeScheduleTimeSchedule) GetId() string { return v.TimeSchedule.Id }
+
+// GetName returns getTimeScheduleTimeSchedule.Name, and is useful for accessing the field via an interface.
+func (v *getTimeScheduleTimeSchedule) GetName() string { return v.TimeSchedule.Name }
+
+// GetEntries returns getTimeScheduleTimeSchedule.Entries, and is useful for accessing the field via an interface.
+func (v *getTimeScheduleTimeSchedule) GetEntries() []TimeScheduleEntriesTimeScheduleEntry {
+	return v.TimeSchedule.Entries
+}
+
+// GetArchivedAt returns getTimeScheduleTimeSchedule.ArchivedAt, and is useful for accessing the field via an interface.
+func (v *getTimeScheduleTimeSchedule) GetArchivedAt() *time.Time { return v.TimeSchedule.ArchivedAt }
+
+func (v *getTimeScheduleTimeSchedule) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getTimeScheduleTimeSchedule
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getTimeScheduleTimeSchedule = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.TimeSchedule)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetTimeScheduleTimeSchedule struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Entries []TimeScheduleEntriesTimeScheduleEntry `json:"entries"`
+
+	ArchivedAt *time.Time `json:"archivedAt"`
+}
+
+func (v *getTimeScheduleTimeSchedule) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getTimeScheduleTimeSchedule) __premarshalJSON() (*__premarshalgetTimeScheduleTimeSchedule, error) {
+	var retval __premarshalgetTimeScheduleTimeSchedule
+
+	retval.Id = v.TimeSchedule.Id
+	retval.Name = v.TimeSchedule.Name
+	retval.Entries = v.TimeSchedule.Entries
+	retval.ArchivedAt = v.TimeSchedule.ArchivedAt
+	return &retval, nil
+}
+
+// getTriageResponsibilityResponse is returned by getTriageResponsibility on success.
+type getTriageResponsibilityResponse struct {
+	// A specific triage responsibility.
+	TriageResponsibility getTriageResponsibilityTriageResponsibility `json:"triageResponsibility"`
+}
+
+// GetTriageResponsibility returns getTriageResponsibilityResponse.TriageResponsibility, and is useful for accessing the field via an interface.
+func (v *getTriageResponsibilityResponse) GetTriageResponsibility() getTriageResponsibilityTriageResponsibility {
+	return v.TriageResponsibility
+}
+
+// getTriageResponsibilityTriageResponsibility includes the requested fields of the GraphQL type TriageResponsibility.
+// The GraphQL type's documentation follows.
+//
+// A team's triage responsibility.
+type getTriageResponsibilityTriageResponsibility struct {
+	TriageResponsibility `json:"-"`
+}
+
+// GetId returns getTriageResponsibilityTriageResponsibility.Id, and is useful for accessing the field via an interface.
+func (v *getTriageResponsibilityTriageResponsibility) GetId() string {
+	return v.TriageResponsibility.Id
+}
+
+// GetAction returns getTriageResponsibilityTriageResponsibility.Action, and is useful for accessing the field via an interface.
+func (v *getTriageResponsibilityTriageResponsibility) GetAction() TriageResponsibilityAction {
+	return v.TriageResponsibility.Action
+}
+
+// GetManualSelection returns getTriageResponsibilityTriageResponsibility.ManualSelection, and is useful for accessing the field via an interface.
+func (v *getTriageResponsibilityTriageResponsibility) GetManualSelection() *TriageResponsibilityManualSelection {
+	return v.TriageResponsibility.ManualSelection
+}
+
+// GetTimeSchedule returns getTriageResponsibilityTriageResponsibility.TimeSchedule, and is useful for accessing the field via an interface.
+func (v *getTriageResponsibilityTriageResponsibility) GetTimeSchedule() *TriageResponsibilityTimeSchedule {
+	return v.TriageResponsibility.TimeSchedule
+}
+
+// GetTeam returns getTriageResponsibilityTriageResponsibility.Team, and is useful for accessing the field via an interface.
+func (v *getTriageResponsibilityTriageResponsibility) GetTeam() TriageResponsibilityTeam {
+	return v.TriageResponsibility.Team
+}
+
+// GetArchivedAt returns getTriageResponsibilityTriageResponsibility.ArchivedAt, and is useful for accessing the field via an interface.
+func (v *getTriageResponsibilityTriageResponsibility) GetArchivedAt() *time.Time {
+	return v.TriageResponsibility.ArchivedAt
+}
+
+func (v *getTriageResponsibilityTriageResponsibility) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getTriageResponsibilityTriageResponsibility
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getTriageResponsibilityTriageResponsibility = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.TriageResponsibility)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetTriageResponsibilityTriageResponsibility struct {
+	Id string `json:"id"`
+
+	Action TriageResponsibilityAction `json:"action"`
+
+	ManualSelection *TriageResponsibilityManualSelection `json:"manualSelection"`
+
+	TimeSchedule *TriageResponsibilityTimeSchedule `json:"timeSchedule"`
+
+	Team TriageResponsibilityTeam `json:"team"`
+
+	ArchivedAt *time.Time `json:"archivedAt"`
+}
+
+func (v *getTriageResponsibilityTriageResponsibility) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getTriageResponsibilityTriageResponsibility) __premarshalJSON() (*__premarshalgetTriageResponsibilityTriageResponsibility, error) {
+	var retval __premarshalgetTriageResponsibilityTriageResponsibility
+
+	retval.Id = v.TriageResponsibility.Id
+	retval.Action = v.TriageResponsibility.Action
+	retval.ManualSelection = v.TriageResponsibility.ManualSelection
+	retval.TimeSchedule = v.TriageResponsibility.TimeSchedule
+	retval.Team = v.TriageResponsibility.Team
+	retval.ArchivedAt = v.TriageResponsibility.ArchivedAt
+	return &retval, nil
+}
+
+// getWebhookResponse is returned by getWebhook on success.
+type getWebhookResponse struct {
+	// A specific webhook.
+	Webhook getWebhookWebhook `json:"webhook"`
+}
+
+// GetWebhook returns getWebhookResponse.Webhook, and is useful for accessing the field via an interface.
+func (v *getWebhookResponse) GetWebhook() getWebhookWebhook { return v.Webhook }
+
+// getWebhookWebhook includes the requested fields of the GraphQL type Webhook.
+// The GraphQL type's documentation follows.
+//
+// A webhook used to send HTTP notifications over data updates.
+type getWebhookWebhook struct {
+	Webhook `json:"-"`
+}
+
+// GetId returns getWebhookWebhook.Id, and is useful for accessing the field via an interface.
+func (v *getWebhookWebhook) GetId() string { return v.Webhook.Id }
+
+// GetLabel returns getWebhookWebhook.Label, and is useful for accessing the field via an interface.
+func (v *getWebhookWebhook) GetLabel() *string { return v.Webhook.Label }
+
+// GetUrl returns getWebhookWebhook.Url, and is useful for accessing the field via an interface.
+func (v *getWebhookWebhook) GetUrl() string { return v.Webhook.Url }
+
+// GetEnabled returns getWebhookWebhook.Enabled, and is useful for accessing the field via an interface.
+func (v *getWebhookWebhook) GetEnabled() bool { return v.Webhook.Enabled }
+
+// GetTeam returns getWebhookWebhook.Team, and is useful for accessing the field via an interface.
+func (v *getWebhookWebhook) GetTeam() *WebhookTeam { return v.Webhook.Team }
+
+// GetAllPublicTeams returns getWebhookWebhook.AllPublicTeams, and is useful for accessing the field via an interface.
+func (v *getWebhookWebhook) GetAllPublicTeams() bool { return v.Webhook.AllPublicTeams }
+
+// GetResourceTypes returns getWebhookWebhook.ResourceTypes, and is useful for accessing the field via an interface.
+func (v *getWebhookWebhook) GetResourceTypes() []string { return v.Webhook.ResourceTypes }
+
+func (v *getWebhookWebhook) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getWebhookWebhook
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getWebhookWebhook = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Webhook)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetWebhookWebhook struct {
+	Id string `json:"id"`
+
+	Label *string `json:"label"`
+
+	Url string `json:"url"`
+
+	Enabled bool `json:"enabled"`
+
+	Team *WebhookTeam `json:"team"`
+
+	AllPublicTeams bool `json:"allPublicTeams"`
+
+	ResourceTypes []string `json:"resourceTypes"`
+}
+
+func (v *getWebhookWebhook) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getWebhookWebhook) __premarshalJSON() (*__premarshalgetWebhookWebhook, error) {
+	var retval __premarshalgetWebhookWebhook
+
+	retval.Id = v.Webhook.Id
+	retval.Label = v.Webhook.Label
+	retval.Url = v.Webhook.Url
+	retval.Enabled = v.Webhook.Enabled
+	retval.Team = v.Webhook.Team
+	retval.AllPublicTeams = v.Webhook.AllPublicTeams
+	retval.ResourceTypes = v.Webhook.ResourceTypes
+	return &retval, nil
+}
+
+// getWorkflowStateResponse is returned by getWorkflowState on success.
+type getWorkflowStateResponse struct {
+	// One specific state.
+	WorkflowState getWorkflowStateWorkflowState `json:"workflowState"`
+}
+
+// GetWorkflowState returns getWorkflowStateResponse.WorkflowState, and is useful for accessing the field via an interface.
+func (v *getWorkflowStateResponse) GetWorkflowState() getWorkflowStateWorkflowState {
+	return v.WorkflowState
+}
+
+// getWorkflowStateWorkflowState includes the requested fields of the GraphQL type WorkflowState.
+// The GraphQL type's documentation follows.
+//
+// A state in a team workflow.
+type getWorkflowStateWorkflowState struct {
+	WorkflowState `json:"-"`
+}
+
+// GetId returns getWorkflowStateWorkflowState.Id, and is useful for accessing the field via an interface.
+func (v *getWorkflowStateWorkflowState) GetId() string { return v.WorkflowState.Id }
+
+// GetName returns getWorkflowStateWorkflowState.Name, and is useful for accessing the field via an interface.
+func (v *getWorkflowStateWorkflowState) GetName() string { return v.WorkflowState.Name }
+
+// GetColor returns getWorkflowStateWorkflowState.Color, and is useful for accessing the field via an interface.
+func (v *getWorkflowStateWorkflowState) GetColor() string { return v.WorkflowState.Color }
+
+// GetDescription returns getWorkflowStateWorkflowState.Description, and is useful for accessing the field via an interface.
+func (v *getWorkflowStateWorkflowState) GetDescription() *string { return v.WorkflowState.Description }
+
+// GetType returns getWorkflowStateWorkflowState.Type, and is useful for accessing the field via an interface.
+func (v *getWorkflowStateWorkflowState) GetType() string { return v.WorkflowState.Type }
+
+// GetPosition returns getWorkflowStateWorkflowState.Position, and is useful for accessing the field via an interface.
+func (v *getWorkflowStateWorkflowState) GetPosition() float64 { return v.WorkflowState.Position }
+
+// GetTeam returns getWorkflowStateWorkflowState.Team, and is useful for accessing the field via an interface.
+func (v *getWorkflowStateWorkflowState) GetTeam() WorkflowStateTeam { return v.WorkflowState.Team }
+
+func (v *getWorkflowStateWorkflowState) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getWorkflowStateWorkflowState
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getWorkflowStateWorkflowState = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.WorkflowState)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetWorkflowStateWorkflowState struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Color string `json:"color"`
+
+	Description *string `json:"description"`
+
+	Type string `json:"type"`
+
+	Position float64 `json:"position"`
+
+	Team WorkflowStateTeam `json:"team"`
+}
+
+func (v *getWorkflowStateWorkflowState) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getWorkflowStateWorkflowState) __premarshalJSON() (*__premarshalgetWorkflowStateWorkflowState, error) {
+	var retval __premarshalgetWorkflowStateWorkflowState
+
+	retval.Id = v.WorkflowState.Id
+	retval.Name = v.WorkflowState.Name
+	retval.Color = v.WorkflowState.Color
+	retval.Description = v.WorkflowState.Description
+	retval.Type = v.WorkflowState.Type
+	retval.Position = v.WorkflowState.Position
+	retval.Team = v.WorkflowState.Team
+	return &retval, nil
+}
+
+// getWorkspaceLabelsIssueLabelsIssueLabelConnection includes the requested fields of the GraphQL type IssueLabelConnection.
+type getWorkspaceLabelsIssueLabelsIssueLabelConnection struct {
+	Nodes []getWorkspaceLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel `json:"nodes"`
+}
+
+// GetNodes returns getWorkspaceLabelsIssueLabelsIssueLabelConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *getWorkspaceLabelsIssueLabelsIssueLabelConnection) GetNodes() []getWorkspaceLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel {
+	return v.Nodes
+}
+
+// getWorkspaceLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel includes the requested fields of the GraphQL type IssueLabel.
+// The GraphQL type's documentation follows.
+//
+// Labels that can be associated with issues.
+type getWorkspaceLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel struct {
+	IssueLabel `json:"-"`
+}
+
+// GetId returns getWorkspaceLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel.Id, and is useful for accessing the field via an interface.
+func (v *getWorkspaceLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel) GetId() string {
+	return v.IssueLabel.Id
+}
+
+// GetName returns getWorkspaceLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel.Name, and is useful for accessing the field via an interface.
+func (v *getWorkspaceLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel) GetName() string {
+	return v.IssueLabel.Name
+}
+
+// GetDescription returns getWorkspaceLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel.Description, and is useful for accessing the field via an interface.
+func (v *getWorkspaceLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel) GetDescription() *string {
+	return v.IssueLabel.Description
+}
+
+// GetColor returns getWorkspaceLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel.Color, and is useful for accessing the field via an interface.
+func (v *getWorkspaceLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel) GetColor() *string {
+	return v.IssueLabel.Color
+}
+
+// GetParent returns getWorkspaceLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel.Parent, and is useful for accessing the field via an interface.
+func (v *getWorkspaceLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel) GetParent() *IssueLabelParentIssueLabel {
+	return v.IssueLabel.Parent
+}
+
+// GetTeam returns getWorkspaceLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel.Team, and is useful for accessing the field via an interface.
+func (v *getWorkspaceLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel) GetTeam() *IssueLabelTeam {
+	return v.IssueLabel.Team
+}
+
+func (v *getWorkspaceLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getWorkspaceLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getWorkspaceLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.IssueLabel)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetWorkspaceLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description *string `json:"description"`
+
+	Color *string `json:"color"`
+
+	Parent *IssueLabelParentIssueLabel `json:"parent"`
+
+	Team *IssueLabelTeam `json:"team"`
+}
+
+func (v *getWorkspaceLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getWorkspaceLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel) __premarshalJSON() (*__premarshalgetWorkspaceLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel, error) {
+	var retval __premarshalgetWorkspaceLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel
+
+	retval.Id = v.IssueLabel.Id
+	retval.Name = v.IssueLabel.Name
+	retval.Description = v.IssueLabel.Description
+	retval.Color = v.IssueLabel.Color
+	retval.Parent = v.IssueLabel.Parent
+	retval.Team = v.IssueLabel.Team
+	return &retval, nil
+}
+
+// getWorkspaceLabelsResponse is returned by getWorkspaceLabels on success.
+type getWorkspaceLabelsResponse struct {
+	// All issue labels.
+	IssueLabels getWorkspaceLabelsIssueLabelsIssueLabelConnection `json:"issueLabels"`
+}
+
+// GetIssueLabels returns getWorkspaceLabelsResponse.IssueLabels, and is useful for accessing the field via an interface.
+func (v *getWorkspaceLabelsResponse) GetIssueLabels() getWorkspaceLabelsIssueLabelsIssueLabelConnection {
+	return v.IssueLabels
+}
+
+// getWorkspaceOrganization includes the requested fields of the GraphQL type Organization.
+// The GraphQL type's documentation follows.
+//
+// An organization. Organizations are root-level objects that contain user accounts and teams.
+type getWorkspaceOrganization struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The organization's unique URL key.
+	UrlKey string `json:"urlKey"`
+	// The organization's name.
+	Name string `json:"name"`
+}
+
+// GetId returns getWorkspaceOrganization.Id, and is useful for accessing the field via an interface.
+func (v *getWorkspaceOrganization) GetId() string { return v.Id }
+
+// GetUrlKey returns getWorkspaceOrganization.UrlKey, and is useful for accessing the field via an interface.
+func (v *getWorkspaceOrganization) GetUrlKey() string { return v.UrlKey }
+
+// GetName returns getWorkspaceOrganization.Name, and is useful for accessing the field via an interface.
+func (v *getWorkspaceOrganization) GetName() string { return v.Name }
+
+// getWorkspaceResponse is returned by getWorkspace on success.
+type getWorkspaceResponse struct {
+	// The user's organization.
+	Organization getWorkspaceOrganization `json:"organization"`
+}
+
+// GetOrganization returns getWorkspaceResponse.Organization, and is useful for accessing the field via an interface.
+func (v *getWorkspaceResponse) GetOrganization() getWorkspaceOrganization { return v.Organization }
+
+// getWorkspaceSettingsOrganization includes the requested fields of the GraphQL type Organization.
+// The GraphQL type's documentation follows.
+//
+// An organization. Organizations are root-level objects that contain user accounts and teams.
+type getWorkspaceSettingsOrganization struct {
+	Organization `json:"-"`
+}
+
+// GetId returns getWorkspaceSettingsOrganization.Id, and is useful for accessing the field via an interface.
+func (v *getWorkspaceSettingsOrganization) GetId() string { return v.Organization.Id }
+
+// GetAllowMembersToInvite returns getWorkspaceSettingsOrganization.AllowMembersToInvite, and is useful for accessing the field via an interface.
+func (v *getWorkspaceSettingsOrganization) GetAllowMembersToInvite() bool {
+	return v.Organization.AllowMembersToInvite
+}
+
+// GetRestrictTeamCreationToAdmins returns getWorkspaceSettingsOrganization.RestrictTeamCreationToAdmins, and is useful for accessing the field via an interface.
+func (v *getWorkspaceSettingsOrganization) GetRestrictTeamCreationToAdmins() bool {
+	return v.Organization.RestrictTeamCreationToAdmins
+}
+
+// GetRestrictLabelManagementToAdmins returns getWorkspaceSettingsOrganization.RestrictLabelManagementToAdmins, and is useful for accessing the field via an interface.
+func (v *getWorkspaceSettingsOrganization) GetRestrictLabelManagementToAdmins() bool {
+	return v.Organization.RestrictLabelManagementToAdmins
+}
+
+// GetGitLinkbackMessagesEnabled returns getWorkspaceSettingsOrganization.GitLinkbackMessagesEnabled, and is useful for accessing the field via an interface.
+func (v *getWorkspaceSettingsOrganization) GetGitLinkbackMessagesEnabled() bool {
+	return v.Organization.GitLinkbackMessagesEnabled
+}
+
+// GetGitPublicLinkbackMessagesEnabled returns getWorkspaceSettingsOrganization.GitPublicLinkbackMessagesEnabled, and is useful for accessing the field via an interface.
+func (v *getWorkspaceSettingsOrganization) GetGitPublicLinkbackMessagesEnabled() bool {
+	return v.Organization.GitPublicLinkbackMessagesEnabled
+}
+
+// GetFiscalYearStartMonth returns getWorkspaceSettingsOrganization.FiscalYearStartMonth, and is useful for accessing the field via an interface.
+func (v *getWorkspaceSettingsOrganization) GetFiscalYearStartMonth() float64 {
+	return v.Organization.FiscalYearStartMonth
+}
+
+// GetProjectUpdateReminderFrequencyInWeeks returns getWorkspaceSettingsOrganization.ProjectUpdateReminderFrequencyInWeeks, and is useful for accessing the field via an interface.
+func (v *getWorkspaceSettingsOrganization) GetProjectUpdateReminderFrequencyInWeeks() float64 {
+	return v.Organization.ProjectUpdateReminderFrequencyInWeeks
+}
+
+// GetProjectUpdateRemindersDay returns getWorkspaceSettingsOrganization.ProjectUpdateRemindersDay, and is useful for accessing the field via an interface.
+func (v *getWorkspaceSettingsOrganization) GetProjectUpdateRemindersDay() Day {
+	return v.Organization.ProjectUpdateRemindersDay
+}
+
+// GetProjectUpdateRemindersHour returns getWorkspaceSettingsOrganization.ProjectUpdateRemindersHour, and is useful for accessing the field via an interface.
+func (v *getWorkspaceSettingsOrganization) GetProjectUpdateRemindersHour() float64 {
+	return v.Organization.ProjectUpdateRemindersHour
+}
+
+// GetRoadmapEnabled returns getWorkspaceSettingsOrganization.RoadmapEnabled, and is useful for accessing the field via an interface.
+func (v *getWorkspaceSettingsOrganization) GetRoadmapEnabled() bool {
+	return v.Organization.RoadmapEnabled
+}
+
+// GetInitiativeUpdateReminderFrequencyInWeeks returns getWorkspaceSettingsOrganization.InitiativeUpdateReminderFrequencyInWeeks, and is useful for accessing the field via an interface.
+func (v *getWorkspaceSettingsOrganization) GetInitiativeUpdateReminderFrequencyInWeeks() float64 {
+	return v.Organization.InitiativeUpdateReminderFrequencyInWeeks
+}
+
+// GetInitiativeUpdateRemindersDay returns getWorkspaceSettingsOrganization.InitiativeUpdateRemindersDay, and is useful for accessing the field via an interface.
+func (v *getWorkspaceSettingsOrganization) GetInitiativeUpdateRemindersDay() Day {
+	return v.Organization.InitiativeUpdateRemindersDay
+}
+
+// GetInitiativeUpdateRemindersHour returns getWorkspaceSettingsOrganization.InitiativeUpdateRemindersHour, and is useful for accessing the field via an interface.
+func (v *getWorkspaceSettingsOrganization) GetInitiativeUpdateRemindersHour() float64 {
+	return v.Organization.InitiativeUpdateRemindersHour
+}
+
+// GetFeedEnabled returns getWorkspaceSettingsOrganization.FeedEnabled, and is useful for accessing the field via an interface.
+func (v *getWorkspaceSettingsOrganization) GetFeedEnabled() bool { return v.Organization.FeedEnabled }
+
+// GetDefaultFeedSummarySchedule returns getWorkspaceSettingsOrganization.DefaultFeedSummarySchedule, and is useful for accessing the field via an interface.
+func (v *getWorkspaceSettingsOrganization) GetDefaultFeedSummarySchedule() FeedSummarySchedule {
+	return v.Organization.DefaultFeedSummarySchedule
+}
+
+// GetCustomersEnabled returns getWorkspaceSettingsOrganization.CustomersEnabled, and is useful for accessing the field via an interface.
+func (v *getWorkspaceSettingsOrganization) GetCustomersEnabled() bool {
+	return v.Organization.CustomersEnabled
+}
+
+func (v *getWorkspaceSettingsOrganization) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getWorkspaceSettingsOrganization
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getWorkspaceSettingsOrganization = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Organization)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetWorkspaceSettingsOrganization struct {
+	Id string `json:"id"`
+
+	AllowMembersToInvite bool `json:"allowMembersToInvite"`
+
+	RestrictTeamCreationToAdmins bool `json:"restrictTeamCreationToAdmins"`
+
+	RestrictLabelManagementToAdmins bool `json:"restrictLabelManagementToAdmins"`
+
+	GitLinkbackMessagesEnabled bool `json:"gitLinkbackMessagesEnabled"`
+
+	GitPublicLinkbackMessagesEnabled bool `json:"gitPublicLinkbackMessagesEnabled"`
+
+	FiscalYearStartMonth float64 `json:"fiscalYearStartMonth"`
+
+	ProjectUpdateReminderFrequencyInWeeks float64 `json:"projectUpdateReminderFrequencyInWeeks"`
+
+	ProjectUpdateRemindersDay Day `json:"projectUpdateRemindersDay"`
+
+	ProjectUpdateRemindersHour float64 `json:"projectUpdateRemindersHour"`
+
+	RoadmapEnabled bool `json:"roadmapEnabled"`
+
+	InitiativeUpdateReminderFrequencyInWeeks float64 `json:"initiativeUpdateReminderFrequencyInWeeks"`
+
+	InitiativeUpdateRemindersDay Day `json:"initiativeUpdateRemindersDay"`
+
+	InitiativeUpdateRemindersHour float64 `json:"initiativeUpdateRemindersHour"`
+
+	FeedEnabled bool `json:"feedEnabled"`
+
+	DefaultFeedSummarySchedule FeedSummarySchedule `json:"defaultFeedSummarySchedule"`
+
+	CustomersEnabled bool `json:"customersEnabled"`
+}
+
+func (v *getWorkspaceSettingsOrganization) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getWorkspaceSettingsOrganization) __premarshalJSON() (*__premarshalgetWorkspaceSettingsOrganization, error) {
+	var retval __premarshalgetWorkspaceSettingsOrganization
+
+	retval.Id = v.Organization.Id
+	retval.AllowMembersToInvite = v.Organization.AllowMembersToInvite
+	retval.RestrictTeamCreationToAdmins = v.Organization.RestrictTeamCreationToAdmins
+	retval.RestrictLabelManagementToAdmins = v.Organization.RestrictLabelManagementToAdmins
+	retval.GitLinkbackMessagesEnabled = v.Organization.GitLinkbackMessagesEnabled
+	retval.GitPublicLinkbackMessagesEnabled = v.Organization.GitPublicLinkbackMessagesEnabled
+	retval.FiscalYearStartMonth = v.Organization.FiscalYearStartMonth
+	retval.ProjectUpdateReminderFrequencyInWeeks = v.Organization.ProjectUpdateReminderFrequencyInWeeks
+	retval.ProjectUpdateRemindersDay = v.Organization.ProjectUpdateRemindersDay
+	retval.ProjectUpdateRemindersHour = v.Organization.ProjectUpdateRemindersHour
+	retval.RoadmapEnabled = v.Organization.RoadmapEnabled
+	retval.InitiativeUpdateReminderFrequencyInWeeks = v.Organization.InitiativeUpdateReminderFrequencyInWeeks
+	retval.InitiativeUpdateRemindersDay = v.Organization.InitiativeUpdateRemindersDay
+	retval.InitiativeUpdateRemindersHour = v.Organization.InitiativeUpdateRemindersHour
+	retval.FeedEnabled = v.Organization.FeedEnabled
+	retval.DefaultFeedSummarySchedule = v.Organization.DefaultFeedSummarySchedule
+	retval.CustomersEnabled = v.Organization.CustomersEnabled
+	return &retval, nil
+}
+
+// getWorkspaceSettingsResponse is returned by getWorkspaceSettings on success.
+type getWorkspaceSettingsResponse struct {
+	// The user's organization.
+	Organization getWorkspaceSettingsOrganization `json:"organization"`
+}
+
+// GetOrganization returns getWorkspaceSettingsResponse.Organization, and is useful for accessing the field via an interface.
+func (v *getWorkspaceSettingsResponse) GetOrganization() getWorkspaceSettingsOrganization {
+	return v.Organization
+}
+
+// refreshOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayload includes the requested fields of the GraphQL type OrganizationDomainPayload.
+// The GraphQL type's documentation follows.
+//
+// [INTERNAL] Organization domain operation response.
+type refreshOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayload struct {
+	// The organization domain that was created or updated.
+	OrganizationDomain refreshOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain `json:"organizationDomain"`
+}
+
+// GetOrganizationDomain returns refreshOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayload.OrganizationDomain, and is useful for accessing the field via an interface.
+func (v *refreshOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayload) GetOrganizationDomain() refreshOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain {
+	return v.OrganizationDomain
+}
+
+// refreshOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain includes the requested fields of the GraphQL type OrganizationDomain.
+// The GraphQL type's documentation follows.
+//
+// Defines the use of a domain by an organization.
+type refreshOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain struct {
+	OrganizationDomain `json:"-"`
+}
+
+// GetId returns refreshOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain.Id, and is useful for accessing the field via an interface.
+func (v *refreshOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain) GetId() string {
+	return v.OrganizationDomain.Id
+}
+
+// GetName returns refreshOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain.Name, and is useful for accessing the field via an interface.
+func (v *refreshOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain) GetName() string {
+	return v.OrganizationDomain.Name
+}
+
+// GetVerificationEmail returns refreshOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain.VerificationEmail, and is useful for accessing the field via an interface.
+func (v *refreshOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain) GetVerificationEmail() *string {
+	return v.OrganizationDomain.VerificationEmail
+}
+
+// GetAuthType returns refreshOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain.AuthType, and is useful for accessing the field via an interface.
+func (v *refreshOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain) GetAuthType() OrganizationDomainAuthType {
+	return v.OrganizationDomain.AuthType
+}
+
+// GetVerified returns refreshOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain.Verified, and is useful for accessing the field via an interface.
+func (v *refreshOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain) GetVerified() bool {
+	return v.OrganizationDomain.Verified
+}
+
+// GetClaimed returns refreshOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain.Claimed, and is useful for accessing the field via an interface.
+func (v *refreshOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain) GetClaimed() *bool {
+	return v.OrganizationDomain.Claimed
+}
+
+// GetDisableOrganizationCreation returns refreshOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain.DisableOrganizationCreation, and is useful for accessing the field via an interface.
+func (v *refreshOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain) GetDisableOrganizationCreation() *bool {
+	return v.OrganizationDomain.DisableOrganizationCreation
+}
+
+func (v *refreshOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*refreshOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.refreshOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.OrganizationDomain)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalrefreshOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	VerificationEmail *string `json:"verificationEmail"`
+
+	AuthType OrganizationDomainAuthType `json:"authType"`
+
+	Verified bool `json:"verified"`
+
+	Claimed *bool `json:"claimed"`
+
+	DisableOrganizationCreation *bool `json:"disableOrganizationCreation"`
+}
+
+func (v *refreshOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *refreshOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain) __premarshalJSON() (*__premarshalrefreshOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain, error) {
+	var retval __premarshalrefreshOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain
+
+	retval.Id = v.OrganizationDomain.Id
+	retval.Name = v.OrganizationDomain.Name
+	retval.VerificationEmail = v.OrganizationDomain.VerificationEmail
+	retval.AuthType = v.OrganizationDomain.AuthType
+	retval.Verified = v.OrganizationDomain.Verified
+	retval.Claimed = v.OrganizationDomain.Claimed
+	retval.DisableOrganizationCreation = v.OrganizationDomain.DisableOrganizationCreation
+	return &retval, nil
+}
+
+// refreshOrganizationDomainResponse is returned by refreshOrganizationDomain on success.
+type refreshOrganizationDomainResponse struct {
+	// [INTERNAL] Updates an organization domain settings.
+	OrganizationDomainUpdate refreshOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayload `json:"organizationDomainUpdate"`
+}
+
+// GetOrganizationDomainUpdate returns refreshOrganizationDomainResponse.OrganizationDomainUpdate, and is useful for accessing the field via an interface.
+func (v *refreshOrganizationDomainResponse) GetOrganizationDomainUpdate() refreshOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayload {
+	return v.OrganizationDomainUpdate
+}
+
+// removeTeamParentResponse is returned by removeTeamParent on success.
+type removeTeamParentResponse struct {
+	// Updates a team.
+	TeamUpdate removeTeamParentTeamUpdateTeamPayload `json:"teamUpdate"`
+}
+
+// GetTeamUpdate returns removeTeamParentResponse.TeamUpdate, and is useful for accessing the field via an interface.
+func (v *removeTeamParentResponse) GetTeamUpdate() removeTeamParentTeamUpdateTeamPayload {
+	return v.TeamUpdate
+}
+
+// removeTeamParentTeamUpdateTeamPayload includes the requested fields of the GraphQL type TeamPayload.
+type removeTeamParentTeamUpdateTeamPayload struct {
+	// The team that was created or updated.
+	Team removeTeamParentTeamUpdateTeamPayloadTeam `json:"team"`
+}
+
+// GetTeam returns removeTeamParentTeamUpdateTeamPayload.Team, and is useful for accessing the field via an interface.
+func (v *removeTeamParentTeamUpdateTeamPayload) GetTeam() removeTeamParentTeamUpdateTeamPayloadTeam {
+	return v.Team
+}
+
+// removeTeamParentTeamUpdateTeamPayloadTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type removeTeamParentTeamUpdateTeamPayloadTeam struct {
+	Team `json:"-"`
+}
+
+// GetId returns removeTeamParentTeamUpdateTeamPayloadTeam.Id, and is useful for accessing the field via an interface.
+func (v *removeTeamParentTeamUpdateTeamPayloadTeam) GetId() string { return v.Team.Id }
+
+// GetName returns removeTeamParentTeamUpdateTeamPayloadTeam.Name, and is useful for accessing the field via an interface.
+func (v *removeTeamParentTeamUpdateTeamPayloadTeam) GetName() string { return v.Team.Name }
+
+// GetKey returns removeTeamParentTeamUpdateTeamPayloadTeam.Key, and is useful for accessing the field via an interface.
+func (v *removeTeamParentTeamUpdateTeamPayloadTeam) GetKey() string { return v.Team.Key }
+
+// GetPrivate returns removeTeamParentTeamUpdateTeamPayloadTeam.Private, and is useful for accessing the field via an interface.
+func (v *removeTeamParentTeamUpdateTeamPayloadTeam) GetPrivate() bool { return v.Team.Private }
+
+// GetDescription returns removeTeamParentTeamUpdateTeamPayloadTeam.Description, and is useful for accessing the field via an interface.
+func (v *removeTeamParentTeamUpdateTeamPayloadTeam) GetDescription() *string {
+	return v.Team.Description
+}
+
+// GetIcon returns removeTeamParentTeamUpdateTeamPayloadTeam.Icon, and is useful for accessing the field via an interface.
+func (v *removeTeamParentTeamUpdateTeamPayloadTeam) GetIcon() *string { return v.Team.Icon }
+
+// GetColor returns removeTeamParentTeamUpdateTeamPayloadTeam.Color, and is useful for accessing the field via an interface.
+func (v *removeTeamParentTeamUpdateTeamPayloadTeam) GetColor() *string { return v.Team.Color }
+
+// GetParent returns removeTeamParentTeamUpdateTeamPayloadTeam.Parent, and is useful for accessing the field via an interface.
+func (v *removeTeamParentTeamUpdateTeamPayloadTeam) GetParent() *TeamParentTeam { return v.Team.Parent }
+
+// GetTimezone returns removeTeamParentTeamUpdateTeamPayloadTeam.Timezone, and is useful for accessing the field via an interface.
+func (v *removeTeamParentTeamUpdateTeamPayloadTeam) GetTimezone() string { return v.Team.Timezone }
+
+// GetGroupIssueHistory returns removeTeamParentTeamUpdateTeamPayloadTeam.GroupIssueHistory, and is useful for accessing the field via an interface.
+func (v *removeTeamParentTeamUpdateTeamPayloadTeam) GetGroupIssueHistory() bool {
+	return v.Team.GroupIssueHistory
+}
+
+// GetSetIssueSortOrderOnStateChange returns removeTeamParentTeamUpdateTeamPayloadTeam.SetIssueSortOrderOnStateChange, and is useful for accessing the field via an interface.
+func (v *removeTeamParentTeamUpdateTeamPayloadTeam) GetSetIssueSortOrderOnStateChange() string {
+	return v.Team.SetIssueSortOrderOnStateChange
+}
+
+// GetAiThreadSummariesEnabled returns removeTeamParentTeamUpdateTeamPayloadTeam.AiThreadSummariesEnabled, and is useful for accessing the field via an interface.
+func (v *removeTeamParentTeamUpdateTeamPayloadTeam) GetAiThreadSummariesEnabled() bool {
+	return v.Team.AiThreadSummariesEnabled
+}
+
+// GetAutoArchivePeriod returns removeTeamParentTeamUpdateTeamPayloadTeam.AutoArchivePeriod, and is useful for accessing the field via an interface.
+func (v *removeTeamParentTeamUpdateTeamPayloadTeam) GetAutoArchivePeriod() float64 {
+	return v.Team.AutoArchivePeriod
+}
+
+// GetAutoClosePeriod returns removeTeamParentTeamUpdateTeamPayloadTeam.AutoClosePeriod, and is useful for accessing the field via an interface.
+func (v *removeTeamParentTeamUpdateTeamPayloadTeam) GetAutoClosePeriod() *float64 {
+	return v.Team.AutoClosePeriod
+}
+
+// GetAutoCloseParentIssues returns removeTeamParentTeamUpdateTeamPayloadTeam.AutoCloseParentIssues, and is useful for accessing the field via an interface.
+func (v *removeTeamParentTeamUpdateTeamPayloadTeam) GetAutoCloseParentIssues() bool {
+	return v.Team.AutoCloseParentIssues
+}
+
+// GetAutoCloseChildIssues returns removeTeamParentTeamUpdateTeamPayloadTeam.AutoCloseChildIssues, and is useful for accessing the field via an interface.
+func (v *removeTeamParentTeamUpdateTeamPayloadTeam) GetAutoCloseChildIssues() bool {
+	return v.Team.AutoCloseChildIssues
+}
+
+// GetTriageEnabled returns removeTeamParentTeamUpdateTeamPayloadTeam.TriageEnabled, and is useful for accessing the field via an interface.
+func (v *removeTeamParentTeamUpdateTeamPayloadTeam) GetTriageEnabled() bool {
+	return v.Team.TriageEnabled
+}
+
+// GetRequirePriorityToLeaveTriage returns removeTeamParentTeamUpdateTeamPayloadTeam.RequirePriorityToLeaveTriage, and is useful for accessing the field via an interface.
+func (v *removeTeamParentTeamUpdateTeamPayloadTeam) GetRequirePriorityToLeaveTriage() bool {
+	return v.Team.RequirePriorityToLeaveTriage
+}
+
+// GetCyclesEnabled returns removeTeamParentTeamUpdateTeamPayloadTeam.CyclesEnabled, and is useful for accessing the field via an interface.
+func (v *removeTeamParentTeamUpdateTeamPayloadTeam) GetCyclesEnabled() bool {
+	return v.Team.CyclesEnabled
+}
+
+// GetCycleStartDay returns removeTeamParentTeamUpdateTeamPayloadTeam.CycleStartDay, and is useful for accessing the field via an interface.
+func (v *removeTeamParentTeamUpdateTeamPayloadTeam) GetCycleStartDay() float64 {
+	return v.Team.CycleStartDay
+}
+
+// GetCycleDuration returns removeTeamParentTeamUpdateTeamPayloadTeam.CycleDuration, and is useful for accessing the field via an interface.
+func (v *removeTeamParentTeamUpdateTeamPayloadTeam) GetCycleDuration() float64 {
+	return v.Team.CycleDuration
+}
+
+// GetCycleCooldownTime returns removeTeamParentTeamUpdateTeamPayloadTeam.CycleCooldownTime, and is useful for accessing the field via an interface.
+func (v *removeTeamParentTeamUpdateTeamPayloadTeam) GetCycleCooldownTime() float64 {
+	return v.Team.CycleCooldownTime
+}
+
+// GetUpcomingCycleCount returns removeTeamParentTeamUpdateTeamPayloadTeam.UpcomingCycleCount, and is useful for accessing the field via an interface.
+func (v *removeTeamParentTeamUpdateTeamPayloadTeam) GetUpcomingCycleCount() float64 {
+	return v.Team.UpcomingCycleCount
+}
+
+// GetCycleIssueAutoAssignStarted returns removeTeamParentTeamUpdateTeamPayloadTeam.CycleIssueAutoAssignStarted, and is useful for accessing the field via an interface.
+func (v *removeTeamParentTeamUpdateTeamPayloadTeam) GetCycleIssueAutoAssignStarted() bool {
+	return v.Team.CycleIssueAutoAssignStarted
+}
+
+// GetCycleIssueAutoAssignCompleted returns removeTeamParentTeamUpdateTeamPayloadTeam.CycleIssueAutoAssignCompleted, and is useful for accessing the field via an interface.
+func (v *removeTeamParentTeamUpdateTeamPayloadTeam) GetCycleIssueAutoAssignCompleted() bool {
+	return v.Team.CycleIssueAutoAssignCompleted
+}
+
+// GetCycleLockToActive returns removeTeamParentTeamUpdateTeamPayloadTeam.CycleLockToActive, and is useful for accessing the field via an interface.
+func (v *removeTeamParentTeamUpdateTeamPayloadTeam) GetCycleLockToActive() bool {
+	return v.Team.CycleLockToActive
+}
+
+// GetIssueEstimationType returns removeTeamParentTeamUpdateTeamPayloadTeam.IssueEstimationType, and is useful for accessing the field via an interface.
+func (v *removeTeamParentTeamUpdateTeamPayloadTeam) GetIssueEstimationType() string {
+	return v.Team.IssueEstimationType
+}
+
+// GetIssueEstimationAllowZero returns removeTeamParentTeamUpdateTeamPayloadTeam.IssueEstimationAllowZero, and is useful for accessing the field via an interface.
+func (v *removeTeamParentTeamUpdateTeamPayloadTeam) GetIssueEstimationAllowZero() bool {
+	return v.Team.IssueEstimationAllowZero
+}
+
+// GetIssueEstimationExtended returns removeTeamParentTeamUpdateTeamPayloadTeam.IssueEstimationExtended, and is useful for accessing the field via an interface.
+func (v *removeTeamParentTeamUpdateTeamPayloadTeam) GetIssueEstimationExtended() bool {
+	return v.Team.IssueEstimationExtended
+}
+
+// GetDefaultIssueEstimate returns removeTeamParentTeamUpdateTeamPayloadTeam.DefaultIssueEstimate, and is useful for accessing the field via an interface.
+func (v *removeTeamParentTeamUpdateTeamPayloadTeam) GetDefaultIssueEstimate() float64 {
+	return v.Team.DefaultIssueEstimate
+}
+
+func (v *removeTeamParentTeamUpdateTeamPayloadTeam) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*removeTeamParentTeamUpdateTeamPayloadTeam
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.removeTeamParentTeamUpdateTeamPayloadTeam = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Team)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalremoveTeamParentTeamUpdateTeamPayloadTeam struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Key string `json:"key"`
+
+	Private bool `json:"private"`
+
+	Description *string `json:"description"`
+
+	Icon *string `json:"icon"`
+
+	Color *string `json:"color"`
+
+	Parent *TeamParentTeam `json:"parent"`
+
+	Timezone string `json:"timezone"`
+
+	GroupIssueHistory bool `json:"groupIssueHistory"`
+
+	SetIssueSortOrderOnStateChange string `json:"setIssueSortOrderOnStateChange"`
+
+	AiThreadSummariesEnabled bool `json:"aiThreadSummariesEnabled"`
+
+	AutoArchivePeriod float64 `json:"autoArchivePeriod"`
+
+	AutoClosePeriod *float64 `json:"autoClosePeriod"`
+
+	AutoCloseParentIssues bool `json:"autoCloseParentIssues"`
+
+	AutoCloseChildIssues bool `json:"autoCloseChildIssues"`
+
+	TriageEnabled bool `json:"triageEnabled"`
+
+	RequirePriorityToLeaveTriage bool `json:"requirePriorityToLeaveTriage"`
+
+	CyclesEnabled bool `json:"cyclesEnabled"`
+
+	CycleStartDay float64 `json:"cycleStartDay"`
+
+	CycleDuration float64 `json:"cycleDuration"`
+
+	CycleCooldownTime float64 `json:"cycleCooldownTime"`
+
+	UpcomingCycleCount float64 `json:"upcomingCycleCount"`
+
+	CycleIssueAutoAssignStarted bool `json:"cycleIssueAutoAssignStarted"`
+
+	CycleIssueAutoAssignCompleted bool `json:"cycleIssueAutoAssignCompleted"`
+
+	CycleLockToActive bool `json:"cycleLockToActive"`
+
+	IssueEstimationType string `json:"issueEstimationType"`
+
+	IssueEstimationAllowZero bool `json:"issueEstimationAllowZero"`
+
+	IssueEstimationExtended bool `json:"issueEstimationExtended"`
+
+	DefaultIssueEstimate float64 `json:"defaultIssueEstimate"`
+}
+
+func (v *removeTeamParentTeamUpdateTeamPayloadTeam) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *removeTeamParentTeamUpdateTeamPayloadTeam) __premarshalJSON() (*__premarshalremoveTeamParentTeamUpdateTeamPayloadTeam, error) {
+	var retval __premarshalremoveTeamParentTeamUpdateTeamPayloadTeam
+
+	retval.Id = v.Team.Id
+	retval.Name = v.Team.Name
+	retval.Key = v.Team.Key
+	retval.Private = v.Team.Private
+	retval.Description = v.Team.Description
+	retval.Icon = v.Team.Icon
+	retval.Color = v.Team.Color
+	retval.Parent = v.Team.Parent
+	retval.Timezone = v.Team.Timezone
+	retval.GroupIssueHistory = v.Team.GroupIssueHistory
+	retval.SetIssueSortOrderOnStateChange = v.Team.SetIssueSortOrderOnStateChange
+	retval.AiThreadSummariesEnabled = v.Team.AiThreadSummariesEnabled
+	retval.AutoArchivePeriod = v.Team.AutoArchivePeriod
+	retval.AutoClosePeriod = v.Team.AutoClosePeriod
+	retval.AutoCloseParentIssues = v.Team.AutoCloseParentIssues
+	retval.AutoCloseChildIssues = v.Team.AutoCloseChildIssues
+	retval.TriageEnabled = v.Team.TriageEnabled
+	retval.RequirePriorityToLeaveTriage = v.Team.RequirePriorityToLeaveTriage
+	retval.CyclesEnabled = v.Team.CyclesEnabled
+	retval.CycleStartDay = v.Team.CycleStartDay
+	retval.CycleDuration = v.Team.CycleDuration
+	retval.CycleCooldownTime = v.Team.CycleCooldownTime
+	retval.UpcomingCycleCount = v.Team.UpcomingCycleCount
+	retval.CycleIssueAutoAssignStarted = v.Team.CycleIssueAutoAssignStarted
+	retval.CycleIssueAutoAssignCompleted = v.Team.CycleIssueAutoAssignCompleted
+	retval.CycleLockToActive = v.Team.CycleLockToActive
+	retval.IssueEstimationType = v.Team.IssueEstimationType
+	retval.IssueEstimationAllowZero = v.Team.IssueEstimationAllowZero
+	retval.IssueEstimationExtended = v.Team.IssueEstimationExtended
+	retval.DefaultIssueEstimate = v.Team.DefaultIssueEstimate
+	return &retval, nil
+}
+
+// rotateEmailIntakeAddressEmailIntakeAddressRotateEmailIntakeAddressPayload includes the requested fields of the GraphQL type EmailIntakeAddressPayload.
+type rotateEmailIntakeAddressEmailIntakeAddressRotateEmailIntakeAddressPayload struct {
+	// The email address that was created or updated.
+	EmailIntakeAddress rotateEmailIntakeAddressEmailIntakeAddressRotateEmailIntakeAddressPayloadEmailIntakeAddress `json:"emailIntakeAddress"`
+}
+
+// GetEmailIntakeAddress returns rotateEmailIntakeAddressEmailIntakeAddressRotateEmailIntakeAddressPayload.EmailIntakeAddress, and is useful for accessing the field via an interface.
+func (v *rotateEmailIntakeAddressEmailIntakeAddressRotateEmailIntakeAddressPayload) GetEmailIntakeAddress() rotateEmailIntakeAddressEmailIntakeAddressRotateEmailIntakeAddressPayloadEmailIntakeAddress {
+	return v.EmailIntakeAddress
+}
+
+// rotateEmailIntakeAddressEmailIntakeAddressRotateEmailIntakeAddressPayloadEmailIntakeAddress includes the requested fields of the GraphQL type EmailIntakeAddress.
+// The GraphQL type's documentation follows.
+//
+// An email address that can be used for submitting issues.
+type rotateEmailIntakeAddressEmailIntakeAddressRotateEmailIntakeAddressPayloadEmailIntakeAddress struct {
+	EmailIntakeAddress `json:"-"`
+}
+
+// GetId returns rotateEmailIntakeAddressEmailIntakeAddressRotateEmailIntakeAddressPayloadEmailIntakeAddress.Id, and is useful for accessing the field via an interface.
+func (v *rotateEmailIntakeAddressEmailIntakeAddressRotateEmailIntakeAddressPayloadEmailIntakeAddress) GetId() string {
+	return v.EmailIntakeAddress.Id
+}
+
+// GetAddress returns rotateEmailIntakeAddressEmailIntakeAddressRotateEmailIntakeAddressPayloadEmailIntakeAddress.Address, and is useful for accessing the field via an interface.
+func (v *rotateEmailIntakeAddressEmailIntakeAddressRotateEmailIntakeAddressPayloadEmailIntakeAddress) GetAddress() string {
+	return v.EmailIntakeAddress.Address
+}
+
+// GetForwardingEmailAddress returns rotateEmailIntakeAddressEmailIntakeAddressRotateEmailIntakeAddressPayloadEmailIntakeAddress.ForwardingEmailAddress, and is useful for accessing the field via an interface.
+func (v *rotateEmailIntakeAddressEmailIntakeAddressRotateEmailIntakeAddressPayloadEmailIntakeAddress) GetForwardingEmailAddress() *string {
+	return v.EmailIntakeAddress.ForwardingEmailAddress
+}
+
+// GetSenderName returns rotateEmailIntakeAddressEmailIntakeAddressRotateEmailIntakeAddressPayloadEmailIntakeAddress.SenderName, and is useful for accessing the field via an interface.
+func (v *rotateEmailIntakeAddressEmailIntakeAddressRotateEmailIntakeAddressPayloadEmailIntakeAddress) GetSenderName() *string {
+	return v.EmailIntakeAddress.SenderName
+}
+
+// GetEnabled returns rotateEmailIntakeAddressEmailIntakeAddressRotateEmailIntakeAddressPayloadEmailIntakeAddress.Enabled, and is useful for accessing the field via an interface.
+func (v *rotateEmailIntakeAddressEmailIntakeAddressRotateEmailIntakeAddressPayloadEmailIntakeAddress) GetEnabled() bool {
+	return v.EmailIntakeAddress.Enabled
+}
+
+// GetRepliesEnabled returns rotateEmailIntakeAddressEmailIntakeAddressRotateEmailIntakeAddressPayloadEmailIntakeAddress.RepliesEnabled, and is useful for accessing the field via an interface.
+func (v *rotateEmailIntakeAddressEmailIntakeAddressRotateEmailIntakeAddressPayloadEmailIntakeAddress) GetRepliesEnabled() bool {
+	return v.EmailIntakeAddress.RepliesEnabled
+}
+
+// GetTeam returns rotateEmailIntakeAddressEmailIntakeAddressRotateEmailIntakeAddressPayloadEmailIntakeAddress.Team, and is useful for accessing the field via an interface.
+func (v *rotateEmailIntakeAddressEmailIntakeAddressRotateEmailIntakeAddressPayloadEmailIntakeAddress) GetTeam() *EmailIntakeAddressTeam {
+	return v.EmailIntakeAddress.Team
+}
+
+// GetTemplate returns rotateEmailIntakeAddressEmailIntakeAddressRotateEmailIntakeAddressPayloadEmailIntakeAddress.Template, and is useful for accessing the field via an interface.
+func (v *rotateEmailIntakeAddressEmailIntakeAddressRotateEmailIntakeAddressPayloadEmailIntakeAddress) GetTemplate() *EmailIntakeAddressTemplate {
+	return v.EmailIntakeAddress.Template
+}
+
+// GetArchivedAt returns rotateEmailIntakeAddressEmailIntakeAddressRotateEmailIntakeAddressPayloadEmailIntakeAddress.ArchivedAt, and is useful for accessing the field via an interface.
+func (v *rotateEmailIntakeAddressEmailIntakeAddressRotateEmailIntakeAddressPayloadEmailIntakeAddress) GetArchivedAt() *time.Time {
+	return v.EmailIntakeAddress.ArchivedAt
+}
+
+func (v *rotateEmailIntakeAddressEmailIntakeAddressRotateEmailIntakeAddressPayloadEmailIntakeAddress) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*rotateEmailIntakeAddressEmailIntakeAddressRotateEmailIntakeAddressPayloadEmailIntakeAddress
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.rotateEmailIntakeAddressEmailIntakeAddressRotateEmailIntakeAddressPayloadEmailIntakeAddress = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.EmailIntakeAddress)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalrotateEmailIntakeAddressEmailIntakeAddressRotateEmailIntakeAddressPayloadEmailIntakeAddress struct {
+	Id string `json:"id"`
+
+	Address string `json:"address"`
+
+	ForwardingEmailAddress *string `json:"forwardingEmailAddress"`
+
+	SenderName *string `json:"senderName"`
+
+	Enabled bool `json:"enabled"`
+
+	RepliesEnabled bool `json:"repliesEnabled"`
+
+	Team *EmailIntakeAddressTeam `json:"team"`
+
+	Template *EmailIntakeAddressTemplate `json:"template"`
+
+	ArchivedAt *time.Time `json:"archivedAt"`
+}
+
+func (v *rotateEmailIntakeAddressEmailIntakeAddressRotateEmailIntakeAddressPayloadEmailIntakeAddress) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *rotateEmailIntakeAddressEmailIntakeAddressRotateEmailIntakeAddressPayloadEmailIntakeAddress) __premarshalJSON() (*__premarshalrotateEmailIntakeAddressEmailIntakeAddressRotateEmailIntakeAddressPayloadEmailIntakeAddress, error) {
+	var retval __premarshalrotateEmailIntakeAddressEmailIntakeAddressRotateEmailIntakeAddressPayloadEmailIntakeAddress
+
+	retval.Id = v.EmailIntakeAddress.Id
+	retval.Address = v.EmailIntakeAddress.Address
+	retval.ForwardingEmailAddress = v.EmailIntakeAddress.ForwardingEmailAddress
+	retval.SenderName = v.EmailIntakeAddress.SenderName
+	retval.Enabled = v.EmailIntakeAddress.Enabled
+	retval.RepliesEnabled = v.EmailIntakeAddress.RepliesEnabled
+	retval.Team = v.EmailIntakeAddress.Team
+	retval.Template = v.EmailIntakeAddress.Template
+	retval.ArchivedAt = v.EmailIntakeAddress.ArchivedAt
+	return &retval, nil
+}
+
+// rotateEmailIntakeAddressResponse is returned by rotateEmailIntakeAddress on success.
+type rotateEmailIntakeAddressResponse struct {
+	// Rotates an existing email intake address.
+	EmailIntakeAddressRotate rotateEmailIntakeAddressEmailIntakeAddressRotateEmailIntakeAddressPayload `json:"emailIntakeAddressRotate"`
+}
+
+// GetEmailIntakeAddressRotate returns rotateEmailIntakeAddressResponse.EmailIntakeAddressRotate, and is useful for accessing the field via an interface.
+func (v *rotateEmailIntakeAddressResponse) GetEmailIntakeAddressRotate() rotateEmailIntakeAddressEmailIntakeAddressRotateEmailIntakeAddressPayload {
+	return v.EmailIntakeAddressRotate
+}
+
+// templateCreateResponse is returned by templateCreate on success.
+type templateCreateResponse struct {
+	// Creates a new template.
+	TemplateCreate templateCreateTemplateCreateTemplatePayload `json:"templateCreate"`
+}
+
+// GetTemplateCreate returns templateCreateResponse.TemplateCreate, and is useful for accessing the field via an interface.
+func (v *templateCreateResponse) GetTemplateCreate() templateCreateTemplateCreateTemplatePayload {
+	return v.TemplateCreate
+}
+
+// templateCreateTemplateCreateTemplatePayload includes the requested fields of the GraphQL type TemplatePayload.
+type templateCreateTemplateCreateTemplatePayload struct {
+	// The template that was created or updated.
+	Template templateCreateTemplateCreateTemplatePayloadTemplate `json:"template"`
+}
+
+// GetTemplate returns templateCreateTemplateCreateTemplatePayload.Template, and is useful for accessing the field via an interface.
+func (v *templateCreateTemplateCreateTemplatePayload) GetTemplate() templateCreateTemplateCreateTemplatePayloadTemplate {
+	return v.Template
+}
+
+// templateCreateTemplateCreateTemplatePayloadTemplate includes the requested fields of the GraphQL type Template.
+// The GraphQL type's documentation follows.
+//
+// A template object used for creating entities faster.
+type templateCreateTemplateCreateTemplatePayloadTemplate struct {
+	Template `json:"-"`
+}
+
+// GetId returns templateCreateTemplateCreateTemplatePayloadTemplate.Id, and is useful for accessing the field via an interface.
+func (v *templateCreateTemplateCreateTemplatePayloadTemplate) GetId() string { return v.Template.Id }
+
+// GetName returns templateCreateTemplateCreateTemplatePayloadTemplate.Name, and is useful for accessing the field via an interface.
+func (v *templateCreateTemplateCreateTemplatePayloadTemplate) GetName() string {
+	return v.Template.Name
+}
+
+// GetDescription returns templateCreateTemplateCreateTemplatePayloadTemplate.Description, and is useful for accessing the field via an interface.
+func (v *templateCreateTemplateCreateTemplatePayloadTemplate) GetDescription() *string {
+	return v.Template.Description
+}
+
+// GetType returns templateCreateTemplateCreateTemplatePayloadTemplate.Type, and is useful for accessing the field via an interface.
+func (v *templateCreateTemplateCreateTemplatePayloadTemplate) GetType() string {
+	return v.Template.Type
+}
+
+// GetTeam returns templateCreateTemplateCreateTemplatePayloadTemplate.Team, and is useful for accessing the field via an interface.
+func (v *templateCreateTemplateCreateTemplatePayloadTemplate) GetTeam() *TemplateTeam {
+	return v.Template.Team
+}
+
+// GetTemplateData returns templateCreateTemplateCreateTemplatePayloadTemplate.TemplateData, and is useful for accessing the field via an interface.
+func (v *templateCreateTemplateCreateTemplatePayloadTemplate) GetTemplateData() string {
+	return v.Template.TemplateData
+}
+
+func (v *templateCreateTemplateCreateTemplatePayloadTemplate) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*templateCreateTemplateCreateTemplatePayloadTemplate
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.templateCreateTemplateCreateTemplatePayloadTemplate = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Template)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshaltemplateCreateTemplateCreateTemplatePayloadTemplate struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description *string `json:"description"`
+
+	Type string `json:"type"`
+
+	Team *TemplateTeam `json:"team"`
+
+	TemplateData string `json:"templateData"`
+}
+
+func (v *templateCreateTemplateCreateTemplatePayloadTemplate) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *templateCreateTemplateCreateTemplatePayloadTemplate) __premarshalJSON() (*__premarshaltemplateCreateTemplateCreateTemplatePayloadTemplate, error) {
+	var retval __premarshaltemplateCreateTemplateCreateTemplatePayloadTemplate
+
+	retval.Id = v.Template.Id
+	retval.Name = v.Template.Name
+	retval.Description = v.Template.Description
+	retval.Type = v.Template.Type
+	retval.Team = v.Template.Team
+	retval.TemplateData = v.Template.TemplateData
+	return &retval, nil
+}
+
+// templateDeleteResponse is returned by templateDelete on success.
+type templateDeleteResponse struct {
+	// Deletes a template.
+	TemplateDelete templateDeleteTemplateDeleteDeletePayload `json:"templateDelete"`
+}
+
+// GetTemplateDelete returns templateDeleteResponse.TemplateDelete, and is useful for accessing the field via an interface.
+func (v *templateDeleteResponse) GetTemplateDelete() templateDeleteTemplateDeleteDeletePayload {
+	return v.TemplateDelete
+}
+
+// templateDeleteTemplateDeleteDeletePayload includes the requested fields of the GraphQL type DeletePayload.
+// The GraphQL type's documentation follows.
+//
+// A generic payload return from entity deletion mutations.
+type templateDeleteTemplateDeleteDeletePayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
+}
+
+// GetSuccess returns templateDeleteTemplateDeleteDeletePayload.Success, and is useful for accessing the field via an interface.
+func (v *templateDeleteTemplateDeleteDeletePayload) GetSuccess() bool { return v.Success }
+
+// templateUpdateResponse is returned by templateUpdate on success.
+type templateUpdateResponse struct {
+	// Updates an existing template.
+	TemplateUpdate templateUpdateTemplateUpdateTemplatePayload `json:"templateUpdate"`
+}
+
+// GetTemplateUpdate returns templateUpdateResponse.TemplateUpdate, and is useful for accessing the field via an interface.
+func (v *templateUpdateResponse) GetTemplateUpdate() templateUpdateTemplateUpdateTemplatePayload {
+	return v.TemplateUpdate
+}
+
+// templateUpdateTemplateUpdateTemplatePayload includes the requested fields of the GraphQL type TemplatePayload.
+type templateUpdateTemplateUpdateTemplatePayload struct {
+	// The template that was created or updated.
+	Template templateUpdateTemplateUpdateTemplatePayloadTemplate `json:"template"`
+}
+
+// GetTemplate returns templateUpdateTemplateUpdateTemplatePayload.Template, and is useful for accessing the field via an interface.
+func (v *templateUpdateTemplateUpdateTemplatePayload) GetTemplate() templateUpdateTemplateUpdateTemplatePayloadTemplate {
+	return v.Template
+}
+
+// templateUpdateTemplateUpdateTemplatePayloadTemplate includes the requested fields of the GraphQL type Template.
+// The GraphQL type's documentation follows.
+//
+// A template object used for creating entities faster.
+type templateUpdateTemplateUpdateTemplatePayloadTemplate struct {
+	Template `json:"-"`
+}
+
+// GetId returns templateUpdateTemplateUpdateTemplatePayloadTemplate.Id, and is useful for accessing the field via an interface.
+func (v *templateUpdateTemplateUpdateTemplatePayloadTemplate) GetId() string { return v.Template.Id }
+
+// GetName returns templateUpdateTemplateUpdateTemplatePayloadTemplate.Name, and is useful for accessing the field via an interface.
+func (v *templateUpdateTemplateUpdateTemplatePayloadTemplate) GetName() string {
+	return v.Template.Name
+}
+
+// GetDescription returns templateUpdateTemplateUpdateTemplatePayloadTemplate.Description, and is useful for accessing the field via an interface.
+func (v *templateUpdateTemplateUpdateTemplatePayloadTemplate) GetDescription() *string {
+	return v.Template.Description
+}
+
+// GetType returns templateUpdateTemplateUpdateTemplatePayloadTemplate.Type, and is useful for accessing the field via an interface.
+func (v *templateUpdateTemplateUpdateTemplatePayloadTemplate) GetType() string {
+	return v.Template.Type
+}
+
+// GetTeam returns templateUpdateTemplateUpdateTemplatePayloadTemplate.Team, and is useful for accessing the field via an interface.
+func (v *templateUpdateTemplateUpdateTemplatePayloadTemplate) GetTeam() *TemplateTeam {
+	return v.Template.Team
+}
+
+// GetTemplateData returns templateUpdateTemplateUpdateTemplatePayloadTemplate.TemplateData, and is useful for accessing the field via an interface.
+func (v *templateUpdateTemplateUpdateTemplatePayloadTemplate) GetTemplateData() string {
+	return v.Template.TemplateData
+}
+
+func (v *templateUpdateTemplateUpdateTemplatePayloadTemplate) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*templateUpdateTemplateUpdateTemplatePayloadTemplate
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.templateUpdateTemplateUpdateTemplatePayloadTemplate = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Template)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshaltemplateUpdateTemplateUpdateTemplatePayloadTemplate struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description *string `json:"description"`
+
+	Type string `json:"type"`
+
+	Team *TemplateTeam `json:"team"`
+
+	TemplateData string `json:"templateData"`
+}
+
+func (v *templateUpdateTemplateUpdateTemplatePayloadTemplate) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *templateUpdateTemplateUpdateTemplatePayloadTemplate) __premarshalJSON() (*__premarshaltemplateUpdateTemplateUpdateTemplatePayloadTemplate, error) {
+	var retval __premarshaltemplateUpdateTemplateUpdateTemplatePayloadTemplate
+
+	retval.Id = v.Template.Id
+	retval.Name = v.Template.Name
+	retval.Description = v.Template.Description
+	retval.Type = v.Template.Type
+	retval.Team = v.Template.Team
+	retval.TemplateData = v.Template.TemplateData
+	return &retval, nil
+}
+
+// unarchiveInitiativeInitiativeUnarchiveInitiativeArchivePayload includes the requested fields of the GraphQL type InitiativeArchivePayload.
+// The GraphQL type's documentation follows.
+//
+// A generic payload return from entity archive mutations.
+type unarchiveInitiativeInitiativeUnarchiveInitiativeArchivePayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
+}
+
+// GetSuccess returns unarchiveInitiativeInitiativeUnarchiveInitiativeArchivePayload.Success, and is useful for accessing the field via an interface.
+func (v *unarchiveInitiativeInitiativeUnarchiveInitiativeArchivePayload) GetSuccess() bool {
+	return v.Success
+}
+
+// unarchiveInitiativeResponse is returned by unarchiveInitiative on success.
+type unarchiveInitiativeResponse struct {
+	// Unarchives a initiative.
+	InitiativeUnarchive unarchiveInitiativeInitiativeUnarchiveInitiativeArchivePayload `json:"initiativeUnarchive"`
+}
+
+// GetInitiativeUnarchive returns unarchiveInitiativeResponse.InitiativeUnarchive, and is useful for accessing the field via an interface.
+func (v *unarchiveInitiativeResponse) GetInitiativeUnarchive() unarchiveInitiativeInitiativeUnarchiveInitiativeArchivePayload {
+	return v.InitiativeUnarchive
+}
+
+// unarchiveProjectProjectUnarchiveProjectArchivePayload includes the requested fields of the GraphQL type ProjectArchivePayload.
+// The GraphQL type's documentation follows.
+//
+// A generic payload return from entity archive mutations.
+type unarchiveProjectProjectUnarchiveProjectArchivePayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
+}
+
+// GetSuccess returns unarchiveProjectProjectUnarchiveProjectArchivePayload.Success, and is useful for accessing the field via an interface.
+func (v *unarchiveProjectProjectUnarchiveProjectArchivePayload) GetSuccess() bool { return v.Success }
+
+// unarchiveProjectResponse is returned by unarchiveProject on success.
+type unarchiveProjectResponse struct {
+	// Unarchives a project.
+	ProjectUnarchive unarchiveProjectProjectUnarchiveProjectArchivePayload `json:"projectUnarchive"`
+}
+
+// GetProjectUnarchive returns unarchiveProjectResponse.ProjectUnarchive, and is useful for accessing the field via an interface.
+func (v *unarchiveProjectResponse) GetProjectUnarchive() unarchiveProjectProjectUnarchiveProjectArchivePayload {
+	return v.ProjectUnarchive
+}
+
+// updateCustomViewCustomViewUpdateCustomViewPayload includes the requested fields of the GraphQL type CustomViewPayload.
+type updateCustomViewCustomViewUpdateCustomViewPayload struct {
+	// The custom view that was created or updated.
+	CustomView updateCustomViewCustomViewUpdateCustomViewPayloadCustomView `json:"customView"`
+}
+
+// GetCustomView returns updateCustomViewCustomViewUpdateCustomViewPayload.CustomView, and is useful for accessing the field via an interface.
+func (v *updateCustomViewCustomViewUpdateCustomViewPayload) GetCustomView() updateCustomViewCustomViewUpdateCustomViewPayloadCustomView {
+	return v.CustomView
+}
+
+// updateCustomViewCustomViewUpdateCustomViewPayloadCustomView includes the requested fields of the GraphQL type CustomView.
+// The GraphQL type's documentation follows.
+//
+// A custom view that has been saved by a user.
+type updateCustomViewCustomViewUpdateCustomViewPayloadCustomView struct {
+	CustomView `json:"-"`
+}
+
+// GetId returns updateCustomViewCustomViewUpdateCustomViewPayloadCustomView.Id, and is useful for accessing the field via an interface.
+func (v *updateCustomViewCustomViewUpdateCustomViewPayloadCustomView) GetId() string {
+	return v.CustomView.Id
+}
+
+// GetName returns updateCustomViewCustomViewUpdateCustomViewPayloadCustomView.Name, and is useful for accessing the field via an interface.
+func (v *updateCustomViewCustomViewUpdateCustomViewPayloadCustomView) GetName() string {
+	return v.CustomView.Name
+}
+
+// GetDescription returns updateCustomViewCustomViewUpdateCustomViewPayloadCustomView.Description, and is useful for accessing the field via an interface.
+func (v *updateCustomViewCustomViewUpdateCustomViewPayloadCustomView) GetDescription() *string {
+	return v.CustomView.Description
+}
+
+// GetIcon returns updateCustomViewCustomViewUpdateCustomViewPayloadCustomView.Icon, and is useful for accessing the field via an interface.
+func (v *updateCustomViewCustomViewUpdateCustomViewPayloadCustomView) GetIcon() *string {
+	return v.CustomView.Icon
+}
+
+// GetColor returns updateCustomViewCustomViewUpdateCustomViewPayloadCustomView.Color, and is useful for accessing the field via an interface.
+func (v *updateCustomViewCustomViewUpdateCustomViewPayloadCustomView) GetColor() *string {
+	return v.CustomView.Color
+}
+
+// GetTeam returns updateCustomViewCustomViewUpdateCustomViewPayloadCustomView.Team, and is useful for accessing the field via an interface.
+func (v *updateCustomViewCustomViewUpdateCustomViewPayloadCustomView) GetTeam() *CustomViewTeam {
+	return v.CustomView.Team
+}
+
+// GetShared returns updateCustomViewCustomViewUpdateCustomViewPayloadCustomView.Shared, and is useful for accessing the field via an interface.
+func (v *updateCustomViewCustomViewUpdateCustomViewPayloadCustomView) GetShared() bool {
+	return v.CustomView.Shared
+}
+
+// GetFilterData returns updateCustomViewCustomViewUpdateCustomViewPayloadCustomView.FilterData, and is useful for accessing the field via an interface.
+func (v *updateCustomViewCustomViewUpdateCustomViewPayloadCustomView) GetFilterData() map[string]interface{} {
+	return v.CustomView.FilterData
+}
+
+// GetProjectFilterData returns updateCustomViewCustomViewUpdateCustomViewPayloadCustomView.ProjectFilterData, and is useful for accessing the field via an interface.
+func (v *updateCustomViewCustomViewUpdateCustomViewPayloadCustomView) GetProjectFilterData() map[string]interface{} {
+	return v.CustomView.ProjectFilterData
+}
+
+func (v *updateCustomViewCustomViewUpdateCustomViewPayloadCustomView) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateCustomViewCustomViewUpdateCustomViewPayloadCustomView
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateCustomViewCustomViewUpdateCustomViewPayloadCustomView = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.CustomView)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateCustomViewCustomViewUpdateCustomViewPayloadCustomView struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description *string `json:"description"`
+
+	Icon *string `json:"icon"`
+
+	Color *string `json:"color"`
+
+	Team *CustomViewTeam `json:"team"`
+
+	Shared bool `json:"shared"`
+
+	FilterData map[string]interface{} `json:"filterData"`
+
+	ProjectFilterData map[string]interface{} `json:"projectFilterData"`
+}
+
+func (v *updateCustomViewCustomViewUpdateCustomViewPayloadCustomView) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateCustomViewCustomViewUpdateCustomViewPayloadCustomView) __premarshalJSON() (*__premarshalupdateCustomViewCustomViewUpdateCustomViewPayloadCustomView, error) {
+	var retval __premarshalupdateCustomViewCustomViewUpdateCustomViewPayloadCustomView
+
+	retval.Id = v.CustomView.Id
+	retval.Name = v.CustomView.Name
+	retval.Description = v.CustomView.Description
+	retval.Icon = v.CustomView.Icon
+	retval.Color = v.CustomView.Color
+	retval.Team = v.CustomView.Team
+	retval.Shared = v.CustomView.Shared
+	retval.FilterData = v.CustomView.FilterData
+	retval.ProjectFilterData = v.CustomView.ProjectFilterData
+	return &retval, nil
+}
+
+// updateCustomViewResponse is returned by updateCustomView on success.
+type updateCustomViewResponse struct {
+	// Updates a custom view.
+	CustomViewUpdate updateCustomViewCustomViewUpdateCustomViewPayload `json:"customViewUpdate"`
+}
+
+// GetCustomViewUpdate returns updateCustomViewResponse.CustomViewUpdate, and is useful for accessing the field via an interface.
+func (v *updateCustomViewResponse) GetCustomViewUpdate() updateCustomViewCustomViewUpdateCustomViewPayload {
+	return v.CustomViewUpdate
+}
+
+// updateCycleCycleUpdateCyclePayload includes the requested fields of the GraphQL type CyclePayload.
+type updateCycleCycleUpdateCyclePayload struct {
+	// The Cycle that was created or updated.
+	Cycle updateCycleCycleUpdateCyclePayloadCycle `json:"cycle"`
+}
+
+// GetCycle returns updateCycleCycleUpdateCyclePayload.Cycle, and is useful for accessing the field via an interface.
+func (v *updateCycleCycleUpdateCyclePayload) GetCycle() updateCycleCycleUpdateCyclePayloadCycle {
+	return v.Cycle
+}
+
+// updateCycleCycleUpdateCyclePayloadCycle includes the requested fields of the GraphQL type Cycle.
+// The GraphQL type's documentation follows.
+//
+// A set of issues to be resolved in a specified amount of time.
+type updateCycleCycleUpdateCyclePayloadCycle struct {
+	Cycle `json:"-"`
+}
+
+// GetId returns updateCycleCycleUpdateCyclePayloadCycle.Id, and is useful for accessing the field via an interface.
+func (v *updateCycleCycleUpdateCyclePayloadCycle) GetId() string { return v.Cycle.Id }
+
+// GetNumber returns updateCycleCycleUpdateCyclePayloadCycle.Number, and is useful for accessing the field via an interface.
+func (v *updateCycleCycleUpdateCyclePayloadCycle) GetNumber() float64 { return v.Cycle.Number }
+
+// GetName returns updateCycleCycleUpdateCyclePayloadCycle.Name, and is useful for accessing the field via an interface.
+func (v *updateCycleCycleUpdateCyclePayloadCycle) GetName() *string { return v.Cycle.Name }
+
+// GetDescription returns updateCycleCycleUpdateCyclePayloadCycle.Description, and is useful for accessing the field via an interface.
+func (v *updateCycleCycleUpdateCyclePayloadCycle) GetDescription() *string {
+	return v.Cycle.Description
+}
+
+// GetStartsAt returns updateCycleCycleUpdateCyclePayloadCycle.StartsAt, and is useful for accessing the field via an interface.
+func (v *updateCycleCycleUpdateCyclePayloadCycle) GetStartsAt() time.Time { return v.Cycle.StartsAt }
+
+// GetEndsAt returns updateCycleCycleUpdateCyclePayloadCycle.EndsAt, and is useful for accessing the field via an interface.
+func (v *updateCycleCycleUpdateCyclePayloadCycle) GetEndsAt() time.Time { return v.Cycle.EndsAt }
+
+// GetTeam returns updateCycleCycleUpdateCyclePayloadCycle.Team, and is useful for accessing the field via an interface.
+func (v *updateCycleCycleUpdateCyclePayloadCycle) GetTeam() CycleTeam { return v.Cycle.Team }
+
+// GetArchivedAt returns updateCycleCycleUpdateCyclePayloadCycle.ArchivedAt, and is useful for accessing the field via an interface.
+func (v *updateCycleCycleUpdateCyclePayloadCycle) GetArchivedAt() *time.Time {
+	return v.Cycle.ArchivedAt
+}
+
+func (v *updateCycleCycleUpdateCyclePayloadCycle) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateCycleCycleUpdateCyclePayloadCycle
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateCycleCycleUpdateCyclePayloadCycle = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Cycle)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateCycleCycleUpdateCyclePayloadCycle struct {
+	Id string `json:"id"`
+
+	Number float64 `json:"number"`
+
+	Name *string `json:"name"`
+
+	Description *string `json:"description"`
+
+	StartsAt time.Time `json:"startsAt"`
+
+	EndsAt time.Time `json:"endsAt"`
+
+	Team CycleTeam `json:"team"`
+
+	ArchivedAt *time.Time `json:"archivedAt"`
+}
+
+func (v *updateCycleCycleUpdateCyclePayloadCycle) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateCycleCycleUpdateCyclePayloadCycle) __premarshalJSON() (*__premarshalupdateCycleCycleUpdateCyclePayloadCycle, error) {
+	var retval __premarshalupdateCycleCycleUpdateCyclePayloadCycle
+
+	retval.Id = v.Cycle.Id
+	retval.Number = v.Cycle.Number
+	retval.Name = v.Cycle.Name
+	retval.Description = v.Cycle.Description
+	retval.StartsAt = v.Cycle.StartsAt
+	retval.EndsAt = v.Cycle.EndsAt
+	retval.Team = v.Cycle.Team
+	retval.ArchivedAt = v.Cycle.ArchivedAt
+	return &retval, nil
+}
+
+// updateCycleResponse is returned by updateCycle on success.
+type updateCycleResponse struct {
+	// Updates a cycle.
+	CycleUpdate updateCycleCycleUpdateCyclePayload `json:"cycleUpdate"`
+}
+
+// GetCycleUpdate returns updateCycleResponse.CycleUpdate, and is useful for accessing the field via an interface.
+func (v *updateCycleResponse) GetCycleUpdate() updateCycleCycleUpdateCyclePayload {
+	return v.CycleUpdate
+}
+
+// updateEmailIntakeAddressEmailIntakeAddressUpdateEmailIntakeAddressPayload includes the requested fields of the GraphQL type EmailIntakeAddressPayload.
+type updateEmailIntakeAddressEmailIntakeAddressUpdateEmailIntakeAddressPayload struct {
+	// The email address that was created or updated.
+	EmailIntakeAddress updateEmailIntakeAddressEmailIntakeAddressUpdateEmailIntakeAddressPayloadEmailIntakeAddress `json:"emailIntakeAddress"`
+}
+
+// GetEmailIntakeAddress returns updateEmailIntakeAddressEmailIntakeAddressUpdateEmailIntakeAddressPayload.EmailIntakeAddress, and is useful for accessing the field via an interface.
+func (v *updateEmailIntakeAddressEmailIntakeAddressUpdateEmailIntakeAddressPayload) GetEmailIntakeAddress() updateEmailIntakeAddressEmailIntakeAddressUpdateEmailIntakeAddressPayloadEmailIntakeAddress {
+	return v.EmailIntakeAddress
+}
+
+// updateEmailIntakeAddressEmailIntakeAddressUpdateEmailIntakeAddressPayloadEmailIntakeAddress includes the requested fields of the GraphQL type EmailIntakeAddress.
+// The GraphQL type's documentation follows.
+//
+// An email address that can be used for submitting issues.
+type updateEmailIntakeAddressEmailIntakeAddressUpdateEmailIntakeAddressPayloadEmailIntakeAddress struct {
+	EmailIntakeAddress `json:"-"`
+}
+
+// GetId returns updateEmailIntakeAddressEmailIntakeAddressUpdateEmailIntakeAddressPayloadEmailIntakeAddress.Id, and is useful for accessing the field via an interface.
+func (v *updateEmailIntakeAddressEmailIntakeAddressUpdateEmailIntakeAddressPayloadEmailIntakeAddress) GetId() string {
+	return v.EmailIntakeAddress.Id
+}
+
+// GetAddress returns updateEmailIntakeAddressEmailIntakeAddressUpdateEmailIntakeAddressPayloadEmailIntakeAddress.Address, and is useful for accessing the field via an interface.
+func (v *updateEmailIntakeAddressEmailIntakeAddressUpdateEmailIntakeAddressPayloadEmailIntakeAddress) GetAddress() string {
+	return v.EmailIntakeAddress.Address
+}
+
+// GetForwardingEmailAddress returns updateEmailIntakeAddressEmailIntakeAddressUpdateEmailIntakeAddressPayloadEmailIntakeAddress.ForwardingEmailAddress, and is useful for accessing the field via an interface.
+func (v *updateEmailIntakeAddressEmailIntakeAddressUpdateEmailIntakeAddressPayloadEmailIntakeAddress) GetForwardingEmailAddress() *string {
+	return v.EmailIntakeAddress.ForwardingEmailAddress
+}
+
+// GetSenderName returns updateEmailIntakeAddressEmailIntakeAddressUpdateEmailIntakeAddressPayloadEmailIntakeAddress.SenderName, and is useful for accessing the field via an interface.
+func (v *updateEmailIntakeAddressEmailIntakeAddressUpdateEmailIntakeAddressPayloadEmailIntakeAddress) GetSenderName() *string {
+	return v.EmailIntakeAddress.SenderName
+}
+
+// GetEnabled returns updateEmailIntakeAddressEmailIntakeAddressUpdateEmailIntakeAddressPayloadEmailIntakeAddress.Enabled, and is useful for accessing the field via an interface.
+func (v *updateEmailIntakeAddressEmailIntakeAddressUpdateEmailIntakeAddressPayloadEmailIntakeAddress) GetEnabled() bool {
+	return v.EmailIntakeAddress.Enabled
+}
+
+// GetRepliesEnabled returns updateEmailIntakeAddressEmailIntakeAddressUpdateEmailIntakeAddressPayloadEmailIntakeAddress.RepliesEnabled, and is useful for accessing the field via an interface.
+func (v *updateEmailIntakeAddressEmailIntakeAddressUpdateEmailIntakeAddressPayloadEmailIntakeAddress) GetRepliesEnabled() bool {
+	return v.EmailIntakeAddress.RepliesEnabled
+}
+
+// GetTeam returns updateEmailIntakeAddressEmailIntakeAddressUpdateEmailIntakeAddressPayloadEmailIntakeAddress.Team, and is useful for accessing the field via an interface.
+func (v *updateEmailIntakeAddressEmailIntakeAddressUpdateEmailIntakeAddressPayloadEmailIntakeAddress) GetTeam() *EmailIntakeAddressTeam {
+	return v.EmailIntakeAddress.Team
+}
+
+// GetTemplate returns updateEmailIntakeAddressEmailIntakeAddressUpdateEmailIntakeAddressPayloadEmailIntakeAddress.Template, and is useful for accessing the field via an interface.
+func (v *updateEmailIntakeAddressEmailIntakeAddressUpdateEmailIntakeAddressPayloadEmailIntakeAddress) GetTemplate() *EmailIntakeAddressTemplate {
+	return v.EmailIntakeAddress.Template
+}
+
+// GetArchivedAt returns updateEmailIntakeAddressEmailIntakeAddressUpdateEmailIntakeAddressPayloadEmailIntakeAddress.ArchivedAt, and is useful for accessing the field via an interface.
+func (v *updateEmailIntakeAddressEmailIntakeAddressUpdateEmailIntakeAddressPayloadEmailIntakeAddress) GetArchivedAt() *time.Time {
+	return v.EmailIntakeAddress.ArchivedAt
+}
+
+func (v *updateEmailIntakeAddressEmailIntakeAddressUpdateEmailIntakeAddressPayloadEmailIntakeAddress) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateEmailIntakeAddressEmailIntakeAddressUpdateEmailIntakeAddressPayloadEmailIntakeAddress
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateEmailIntakeAddressEmailIntakeAddressUpdateEmailIntakeAddressPayloadEmailIntakeAddress = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.EmailIntakeAddress)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateEmailIntakeAddressEmailIntakeAddressUpdateEmailIntakeAddressPayloadEmailIntakeAddress struct {
+	Id string `json:"id"`
+
+	Address string `json:"address"`
+
+	ForwardingEmailAddress *string `json:"forwardingEmailAddress"`
+
+	SenderName *string `json:"senderName"`
+
+	Enabled bool `json:"enabled"`
+
+	RepliesEnabled bool `json:"repliesEnabled"`
+
+	Team *EmailIntakeAddressTeam `json:"team"`
+
+	Template *EmailIntakeAddressTemplate `json:"template"`
+
+	ArchivedAt *time.Time `json:"archivedAt"`
+}
+
+func (v *updateEmailIntakeAddressEmailIntakeAddressUpdateEmailIntakeAddressPayloadEmailIntakeAddress) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateEmailIntakeAddressEmailIntakeAddressUpdateEmailIntakeAddressPayloadEmailIntakeAddress) __premarshalJSON() (*__premarshalupdateEmailIntakeAddressEmailIntakeAddressUpdateEmailIntakeAddressPayloadEmailIntakeAddress, error) {
+	var retval __premarshalupdateEmailIntakeAddressEmailIntakeAddressUpdateEmailIntakeAddressPayloadEmailIntakeAddress
+
+	retval.Id = v.EmailIntakeAddress.Id
+	retval.Address = v.EmailIntakeAddress.Address
+	retval.ForwardingEmailAddress = v.EmailIntakeAddress.ForwardingEmailAddress
+	retval.SenderName = v.EmailIntakeAddress.SenderName
+	retval.Enabled = v.EmailIntakeAddress.Enabled
+	retval.RepliesEnabled = v.EmailIntakeAddress.RepliesEnabled
+	retval.Team = v.EmailIntakeAddress.Team
+	retval.Template = v.EmailIntakeAddress.Template
+	retval.ArchivedAt = v.EmailIntakeAddress.ArchivedAt
+	return &retval, nil
+}
+
+// updateEmailIntakeAddressResponse is returned by updateEmailIntakeAddress on success.
+type updateEmailIntakeAddressResponse struct {
+	// Updates an existing email intake address.
+	EmailIntakeAddressUpdate updateEmailIntakeAddressEmailIntakeAddressUpdateEmailIntakeAddressPayload `json:"emailIntakeAddressUpdate"`
+}
+
+// GetEmailIntakeAddressUpdate returns updateEmailIntakeAddressResponse.EmailIntakeAddressUpdate, and is useful for accessing the field via an interface.
+func (v *updateEmailIntakeAddressResponse) GetEmailIntakeAddressUpdate() updateEmailIntakeAddressEmailIntakeAddressUpdateEmailIntakeAddressPayload {
+	return v.EmailIntakeAddressUpdate
+}
+
+// updateGitAutomationStateGitAutomationStateUpdateGitAutomationStatePayload includes the requested fields of the GraphQL type GitAutomationStatePayload.
+type updateGitAutomationStateGitAutomationStateUpdateGitAutomationStatePayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
+}
+
+// GetSuccess returns updateGitAutomationStateGitAutomationStateUpdateGitAutomationStatePayload.Success, and is useful for accessing the field via an interface.
+func (v *updateGitAutomationStateGitAutomationStateUpdateGitAutomationStatePayload) GetSuccess() bool {
+	return v.Success
+}
+
+// updateGitAutomationStateResponse is returned by updateGitAutomationState on success.
+type updateGitAutomationStateResponse struct {
+	// Updates an existing state.
+	GitAutomationStateUpdate updateGitAutomationStateGitAutomationStateUpdateGitAutomationStatePayload `json:"gitAutomationStateUpdate"`
+}
+
+// GetGitAutomationStateUpdate returns updateGitAutomationStateResponse.GitAutomationStateUpdate, and is useful for accessing the field via an interface.
+func (v *updateGitAutomationStateResponse) GetGitAutomationStateUpdate() updateGitAutomationStateGitAutomationStateUpdateGitAutomationStatePayload {
+	return v.GitAutomationStateUpdate
+}
+
+// updateInitiativeInitiativeUpdateInitiativePayload includes the requested fields of the GraphQL type InitiativePayload.
+// The GraphQL type's documentation follows.
+//
+// The payload returned by the initiative mutations.
+type updateInitiativeInitiativeUpdateInitiativePayload struct {
+	// The initiative that was created or updated.
+	Initiative updateInitiativeInitiativeUpdateInitiativePayloadInitiative `json:"initiative"`
+}
+
+// GetInitiative returns updateInitiativeInitiativeUpdateInitiativePayload.Initiative, and is useful for accessing the field via an interface.
+func (v *updateInitiativeInitiativeUpdateInitiativePayload) GetInitiative() updateInitiativeInitiativeUpdateInitiativePayloadInitiative {
+	return v.Initiative
+}
+
+// updateInitiativeInitiativeUpdateInitiativePayloadInitiative includes the requested fields of the GraphQL type Initiative.
+// The GraphQL type's documentation follows.
+//
+// An initiative to group projects.
+type updateInitiativeInitiativeUpdateInitiativePayloadInitiative struct {
+	Initiative `json:"-"`
+}
+
+// GetId returns updateInitiativeInitiativeUpdateInitiativePayloadInitiative.Id, and is useful for accessing the field via an interface.
+func (v *updateInitiativeInitiativeUpdateInitiativePayloadInitiative) GetId() string {
+	return v.Initiative.Id
+}
+
+// GetName returns updateInitiativeInitiativeUpdateInitiativePayloadInitiative.Name, and is useful for accessing the field via an interface.
+func (v *updateInitiativeInitiativeUpdateInitiativePayloadInitiative) GetName() string {
+	return v.Initiative.Name
+}
+
+// GetSlugId returns updateInitiativeInitiativeUpdateInitiativePayloadInitiative.SlugId, and is useful for accessing the field via an interface.
+func (v *updateInitiativeInitiativeUpdateInitiativePayloadInitiative) GetSlugId() string {
+	return v.Initiative.SlugId
+}
+
+// GetUrl returns updateInitiativeInitiativeUpdateInitiativePayloadInitiative.Url, and is useful for accessing the field via an interface.
+func (v *updateInitiativeInitiativeUpdateInitiativePayloadInitiative) GetUrl() string {
+	return v.Initiative.Url
+}
+
+// GetDescription returns updateInitiativeInitiativeUpdateInitiativePayloadInitiative.Description, and is useful for accessing the field via an interface.
+func (v *updateInitiativeInitiativeUpdateInitiativePayloadInitiative) GetDescription() *string {
+	return v.Initiative.Description
+}
+
+// GetOwner returns updateInitiativeInitiativeUpdateInitiativePayloadInitiative.Owner, and is useful for accessing the field via an interface.
+func (v *updateInitiativeInitiativeUpdateInitiativePayloadInitiative) GetOwner() *InitiativeOwnerUser {
+	return v.Initiative.Owner
+}
+
+// GetColor returns updateInitiativeInitiativeUpdateInitiativePayloadInitiative.Color, and is useful for accessing the field via an interface.
+func (v *updateInitiativeInitiativeUpdateInitiativePayloadInitiative) GetColor() *string {
+	return v.Initiative.Color
+}
+
+// GetIcon returns updateInitiativeInitiativeUpdateInitiativePayloadInitiative.Icon, and is useful for accessing the field via an interface.
+func (v *updateInitiativeInitiativeUpdateInitiativePayloadInitiative) GetIcon() *string {
+	return v.Initiative.Icon
+}
+
+// GetStatus returns updateInitiativeInitiativeUpdateInitiativePayloadInitiative.Status, and is useful for accessing the field via an interface.
+func (v *updateInitiativeInitiativeUpdateInitiativePayloadInitiative) GetStatus() InitiativeStatus {
+	return v.Initiative.Status
+}
+
+// GetTargetDate returns updateInitiativeInitiativeUpdateInitiativePayloadInitiative.TargetDate, and is useful for accessing the field via an interface.
+func (v *updateInitiativeInitiativeUpdateInitiativePayloadInitiative) GetTargetDate() *string {
+	return v.Initiative.TargetDate
+}
+
+// GetTrashed returns updateInitiativeInitiativeUpdateInitiativePayloadInitiative.Trashed, and is useful for accessing the field via an interface.
+func (v *updateInitiativeInitiativeUpdateInitiativePayloadInitiative) GetTrashed() bool {
+	return v.Initiative.Trashed
+}
+
+// GetArchivedAt returns updateInitiativeInitiativeUpdateInitiativePayloadInitiative.ArchivedAt, and is useful for accessing the field via an interface.
+func (v *updateInitiativeInitiativeUpdateInitiativePayloadInitiative) GetArchivedAt() *time.Time {
+	return v.Initiative.ArchivedAt
+}
+
+func (v *updateInitiativeInitiativeUpdateInitiativePayloadInitiative) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateInitiativeInitiativeUpdateInitiativePayloadInitiative
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateInitiativeInitiativeUpdateInitiativePayloadInitiative = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Initiative)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateInitiativeInitiativeUpdateInitiativePayloadInitiative struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	SlugId string `json:"slugId"`
+
+	Url string `json:"url"`
+
+	Description *string `json:"description"`
+
+	Owner *InitiativeOwnerUser `json:"owner"`
+
+	Color *string `json:"color"`
+
+	Icon *string `json:"icon"`
+
+	Status InitiativeStatus `json:"status"`
+
+	TargetDate *string `json:"targetDate"`
+
+	Trashed bool `json:"trashed"`
+
+	ArchivedAt *time.Time `json:"archivedAt"`
+}
+
+func (v *updateInitiativeInitiativeUpdateInitiativePayloadInitiative) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateInitiativeInitiativeUpdateInitiativePayloadInitiative) __premarshalJSON() (*__premarshalupdateInitiativeInitiativeUpdateInitiativePayloadInitiative, error) {
+	var retval __premarshalupdateInitiativeInitiativeUpdateInitiativePayloadInitiative
+
+	retval.Id = v.Initiative.Id
+	retval.Name = v.Initiative.Name
+	retval.SlugId = v.Initiative.SlugId
+	retval.Url = v.Initiative.Url
+	retval.Description = v.Initiative.Description
+	retval.Owner = v.Initiative.Owner
+	retval.Color = v.Initiative.Color
+	retval.Icon = v.Initiative.Icon
+	retval.Status = v.Initiative.Status
+	retval.TargetDate = v.Initiative.TargetDate
+	retval.Trashed = v.Initiative.Trashed
+	retval.ArchivedAt = v.Initiative.ArchivedAt
+	return &retval, nil
+}
+
+// updateInitiativeResponse is returned by updateInitiative on success.
+type updateInitiativeResponse struct {
+	// Updates a initiative.
+	InitiativeUpdate updateInitiativeInitiativeUpdateInitiativePayload `json:"initiativeUpdate"`
+}
+
+// GetInitiativeUpdate returns updateInitiativeResponse.InitiativeUpdate, and is useful for accessing the field via an interface.
+func (v *updateInitiativeResponse) GetInitiativeUpdate() updateInitiativeInitiativeUpdateInitiativePayload {
+	return v.InitiativeUpdate
+}
+
+// updateLabelIssueLabelUpdateIssueLabelPayload includes the requested fields of the GraphQL type IssueLabelPayload.
+type updateLabelIssueLabelUpdateIssueLabelPayload struct {
+	// The label that was created or updated.
+	IssueLabel updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel `json:"issueLabel"`
+}
+
+// GetIssueLabel returns updateLabelIssueLabelUpdateIssueLabelPayload.IssueLabel, and is useful for accessing the field via an interface.
+func (v *updateLabelIssueLabelUpdateIssueLabelPayload) GetIssueLabel() updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel {
+	return v.IssueLabel
+}
+
+// updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel includes the requested fields of the GraphQL type IssueLabel.
+// The GraphQL type's documentation follows.
+//
+// Labels that can be associated with issues.
+type updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel struct {
+	IssueLabel `json:"-"`
+}
+
+// GetId returns updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel.Id, and is useful for accessing the field via an interface.
+func (v *updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel) GetId() string {
+	return v.IssueLabel.Id
+}
+
+// GetName returns updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel.Name, and is useful for accessing the field via an interface.
+func (v *updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel) GetName() string {
+	return v.IssueLabel.Name
+}
+
+// GetDescription returns updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel.Description, and is useful for accessing the field via an interface.
+func (v *updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel) GetDescription() *string {
+	return v.IssueLabel.Description
+}
+
+// GetColor returns updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel.Color, and is useful for accessing the field via an interface.
+func (v *updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel) GetColor() *string {
+	return v.IssueLabel.Color
+}
+
+// GetParent returns updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel.Parent, and is useful for accessing the field via an interface.
+func (v *updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel) GetParent() *IssueLabelParentIssueLabel {
+	return v.IssueLabel.Parent
+}
+
+// GetTeam returns updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel.Team, and is useful for accessing the field via an interface.
+func (v *updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel) GetTeam() *IssueLabelTeam {
+	return v.IssueLabel.Team
+}
+
+func (v *updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.IssueLabel)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description *string `json:"description"`
+
+	Color *string `json:"color"`
+
+	Parent *IssueLabelParentIssueLabel `json:"parent"`
+
+	Team *IssueLabelTeam `json:"team"`
+}
+
+func (v *updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel) __premarshalJSON() (*__premarshalupdateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel, error) {
+	var retval __premarshalupdateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel
+
+	retval.Id = v.IssueLabel.Id
+	retval.Name = v.IssueLabel.Name
+	retval.Description = v.IssueLabel.Description
+	retval.Color = v.IssueLabel.Color
+	retval.Parent = v.IssueLabel.Parent
+	retval.Team = v.IssueLabel.Team
+	return &retval, nil
+}
+
+// updateLabelResponse is returned by updateLabel on success.
+type updateLabelResponse struct {
+	// Updates an label.
+	IssueLabelUpdate updateLabelIssueLabelUpdateIssueLabelPayload `json:"issueLabelUpdate"`
+}
+
+// GetIssueLabelUpdate returns updateLabelResponse.IssueLabelUpdate, and is useful for accessing the field via an interface.
+func (v *updateLabelResponse) GetIssueLabelUpdate() updateLabelIssueLabelUpdateIssueLabelPayload {
+	return v.IssueLabelUpdate
+}
+
+// updateOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayload includes the requested fields of the GraphQL type OrganizationDomainPayload.
+// The GraphQL type's documentation follows.
+//
+// [INTERNAL] Organization domain operation response.
+type updateOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayload struct {
+	// The organization domain that was created or updated.
+	OrganizationDomain updateOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain `json:"organizationDomain"`
+}
+
+// GetOrganizationDomain returns updateOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayload.OrganizationDomain, and is useful for accessing the field via an interface.
+func (v *updateOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayload) GetOrganizationDomain() updateOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain {
+	return v.OrganizationDomain
+}
+
+// updateOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain includes the requested fields of the GraphQL type OrganizationDomain.
+// The GraphQL type's documentation follows.
+//
+// Defines the use of a domain by an organization.
+type updateOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain struct {
+	OrganizationDomain `json:"-"`
+}
+
+// GetId returns updateOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain.Id, and is useful for accessing the field via an interface.
+func (v *updateOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain) GetId() string {
+	return v.OrganizationDomain.Id
+}
+
+// GetName returns updateOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain.Name, and is useful for accessing the field via an interface.
+func (v *updateOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain) GetName() string {
+	return v.OrganizationDomain.Name
+}
+
+// GetVerificationEmail returns updateOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain.VerificationEmail, and is useful for accessing the field via an interface.
+func (v *updateOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain) GetVerificationEmail() *string {
+	return v.OrganizationDomain.VerificationEmail
+}
+
+// GetAuthType returns updateOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain.AuthType, and is useful for accessing the field via an interface.
+func (v *updateOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain) GetAuthType() OrganizationDomainAuthType {
+	return v.OrganizationDomain.AuthType
+}
+
+// GetVerified returns updateOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain.Verified, and is useful for accessing the field via an interface.
+func (v *updateOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain) GetVerified() bool {
+	return v.OrganizationDomain.Verified
+}
+
+// GetClaimed returns updateOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain.Claimed, and is useful for accessing the field via an interface.
+func (v *updateOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain) GetClaimed() *bool {
+	return v.OrganizationDomain.Claimed
+}
+
+// GetDisableOrganizationCreation returns updateOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain.DisableOrganizationCreation, and is useful for accessing the field via an interface.
+func (v *updateOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain) GetDisableOrganizationCreation() *bool {
+	return v.OrganizationDomain.DisableOrganizationCreation
+}
+
+func (v *updateOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.OrganizationDomain)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	VerificationEmail *string `json:"verificationEmail"`
+
+	AuthType OrganizationDomainAuthType `json:"authType"`
+
+	Verified bool `json:"verified"`
+
+	Claimed *bool `json:"claimed"`
+
+	DisableOrganizationCreation *bool `json:"disableOrganizationCreation"`
+}
+
+func (v *updateOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain) __premarshalJSON() (*__premarshalupdateOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain, error) {
+	var retval __premarshalupdateOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayloadOrganizationDomain
+
+	retval.Id = v.OrganizationDomain.Id
+	retval.Name = v.OrganizationDomain.Name
+	retval.VerificationEmail = v.OrganizationDomain.VerificationEmail
+	retval.AuthType = v.OrganizationDomain.AuthType
+	retval.Verified = v.OrganizationDomain.Verified
+	retval.Claimed = v.OrganizationDomain.Claimed
+	retval.DisableOrganizationCreation = v.OrganizationDomain.DisableOrganizationCreation
+	return &retval, nil
+}
+
+// updateOrganizationDomainResponse is returned by updateOrganizationDomain on success.
+type updateOrganizationDomainResponse struct {
+	// [INTERNAL] Updates an organization domain settings.
+	OrganizationDomainUpdate updateOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayload `json:"organizationDomainUpdate"`
+}
+
+// GetOrganizationDomainUpdate returns updateOrganizationDomainResponse.OrganizationDomainUpdate, and is useful for accessing the field via an interface.
+func (v *updateOrganizationDomainResponse) GetOrganizationDomainUpdate() updateOrganizationDomainOrganizationDomainUpdateOrganizationDomainPayload {
+	return v.OrganizationDomainUpdate
+}
+
+// updateOrganizationInviteOrganizationInviteUpdateOrganizationInvitePayload includes the requested fields of the GraphQL type OrganizationInvitePayload.
+type updateOrganizationInviteOrganizationInviteUpdateOrganizationInvitePayload struct {
+	// The organization invite that was created or updated.
+	OrganizationInvite updateOrganizationInviteOrganizationInviteUpdateOrganizationInvitePayloadOrganizationInvite `json:"organizationInvite"`
+}
+
+// GetOrganizationInvite returns updateOrganizationInviteOrganizationInviteUpdateOrganizationInvitePayload.OrganizationInvite, and is useful for accessing the field via an interface.
+func (v *updateOrganizationInviteOrganizationInviteUpdateOrganizationInvitePayload) GetOrganizationInvite() updateOrganizationInviteOrganizationInviteUpdateOrganizationInvitePayloadOrganizationInvite {
+	return v.OrganizationInvite
+}
+
+// updateOrganizationInviteOrganizationInviteUpdateOrganizationInvitePayloadOrganizationInvite includes the requested fields of the GraphQL type OrganizationInvite.
+// The GraphQL type's documentation follows.
+//
+// An invitation to the organization that has been sent via email.
+type updateOrganizationInviteOrganizationInviteUpdateOrganizationInvitePayloadOrganizationInvite struct {
+	OrganizationInvite `json:"-"`
+}
+
+// GetId returns updateOrganizationInviteOrganizationInviteUpdateOrganizationInvitePayloadOrganizationInvite.Id, and is useful for accessing the field via an interface.
+func (v *updateOrganizationInviteOrganizationInviteUpdateOrganizationInvitePayloadOrganizationInvite) GetId() string {
+	return v.OrganizationInvite.Id
+}
+
+// GetEmail returns updateOrganizationInviteOrganizationInviteUpdateOrganizationInvitePayloadOrganizationInvite.Email, and is useful for accessing the field via an interface.
+func (v *updateOrganizationInviteOrganizationInviteUpdateOrganizationInvitePayloadOrganizationInvite) GetEmail() string {
+	return v.OrganizationInvite.Email
+}
+
+// GetRole returns updateOrganizationInviteOrganizationInviteUpdateOrganizationInvitePayloadOrganizationInvite.Role, and is useful for accessing the field via an interface.
+func (v *updateOrganizationInviteOrganizationInviteUpdateOrganizationInvitePayloadOrganizationInvite) GetRole() UserRoleType {
+	return v.OrganizationInvite.Role
+}
+
+// GetMetadata returns updateOrganizationInviteOrganizationInviteUpdateOrganizationInvitePayloadOrganizationInvite.Metadata, and is useful for accessing the field via an interface.
+func (v *updateOrganizationInviteOrganizationInviteUpdateOrganizationInvitePayloadOrganizationInvite) GetMetadata() map[string]interface{} {
+	return v.OrganizationInvite.Metadata
+}
+
+// GetAcceptedAt returns updateOrganizationInviteOrganizationInviteUpdateOrganizationInvitePayloadOrganizationInvite.AcceptedAt, and is useful for accessing the field via an interface.
+func (v *updateOrganizationInviteOrganizationInviteUpdateOrganizationInvitePayloadOrganizationInvite) GetAcceptedAt() *time.Time {
+	return v.OrganizationInvite.AcceptedAt
+}
+
+// GetExpiresAt returns updateOrganizationInviteOrganizationInviteUpdateOrganizationInvitePayloadOrganizationInvite.ExpiresAt, and is useful for accessing the field via an interface.
+func (v *updateOrganizationInviteOrganizationInviteUpdateOrganizationInvitePayloadOrganizationInvite) GetExpiresAt() *time.Time {
+	return v.OrganizationInvite.ExpiresAt
+}
+
+func (v *updateOrganizationInviteOrganizationInviteUpdateOrganizationInvitePayloadOrganizationInvite) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateOrganizationInviteOrganizationInviteUpdateOrganizationInvitePayloadOrganizationInvite
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateOrganizationInviteOrganizationInviteUpdateOrganizationInvitePayloadOrganizationInvite = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.OrganizationInvite)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateOrganizationInviteOrganizationInviteUpdateOrganizationInvitePayloadOrganizationInvite struct {
+	Id string `json:"id"`
+
+	Email string `json:"email"`
+
+	Role UserRoleType `json:"role"`
+
+	Metadata map[string]interface{} `json:"metadata"`
+
+	AcceptedAt *time.Time `json:"acceptedAt"`
+
+	ExpiresAt *time.Time `json:"expiresAt"`
+}
+
+func (v *updateOrganizationInviteOrganizationInviteUpdateOrganizationInvitePayloadOrganizationInvite) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateOrganizationInviteOrganizationInviteUpdateOrganizationInvitePayloadOrganizationInvite) __premarshalJSON() (*__premarshalupdateOrganizationInviteOrganizationInviteUpdateOrganizationInvitePayloadOrganizationInvite, error) {
+	var retval __premarshalupdateOrganizationInviteOrganizationInviteUpdateOrganizationInvitePayloadOrganizationInvite
+
+	retval.Id = v.OrganizationInvite.Id
+	retval.Email = v.OrganizationInvite.Email
+	retval.Role = v.OrganizationInvite.Role
+	retval.Metadata = v.OrganizationInvite.Metadata
+	retval.AcceptedAt = v.OrganizationInvite.AcceptedAt
+	retval.ExpiresAt = v.OrganizationInvite.ExpiresAt
+	return &retval, nil
+}
+
+// updateOrganizationInviteResponse is returned by updateOrganizationInvite on success.
+type updateOrganizationInviteResponse struct {
+	// Updates an organization invite.
+	OrganizationInviteUpdate updateOrganizationInviteOrganizationInviteUpdateOrganizationInvitePayload `json:"organizationInviteUpdate"`
+}
+
+// GetOrganizationInviteUpdate returns updateOrganizationInviteResponse.OrganizationInviteUpdate, and is useful for accessing the field via an interface.
+func (v *updateOrganizationInviteResponse) GetOrganizationInviteUpdate() updateOrganizationInviteOrganizationInviteUpdateOrganizationInvitePayload {
+	return v.OrganizationInviteUpdate
+}
+
+// updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayload includes the requested fields of the GraphQL type ProjectMilestonePayload.
+type updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayload struct {
+	// The project milestone that was created or updated.
+	ProjectMilestone updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayloadProjectMilestone `json:"projectMilestone"`
+}
+
+// GetProjectMilestone returns updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayload.ProjectMilestone, and is useful for accessing the field via an interface.
+func (v *updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayload) GetProjectMilestone() updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayloadProjectMilestone {
+	return v.ProjectMilestone
+}
+
+// updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayloadProjectMilestone includes the requested fields of the GraphQL type ProjectMilestone.
+// The GraphQL type's documentation follows.
+//
+// A milestone for a project.
+type updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayloadProjectMilestone struct {
+	ProjectMilestone `json:"-"`
+}
+
+// GetId returns updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayloadProjectMilestone.Id, and is useful for accessing the field via an interface.
+func (v *updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayloadProjectMilestone) GetId() string {
+	return v.ProjectMilestone.Id
+}
+
+// GetName returns updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayloadProjectMilestone.Name, and is useful for accessing the field via an interface.
+func (v *updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayloadProjectMilestone) GetName() string {
+	return v.ProjectMilestone.Name
+}
+
+// GetDescription returns updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayloadProjectMilestone.Description, and is useful for accessing the field via an interface.
+func (v *updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayloadProjectMilestone) GetDescription() *string {
+	return v.ProjectMilestone.Description
+}
+
+// GetTargetDate returns updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayloadProjectMilestone.TargetDate, and is useful for accessing the field via an interface.
+func (v *updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayloadProjectMilestone) GetTargetDate() *string {
+	return v.ProjectMilestone.TargetDate
+}
+
+// GetSortOrder returns updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayloadProjectMilestone.SortOrder, and is useful for accessing the field via an interface.
+func (v *updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayloadProjectMilestone) GetSortOrder() float64 {
+	return v.ProjectMilestone.SortOrder
+}
+
+// GetProject returns updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayloadProjectMilestone.Project, and is useful for accessing the field via an interface.
+func (v *updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayloadProjectMilestone) GetProject() ProjectMilestoneProject {
+	return v.ProjectMilestone.Project
+}
+
+func (v *updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayloadProjectMilestone) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayloadProjectMilestone
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayloadProjectMilestone = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ProjectMilestone)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayloadProjectMilestone struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description *string `json:"description"`
+
+	TargetDate *string `json:"targetDate"`
+
+	SortOrder float64 `json:"sortOrder"`
+
+	Project ProjectMilestoneProject `json:"project"`
+}
+
+func (v *updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayloadProjectMilestone) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayloadProjectMilestone) __premarshalJSON() (*__premarshalupdateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayloadProjectMilestone, error) {
+	var retval __premarshalupdateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayloadProjectMilestone
+
+	retval.Id = v.ProjectMilestone.Id
+	retval.Name = v.ProjectMilestone.Name
+	retval.Description = v.ProjectMilestone.Description
+	retval.TargetDate = v.ProjectMilestone.TargetDate
+	retval.SortOrder = v.ProjectMilestone.SortOrder
+	retval.Project = v.ProjectMilestone.Project
+	return &retval, nil
+}
+
+// updateProjectMilestoneResponse is returned by updateProjectMilestone on success.
+type updateProjectMilestoneResponse struct {
+	// Updates a project milestone.
+	ProjectMilestoneUpdate updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayload `json:"projectMilestoneUpdate"`
+}
+
+// GetProjectMilestoneUpdate returns updateProjectMilestoneResponse.ProjectMilestoneUpdate, and is useful for accessing the field via an interface.
+func (v *updateProjectMilestoneResponse) GetProjectMilestoneUpdate() updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayload {
+	return v.ProjectMilestoneUpdate
+}
+
+// updateProjectProjectUpdateProjectPayload includes the requested fields of the GraphQL type ProjectPayload.
+type updateProjectProjectUpdateProjectPayload struct {
+	// The project that was created or updated.
+	Project updateProjectProjectUpdateProjectPayloadProject `json:"project"`
+}
+
+// GetProject returns updateProjectProjectUpdateProjectPayload.Project, and is useful for accessing the field via an interface.
+func (v *updateProjectProjectUpdateProjectPayload) GetProject() updateProjectProjectUpdateProjectPayloadProject {
+	return v.Project
+}
+
+// updateProjectProjectUpdateProjectPayloadProject includes the requested fields of the GraphQL type Project.
+// The GraphQL type's documentation follows.
+//
+// A project.
+type updateProjectProjectUpdateProjectPayloadProject struct {
+	Project `json:"-"`
+}
+
+// GetId returns updateProjectProjectUpdateProjectPayloadProject.Id, and is useful for accessing the field via an interface.
+func (v *updateProjectProjectUpdateProjectPayloadProject) GetId() string { return v.Project.Id }
+
+// GetName returns updateProjectProjectUpdateProjectPayloadProject.Name, and is useful for accessing the field via an interface.
+func (v *updateProjectProjectUpdateProjectPayloadProject) GetName() string { return v.Project.Name }
+
+// GetSlugId returns updateProjectProjectUpdateProjectPayloadProject.SlugId, and is useful for accessing the field via an interface.
+func (v *updateProjectProjectUpdateProjectPayloadProject) GetSlugId() string { return v.Project.SlugId }
+
+// GetUrl returns updateProjectProjectUpdateProjectPayloadProject.Url, and is useful for accessing the field via an interface.
+func (v *updateProjectProjectUpdateProjectPayloadProject) GetUrl() string { return v.Project.Url }
+
+// GetDescription returns updateProjectProjectUpdateProjectPayloadProject.Description, and is useful for accessing the field via an interface.
+func (v *updateProjectProjectUpdateProjectPayloadProject) GetDescription() string {
+	return v.Project.Description
+}
+
+// GetIcon returns updateProjectProjectUpdateProjectPayloadProject.Icon, and is useful for accessing the field via an interface.
+func (v *updateProjectProjectUpdateProjectPayloadProject) GetIcon() *string { return v.Project.Icon }
+
+// GetColor returns updateProjectProjectUpdateProjectPayloadProject.Color, and is useful for accessing the field via an interface.
+func (v *updateProjectProjectUpdateProjectPayloadProject) GetColor() string { return v.Project.Color }
+
+// GetStatus returns updateProjectProjectUpdateProjectPayloadProject.Status, and is useful for accessing the field via an interface.
+func (v *updateProjectProjectUpdateProjectPayloadProject) GetStatus() ProjectStatusProjectStatus {
+	return v.Project.Status
+}
+
+// GetLead returns updateProjectProjectUpdateProjectPayloadProject.Lead, and is useful for accessing the field via an interface.
+func (v *updateProjectProjectUpdateProjectPayloadProject) GetLead() *ProjectLeadUser {
+	return v.Project.Lead
+}
+
+// GetTeams returns updateProjectProjectUpdateProjectPayloadProject.Teams, and is useful for accessing the field via an interface.
+func (v *updateProjectProjectUpdateProjectPayloadProject) GetTeams() ProjectTeamsTeamConnection {
+	return v.Project.Teams
+}
+
+// GetPriority returns updateProjectProjectUpdateProjectPayloadProject.Priority, and is useful for accessing the field via an interface.
+func (v *updateProjectProjectUpdateProjectPayloadProject) GetPriority() int {
+	return v.Project.Priority
+}
+
+// GetStartDate returns updateProjectProjectUpdateProjectPayloadProject.StartDate, and is useful for accessing the field via an interface.
+func (v *updateProjectProjectUpdateProjectPayloadProject) GetStartDate() *string {
+	return v.Project.StartDate
+}
+
+// GetTargetDate returns updateProjectProjectUpdateProjectPayloadProject.TargetDate, and is useful for accessing the field via an interface.
+func (v *updateProjectProjectUpdateProjectPayloadProject) GetTargetDate() *string {
+	return v.Project.TargetDate
+}
+
+// GetLabelIds returns updateProjectProjectUpdateProjectPayloadProject.LabelIds, and is useful for accessing the field via an interface.
+func (v *updateProjectProjectUpdateProjectPayloadProject) GetLabelIds() []string {
+	return v.Project.LabelIds
+}
+
+// GetTrashed returns updateProjectProjectUpdateProjectPayloadProject.Trashed, and is useful for accessing the field via an interface.
+func (v *updateProjectProjectUpdateProjectPayloadProject) GetTrashed() bool { return v.Project.Trashed }
+
+// GetArchivedAt returns updateProjectProjectUpdateProjectPayloadProject.ArchivedAt, and is useful for accessing the field via an interface.
+func (v *updateProjectProjectUpdateProjectPayloadProject) GetArchivedAt() *time.Time {
+	return v.Project.ArchivedAt
+}
+
+func (v *updateProjectProjectUpdateProjectPayloadProject) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateProjectProjectUpdateProjectPayloadProject
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateProjectProjectUpdateProjectPayloadProject = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Project)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateProjectProjectUpdateProjectPayloadProject struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	SlugId string `json:"slugId"`
+
+	Url string `json:"url"`
+
+	Description string `json:"description"`
+
+	Icon *string `json:"icon"`
+
+	Color string `json:"color"`
+
+	Status ProjectStatusProjectStatus `json:"status"`
+
+	Lead *ProjectLeadUser `json:"lead"`
+
+	Teams ProjectTeamsTeamConnection `json:"teams"`
+
+	Priority int `json:"priority"`
+
+	StartDate *string `json:"startDate"`
+
+	TargetDate *string `json:"targetDate"`
+
+	LabelIds []string `json:"labelIds"`
+
+	Trashed bool `json:"trashed"`
+
+	ArchivedAt *time.Time `json:"archivedAt"`
+}
+
+func (v *updateProjectProjectUpdateProjectPayloadProject) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateProjectProjectUpdateProjectPayloadProject) __premarshalJSON() (*__premarshalupdateProjectProjectUpdateProjectPayloadProject, error) {
+	var retval __premarshalupdateProjectProjectUpdateProjectPayloadProject
+
+	retval.Id = v.Project.Id
+	retval.Name = v.Project.Name
+	retval.SlugId = v.Project.SlugId
+	retval.Url = v.Project.Url
+	retval.Description = v.Project.Description
+	retval.Icon = v.Project.Icon
+	retval.Color = v.Project.Color
+	retval.Status = v.Project.Status
+	retval.Lead = v.Project.Lead
+	retval.Teams = v.Project.Teams
+	retval.Priority = v.Project.Priority
+	retval.StartDate = v.Project.StartDate
+	retval.TargetDate = v.Project.TargetDate
+	retval.LabelIds = v.Project.LabelIds
+	retval.Trashed = v.Project.Trashed
+	retval.ArchivedAt = v.Project.ArchivedAt
+	return &retval, nil
+}
+
+// updateProjectResponse is returned by updateProject on success.
+type updateProjectResponse struct {
+	// Updates a project.
+	ProjectUpdate updateProjectProjectUpdateProjectPayload `json:"projectUpdate"`
+}
+
+// GetProjectUpdate returns updateProjectResponse.ProjectUpdate, and is useful for accessing the field via an interface.
+func (v *updateProjectResponse) GetProjectUpdate() updateProjectProjectUpdateProjectPayload {
+	return v.ProjectUpdate
+}
+
+// updateProjectStatusProjectStatusUpdateProjectStatusPayload includes the requested fields of the GraphQL type ProjectStatusPayload.
+type updateProjectStatusProjectStatusUpdateProjectStatusPayload struct {
+	// The project status that was created or updated.
+	Status updateProjectStatusProjectStatusUpdateProjectStatusPayloadStatusProjectStatus `json:"status"`
+}
+
+// GetStatus returns updateProjectStatusProjectStatusUpdateProjectStatusPayload.Status, and is useful for accessing the field via an interface.
+func (v *updateProjectStatusProjectStatusUpdateProjectStatusPayload) GetStatus() updateProjectStatusProjectStatusUpdateProjectStatusPayloadStatusProjectStatus {
+	return v.Status
+}
+
+// updateProjectStatusProjectStatusUpdateProjectStatusPayloadStatusProjectStatus includes the requested fields of the GraphQL type ProjectStatus.
+// The GraphQL type's documentation follows.
+//
+// A project status.
+type updateProjectStatusProjectStatusUpdateProjectStatusPayloadStatusProjectStatus struct {
+	ProjectStatus `json:"-"`
+}
+
+// GetId returns updateProjectStatusProjectStatusUpdateProjectStatusPayloadStatusProjectStatus.Id, and is useful for accessing the field via an interface.
+func (v *updateProjectStatusProjectStatusUpdateProjectStatusPayloadStatusProjectStatus) GetId() string {
+	return v.ProjectStatus.Id
+}
+
+// GetName returns updateProjectStatusProjectStatusUpdateProjectStatusPayloadStatusProjectStatus.Name, and is useful for accessing the field via an interface.
+func (v *updateProjectStatusProjectStatusUpdateProjectStatusPayloadStatusProjectStatus) GetName() string {
+	return v.ProjectStatus.Name
+}
+
+// GetColor returns updateProjectStatusProjectStatusUpdateProjectStatusPayloadStatusProjectStatus.Color, and is useful for accessing the field via an interface.
+func (v *updateProjectStatusProjectStatusUpdateProjectStatusPayloadStatusProjectStatus) GetColor() string {
+	return v.ProjectStatus.Color
+}
+
+// GetDescription returns updateProjectStatusProjectStatusUpdateProjectStatusPayloadStatusProjectStatus.Description, and is useful for accessing the field via an interface.
+func (v *updateProjectStatusProjectStatusUpdateProjectStatusPayloadStatusProjectStatus) GetDescription() *string {
+	return v.ProjectStatus.Description
+}
+
+// GetType returns updateProjectStatusProjectStatusUpdateProjectStatusPayloadStatusProjectStatus.Type, and is useful for accessing the field via an interface.
+func (v *updateProjectStatusProjectStatusUpdateProjectStatusPayloadStatusProjectStatus) GetType() ProjectStatusType {
+	return v.ProjectStatus.Type
+}
+
+// GetPosition returns updateProjectStatusProjectStatusUpdateProjectStatusPayloadStatusProjectStatus.Position, and is useful for accessing the field via an interface.
+func (v *updateProjectStatusProjectStatusUpdateProjectStatusPayloadStatusProjectStatus) GetPosition() float64 {
+	return v.ProjectStatus.Position
+}
+
+// GetIndefinite returns updateProjectStatusProjectStatusUpdateProjectStatusPayloadStatusProjectStatus.Indefinite, and is useful for accessing the field via an interface.
+func (v *updateProjectStatusProjectStatusUpdateProjectStatusPayloadStatusProjectStatus) GetIndefinite() bool {
+	return v.ProjectStatus.Indefinite
+}
+
+// GetArchivedAt returns updateProjectStatusProjectStatusUpdateProjectStatusPayloadStatusProjectStatus.ArchivedAt, and is useful for accessing the field via an interface.
+func (v *updateProjectStatusProjectStatusUpdateProjectStatusPayloadStatusProjectStatus) GetArchivedAt() *time.Time {
+	return v.ProjectStatus.ArchivedAt
+}
+
+func (v *updateProjectStatusProjectStatusUpdateProjectStatusPayloadStatusProjectStatus) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateProjectStatusProjectStatusUpdateProjectStatusPayloadStatusProjectStatus
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateProjectStatusProjectStatusUpdateProjectStatusPayloadStatusProjectStatus = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ProjectStatus)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateProjectStatusProjectStatusUpdateProjectStatusPayloadStatusProjectStatus struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Color string `json:"color"`
+
+	Description *string `json:"description"`
+
+	Type ProjectStatusType `json:"type"`
+
+	Position float64 `json:"position"`
+
+	Indefinite bool `json:"indefinite"`
+
+	ArchivedAt *time.Time `json:"archivedAt"`
+}
+
+func (v *updateProjectStatusProjectStatusUpdateProjectStatusPayloadStatusProjectStatus) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateProjectStatusProjectStatusUpdateProjectStatusPayloadStatusProjectStatus) __premarshalJSON() (*__premarshalupdateProjectStatusProjectStatusUpdateProjectStatusPayloadStatusProjectStatus, error) {
+	var retval __premarshalupdateProjectStatusProjectStatusUpdateProjectStatusPayloadStatusProjectStatus
+
+	retval.Id = v.ProjectStatus.Id
+	retval.Name = v.ProjectStatus.Name
+	retval.Color = v.ProjectStatus.Color
+	retval.Description = v.ProjectStatus.Description
+	retval.Type = v.ProjectStatus.Type
+	retval.Position = v.ProjectStatus.Position
+	retval.Indefinite = v.ProjectStatus.Indefinite
+	retval.ArchivedAt = v.ProjectStatus.ArchivedAt
+	return &retval, nil
+}
+
+// updateProjectStatusResponse is returned by updateProjectStatus on success.
+type updateProjectStatusResponse struct {
+	// Updates a project status.
+	ProjectStatusUpdate updateProjectStatusProjectStatusUpdateProjectStatusPayload `json:"projectStatusUpdate"`
+}
+
+// GetProjectStatusUpdate returns updateProjectStatusResponse.ProjectStatusUpdate, and is useful for accessing the field via an interface.
+func (v *updateProjectStatusResponse) GetProjectStatusUpdate() updateProjectStatusProjectStatusUpdateProjectStatusPayload {
+	return v.ProjectStatusUpdate
+}
+
+// updateTeamMembershipResponse is returned by updateTeamMembership on success.
+type updateTeamMembershipResponse struct {
+	// Updates a team membership.
+	TeamMembershipUpdate updateTeamMembershipTeamMembershipUpdateTeamMembershipPayload `json:"teamMembershipUpdate"`
+}
+
+// GetTeamMembershipUpdate returns updateTeamMembershipResponse.TeamMembershipUpdate, and is useful for accessing the field via an interface.
+func (v *updateTeamMembershipResponse) GetTeamMembershipUpdate() updateTeamMembershipTeamMembershipUpdateTeamMembershipPayload {
+	return v.TeamMembershipUpdate
+}
+
+// updateTeamMembershipTeamMembershipUpdateTeamMembershipPayload includes the requested fields of the GraphQL type TeamMembershipPayload.
+type updateTeamMembershipTeamMembershipUpdateTeamMembershipPayload struct {
+	// The team membership that was created or updated.
+	TeamMembership updateTeamMembershipTeamMembershipUpdateTeamMembershipPayloadTeamMembership `json:"teamMembership"`
+}
+
+// GetTeamMembership returns updateTeamMembershipTeamMembershipUpdateTeamMembershipPayload.TeamMembership, and is useful for accessing the field via an interface.
+func (v *updateTeamMembershipTeamMembershipUpdateTeamMembershipPayload) GetTeamMembership() updateTeamMembershipTeamMembershipUpdateTeamMembershipPayloadTeamMembership {
+	return v.TeamMembership
+}
+
+// updateTeamMembershipTeamMembershipUpdateTeamMembershipPayloadTeamMembership includes the requested fields of the GraphQL type TeamMembership.
+// The GraphQL type's documentation follows.
+//
+// Defines the membership of a user to a team.
+type updateTeamMembershipTeamMembershipUpdateTeamMembershipPayloadTeamMembership struct {
+	TeamMembership `json:"-"`
+}
+
+// GetId returns updateTeamMembershipTeamMembershipUpdateTeamMembershipPayloadTeamMembership.Id, and is useful for accessing the field via an interface.
+func (v *updateTeamMembershipTeamMembershipUpdateTeamMembershipPayloadTeamMembership) GetId() string {
+	return v.TeamMembership.Id
+}
+
+// GetOwner returns updateTeamMembershipTeamMembershipUpdateTeamMembershipPayloadTeamMembership.Owner, and is useful for accessing the field via an interface.
+func (v *updateTeamMembershipTeamMembershipUpdateTeamMembershipPayloadTeamMembership) GetOwner() bool {
+	return v.TeamMembership.Owner
+}
+
+// GetSortOrder returns updateTeamMembershipTeamMembershipUpdateTeamMembershipPayloadTeamMembership.SortOrder, and is useful for accessing the field via an interface.
+func (v *updateTeamMembershipTeamMembershipUpdateTeamMembershipPayloadTeamMembership) GetSortOrder() float64 {
+	return v.TeamMembership.SortOrder
+}
+
+// GetTeam returns updateTeamMembershipTeamMembershipUpdateTeamMembershipPayloadTeamMembership.Team, and is useful for accessing the field via an interface.
+func (v *updateTeamMembershipTeamMembershipUpdateTeamMembershipPayloadTeamMembership) GetTeam() TeamMembershipTeam {
+	return v.TeamMembership.Team
+}
+
+// GetUser returns updateTeamMembershipTeamMembershipUpdateTeamMembershipPayloadTeamMembership.User, and is useful for accessing the field via an interface.
+func (v *updateTeamMembershipTeamMembershipUpdateTeamMembershipPayloadTeamMembership) GetUser() TeamMembershipUser {
+	return v.TeamMembership.User
+}
+
+// GetArchivedAt returns updateTeamMembershipTeamMembershipUpdateTeamMembershipPayloadTeamMembership.ArchivedAt, and is useful for accessing the field via an interface.
+func (v *updateTeamMembershipTeamMembershipUpdateTeamMembershipPayloadTeamMembership) GetArchivedAt() *time.Time {
+	return v.TeamMembership.ArchivedAt
+}
+
+func (v *updateTeamMembershipTeamMembershipUpdateTeamMembershipPayloadTeamMembership) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateTeamMembershipTeamMembershipUpdateTeamMembershipPayloadTeamMembership
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateTeamMembershipTeamMembershipUpdateTeamMembershipPayloadTeamMembership = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.TeamMembership)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateTeamMembershipTeamMembershipUpdateTeamMembershipPayloadTeamMembership struct {
+	Id string `json:"id"`
+
+	Owner bool `json:"owner"`
+
+	SortOrder float64 `json:"sortOrder"`
+
+	Team TeamMembershipTeam `json:"team"`
+
+	User TeamMembershipUser `json:"user"`
+
+	ArchivedAt *time.Time `json:"archivedAt"`
+}
+
+func (v *updateTeamMembershipTeamMembershipUpdateTeamMembershipPayloadTeamMembership) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateTeamMembershipTeamMembershipUpdateTeamMembershipPayloadTeamMembership) __premarshalJSON() (*__premarshalupdateTeamMembershipTeamMembershipUpdateTeamMembershipPayloadTeamMembership, error) {
+	var retval __premarshalupdateTeamMembershipTeamMembershipUpdateTeamMembershipPayloadTeamMembership
+
+	retval.Id = v.TeamMembership.Id
+	retval.Owner = v.TeamMembership.Owner
+	retval.SortOrder = v.TeamMembership.SortOrder
+	retval.Team = v.TeamMembership.Team
+	retval.User = v.TeamMembership.User
+	retval.ArchivedAt = v.TeamMembership.ArchivedAt
+	return &retval, nil
+}
+
+// updateTeamResponse is returned by updateTeam on success.
+type updateTeamResponse struct {
+	// Updates a team.
+	TeamUpdate updateTeamTeamUpdateTeamPayload `json:"teamUpdate"`
+}
+
+// GetTeamUpdate returns updateTeamResponse.TeamUpdate, and is useful for accessing the field via an interface.
+func (v *updateTeamResponse) GetTeamUpdate() updateTeamTeamUpdateTeamPayload { return v.TeamUpdate }
+
+// updateTeamTeamUpdateTeamPayload includes the requested fields of the GraphQL type TeamPayload.
+type updateTeamTeamUpdateTeamPayload struct {
+	// The team that was created or updated.
+	Team updateTeamTeamUpdateTeamPayloadTeam `json:"team"`
+}
+
+// GetTeam returns updateTeamTeamUpdateTeamPayload.Team, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayload) GetTeam() updateTeamTeamUpdateTeamPayloadTeam {
+	return v.Team
+}
+
+// updateTeamTeamUpdateTeamPayloadTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type updateTeamTeamUpdateTeamPayloadTeam struct {
+	Team `json:"-"`
+}
+
+// GetId returns updateTeamTeamUpdateTeamPayloadTeam.Id, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetId() string { return v.Team.Id }
+
+// GetName returns updateTeamTeamUpdateTeamPayloadTeam.Name, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetName() string { return v.Team.Name }
+
+// GetKey returns updateTeamTeamUpdateTeamPayloadTeam.Key, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetKey() string { return v.Team.Key }
+
+// GetPrivate returns updateTeamTeamUpdateTeamPayloadTeam.Private, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetPrivate() bool { return v.Team.Private }
+
+// GetDescription returns updateTeamTeamUpdateTeamPayloadTeam.Description, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetDescription() *string { return v.Team.Description }
+
+// GetIcon returns updateTeamTeamUpdateTeamPayloadTeam.Icon, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetIcon() *string { return v.Team.Icon }
+
+// GetColor returns updateTeamTeamUpdateTeamPayloadTeam.Color, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetColor() *string { return v.Team.Color }
+
+// GetParent returns updateTeamTeamUpdateTeamPayloadTeam.Parent, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetParent() *TeamParentTeam { return v.Team.Parent }
+
+// GetTimezone returns updateTeamTeamUpdateTeamPayloadTeam.Timezone, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetTimezone() string { return v.Team.Timezone }
+
+// GetGroupIssueHistory returns updateTeamTeamUpdateTeamPayloadTeam.GroupIssueHistory, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetGroupIssueHistory() bool {
+	return v.Team.GroupIssueHistory
+}
+
+// GetSetIssueSortOrderOnStateChange returns updateTeamTeamUpdateTeamPayloadTeam.SetIssueSortOrderOnStateChange, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetSetIssueSortOrderOnStateChange() string {
+	return v.Team.SetIssueSortOrderOnStateChange
+}
+
+// GetAiThreadSummariesEnabled returns updateTeamTeamUpdateTeamPayloadTeam.AiThreadSummariesEnabled, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetAiThreadSummariesEnabled() bool {
+	return v.Team.AiThreadSummariesEnabled
+}
+
+// GetAutoArchivePeriod returns updateTeamTeamUpdateTeamPayloadTeam.AutoArchivePeriod, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetAutoArchivePeriod() float64 {
+	return v.Team.AutoArchivePeriod
+}
+
+// GetAutoClosePeriod returns updateTeamTeamUpdateTeamPayloadTeam.AutoClosePeriod, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetAutoClosePeriod() *float64 {
+	return v.Team.AutoClosePeriod
+}
+
+// GetAutoCloseParentIssues returns updateTeamTeamUpdateTeamPayloadTeam.AutoCloseParentIssues, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetAutoCloseParentIssues() bool {
+	return v.Team.AutoCloseParentIssues
+}
+
+// GetAutoCloseChildIssues returns updateTeamTeamUpdateTeamPayloadTeam.AutoCloseChildIssues, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetAutoCloseChildIssues() bool {
+	return v.Team.AutoCloseChildIssues
+}
+
+// GetTriageEnabled returns updateTeamTeamUpdateTeamPayloadTeam.TriageEnabled, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetTriageEnabled() bool { return v.Team.TriageEnabled }
+
+// GetRequirePriorityToLeaveTriage returns updateTeamTeamUpdateTeamPayloadTeam.RequirePriorityToLeaveTriage, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetRequirePriorityToLeaveTriage() bool {
+	return v.Team.RequirePriorityToLeaveTriage
+}
+
+// GetCyclesEnabled returns updateTeamTeamUpdateTeamPayloadTeam.CyclesEnabled, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetCyclesEnabled() bool { return v.Team.CyclesEnabled }
+
+// GetCycleStartDay returns updateTeamTeamUpdateTeamPayloadTeam.CycleStartDay, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetCycleStartDay() float64 { return v.Team.CycleStartDay }
+
+// GetCycleDuration returns updateTeamTeamUpdateTeamPayloadTeam.CycleDuration, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetCycleDuration() float64 { return v.Team.CycleDuration }
+
+// GetCycleCooldownTime returns updateTeamTeamUpdateTeamPayloadTeam.CycleCooldownTime, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetCycleCooldownTime() float64 {
+	return v.Team.CycleCooldownTime
+}
+
+// GetUpcomingCycleCount returns updateTeamTeamUpdateTeamPayloadTeam.UpcomingCycleCount, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetUpcomingCycleCount() float64 {
+	return v.Team.UpcomingCycleCount
+}
+
+// GetCycleIssueAutoAssignStarted returns updateTeamTeamUpdateTeamPayloadTeam.CycleIssueAutoAssignStarted, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetCycleIssueAutoAssignStarted() bool {
+	return v.Team.CycleIssueAutoAssignStarted
+}
+
+// GetCycleIssueAutoAssignCompleted returns updateTeamTeamUpdateTeamPayloadTeam.CycleIssueAutoAssignCompleted, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetCycleIssueAutoAssignCompleted() bool {
+	return v.Team.CycleIssueAutoAssignCompleted
+}
+
+// GetCycleLockToActive returns updateTeamTeamUpdateTeamPayloadTeam.CycleLockToActive, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetCycleLockToActive() bool {
+	return v.Team.CycleLockToActive
+}
+
+// GetIssueEstimationType returns updateTeamTeamUpdateTeamPayloadTeam.IssueEstimationType, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetIssueEstimationType() string {
+	return v.Team.IssueEstimationType
+}
+
+// GetIssueEstimationAllowZero returns updateTeamTeamUpdateTeamPayloadTeam.IssueEstimationAllowZero, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetIssueEstimationAllowZero() bool {
+	return v.Team.IssueEstimationAllowZero
+}
+
+// GetIssueEstimationExtended returns updateTeamTeamUpdateTeamPayloadTeam.IssueEstimationExtended, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetIssueEstimationExtended() bool {
+	return v.Team.IssueEstimationExtended
+}
+
+// GetDefaultIssueEstimate returns updateTeamTeamUpdateTeamPayloadTeam.DefaultIssueEstimate, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetDefaultIssueEstimate() float64 {
+	return v.Team.DefaultIssueEstimate
+}
+
+func (v *updateTeamTeamUpdateTeamPayloadTeam) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateTeamTeamUpdateTeamPayloadTeam
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateTeamTeamUpdateTeamPayloadTeam = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Team)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateTeamTeamUpdateTeamPayloadTeam struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Key string `json:"key"`
+
+	Private bool `json:"private"`
+
+	Description *string `json:"description"`
+
+	Icon *string `json:"icon"`
+
+	Color *string `json:"color"`
+
+	Parent *TeamParentTeam `json:"parent"`
+
+	Timezone string `json:"timezone"`
+
+	GroupIssueHistory bool `json:"groupIssueHistory"`
+
+	SetIssueSortOrderOnStateChange string `json:"setIssueSortOrderOnStateChange"`
+
+	AiThreadSummariesEnabled bool `json:"aiThreadSummariesEnabled"`
+
+	AutoArchivePeriod float64 `json:"autoArchivePeriod"`
+
+	AutoClosePeriod *float64 `json:"autoClosePeriod"`
+
+	AutoCloseParentIssues bool `json:"autoCloseParentIssues"`
+
+	AutoCloseChildIssues bool `json:"autoCloseChildIssues"`
+
+	TriageEnabled bool `json:"triageEnabled"`
+
+	RequirePriorityToLeaveTriage bool `json:"requirePriorityToLeaveTriage"`
+
+	CyclesEnabled bool `json:"cyclesEnabled"`
+
+	CycleStartDay float64 `json:"cycleStartDay"`
+
+	CycleDuration float64 `json:"cycleDuration"`
+
+	CycleCooldownTime float64 `json:"cycleCooldownTime"`
+
+	UpcomingCycleCount float64 `json:"upcomingCycleCount"`
+
+	CycleIssueAutoAssignStarted bool `json:"cycleIssueAutoAssignStarted"`
+
+	CycleIssueAutoAssignCompleted bool `json:"cycleIssueAutoAssignCompleted"`
+
+	CycleLockToActive bool `json:"cycleLockToActive"`
+
+	IssueEstimationType string `json:"issueEstimationType"`
+
+	IssueEstimationAllowZero bool `json:"issueEstimationAllowZero"`
+
+	IssueEstimationExtended bool `json:"issueEstimationExtended"`
+
+	DefaultIssueEstimate float64 `json:"defaultIssueEstimate"`
+}
+
+func (v *updateTeamTeamUpdateTeamPayloadTeam) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateTeamTeamUpdateTeamPayloadTeam) __premarshalJSON() (*__premarshalupdateTeamTeamUpdateTeamPayloadTeam, error) {
+	var retval __premarshalupdateTeamTeamUpdateTeamPayloadTeam
+
+	retval.Id = v.Team.Id
+	retval.Name = v.Team.Name
+	retval.Key = v.Team.Key
+	retval.Private = v.Team.Private
+	retval.Description = v.Team.Description
+	retval.Icon = v.Team.Icon
+	retval.Color = v.Team.Color
+	retval.Parent = v.Team.Parent
+	retval.Timezone = v.Team.Timezone
+	retval.GroupIssueHistory = v.Team.GroupIssueHistory
+	retval.SetIssueSortOrderOnStateChange = v.Team.SetIssueSortOrderOnStateChange
+	retval.AiThreadSummariesEnabled = v.Team.AiThreadSummariesEnabled
+	retval.AutoArchivePeriod = v.Team.AutoArchivePeriod
+	retval.AutoClosePeriod = v.Team.AutoClosePeriod
+	retval.AutoCloseParentIssues = v.Team.AutoCloseParentIssues
+	retval.AutoCloseChildIssues = v.Team.AutoCloseChildIssues
+	retval.TriageEnabled = v.Team.TriageEnabled
+	retval.RequirePriorityToLeaveTriage = v.Team.RequirePriorityToLeaveTriage
+	retval.CyclesEnabled = v.Team.CyclesEnabled
+	retval.CycleStartDay = v.Team.CycleStartDay
+	retval.CycleDuration = v.Team.CycleDuration
+	retval.CycleCooldownTime = v.Team.CycleCooldownTime
+	retval.UpcomingCycleCount = v.Team.UpcomingCycleCount
+	retval.CycleIssueAutoAssignStarted = v.Team.CycleIssueAutoAssignStarted
+	retval.CycleIssueAutoAssignCompleted = v.Team.CycleIssueAutoAssignCompleted
+	retval.CycleLockToActive = v.Team.CycleLockToActive
+	retval.IssueEstimationType = v.Team.IssueEstimationType
+	retval.IssueEstimationAllowZero = v.Team.IssueEstimationAllowZero
+	retval.IssueEstimationExtended = v.Team.IssueEstimationExtended
+	retval.DefaultIssueEstimate = v.Team.DefaultIssueEstimate
+	return &retval, nil
+}
+
+// updateTimeScheduleResponse is returned by updateTimeSchedule on success.
+type updateTimeScheduleResponse struct {
+	// Updates a time schedule.
+	TimeScheduleUpdate updateTimeScheduleTimeScheduleUpdateTimeSchedulePayload `json:"timeScheduleUpdate"`
+}
+
+// GetTimeScheduleUpdate returns updateTimeScheduleResponse.TimeScheduleUpdate, and is useful for accessing the field via an interface.
+func (v *updateTimeScheduleResponse) GetTimeScheduleUpdate() updateTimeScheduleTimeScheduleUpdateTimeSchedulePayload {
+	return v.TimeScheduleUpdate
+}
+
+// updateTimeScheduleTimeScheduleUpdateTimeSchedulePayload includes the requested fields of the GraphQL type TimeSchedulePayload.
+type updateTimeScheduleTimeScheduleUpdateTimeSchedulePayload struct {
+	TimeSchedule updateTimeScheduleTimeScheduleUpdateTimeSchedulePayloadTimeSchedule `json:"timeSchedule"`
+}
+
+// GetTimeSchedule returns updateTimeScheduleTimeScheduleUpdateTimeSchedulePayload.TimeSchedule, and is useful for accessing the field via an interface.
+func (v *updateTimeScheduleTimeScheduleUpdateTimeSchedulePayload) GetTimeSchedule() updateTimeScheduleTimeScheduleUpdateTimeSchedulePayloadTimeSchedule {
+	return v.TimeSchedule
+}
+
+// updateTimeScheduleTimeScheduleUpdateTimeSchedulePayloadTimeSchedule includes the requested fields of the GraphQL type TimeSchedule.
+// The GraphQL type's documentation follows.
+//
+// A time schedule.
+type updateTimeScheduleTimeScheduleUpdateTimeSchedulePayloadTimeSchedule struct {
+	TimeSchedule `json:"-"`
+}
+
+// GetId returns updateTimeScheduleTimeScheduleUpdateTimeSchedulePayloadTimeSchedule.Id, and is useful for accessing the field via an interface.
+func (v *updateTimeScheduleTimeScheduleUpdateTimeSchedulePayloadTimeSchedule) GetId() string {
+	return v.TimeSchedule.Id
+}
+
+// GetName returns updateTimeScheduleTimeScheduleUpdateTimeSchedulePayloadTimeSchedule.Name, and is useful for accessing the field via an interface.
+func (v *updateTimeScheduleTimeScheduleUpdateTimeSchedulePayloadTimeSchedule) GetName() string {
+	return v.TimeSchedule.Name
+}
+
+// GetEntries returns updateTimeScheduleTimeScheduleUpdateTimeSchedulePayloadTimeSchedule.Entries, and is useful for accessing the field via an interface.
+func (v *updateTimeScheduleTimeScheduleUpdateTimeSchedulePayloadTimeSchedule) GetEntries() []TimeScheduleEntriesTimeScheduleEntry {
+	return v.TimeSchedule.Entries
+}
+
+// GetArchivedAt returns updateTimeScheduleTimeScheduleUpdateTimeSchedulePayloadTimeSchedule.ArchivedAt, and is useful for accessing the field via an interface.
+func (v *updateTimeScheduleTimeScheduleUpdateTimeSchedulePayloadTimeSchedule) GetArchivedAt() *time.Time {
+	return v.TimeSchedule.ArchivedAt
+}
+
+func (v *updateTimeScheduleTimeScheduleUpdateTimeSchedulePayloadTimeSchedule) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateTimeScheduleTimeScheduleUpdateTimeSchedulePayloadTimeSchedule
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateTimeScheduleTimeScheduleUpdateTimeSchedulePayloadTimeSchedule = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.TimeSchedule)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateTimeScheduleTimeScheduleUpdateTimeSchedulePayloadTimeSchedule struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Entries []TimeScheduleEntriesTimeScheduleEntry `json:"entries"`
+
+	ArchivedAt *time.Time `json:"archivedAt"`
+}
+
+func (v *updateTimeScheduleTimeScheduleUpdateTimeSchedulePayloadTimeSchedule) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateTimeScheduleTimeScheduleUpdateTimeSchedulePayloadTimeSchedule) __premarshalJSON() (*__premarshalupdateTimeScheduleTimeScheduleUpdateTimeSchedulePayloadTimeSchedule, error) {
+	var retval __premarshalupdateTimeScheduleTimeScheduleUpdateTimeSchedulePayloadTimeSchedule
+
+	retval.Id = v.TimeSchedule.Id
+	retval.Name = v.TimeSchedule.Name
+	retval.Entries = v.TimeSchedule.Entries
+	retval.ArchivedAt = v.TimeSchedule.ArchivedAt
+	return &retval, nil
+}
+
+// updateTriageResponsibilityResponse is returned by updateTriageResponsibility on success.
+type updateTriageResponsibilityResponse struct {
+	// Updates an existing triage responsibility.
+	TriageResponsibilityUpdate updateTriageResponsibilityTriageResponsibilityUpdateTriageResponsibilityPayload `json:"triageResponsibilityUpdate"`
+}
+
+// GetTriageResponsibilityUpdate returns updateTriageResponsibilityResponse.TriageResponsibilityUpdate, and is useful for accessing the field via an interface.
+func (v *updateTriageResponsibilityResponse) GetTriageResponsibilityUpdate() updateTriageResponsibilityTriageResponsibilityUpdateTriageResponsibilityPayload {
+	return v.TriageResponsibilityUpdate
+}
+
+// updateTriageResponsibilityTriageResponsibilityUpdateTriageResponsibilityPayload includes the requested fields of the GraphQL type TriageResponsibilityPayload.
+type updateTriageResponsibilityTriageResponsibilityUpdateTriageResponsibilityPayload struct {
+	TriageResponsibility updateTriageResponsibilityTriageResponsibilityUpdateTriageResponsibilityPayloadTriageResponsibility `json:"triageResponsibility"`
+}
+
+// GetTriageResponsibility returns updateTriageResponsibilityTriageResponsibilityUpdateTriageResponsibilityPayload.TriageResponsibility, and is useful for accessing the field via an interface.
+func (v *updateTriageResponsibilityTriageResponsibilityUpdateTriageResponsibilityPayload) GetTriageResponsibility() updateTriageResponsibilityTriageResponsibilityUpdateTriageResponsibilityPayloadTriageResponsibility {
+	return v.TriageResponsibility
+}
+
+// updateTriageResponsibilityTriageResponsibilityUpdateTriageResponsibilityPayloadTriageResponsibility includes the requested fields of the GraphQL type TriageResponsibility.
+// The GraphQL type's documentation follows.
+//
+// A team's triage responsibility.
+type updateTriageResponsibilityTriageResponsibilityUpdateTriageResponsibilityPayloadTriageResponsibility struct {
+	TriageResponsibility `json:"-"`
+}
+
+// GetId returns updateTriageResponsibilityTriageResponsibilityUpdateTriageResponsibilityPayloadTriageResponsibility.Id, and is useful for accessing the field via an interface.
+func (v *updateTriageResponsibilityTriageResponsibilityUpdateTriageResponsibilityPayloadTriageResponsibility) GetId() string {
+	return v.TriageResponsibility.Id
+}
+
+// GetAction returns updateTriageResponsibilityTriageResponsibilityUpdateTriageResponsibilityPayloadTriageResponsibility.Action, and is useful for accessing the field via an interface.
+func (v *updateTriageResponsibilityTriageResponsibilityUpdateTriageResponsibilityPayloadTriageResponsibility) GetAction() TriageResponsibilityAction {
+	return v.TriageResponsibility.Action
+}
+
+// GetManualSelection returns updateTriageResponsibilityTriageResponsibilityUpdateTriageResponsibilityPayloadTriageResponsibility.ManualSelection, and is useful for accessing the field via an interface.
+func (v *updateTriageResponsibilityTriageResponsibilityUpdateTriageResponsibilityPayloadTriageResponsibility) GetManualSelection() *TriageResponsibilityManualSelection {
+	return v.TriageResponsibility.ManualSelection
+}
+
+// GetTimeSchedule returns updateTriageResponsibilityTriageResponsibilityUpdateTriageResponsibilityPayloadTriageResponsibility.TimeSchedule, and is useful for accessing the field via an interface.
+func (v *updateTriageResponsibilityTriageResponsibilityUpdateTriageResponsibilityPayloadTriageResponsibility) GetTimeSchedule() *TriageResponsibilityTimeSchedule {
+	return v.TriageResponsibility.TimeSchedule
+}
+
+// GetTeam returns updateTriageResponsibilityTriageResponsibilityUpdateTriageResponsibilityPayloadTriageResponsibility.Team, and is useful for accessing the field via an interface.
+func (v *updateTriageResponsibilityTriageResponsibilityUpdateTriageResponsibilityPayloadTriageResponsibility) GetTeam() TriageResponsibilityTeam {
+	return v.TriageResponsibility.Team
+}
+
+// GetArchivedAt returns updateTriageResponsibilityTriageResponsibilityUpdateTriageResponsibilityPayloadTriageResponsibility.ArchivedAt, and is useful for accessing the field via an interface.
+func (v *updateTriageResponsibilityTriageResponsibilityUpdateTriageResponsibilityPayloadTriageResponsibility) GetArchivedAt() *time.Time {
+	return v.TriageResponsibility.ArchivedAt
+}
+
+func (v *updateTriageResponsibilityTriageResponsibilityUpdateTriageResponsibilityPayloadTriageResponsibility) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateTriageResponsibilityTriageResponsibilityUpdateTriageResponsibilityPayloadTriageResponsibility
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateTriageResponsibilityTriageResponsibilityUpdateTriageResponsibilityPayloadTriageResponsibility = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.TriageResponsibility)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateTriageResponsibilityTriageResponsibilityUpdateTriageResponsibilityPayloadTriageResponsibility struct {
+	Id string `json:"id"`
+
+	Action TriageResponsibilityAction `json:"action"`
+
+	ManualSelection *TriageResponsibilityManualSelection `json:"manualSelection"`
+
+	TimeSchedule *TriageResponsibilityTimeSchedule `json:"timeSchedule"`
+
+	Team TriageResponsibilityTeam `json:"team"`
+
+	ArchivedAt *time.Time `json:"archivedAt"`
+}
+
+func (v *updateTriageResponsibilityTriageResponsibilityUpdateTriageResponsibilityPayloadTriageResponsibility) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateTriageResponsibilityTriageResponsibilityUpdateTriageResponsibilityPayloadTriageResponsibility) __premarshalJSON() (*__premarshalupdateTriageResponsibilityTriageResponsibilityUpdateTriageResponsibilityPayloadTriageResponsibility, error) {
+	var retval __premarshalupdateTriageResponsibilityTriageResponsibilityUpdateTriageResponsibilityPayloadTriageResponsibility
+
+	retval.Id = v.TriageResponsibility.Id
+	retval.Action = v.TriageResponsibility.Action
+	retval.ManualSelection = v.TriageResponsibility.ManualSelection
+	retval.TimeSchedule = v.TriageResponsibility.TimeSchedule
+	retval.Team = v.TriageResponsibility.Team
+	retval.ArchivedAt = v.TriageResponsibility.ArchivedAt
+	return &retval, nil
+}
+
+// updateWebhookResponse is returned by updateWebhook on success.
+type updateWebhookResponse struct {
+	// Updates an existing Webhook.
+	WebhookUpdate updateWebhookWebhookUpdateWebhookPayload `json:"webhookUpdate"`
+}
+
+// GetWebhookUpdate returns updateWebhookResponse.WebhookUpdate, and is useful for accessing the field via an interface.
+func (v *updateWebhookResponse) GetWebhookUpdate() updateWebhookWebhookUpdateWebhookPayload {
+	return v.WebhookUpdate
+}
+
+// updateWebhookWebhookUpdateWebhookPayload includes the requested fields of the GraphQL type WebhookPayload.
+type updateWebhookWebhookUpdateWebhookPayload struct {
+	// The webhook entity being mutated.
+	Webhook updateWebhookWebhookUpdateWebhookPayloadWebhook `json:"webhook"`
+}
+
+// GetWebhook returns updateWebhookWebhookUpdateWebhookPayload.Webhook, and is useful for accessing the field via an interface.
+func (v *updateWebhookWebhookUpdateWebhookPayload) GetWebhook() updateWebhookWebhookUpdateWebhookPayloadWebhook {
+	return v.Webhook
+}
+
+// updateWebhookWebhookUpdateWebhookPayloadWebhook includes the requested fields of the GraphQL type Webhook.
+// The GraphQL type's documentation follows.
+//
+// A webhook used to send HTTP notifications over data updates.
+type updateWebhookWebhookUpdateWebhookPayloadWebhook struct {
+	Webhook `json:"-"`
+}
+
+// GetId returns updateWebhookWebhookUpdateWebhookPayloadWebhook.Id, and is useful for accessing the field via an interface.
+func (v *updateWebhookWebhookUpdateWebhookPayloadWebhook) GetId() string { return v.Webhook.Id }
+
+// GetLabel returns updateWebhookWebhookUpdateWebhookPayloadWebhook.Label, and is useful for accessing the field via an interface.
+func (v *updateWebhookWebhookUpdateWebhookPayloadWebhook) GetLabel() *string { return v.Webhook.Label }
+
+// GetUrl returns updateWebhookWebhookUpdateWebhookPayloadWebhook.Url, and is useful for accessing the field via an interface.
+func (v *updateWebhookWebhookUpdateWebhookPayloadWebhook) GetUrl() string { return v.Webhook.Url }
+
+// GetEnabled returns updateWebhookWebhookUpdateWebhookPayloadWebhook.Enabled, and is useful for accessing the field via an interface.
+func (v *updateWebhookWebhookUpdateWebhookPayloadWebhook) GetEnabled() bool { return v.Webhook.Enabled }
+
+// GetTeam returns updateWebhookWebhookUpdateWebhookPayloadWebhook.Team, and is useful for accessing the field via an interface.
+func (v *updateWebhookWebhookUpdateWebhookPayloadWebhook) GetTeam() *WebhookTeam {
+	return v.Webhook.Team
+}
+
+// GetAllPublicTeams returns updateWebhookWebhookUpdateWebhookPayloadWebhook.AllPublicTeams, and is useful for accessing the field via an interface.
+func (v *updateWebhookWebhookUpdateWebhookPayloadWebhook) GetAllPublicTeams() bool {
+	return v.Webhook.AllPublicTeams
+}
+
+// GetResourceTypes returns updateWebhookWebhookUpdateWebhookPayloadWebhook.ResourceTypes, and is useful for accessing the field via an interface.
+func (v *updateWebhookWebhookUpdateWebhookPayloadWebhook) GetResourceTypes() []string {
+	return v.Webhook.ResourceTypes
+}
+
+func (v *updateWebhookWebhookUpdateWebhookPayloadWebhook) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateWebhookWebhookUpdateWebhookPayloadWebhook
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateWebhookWebhookUpdateWebhookPayloadWebhook = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Webhook)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateWebhookWebhookUpdateWebhookPayloadWebhook struct {
+	Id string `json:"id"`
+
+	Label *string `json:"label"`
+
+	Url string `json:"url"`
+
+	Enabled bool `json:"enabled"`
+
+	Team *WebhookTeam `json:"team"`
+
+	AllPublicTeams bool `json:"allPublicTeams"`
+
+	ResourceTypes []string `json:"resourceTypes"`
+}
+
+func (v *updateWebhookWebhookUpdateWebhookPayloadWebhook) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateWebhookWebhookUpdateWebhookPayloadWebhook) __premarshalJSON() (*__premarshalupdateWebhookWebhookUpdateWebhookPayloadWebhook, error) {
+	var retval __premarshalupdateWebhookWebhookUpdateWebhookPayloadWebhook
+
+	retval.Id = v.Webhook.Id
+	retval.Label = v.Webhook.Label
+	retval.Url = v.Webhook.Url
+	retval.Enabled = v.Webhook.Enabled
+	retval.Team = v.Webhook.Team
+	retval.AllPublicTeams = v.Webhook.AllPublicTeams
+	retval.ResourceTypes = v.Webhook.ResourceTypes
+	return &retval, nil
+}
+
+// updateWorkflowStateResponse is returned by updateWorkflowState on success.
+type updateWorkflowStateResponse struct {
+	// Updates a state.
+	WorkflowStateUpdate updateWorkflowStateWorkflowStateUpdateWorkflowStatePayload `json:"workflowStateUpdate"`
+}
+
+// GetWorkflowStateUpdate returns updateWorkflowStateResponse.WorkflowStateUpdate, and is useful for accessing the field via an interface.
+func (v *updateWorkflowStateResponse) GetWorkflowStateUpdate() updateWorkflowStateWorkflowStateUpdateWorkflowStatePayload {
+	return v.WorkflowStateUpdate
+}
+
+// updateWorkflowStateWorkflowStateUpdateWorkflowStatePayload includes the requested fields of the GraphQL type WorkflowStatePayload.
+type updateWorkflowStateWorkflowStateUpdateWorkflowStatePayload struct {
+	// The state that was created or updated.
+	WorkflowState updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState `json:"workflowState"`
+}
+
+// GetWorkflowState returns updateWorkflowStateWorkflowStateUpdateWorkflowStatePayload.WorkflowState, and is useful for accessing the field via an interface.
+func (v *updateWorkflowStateWorkflowStateUpdateWorkflowStatePayload) GetWorkflowState() updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState {
+	return v.WorkflowState
+}
+
+// updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState includes the requested fields of the GraphQL type WorkflowState.
+// The GraphQL type's documentation follows.
+//
+// A state in a team workflow.
+type updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState struct {
+	WorkflowState `json:"-"`
+}
+
+// GetId returns updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState.Id, and is useful for accessing the field via an interface.
+func (v *updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState) GetId() string {
+	return v.WorkflowState.Id
+}
+
+// GetName returns updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState.Name, and is useful for accessing the field via an interface.
+func (v *updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState) GetName() string {
+	return v.WorkflowState.Name
+}
+
+// GetColor returns updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState.Color, and is useful for accessing the field via an interface.
+func (v *updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState) GetColor() string {
+	return v.WorkflowState.Color
+}
+
+// GetDescription returns updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState.Description, and is useful for accessing the field via an interface.
+func (v *updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState) GetDescription() *string {
+	return v.WorkflowState.Description
+}
+
+// GetType returns updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState.Type, and is useful for accessing the field via an interface.
+func (v *updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState) GetType() string {
+	return v.WorkflowState.Type
+}
+
+// GetPosition returns updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState.Position, and is useful for accessing the field via an interface.
+func (v *updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState) GetPosition() float64 {
+	return v.WorkflowState.Position
+}
+
+// GetTeam returns updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState.Team, and is useful for accessing the field via an interface.
+func (v *updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState) GetTeam() WorkflowStateTeam {
+	return v.WorkflowState.Team
+}
+
+func (v *updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.WorkflowState)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Color string `json:"color"`
+
+	Description *string `json:"description"`
+
+	Type string `json:"type"`
+
+	Position float64 `json:"position"`
+
+	Team WorkflowStateTeam `json:"team"`
+}
+
+func (v *updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState) __premarshalJSON() (*__premarshalupdateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState, error) {
+	var retval __premarshalupdateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState
+
+	retval.Id = v.WorkflowState.Id
+	retval.Name = v.WorkflowState.Name
+	retval.Color = v.WorkflowState.Color
+	retval.Description = v.WorkflowState.Description
+	retval.Type = v.WorkflowState.Type
+	retval.Position = v.WorkflowState.Position
+	retval.Team = v.WorkflowState.Team
+	return &retval, nil
+}
+
+// updateWorkspaceSettingsOrganizationUpdateOrganizationPayload includes the requested fields of the GraphQL type OrganizationPayload.
+type updateWorkspaceSettingsOrganizationUpdateOrganizationPayload struct {
+	// The organization that was created or updated.
+	Organization updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization `json:"organization"`
+}
+
+// GetOrganization returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayload.Organization, and is useful for accessing the field via an interface.
+func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayload) GetOrganization() updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization {
+	return v.Organization
+}
+
+// updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization includes the requested fields of the GraphQL type Organization.
+// The GraphQL type's documentation follows.
+//
+// An organization. Organizations are root-level objects that contain user accounts and teams.
+type updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization struct {
+	Organization `json:"-"`
+}
+
+// GetId returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization.Id, and is useful for accessing the field via an interface.
+func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) GetId() string {
+	return v.Organization.Id
+}
+
+// GetAllowMembersToInvite returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization.AllowMembersToInvite, and is useful for accessing the field via an interface.
+func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) GetAllowMembersToInvite() bool {
+	return v.Organization.AllowMembersToInvite
+}
+
+// GetRestrictTeamCreationToAdmins returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization.RestrictTeamCreationToAdmins, and is useful for accessing the field via an interface.
+func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) GetRestrictTeamCreationToAdmins() bool {
+	return v.Organization.RestrictTeamCreationToAdmins
+}
+
+// GetRestrictLabelManagementToAdmins returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization.RestrictLabelManagementToAdmins, and is useful for accessing the field via an interface.
+func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) GetRestrictLabelManagementToAdmins() bool {
+	return v.Organization.RestrictLabelManagementToAdmins
+}
+
+// GetGitLinkbackMessagesEnabled returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization.GitLinkbackMessagesEnabled, and is useful for accessing the field via an interface.
+func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) GetGitLinkbackMessagesEnabled() bool {
+	return v.Organization.GitLinkbackMessagesEnabled
+}
+
+// GetGitPublicLinkbackMessagesEnabled returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization.GitPublicLinkbackMessagesEnabled, and is useful for accessing the field via an interface.
+func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) GetGitPublicLinkbackMessagesEnabled() bool {
+	return v.Organization.GitPublicLinkbackMessagesEnabled
+}
+
+// GetFiscalYearStartMonth returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization.FiscalYearStartMonth, and is useful for accessing the field via an interface.
+func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) GetFiscalYearStartMonth() float64 {
+	return v.Organization.FiscalYearStartMonth
+}
+
+// GetProjectUpdateReminderFrequencyInWeeks returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization.ProjectUpdateReminderFrequencyInWeeks, and is useful for accessing the field via an interface.
+func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) GetProjectUpdateReminderFrequencyInWeeks() float64 {
+	return v.Organization.ProjectUpdateReminderFrequencyInWeeks
+}
+
+// GetProjectUpdateRemindersDay returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization.ProjectUpdateRemindersDay, and is useful for accessing the field via an interface.
+func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) GetProjectUpdateRemindersDay() Day {
+	return v.Organization.ProjectUpdateRemindersDay
+}
+
+// GetProjectUpdateRemindersHour returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization.ProjectUpdateRemindersHour, and is useful for accessing the field via an interface.
+func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) GetProjectUpdateRemindersHour() float64 {
+	return v.Organization.ProjectUpdateRemindersHour
+}
+
+// GetRoadmapEnabled returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization.RoadmapEnabled, and is useful for accessing the field via an interface.
+func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) GetRoadmapEnabled() bool {
+	return v.Organization.RoadmapEnabled
+}
+
+// GetInitiativeUpdateReminderFrequencyInWeeks returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization.InitiativeUpdateReminderFrequencyInWeeks, and is useful for accessing the field via an interface.
+func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) GetInitiativeUpdateReminderFrequencyInWeeks() float64 {
+	return v.Organization.InitiativeUpdateReminderFrequencyInWeeks
+}
+
+// GetInitiativeUpdateRemindersDay returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization.InitiativeUpdateRemindersDay, and is useful for accessing the field via an interface.
+func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) GetInitiativeUpdateRemindersDay() Day {
+	return v.Organization.InitiativeUpdateRemindersDay
+}
+
+// GetInitiativeUpdateRemindersHour returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization.InitiativeUpdateRemindersHour, and is useful for accessing the field via an interface.
+func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) GetInitiativeUpdateRemindersHour() float64 {
+	return v.Organization.InitiativeUpdateRemindersHour
+}
+
+// GetFeedEnabled returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization.FeedEnabled, and is useful for accessing the field via an interface.
+func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) GetFeedEnabled() bool {
+	return v.Organization.FeedEnabled
+}
+
+// GetDefaultFeedSummarySchedule returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization.DefaultFeedSummarySchedule, and is useful for accessing the field via an interface.
+func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) GetDefaultFeedSummarySchedule() FeedSummarySchedule {
+	return v.Organization.DefaultFeedSummarySchedule
+}
+
+// GetCustomersEnabled returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization.CustomersEnabled, and is useful for accessing the field via an interface.
+func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) GetCustomersEnabled() bool {
+	return v.Organization.CustomersEnabled
+}
+
+func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Organization)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization struct {
+	Id string `json:"id"`
+
+	AllowMembersToInvite bool `json:"allowMembersToInvite"`
+
+	RestrictTeamCreationToAdmins bool `json:"restrictTeamCreationToAdmins"`
+
+	RestrictLabelManagementToAdmins bool `json:"restrictLabelManagementToAdmins"`
+
+	GitLinkbackMessagesEnabled bool `json:"gitLinkbackMessagesEnabled"`
+
+	GitPublicLinkbackMessagesEnabled bool `json:"gitPublicLinkbackMessagesEnabled"`
+
+	FiscalYearStartMonth float64 `json:"fiscalYearStartMonth"`
+
+	ProjectUpdateReminderFrequencyInWeeks float64 `json:"projectUpdateReminderFrequencyInWeeks"`
+
+	ProjectUpdateRemindersDay Day `json:"projectUpdateRemindersDay"`
+
+	ProjectUpdateRemindersHour float64 `json:"projectUpdateRemindersHour"`
+
+	RoadmapEnabled bool `json:"roadmapEnabled"`
+
+	InitiativeUpdateReminderFrequencyInWeeks float64 `json:"initiativeUpdateReminderFrequencyInWeeks"`
+
+	InitiativeUpdateRemindersDay Day `json:"initiativeUpdateRemindersDay"`
+
+	InitiativeUpdateRemindersHour float64 `json:"initiativeUpdateRemindersHour"`
+
+	FeedEnabled bool `json:"feedEnabled"`
+
+	DefaultFeedSummarySchedule FeedSummarySchedule `json:"defaultFeedSummarySchedule"`
+
+	CustomersEnabled bool `json:"customersEnabled"`
+}
+
+func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) __premarshalJSON() (*__premarshalupdateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization, error) {
+	var retval __premarshalupdateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization
+
+	retval.Id = v.Organization.Id
+	retval.AllowMembersToInvite = v.Organization.AllowMembersToInvite
+	retval.RestrictTeamCreationToAdmins = v.Organization.RestrictTeamCreationToAdmins
+	retval.RestrictLabelManagementToAdmins = v.Organization.RestrictLabelManagementToAdmins
+	retval.GitLinkbackMessagesEnabled = v.Organization.GitLinkbackMessagesEnabled
+	retval.GitPublicLinkbackMessagesEnabled = v.Organization.GitPublicLinkbackMessagesEnabled
+	retval.FiscalYearStartMonth = v.Organization.FiscalYearStartMonth
+	retval.ProjectUpdateReminderFrequencyInWeeks = v.Organization.ProjectUpdateReminderFrequencyInWeeks
+	retval.ProjectUpdateRemindersDay = v.Organization.ProjectUpdateRemindersDay
+	retval.ProjectUpdateRemindersHour = v.Organization.ProjectUpdateRemindersHour
+	retval.RoadmapEnabled = v.Organization.RoadmapEnabled
+	retval.InitiativeUpdateReminderFrequencyInWeeks = v.Organization.InitiativeUpdateReminderFrequencyInWeeks
+	retval.InitiativeUpdateRemindersDay = v.Organization.InitiativeUpdateRemindersDay
+	retval.InitiativeUpdateRemindersHour = v.Organization.InitiativeUpdateRemindersHour
+	retval.FeedEnabled = v.Organization.FeedEnabled
+	retval.DefaultFeedSummarySchedule = v.Organization.DefaultFeedSummarySchedule
+	retval.CustomersEnabled = v.Organization.CustomersEnabled
+	return &retval, nil
+}
+
+// updateWorkspaceSettingsResponse is returned by updateWorkspaceSettings on success.
+type updateWorkspaceSettingsResponse struct {
+	// Updates the user's organization.
+	OrganizationUpdate updateWorkspaceSettingsOrganizationUpdateOrganizationPayload `json:"organizationUpdate"`
+}
+
+// GetOrganizationUpdate returns updateWorkspaceSettingsResponse.OrganizationUpdate, and is useful for accessing the field via an interface.
+func (v *updateWorkspaceSettingsResponse) GetOrganizationUpdate() updateWorkspaceSettingsOrganizationUpdateOrganizationPayload {
+	return v.OrganizationUpdate
+}
+
+// verifyOrganizationDomainOrganizationDomainVerifyOrganizationDomainPayload includes the requested fields of the GraphQL type OrganizationDomainPayload.
+// The GraphQL type's documentation follows.
+//
+// [INTERNAL] Organization domain operation response.
+type verifyOrganizationDomainOrganizationDomainVerifyOrganizationDomainPayload struct {
+	// The organization domain that was created or updated.
+	OrganizationDomain verifyOrganizationDomainOrganizationDomainVerifyOrganizationDomainPayloadOrganizationDomain `json:"organizationDomain"`
+}
+
+// GetOrganizationDomain returns verifyOrganizationDomainOrganizationDomainVerifyOrganizationDomainPayload.OrganizationDomain, and is useful for accessing the field via an interface.
+func (v *verifyOrganizationDomainOrganizationDomainVerifyOrganizationDomainPayload) GetOrganizationDomain() verifyOrganizationDomainOrganizationDomainVerifyOrganizationDomainPayloadOrganizationDomain {
+	return v.OrganizationDomain
+}
+
+// verifyOrganizationDomainOrganizationDomainVerifyOrganizationDomainPayloadOrganizationDomain includes the requested fields of the GraphQL type OrganizationDomain.
+// The GraphQL type's documentation follows.
+//
+// Defines the use of a domain by an organization.
+type verifyOrganizationDomainOrganizationDomainVerifyOrganizationDomainPayloadOrganizationDomain struct {
+	OrganizationDomain `json:"-"`
+}
+
+// GetId returns verifyOrganizationDomainOrganizationDomainVerifyOrganizationDomainPayloadOrganizationDomain.Id, and is useful for accessing the field via an interface.
+func (v *verifyOrganizationDomainOrganizationDomainVerifyOrganizationDomainPayloadOrganizationDomain) GetId() string {
+	return v.OrganizationDomain.Id
+}
+
+// GetName returns verifyOrganizationDomainOrganizationDomainVerifyOrganizationDomainPayloadOrganizationDomain.Name, and is useful for accessing the field via an interface.
+func (v *verifyOrganizationDomainOrganizationDomainVerifyOrganizationDomainPayloadOrganizationDomain) GetName() string {
+	return v.OrganizationDomain.Name
+}
+
+// GetVerificationEmail returns verifyOrganizationDomainOrganizationDomainVerifyOrganizationDomainPayloadOrganizationDomain.VerificationEmail, and is useful for accessing the field via an interface.
+func (v *verifyOrganizationDomainOrganizationDomainVerifyOrganizationDomainPayloadOrganizationDomain) GetVerificationEmail() *string {
+	return v.OrganizationDomain.VerificationEmail
+}
+
+// GetAuthType returns verifyOrganizationDomainOrganizationDomainVerifyOrganizationDomainPayloadOrganizationDomain.AuthType, and is useful for accessing the field via an interface.
+func (v *verifyOrganizationDomainOrganizationDomainVerifyOrganizationDomainPayloadOrganizationDomain) GetAuthType() OrganizationDomainAuthType {
+	return v.OrganizationDomain.AuthType
+}
+
+// GetVerified returns verifyOrganizationDomainOrganizationDomainVerifyOrganizationDomainPayloadOrganizationDomain.Verified, and is useful for accessing the field via an interface.
+func (v *verifyOrganizationDomainOrganizationDomainVerifyOrganizationDomainPayloadOrganizationDomain) GetVerified() bool {
+	return v.OrganizationDomain.Verified
+}
+
+// GetClaimed returns verifyOrganizationDomainOrganizationDomainVerifyOrganizationDomainPayloadOrganizationDomain.Claimed, and is useful for accessing the field via an interface.
+func (v *verifyOrganizationDomainOrganizationDomainVerifyOrganizationDomainPayloadOrganizationDomain) GetClaimed() *bool {
+	return v.OrganizationDomain.Claimed
+}
+
+// GetDisableOrganizationCreation returns verifyOrganizationDomainOrganizationDomainVerifyOrganizationDomainPayloadOrganizationDomain.DisableOrganizationCreation, and is useful for accessing the field via an interface.
+func (v *verifyOrganizationDomainOrganizationDomainVerifyOrganizationDomainPayloadOrganizationDomain) GetDisableOrganizationCreation() *bool {
+	return v.OrganizationDomain.DisableOrganizationCreation
+}
+
+func (v *verifyOrganizationDomainOrganizationDomainVerifyOrganizationDomainPayloadOrganizationDomain) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*verifyOrganizationDomainOrganizationDomainVerifyOrganizationDomainPayloadOrganizationDomain
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.verifyOrganizationDomainOrganizationDomainVerifyOrganizationDomainPayloadOrganizationDomain = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.OrganizationDomain)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalverifyOrganizationDomainOrganizationDomainVerifyOrganizationDomainPayloadOrganizationDomain struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	VerificationEmail *string `json:"verificationEmail"`
+
+	AuthType OrganizationDomainAuthType `json:"authType"`
+
+	Verified bool `json:"verified"`
+
+	Claimed *bool `json:"claimed"`
+
+	DisableOrganizationCreation *bool `json:"disableOrganizationCreation"`
+}
+
+func (v *verifyOrganizationDomainOrganizationDomainVerifyOrganizationDomainPayloadOrganizationDomain) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *verifyOrganizationDomainOrganizationDomainVerifyOrganizationDomainPayloadOrganizationDomain) __premarshalJSON() (*__premarshalverifyOrganizationDomainOrganizationDomainVerifyOrganizationDomainPayloadOrganizationDomain, error) {
+	var retval __premarshalverifyOrganizationDomainOrganizationDomainVerifyOrganizationDomainPayloadOrganizationDomain
+
+	retval.Id = v.OrganizationDomain.Id
+	retval.Name = v.OrganizationDomain.Name
+	retval.VerificationEmail = v.OrganizationDomain.VerificationEmail
+	retval.AuthType = v.OrganizationDomain.AuthType
+	retval.Verified = v.OrganizationDomain.Verified
+	retval.Claimed = v.OrganizationDomain.Claimed
+	retval.DisableOrganizationCreation = v.OrganizationDomain.DisableOrganizationCreation
+	return &retval, nil
+}
+
+// verifyOrganizationDomainResponse is returned by verifyOrganizationDomain on success.
+type verifyOrganizationDomainResponse struct {
+	// [INTERNAL] Verifies a domain to be added to an organization.
+	OrganizationDomainVerify verifyOrganizationDomainOrganizationDomainVerifyOrganizationDomainPayload `json:"organizationDomainVerify"`
+}
+
+// GetOrganizationDomainVerify returns verifyOrganizationDomainResponse.OrganizationDomainVerify, and is useful for accessing the field via an interface.
+func (v *verifyOrganizationDomainResponse) GetOrganizationDomainVerify() verifyOrganizationDomainOrganizationDomainVerifyOrganizationDomainPayload {
+	return v.OrganizationDomainVerify
+}
+
+func archiveInitiative(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*archiveInitiativeResponse, error) {
+	req := &graphql.Request{
+		OpName: "archiveInitiative",
+		Query: `
+mutation archiveInitiative ($id: String!) {
+	initiativeArchive(id: $id) {
+		success
+	}
+}
+`,
+		Variables: &__archiveInitiativeInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data archiveInitiativeResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func archiveProject(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*archiveProjectResponse, error) {
+	req := &graphql.Request{
+		OpName: "archiveProject",
+		Query: `
+mutation archiveProject ($id: String!) {
+	projectArchive(id: $id) {
+		success
+	}
+}
+`,
+		Variables: &__archiveProjectInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data archiveProjectResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func claimOrganizationDomain(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*claimOrganizationDomainResponse, error) {
+	req := &graphql.Request{
+		OpName: "claimOrganizationDomain",
+		Query: `
+mutation claimOrganizationDomain ($id: String!) {
+	organizationDomainClaim(id: $id) {
+		success
+	}
+}
+`,
+		Variables: &__claimOrganizationDomainInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data claimOrganizationDomainResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createCustomView(
+	ctx context.Context,
+	client graphql.Client,
+	input CustomViewCreateInput,
+) (*createCustomViewResponse, error) {
+	req := &graphql.Request{
+		OpName: "createCustomView",
+		Query: `
+mutation createCustomView ($input: CustomViewCreateInput!) {
+	customViewCreate(input: $input) {
+		customView {
+			... CustomView
+		}
+	}
+}
+fragment CustomView on CustomView {
+	id
+	name
+	description
+	icon
+	color
+	team {
+		id
+	}
+	shared
+	filterData
+	projectFilterData
+}
+`,
+		Variables: &__createCustomViewInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createCustomViewResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createCycle(
+	ctx context.Context,
+	client graphql.Client,
+	input CycleCreateInput,
+) (*createCycleResponse, error) {
+	req := &graphql.Request{
+		OpName: "createCycle",
+		Query: `
+mutation createCycle ($input: CycleCreateInput!) {
+	cycleCreate(input: $input) {
+		cycle {
+			... Cycle
+		}
+	}
+}
+fragment Cycle on Cycle {
+	id
+	number
+	name
+	description
+	startsAt
+	endsAt
+	team {
+		id
+	}
+	archivedAt
+}
+`,
+		Variables: &__createCycleInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createCycleResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createEmailIntakeAddress(
+	ctx context.Context,
+	client graphql.Client,
+	input EmailIntakeAddressCreateInput,
+) (*createEmailIntakeAddressResponse, error) {
+	req := &graphql.Request{
+		OpName: "createEmailIntakeAddress",
+		Query: `
+mutation createEmailIntakeAddress ($input: EmailIntakeAddressCreateInput!) {
+	emailIntakeAddressCreate(input: $input) {
+		emailIntakeAddress {
+			... EmailIntakeAddress
+		}
+	}
+}
+fragment EmailIntakeAddress on EmailIntakeAddress {
+	id
+	address
+	forwardingEmailAddress
+	senderName
+	enabled
+	repliesEnabled
+	team {
+		id
+	}
+	template {
+		id
+	}
+	archivedAt
+}
+`,
+		Variables: &__createEmailIntakeAddressInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createEmailIntakeAddressResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createEmoji(
+	ctx context.Context,
+	client graphql.Client,
+	input EmojiCreateInput,
+) (*createEmojiResponse, error) {
+	req := &graphql.Request{
+		OpName: "createEmoji",
+		Query: `
+mutation createEmoji ($input: EmojiCreateInput!) {
+	emojiCreate(input: $input) {
+		emoji {
+			... Emoji
+		}
+	}
+}
+fragment Emoji on Emoji {
+	id
+	name
+	url
+	archivedAt
+}
+`,
+		Variables: &__createEmojiInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createEmojiResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createFileUpload(
+	ctx context.Context,
+	client graphql.Client,
+	filename string,
+	contentType string,
+	size int,
+	makePublic bool,
+) (*createFileUploadResponse, error) {
+	req := &graphql.Request{
+		OpName: "createFileUpload",
+		Query: `
+mutation createFileUpload ($filename: String!, $contentType: String!, $size: Int!, $makePublic: Boolean!) {
+	fileUpload(filename: $filename, contentType: $contentType, size: $size, makePublic: $makePublic) {
+		uploadFile {
+			uploadUrl
+			assetUrl
+			headers {
+				key
+				value
+			}
+		}
+	}
+}
+`,
+		Variables: &__createFileUploadInput{
+			Filename:    filename,
+			ContentType: contentType,
+			Size:        size,
+			MakePublic:  makePublic,
+		},
+	}
+	var err error
+
+	var data createFileUploadResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createGitAutomationState(
+	ctx context.Context,
+	client graphql.Client,
+	input GitAutomationStateCreateInput,
+) (*createGitAutomationStateResponse, error) {
+	req := &graphql.Request{
+		OpName: "createGitAutomationState",
+		Query: `
+mutation createGitAutomationState ($input: GitAutomationStateCreateInput!) {
+	gitAutomationStateCreate(input: $input) {
+		success
+	}
+}
+`,
+		Variables: &__createGitAutomationStateInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createGitAutomationStateResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createGitAutomationTargetBranch(
+	ctx context.Context,
+	client graphql.Client,
+	input GitAutomationTargetBranchCreateInput,
+) (*createGitAutomationTargetBranchResponse, error) {
+	req := &graphql.Request{
+		OpName: "createGitAutomationTargetBranch",
+		Query: `
+mutation createGitAutomationTargetBranch ($input: GitAutomationTargetBranchCreateInput!) {
+	gitAutomationTargetBranchCreate(input: $input) {
+		targetBranch {
+			id
+			branchPattern
+			isRegex
+		}
+		success
+	}
+}
+`,
+		Variables: &__createGitAutomationTargetBranchInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createGitAutomationTargetBranchResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createInitiative(
+	ctx context.Context,
+	client graphql.Client,
+	input InitiativeCreateInput,
+) (*createInitiativeResponse, error) {
+	req := &graphql.Request{
+		OpName: "createInitiative",
+		Query: `
+mutation createInitiative ($input: InitiativeCreateInput!) {
+	initiativeCreate(input: $input) {
+		initiative {
+			... Initiative
+		}
+	}
+}
+fragment Initiative on Initiative {
+	id
+	name
+	slugId
+	url
+	description
+	owner {
+		id
+	}
+	color
+	icon
+	status
+	targetDate
+	trashed
+	archivedAt
+}
+`,
+		Variables: &__createInitiativeInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createInitiativeResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createInitiativeRelation(
+	ctx context.Context,
+	client graphql.Client,
+	input InitiativeRelationCreateInput,
+) (*createInitiativeRelationResponse, error) {
+	req := &graphql.Request{
+		OpName: "createInitiativeRelation",
+		Query: `
+mutation createInitiativeRelation ($input: InitiativeRelationCreateInput!) {
+	initiativeRelationCreate(input: $input) {
+		initiativeRelation {
+			... InitiativeRelation
+		}
+	}
+}
+fragment InitiativeRelation on InitiativeRelation {
+	id
+	initiative {
+		id
+	}
+	relatedInitiative {
+		id
+	}
+}
+`,
+		Variables: &__createInitiativeRelationInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createInitiativeRelationResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createInitiativeToProject(
+	ctx context.Context,
+	client graphql.Client,
+	input InitiativeToProjectCreateInput,
+) (*createInitiativeToProjectResponse, error) {
+	req := &graphql.Request{
+		OpName: "createInitiativeToProject",
+		Query: `
+mutation createInitiativeToProject ($input: InitiativeToProjectCreateInput!) {
+	initiativeToProjectCreate(input: $input) {
+		initiativeToProject {
+			... InitiativeToProject
+		}
+	}
+}
+fragment InitiativeToProject on InitiativeToProject {
+	id
+	initiative {
+		id
+	}
+	project {
+		id
+	}
+}
+`,
+		Variables: &__createInitiativeToProjectInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createInitiativeToProjectResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createLabel(
+	ctx context.Context,
+	client graphql.Client,
+	input IssueLabelCreateInput,
+) (*createLabelResponse, error) {
+	req := &graphql.Request{
+		OpName: "createLabel",
+		Query: `
+mutation createLabel ($input: IssueLabelCreateInput!) {
+	issueLabelCreate(input: $input) {
+		issueLabel {
+			... IssueLabel
+		}
+	}
+}
+fragment IssueLabel on IssueLabel {
+	id
+	name
+	description
+	color
+	parent {
+		id
+	}
+	team {
+		id
+	}
+}
+`,
+		Variables: &__createLabelInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createLabelResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createOrganizationDomain(
+	ctx context.Context,
+	client graphql.Client,
+	input OrganizationDomainCreateInput,
+	triggerEmailVerification bool,
+) (*createOrganizationDomainResponse, error) {
+	req := &graphql.Request{
+		OpName: "createOrganizationDomain",
+		Query: `
+mutation createOrganizationDomain ($input: OrganizationDomainCreateInput!, $triggerEmailVerification: Boolean!) {
+	organizationDomainCreate(input: $input, triggerEmailVerification: $triggerEmailVerification) {
+		organizationDomain {
+			... OrganizationDomain
+		}
+	}
+}
+fragment OrganizationDomain on OrganizationDomain {
+	id
+	name
+	verificationEmail
+	authType
+	verified
+	claimed
+	disableOrganizationCreation
+}
+`,
+		Variables: &__createOrganizationDomainInput{
+			Input:                    input,
+			TriggerEmailVerification: triggerEmailVerification,
+		},
+	}
+	var err error
+
+	var data createOrganizationDomainResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createOrganizationInvite(
+	ctx context.Context,
+	client graphql.Client,
+	input OrganizationInviteCreateInput,
+) (*createOrganizationInviteResponse, error) {
+	req := &graphql.Request{
+		OpName: "createOrganizationInvite",
+		Query: `
+mutation createOrganizationInvite ($input: OrganizationInviteCreateInput!) {
+	organizationInviteCreate(input: $input) {
+		organizationInvite {
+			... OrganizationInvite
+		}
+	}
+}
+fragment OrganizationInvite on OrganizationInvite {
+	id
+	email
+	role
+	metadata
+	acceptedAt
+	expiresAt
+}
+`,
+		Variables: &__createOrganizationInviteInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createOrganizationInviteResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createProject(
+	ctx context.Context,
+	client graphql.Client,
+	input ProjectCreateInput,
+) (*createProjectResponse, error) {
+	req := &graphql.Request{
+		OpName: "createProject",
+		Query: `
+mutation createProject ($input: ProjectCreateInput!) {
+	projectCreate(input: $input) {
+		project {
+			... Project
+		}
+	}
+}
+fragment Project on Project {
+	id
+	name
+	slugId
+	url
+	description
+	icon
+	color
+	status {
+		id
+	}
+	lead {
+		id
+	}
+	teams {
+		nodes {
+			id
+		}
+	}
+	priority
+	startDate
+	targetDate
+	labelIds
+	trashed
+	archivedAt
+}
+`,
+		Variables: &__createProjectInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createProjectResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createProjectMilestone(
+	ctx context.Context,
+	client graphql.Client,
+	input ProjectMilestoneCreateInput,
+) (*createProjectMilestoneResponse, error) {
+	req := &graphql.Request{
+		OpName: "createProjectMilestone",
+		Query: `
+mutation createProjectMilestone ($input: ProjectMilestoneCreateInput!) {
+	projectMilestoneCreate(input: $input) {
+		projectMilestone {
+			... ProjectMilestone
+		}
+	}
+}
+fragment ProjectMilestone on ProjectMilestone {
+	id
+	name
+	description
+	targetDate
+	sortOrder
+	project {
+		id
+	}
+}
+`,
+		Variables: &__createProjectMilestoneInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createProjectMilestoneResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createProjectStatus(
+	ctx context.Context,
+	client graphql.Client,
+	input ProjectStatusCreateInput,
+) (*createProjectStatusResponse, error) {
+	req := &graphql.Request{
+		OpName: "createProjectStatus",
+		Query: `
+mutation createProjectStatus ($input: ProjectStatusCreateInput!) {
+	projectStatusCreate(input: $input) {
+		status {
+			... ProjectStatus
+		}
+	}
+}
+fragment ProjectStatus on ProjectStatus {
+	id
+	name
+	color
+	description
+	type
+	position
+	indefinite
+	archivedAt
+}
+`,
+		Variables: &__createProjectStatusInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createProjectStatusResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createTeam(
+	ctx context.Context,
+	client graphql.Client,
+	input TeamCreateInput,
+) (*createTeamResponse, error) {
+	req := &graphql.Request{
+		OpName: "createTeam",
+		Query: `
+mutation createTeam ($input: TeamCreateInput!) {
+	teamCreate(input: $input) {
+		team {
+			... Team
+		}
+	}
+}
+fragment Team on Team {
+	id
+	name
+	key
+	private
+	description
+	icon
+	color
+	parent {
+		id
+	}
+	timezone
+	groupIssueHistory
+	setIssueSortOrderOnStateChange
+	aiThreadSummariesEnabled
+	autoArchivePeriod
+	autoClosePeriod
+	autoCloseParentIssues
+	autoCloseChildIssues
+	triageEnabled
+	requirePriorityToLeaveTriage
+	cyclesEnabled
+	cycleStartDay
+	cycleDuration
+	cycleCooldownTime
+	upcomingCycleCount
+	cycleIssueAutoAssignStarted
+	cycleIssueAutoAssignCompleted
+	cycleLockToActive
+	issueEstimationType
+	issueEstimationAllowZero
+	issueEstimationExtended
+	defaultIssueEstimate
+}
+`,
+		Variables: &__createTeamInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createTeamResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createTeamMembership(
+	ctx context.Context,
+	client graphql.Client,
+	input TeamMembershipCreateInput,
+) (*createTeamMembershipResponse, error) {
+	req := &graphql.Request{
+		OpName: "createTeamMembership",
+		Query: `
+mutation createTeamMembership ($input: TeamMembershipCreateInput!) {
+	teamMembershipCreate(input: $input) {
+		teamMembership {
+			... TeamMembership
+		}
+	}
+}
+fragment TeamMembership on TeamMembership {
+	id
+	owner
+	sortOrder
+	team {
+		id
+	}
+	user {
+		id
+		email
+	}
+	archivedAt
+}
+`,
+		Variables: &__createTeamMembershipInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createTeamMembershipResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createTimeSchedule(
+	ctx context.Context,
+	client graphql.Client,
+	input TimeScheduleCreateInput,
+) (*createTimeScheduleResponse, error) {
+	req := &graphql.Request{
+		OpName: "createTimeSchedule",
+		Query: `
+mutation createTimeSchedule ($input: TimeScheduleCreateInput!) {
+	timeScheduleCreate(input: $input) {
+		timeSchedule {
+			... TimeSchedule
+		}
+	}
+}
+fragment TimeSchedule on TimeSchedule {
+	id
+	name
+	entries {
+		userId
+		startsAt
+		endsAt
+	}
+	archivedAt
+}
+`,
+		Variables: &__createTimeScheduleInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createTimeScheduleResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createTriageResponsibility(
+	ctx context.Context,
+	client graphql.Client,
+	input TriageResponsibilityCreateInput,
+) (*createTriageResponsibilityResponse, error) {
+	req := &graphql.Request{
+		OpName: "createTriageResponsibility",
+		Query: `
+mutation createTriageResponsibility ($input: TriageResponsibilityCreateInput!) {
+	triageResponsibilityCreate(input: $input) {
+		triageResponsibility {
+			... TriageResponsibility
+		}
+	}
+}
+fragment TriageResponsibility on TriageResponsibility {
+	id
+	action
+	manualSelection {
+		userIds
+	}
+	timeSchedule {
+		id
+	}
+	team {
+		id
+	}
+	archivedAt
+}
+`,
+		Variables: &__createTriageResponsibilityInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createTriageResponsibilityResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createWebhook(
+	ctx context.Context,
+	client graphql.Client,
+	input WebhookCreateInput,
+) (*createWebhookResponse, error) {
+	req := &graphql.Request{
+		OpName: "createWebhook",
+		Query: `
+mutation createWebhook ($input: WebhookCreateInput!) {
+	webhookCreate(input: $input) {
+		webhook {
+			... Webhook
+		}
+	}
+}
+fragment Webhook on Webhook {
+	id
+	label
+	url
+	enabled
+	team {
+		id
+	}
+	allPublicTeams
+	resourceTypes
+}
+`,
+		Variables: &__createWebhookInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createWebhookResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createWorkflowState(
+	ctx context.Context,
+	client graphql.Client,
+	input WorkflowStateCreateInput,
+) (*createWorkflowStateResponse, error) {
+	req := &graphql.Request{
+		OpName: "createWorkflowState",
+		Query: `
+mutation createWorkflowState ($input: WorkflowStateCreateInput!) {
+	workflowStateCreate(input: $input) {
+		workflowState {
+			... WorkflowState
+		}
+	}
+}
+fragment WorkflowState on WorkflowState {
+	id
+	name
+	color
+	description
+	type
+	position
+	team {
+		id
+	}
+}
+`,
+		Variables: &__createWorkflowStateInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createWorkflowStateResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteCustomView(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteCustomViewResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteCustomView",
+		Query: `
+mutation deleteCustomView ($id: String!) {
+	customViewDelete(id: $id) {
+		success
+	}
+}
+`,
+		Variables: &__deleteCustomViewInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteCustomViewResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteCycle(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteCycleResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteCycle",
+		Query: `
+mutation deleteCycle ($id: String!) {
+	cycleArchive(id: $id) {
+		success
+	}
+}
+`,
+		Variables: &__deleteCycleInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteCycleResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteEmailIntakeAddress(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteEmailIntakeAddressResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteEmailIntakeAddress",
+		Query: `
+mutation deleteEmailIntakeAddress ($id: String!) {
+	emailIntakeAddressDelete(id: $id) {
+		success
+	}
+}
+`,
+		Variables: &__deleteEmailIntakeAddressInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteEmailIntakeAddressResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteEmoji(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteEmojiResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteEmoji",
+		Query: `
+mutation deleteEmoji ($id: String!) {
+	emojiDelete(id: $id) {
+		success
+	}
+}
+`,
+		Variables: &__deleteEmojiInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteEmojiResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteGitAutomationState(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteGitAutomationStateResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteGitAutomationState",
+		Query: `
+mutation deleteGitAutomationState ($id: String!) {
+	gitAutomationStateDelete(id: $id) {
+		success
+	}
+}
+`,
+		Variables: &__deleteGitAutomationStateInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteGitAutomationStateResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteGitAutomationTargetBranch(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteGitAutomationTargetBranchResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteGitAutomationTargetBranch",
+		Query: `
+mutation deleteGitAutomationTargetBranch ($id: String!) {
+	gitAutomationTargetBranchDelete(id: $id) {
+		success
+	}
+}
+`,
+		Variables: &__deleteGitAutomationTargetBranchInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteGitAutomationTargetBranchResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteInitiative(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteInitiativeResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteInitiative",
+		Query: `
+mutation deleteInitiative ($id: String!) {
+	initiativeDelete(id: $id) {
+		success
+	}
+}
+`,
+		Variables: &__deleteInitiativeInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteInitiativeResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteInitiativeRelation(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteInitiativeRelationResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteInitiativeRelation",
+		Query: `
+mutation deleteInitiativeRelation ($id: String!) {
+	initiativeRelationDelete(id: $id) {
+		success
+	}
+}
+`,
+		Variables: &__deleteInitiativeRelationInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteInitiativeRelationResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteInitiativeToProject(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteInitiativeToProjectResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteInitiativeToProject",
+		Query: `
+mutation deleteInitiativeToProject ($id: String!) {
+	initiativeToProjectDelete(id: $id) {
+		success
+	}
+}
+`,
+		Variables: &__deleteInitiativeToProjectInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteInitiativeToProjectResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteLabel(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteLabelResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteLabel",
+		Query: `
+mutation deleteLabel ($id: String!) {
+	issueLabelDelete(id: $id) {
+		success
+	}
+}
+`,
+		Variables: &__deleteLabelInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteLabelResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteOrganizationDomain(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteOrganizationDomainResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteOrganizationDomain",
+		Query: `
+mutation deleteOrganizationDomain ($id: String!) {
+	organizationDomainDelete(id: $id) {
+		success
+	}
+}
+`,
+		Variables: &__deleteOrganizationDomainInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteOrganizationDomainResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteOrganizationInvite(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteOrganizationInviteResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteOrganizationInvite",
+		Query: `
+mutation deleteOrganizationInvite ($id: String!) {
+	organizationInviteDelete(id: $id) {
+		success
+	}
+}
+`,
+		Variables: &__deleteOrganizationInviteInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteOrganizationInviteResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteProject(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteProjectResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteProject",
+		Query: `
+mutation deleteProject ($id: String!) {
+	projectDelete(id: $id) {
+		success
+	}
+}
+`,
+		Variables: &__deleteProjectInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteProjectResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteProjectMilestone(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteProjectMilestoneResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteProjectMilestone",
+		Query: `
+mutation deleteProjectMilestone ($id: String!) {
+	projectMilestoneDelete(id: $id) {
+		success
+	}
+}
+`,
+		Variables: &__deleteProjectMilestoneInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteProjectMilestoneResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteProjectStatus(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteProjectStatusResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteProjectStatus",
+		Query: `
+mutation deleteProjectStatus ($id: String!) {
+	projectStatusArchive(id: $id) {
+		success
+	}
+}
+`,
+		Variables: &__deleteProjectStatusInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteProjectStatusResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteTeam(
+	ctx context.Context,
+	client graphql.Client,
+	key string,
+) (*deleteTeamResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteTeam",
+		Query: `
+mutation deleteTeam ($key: String!) {
+	teamDelete(id: $key) {
+		success
+	}
+}
+`,
+		Variables: &__deleteTeamInput{
+			Key: key,
+		},
+	}
+	var err error
+
+	var data deleteTeamResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteTeamMembership(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteTeamMembershipResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteTeamMembership",
+		Query: `
+mutation deleteTeamMembership ($id: String!) {
+	teamMembershipDelete(id: $id) {
+		success
+	}
+}
+`,
+		Variables: &__deleteTeamMembershipInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteTeamMembershipResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteTimeSchedule(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteTimeScheduleResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteTimeSchedule",
+		Query: `
+mutation deleteTimeSchedule ($id: String!) {
+	timeScheduleDelete(id: $id) {
+		success
+	}
+}
+`,
+		Variables: &__deleteTimeScheduleInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteTimeScheduleResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteTriageResponsibility(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteTriageResponsibilityResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteTriageResponsibility",
+		Query: `
+mutation deleteTriageResponsibility ($id: String!) {
+	triageResponsibilityDelete(id: $id) {
+		success
+	}
+}
+`,
+		Variables: &__deleteTriageResponsibilityInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteTriageResponsibilityResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteWebhook(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteWebhookResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteWebhook",
+		Query: `
+mutation deleteWebhook ($id: String!) {
+	webhookDelete(id: $id) {
+		success
+	}
+}
+`,
+		Variables: &__deleteWebhookInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteWebhookResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteWorkflowState(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteWorkflowStateResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteWorkflowState",
+		Query: `
+mutation deleteWorkflowState ($id: String!) {
+	workflowStateArchive(id: $id) {
+		success
+	}
+}
+`,
+		Variables: &__deleteWorkflowStateInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteWorkflowStateResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func findCycle(
+	ctx context.Context,
+	client graphql.Client,
+	number float64,
+	key string,
+) (*findCycleResponse, error) {
+	req := &graphql.Request{
+		OpName: "findCycle",
+		Query: `
+query findCycle ($number: Float!, $key: String!) {
+	cycles(filter: {number:{eq:$number},team:{key:{eq:$key}}}) {
+		nodes {
+			id
+		}
+	}
+}
+`,
+		Variables: &__findCycleInput{
+			Number: number,
+			Key:    key,
+		},
+	}
+	var err error
+
+	var data findCycleResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func findProjectMilestone(
+	ctx context.Context,
+	client graphql.Client,
+	name string,
+	projectId string,
+) (*findProjectMilestoneResponse, error) {
+	req := &graphql.Request{
+		OpName: "findProjectMilestone",
+		Query: `
+query findProjectMilestone ($name: String!, $projectId: String!) {
+	project(id: $projectId) {
+		projectMilestones(filter: {name:{eq:$name}}) {
+			nodes {
+				id
+			}
+		}
+	}
+}
+`,
+		Variables: &__findProjectMilestoneInput{
+			Name:      name,
+			ProjectId: projectId,
+		},
+	}
+	var err error
+
+	var data findProjectMilestoneResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func findTeamLabel(
+	ctx context.Context,
+	client graphql.Client,
+	name string,
+	key string,
+) (*findTeamLabelResponse, error) {
+	req := &graphql.Request{
+		OpName: "findTeamLabel",
+		Query: `
+query findTeamLabel ($name: String!, $key: String!) {
+	issueLabels(filter: {name:{eq:$name},team:{key:{eq:$key}}}) {
+		nodes {
+			id
+		}
+	}
+}
+`,
+		Variables: &__findTeamLabelInput{
+			Name: name,
+			Key:  key,
+		},
+	}
+	var err error
+
+	var data findTeamLabelResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func findTriageResponsibility(
+	ctx context.Context,
+	client graphql.Client,
+	key string,
+) (*findTriageResponsibilityResponse, error) {
+	req := &graphql.Request{
+		OpName: "findTriageResponsibility",
+		Query: `
+query findTriageResponsibility ($key: String!) {
+	team(id: $key) {
+		triageResponsibility {
+			id
+		}
+	}
+}
+`,
+		Variables: &__findTriageResponsibilityInput{
+			Key: key,
+		},
+	}
+	var err error
+
+	var data findTriageResponsibilityResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func findWorkflowState(
+	ctx context.Context,
+	client graphql.Client,
+	name string,
+	key string,
+) (*findWorkflowStateResponse, error) {
+	req := &graphql.Request{
+		OpName: "findWorkflowState",
+		Query: `
+query findWorkflowState ($name: String!, $key: String!) {
+	workflowStates(filter: {name:{eq:$name},team:{key:{eq:$key}}}) {
+		nodes {
+			id
+		}
+	}
+}
+`,
+		Variables: &__findWorkflowStateInput{
+			Name: name,
+			Key:  key,
+		},
+	}
+	var err error
+
+	var data findWorkflowStateResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func findWorkspaceLabel(
+	ctx context.Context,
+	client graphql.Client,
+	name string,
+) (*findWorkspaceLabelResponse, error) {
+	req := &graphql.Request{
+		OpName: "findWorkspaceLabel",
+		Query: `
+query findWorkspaceLabel ($name: String!) {
+	issueLabels(filter: {name:{eq:$name}}) {
+		nodes {
+			id
+			team {
+				id
+			}
+		}
+	}
+}
+`,
+		Variables: &__findWorkspaceLabelInput{
+			Name: name,
+		},
+	}
+	var err error
+
+	var data findWorkspaceLabelResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getCustomView(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getCustomViewResponse, error) {
+	req := &graphql.Request{
+		OpName: "getCustomView",
+		Query: `
+query getCustomView ($id: String!) {
+	customView(id: $id) {
+		... CustomView
+	}
+}
+fragment CustomView on CustomView {
+	id
+	name
+	description
+	icon
+	color
+	team {
+		id
+	}
+	shared
+	filterData
+	projectFilterData
+}
+`,
+		Variables: &__getCustomViewInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getCustomViewResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getCycle(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getCycleResponse, error) {
+	req := &graphql.Request{
+		OpName: "getCycle",
+		Query: `
+query getCycle ($id: String!) {
+	cycle(id: $id) {
+		... Cycle
+	}
+}
+fragment Cycle on Cycle {
+	id
+	number
+	name
+	description
+	startsAt
+	endsAt
+	team {
+		id
+	}
+	archivedAt
+}
+`,
+		Variables: &__getCycleInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getCycleResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getEmailIntakeAddress(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getEmailIntakeAddressResponse, error) {
+	req := &graphql.Request{
+		OpName: "getEmailIntakeAddress",
+		Query: `
+query getEmailIntakeAddress ($id: String!) {
+	emailIntakeAddress(id: $id) {
+		... EmailIntakeAddress
+	}
+}
+fragment EmailIntakeAddress on EmailIntakeAddress {
+	id
+	address
+	forwardingEmailAddress
+	senderName
+	enabled
+	repliesEnabled
+	team {
+		id
+	}
+	template {
+		id
+	}
+	archivedAt
+}
+`,
+		Variables: &__getEmailIntakeAddressInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getEmailIntakeAddressResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getEmoji(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getEmojiResponse, error) {
+	req := &graphql.Request{
+		OpName: "getEmoji",
+		Query: `
+query getEmoji ($id: String!) {
+	emoji(id: $id) {
+		... Emoji
+	}
+}
+fragment Emoji on Emoji {
+	id
+	name
+	url
+	archivedAt
+}
+`,
+		Variables: &__getEmojiInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getEmojiResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getInitiative(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getInitiativeResponse, error) {
+	req := &graphql.Request{
+		OpName: "getInitiative",
+		Query: `
+query getInitiative ($id: String!) {
+	initiative(id: $id) {
+		... Initiative
+	}
+}
+fragment Initiative on Initiative {
+	id
+	name
+	slugId
+	url
+	description
+	owner {
+		id
+	}
+	color
+	icon
+	status
+	targetDate
+	trashed
+	archivedAt
+}
+`,
+		Variables: &__getInitiativeInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getInitiativeResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getInitiativeRelations(
+	ctx context.Context,
+	client graphql.Client,
+	after string,
+) (*getInitiativeRelationsResponse, error) {
+	req := &graphql.Request{
+		OpName: "getInitiativeRelations",
+		Query: `
+query getInitiativeRelations ($after: String) {
+	initiativeRelations(first: 250, after: $after) {
+		nodes {
+			... InitiativeRelation
+		}
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+	}
+}
+fragment InitiativeRelation on InitiativeRelation {
+	id
+	initiative {
+		id
+	}
+	relatedInitiative {
+		id
+	}
+}
+`,
+		Variables: &__getInitiativeRelationsInput{
+			After: after,
+		},
+	}
+	var err error
+
+	var data getInitiativeRelationsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getInitiativeToProject(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getInitiativeToProjectResponse, error) {
+	req := &graphql.Request{
+		OpName: "getInitiativeToProject",
+		Query: `
+query getInitiativeToProject ($id: String!) {
+	initiativeToProject(id: $id) {
+		... InitiativeToProject
+	}
+}
+fragment InitiativeToProject on InitiativeToProject {
+	id
+	initiative {
+		id
+	}
+	project {
+		id
+	}
+}
+`,
+		Variables: &__getInitiativeToProjectInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getInitiativeToProjectResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getInitiativeToProjects(
+	ctx context.Context,
+	client graphql.Client,
+) (*getInitiativeToProjectsResponse, error) {
+	req := &graphql.Request{
+		OpName: "getInitiativeToProjects",
+		Query: `
+query getInitiativeToProjects {
+	initiativeToProjects(first: 250) {
+		nodes {
+			... InitiativeToProject
+		}
+	}
+}
+fragment InitiativeToProject on InitiativeToProject {
+	id
+	initiative {
+		id
+	}
+	project {
+		id
+	}
+}
+`,
+	}
+	var err error
+
+	var data getInitiativeToProjectsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getLabel(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getLabelResponse, error) {
+	req := &graphql.Request{
+		OpName: "getLabel",
+		Query: `
+query getLabel ($id: String!) {
+	issueLabel(id: $id) {
+		... IssueLabel
+	}
+}
+fragment IssueLabel on IssueLabel {
+	id
+	name
+	description
+	color
+	parent {
+		id
+	}
+	team {
+		id
+	}
+}
+`,
+		Variables: &__getLabelInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getLabelResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getOrganizationDomainClaimRequest(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getOrganizationDomainClaimRequestResponse, error) {
+	req := &graphql.Request{
+		OpName: "getOrganizationDomainClaimRequest",
+		Query: `
+query getOrganizationDomainClaimRequest ($id: String!) {
+	organizationDomainClaimRequest(id: $id) {
+		verificationString
+	}
+}
+`,
+		Variables: &__getOrganizationDomainClaimRequestInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getOrganizationDomainClaimRequestResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getOrganizationInvite(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getOrganizationInviteResponse, error) {
+	req := &graphql.Request{
+		OpName: "getOrganizationInvite",
+		Query: `
+query getOrganizationInvite ($id: String!) {
+	organizationInvite(id: $id) {
+		... OrganizationInvite
+	}
+}
+fragment OrganizationInvite on OrganizationInvite {
+	id
+	email
+	role
+	metadata
+	acceptedAt
+	expiresAt
+}
+`,
+		Variables: &__getOrganizationInviteInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getOrganizationInviteResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getProject(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getProjectResponse, error) {
+	req := &graphql.Request{
+		OpName: "getProject",
+		Query: `
+query getProject ($id: String!) {
+	project(id: $id) {
+		... Project
+	}
+}
+fragment Project on Project {
+	id
+	name
+	slugId
+	url
+	description
+	icon
+	color
+	status {
+		id
+	}
+	lead {
+		id
+	}
+	teams {
+		nodes {
+			id
+		}
+	}
+	priority
+	startDate
+	targetDate
+	labelIds
+	trashed
+	archivedAt
+}
+`,
+		Variables: &__getProjectInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getProjectResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getProjectMilestone(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getProjectMilestoneResponse, error) {
+	req := &graphql.Request{
+		OpName: "getProjectMilestone",
+		Query: `
+query getProjectMilestone ($id: String!) {
+	projectMilestone(id: $id) {
+		... ProjectMilestone
+	}
+}
+fragment ProjectMilestone on ProjectMilestone {
+	id
+	name
+	description
+	targetDate
+	sortOrder
+	project {
+		id
+	}
+}
+`,
+		Variables: &__getProjectMilestoneInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getProjectMilestoneResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getProjectStatus(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getProjectStatusResponse, error) {
+	req := &graphql.Request{
+		OpName: "getProjectStatus",
+		Query: `
+query getProjectStatus ($id: String!) {
+	projectStatus(id: $id) {
+		... ProjectStatus
+	}
+}
+fragment ProjectStatus on ProjectStatus {
+	id
+	name
+	color
+	description
+	type
+	position
+	indefinite
+	archivedAt
+}
+`,
+		Variables: &__getProjectStatusInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getProjectStatusResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getProjectStatuses(
+	ctx context.Context,
+	client graphql.Client,
+) (*getProjectStatusesResponse, error) {
+	req := &graphql.Request{
+		OpName: "getProjectStatuses",
+		Query: `
+query getProjectStatuses {
+	projectStatuses(first: 250) {
+		nodes {
+			id
+			name
+		}
+	}
+}
+`,
+	}
+	var err error
+
+	var data getProjectStatusesResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getTeam(
+	ctx context.Context,
+	client graphql.Client,
+	key string,
+) (*getTeamResponse, error) {
+	req := &graphql.Request{
+		OpName: "getTeam",
+		Query: `
+query getTeam ($key: String!) {
+	team(id: $key) {
+		... Team
+	}
+}
+fragment Team on Team {
+	id
+	name
+	key
+	private
+	description
+	icon
+	color
+	parent {
+		id
+	}
+	timezone
+	groupIssueHistory
+	setIssueSortOrderOnStateChange
+	aiThreadSummariesEnabled
+	autoArchivePeriod
+	autoClosePeriod
+	autoCloseParentIssues
+	autoCloseChildIssues
+	triageEnabled
+	requirePriorityToLeaveTriage
+	cyclesEnabled
+	cycleStartDay
+	cycleDuration
+	cycleCooldownTime
+	upcomingCycleCount
+	cycleIssueAutoAssignStarted
+	cycleIssueAutoAssignCompleted
+	cycleLockToActive
+	issueEstimationType
+	issueEstimationAllowZero
+	issueEstimationExtended
+	defaultIssueEstimate
+}
+`,
+		Variables: &__getTeamInput{
+			Key: key,
+		},
+	}
+	var err error
+
+	var data getTeamResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getTeamCycles(
+	ctx context.Context,
+	client graphql.Client,
+	teamId string,
+	after string,
+) (*getTeamCyclesResponse, error) {
+	req := &graphql.Request{
+		OpName: "getTeamCycles",
+		Query: `
+query getTeamCycles ($teamId: ID!, $after: String) {
+	cycles(first: 250, after: $after, filter: {team:{id:{eq:$teamId}}}) {
+		nodes {
+			... Cycle
+		}
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+	}
+}
+fragment Cycle on Cycle {
+	id
+	number
+	name
+	description
+	startsAt
+	endsAt
+	team {
+		id
+	}
+	archivedAt
+}
+`,
+		Variables: &__getTeamCyclesInput{
+			TeamId: teamId,
+			After:  after,
+		},
+	}
+	var err error
+
+	var data getTeamCyclesResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getTeamLabels(
+	ctx context.Context,
+	client graphql.Client,
+	teamId string,
+) (*getTeamLabelsResponse, error) {
+	req := &graphql.Request{
+		OpName: "getTeamLabels",
+		Query: `
+query getTeamLabels ($teamId: ID!) {
+	issueLabels(first: 250, filter: {team:{id:{eq:$teamId}}}) {
+		nodes {
+			... IssueLabel
+		}
+	}
+}
+fragment IssueLabel on IssueLabel {
+	id
+	name
+	description
+	color
+	parent {
+		id
+	}
+	team {
+		id
+	}
+}
+`,
+		Variables: &__getTeamLabelsInput{
+			TeamId: teamId,
+		},
+	}
+	var err error
+
+	var data getTeamLabelsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getTeamMembership(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getTeamMembershipResponse, error) {
+	req := &graphql.Request{
+		OpName: "getTeamMembership",
+		Query: `
+query getTeamMembership ($id: String!) {
+	teamMembership(id: $id) {
+		... TeamMembership
+	}
+}
+fragment TeamMembership on TeamMembership {
+	id
+	owner
+	sortOrder
+	team {
+		id
+	}
+	user {
+		id
+		email
+	}
+	archivedAt
+}
+`,
+		Variables: &__getTeamMembershipInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getTeamMembershipResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getTeamMemberships(
+	ctx context.Context,
+	client graphql.Client,
+	key string,
+	after string,
+) (*getTeamMembershipsResponse, error) {
+	req := &graphql.Request{
+		OpName: "getTeamMemberships",
+		Query: `
+query getTeamMemberships ($key: String!, $after: String) {
+	team(id: $key) {
+		id
+		memberships(first: 250, after: $after) {
+			nodes {
+				... TeamMembership
+			}
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+		}
+	}
+}
+fragment TeamMembership on TeamMembership {
+	id
+	owner
+	sortOrder
+	team {
+		id
+	}
+	user {
+		id
+		email
+	}
+	archivedAt
+}
+`,
+		Variables: &__getTeamMembershipsInput{
+			Key:   key,
+			After: after,
+		},
+	}
+	var err error
+
+	var data getTeamMembershipsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getTeamWorkflow(
+	ctx context.Context,
+	client graphql.Client,
+	key string,
+) (*getTeamWorkflowResponse, error) {
+	req := &graphql.Request{
+		OpName: "getTeamWorkflow",
+		Query: `
+query getTeamWorkflow ($key: String!) {
+	team(id: $key) {
+		... TeamWorkflow
+	}
+}
+fragment TeamWorkflow on Team {
+	id
+	key
+	gitAutomationStates {
+		nodes {
+			id
+			state {
+				id
+			}
+			event
+			targetBranch {
+				id
+				branchPattern
+				isRegex
+			}
+		}
+	}
+}
+`,
+		Variables: &__getTeamWorkflowInput{
+			Key: key,
+		},
+	}
+	var err error
+
+	var data getTeamWorkflowResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getTeamWorkflowStates(
+	ctx context.Context,
+	client graphql.Client,
+	teamId string,
+) (*getTeamWorkflowStatesResponse, error) {
+	req := &graphql.Request{
+		OpName: "getTeamWorkflowStates",
+		Query: `
+query getTeamWorkflowStates ($teamId: ID!) {
+	workflowStates(filter: {team:{id:{eq:$teamId}}}) {
+		nodes {
+			... WorkflowState
+		}
+	}
+}
+fragment WorkflowState on WorkflowState {
+	id
+	name
+	color
+	description
+	type
+	position
+	team {
+		id
+	}
+}
+`,
+		Variables: &__getTeamWorkflowStatesInput{
+			TeamId: teamId,
+		},
+	}
+	var err error
+
+	var data getTeamWorkflowStatesResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getTemplate(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getTemplateResponse, error) {
+	req := &graphql.Request{
+		OpName: "getTemplate",
+		Query: `
+query getTemplate ($id: String!) {
+	template(id: $id) {
+		... Template
+	}
+}
+fragment Template on Template {
+	id
+	name
+	description
+	type
+	team {
+		id
+	}
+	templateData
+}
+`,
+		Variables: &__getTemplateInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getTemplateResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getTimeSchedule(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getTimeScheduleResponse, error) {
+	req := &graphql.Request{
+		OpName: "getTimeSchedule",
+		Query: `
+query getTimeSchedule ($id: String!) {
+	timeSchedule(id: $id) {
+		... TimeSchedule
+	}
+}
+fragment TimeSchedule on TimeSchedule {
+	id
+	name
+	entries {
+		userId
+		startsAt
+		endsAt
+	}
+	archivedAt
+}
+`,
+		Variables: &__getTimeScheduleInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getTimeScheduleResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getTriageResponsibility(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getTriageResponsibilityResponse, error) {
+	req := &graphql.Request{
+		OpName: "getTriageResponsibility",
+		Query: `
+query getTriageResponsibility ($id: String!) {
+	triageResponsibility(id: $id) {
+		... TriageResponsibility
+	}
+}
+fragment TriageResponsibility on TriageResponsibility {
+	id
+	action
+	manualSelection {
+		userIds
+	}
+	timeSchedule {
+		id
+	}
+	team {
+		id
+	}
+	archivedAt
+}
+`,
+		Variables: &__getTriageResponsibilityInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getTriageResponsibilityResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getWebhook(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getWebhookResponse, error) {
+	req := &graphql.Request{
+		OpName: "getWebhook",
+		Query: `
+query getWebhook ($id: String!) {
+	webhook(id: $id) {
+		... Webhook
+	}
+}
+fragment Webhook on Webhook {
+	id
+	label
+	url
+	enabled
+	team {
+		id
+	}
+	allPublicTeams
+	resourceTypes
+}
+`,
+		Variables: &__getWebhookInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getWebhookResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getWorkflowState(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getWorkflowStateResponse, error) {
+	req := &graphql.Request{
+		OpName: "getWorkflowState",
+		Query: `
+query getWorkflowState ($id: String!) {
+	workflowState(id: $id) {
+		... WorkflowState
+	}
+}
+fragment WorkflowState on WorkflowState {
+	id
+	name
+	color
+	description
+	type
+	position
+	team {
+		id
 	}
 }
 `,
-		Variables: &__createGitAutomationStateInput{
-			Input: input,
+		Variables: &__getWorkflowStateInput{
+			Id: id,
 		},
 	}
 	var err error
 
-	var data createGitAutomationStateResponse
+	var data getWorkflowStateResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4627,32 +18244,25 @@ mutation createGitAutomationState ($input: GitAutomationStateCreateInput!) {
 	return &data, err
 }
 
-func createGitAutomationTargetBranch(
+func getWorkspace(
 	ctx context.Context,
 	client graphql.Client,
-	input GitAutomationTargetBranchCreateInput,
-) (*createGitAutomationTargetBranchResponse, error) {
+) (*getWorkspaceResponse, error) {
 	req := &graphql.Request{
-		OpName: "createGitAutomationTargetBranch",
+		OpName: "getWorkspace",
 		Query: `
-mutation createGitAutomationTargetBranch ($input: GitAutomationTargetBranchCreateInput!) {
-	gitAutomationTargetBranchCreate(input: $input) {
-		targetBranch {
-			id
-			branchPattern
-			isRegex
-		}
-		success
+query getWorkspace {
+	organization {
+		id
+		urlKey
+		name
 	}
 }
 `,
-		Variables: &__createGitAutomationTargetBranchInput{
-			Input: input,
-		},
 	}
 	var err error
 
-	var data createGitAutomationTargetBranchResponse
+	var data getWorkspaceResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4664,17 +18274,16 @@ mutation createGitAutomationTargetBranch ($input: GitAutomationTargetBranchCreat
 	return &data, err
 }
 
-func createLabel(
+func getWorkspaceLabels(
 	ctx context.Context,
 	client graphql.Client,
-	input IssueLabelCreateInput,
-) (*createLabelResponse, error) {
+) (*getWorkspaceLabelsResponse, error) {
 	req := &graphql.Request{
-		OpName: "createLabel",
+		OpName: "getWorkspaceLabels",
 		Query: `
-mutation createLabel ($input: IssueLabelCreateInput!) {
-	issueLabelCreate(input: $input) {
-		issueLabel {
+query getWorkspaceLabels {
+	issueLabels(first: 250, filter: {team:{null:true}}) {
+		nodes {
 			... IssueLabel
 		}
 	}
@@ -4692,13 +18301,102 @@ fragment IssueLabel on IssueLabel {
 	}
 }
 `,
-		Variables: &__createLabelInput{
-			Input: input,
+	}
+	var err error
+
+	var data getWorkspaceLabelsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getWorkspaceSettings(
+	ctx context.Context,
+	client graphql.Client,
+) (*getWorkspaceSettingsResponse, error) {
+	req := &graphql.Request{
+		OpName: "getWorkspaceSettings",
+		Query: `
+query getWorkspaceSettings {
+	organization {
+		... Organization
+	}
+}
+fragment Organization on Organization {
+	id
+	allowMembersToInvite
+	restrictTeamCreationToAdmins
+	restrictLabelManagementToAdmins
+	gitLinkbackMessagesEnabled
+	gitPublicLinkbackMessagesEnabled
+	fiscalYearStartMonth
+	projectUpdateReminderFrequencyInWeeks
+	projectUpdateRemindersDay
+	projectUpdateRemindersHour
+	roadmapEnabled
+	initiativeUpdateReminderFrequencyInWeeks
+	initiativeUpdateRemindersDay
+	initiativeUpdateRemindersHour
+	feedEnabled
+	defaultFeedSummarySchedule
+	customersEnabled
+}
+`,
+	}
+	var err error
+
+	var data getWorkspaceSettingsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// Linear has no query for a single domain, so it is read back through an update
+// that changes nothing
+func refreshOrganizationDomain(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*refreshOrganizationDomainResponse, error) {
+	req := &graphql.Request{
+		OpName: "refreshOrganizationDomain",
+		Query: `
+mutation refreshOrganizationDomain ($id: String!) {
+	organizationDomainUpdate(input: {}, id: $id) {
+		organizationDomain {
+			... OrganizationDomain
+		}
+	}
+}
+fragment OrganizationDomain on OrganizationDomain {
+	id
+	name
+	verificationEmail
+	authType
+	verified
+	claimed
+	disableOrganizationCreation
+}
+`,
+		Variables: &__refreshOrganizationDomainInput{
+			Id: id,
 		},
 	}
 	var err error
 
-	var data createLabelResponse
+	var data refreshOrganizationDomainResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4710,16 +18408,16 @@ fragment IssueLabel on IssueLabel {
 	return &data, err
 }
 
-func createTeam(
+func removeTeamParent(
 	ctx context.Context,
 	client graphql.Client,
-	input TeamCreateInput,
-) (*createTeamResponse, error) {
+	key string,
+) (*removeTeamParentResponse, error) {
 	req := &graphql.Request{
-		OpName: "createTeam",
+		OpName: "removeTeamParent",
 		Query: `
-mutation createTeam ($input: TeamCreateInput!) {
-	teamCreate(input: $input) {
+mutation removeTeamParent ($key: String!) {
+	teamUpdate(input: {parentId:null}, id: $key) {
 		team {
 			... Team
 		}
@@ -4760,13 +18458,13 @@ fragment Team on Team {
 	defaultIssueEstimate
 }
 `,
-		Variables: &__createTeamInput{
-			Input: input,
+		Variables: &__removeTeamParentInput{
+			Key: key,
 		},
 	}
 	var err error
 
-	var data createTeamResponse
+	var data removeTeamParentResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4778,40 +18476,44 @@ fragment Team on Team {
 	return &data, err
 }
 
-func createWorkflowState(
+func rotateEmailIntakeAddress(
 	ctx context.Context,
 	client graphql.Client,
-	input WorkflowStateCreateInput,
-) (*createWorkflowStateResponse, error) {
+	id string,
+) (*rotateEmailIntakeAddressResponse, error) {
 	req := &graphql.Request{
-		OpName: "createWorkflowState",
+		OpName: "rotateEmailIntakeAddress",
 		Query: `
-mutation createWorkflowState ($input: WorkflowStateCreateInput!) {
-	workflowStateCreate(input: $input) {
-		workflowState {
-			... WorkflowState
+mutation rotateEmailIntakeAddress ($id: String!) {
+	emailIntakeAddressRotate(id: $id) {
+		emailIntakeAddress {
+			... EmailIntakeAddress
 		}
 	}
 }
-fragment WorkflowState on WorkflowState {
+fragment EmailIntakeAddress on EmailIntakeAddress {
 	id
-	name
-	color
-	description
-	type
-	position
+	address
+	forwardingEmailAddress
+	senderName
+	enabled
+	repliesEnabled
 	team {
 		id
 	}
+	template {
+		id
+	}
+	archivedAt
 }
 `,
-		Variables: &__createWorkflowStateInput{
-			Input: input,
+		Variables: &__rotateEmailIntakeAddressInput{
+			Id: id,
 		},
 	}
 	var err error
 
-	var data createWorkflowStateResponse
+	var data rotateEmailIntakeAddressResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4823,27 +18525,39 @@ fragment WorkflowState on WorkflowState {
 	return &data, err
 }
 
-func deleteGitAutomationState(
+func templateCreate(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*deleteGitAutomationStateResponse, error) {
+	input TemplateCreateInput,
+) (*templateCreateResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteGitAutomationState",
+		OpName: "templateCreate",
 		Query: `
-mutation deleteGitAutomationState ($id: String!) {
-	gitAutomationStateDelete(id: $id) {
-		success
+mutation templateCreate ($input: TemplateCreateInput!) {
+	templateCreate(input: $input) {
+		template {
+			... Template
+		}
+	}
+}
+fragment Template on Template {
+	id
+	name
+	description
+	type
+	team {
+		id
 	}
+	templateData
 }
 `,
-		Variables: &__deleteGitAutomationStateInput{
-			Id: id,
+		Variables: &__templateCreateInput{
+			Input: input,
 		},
 	}
 	var err error
 
-	var data deleteGitAutomationStateResponse
+	var data templateCreateResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4855,27 +18569,27 @@ mutation deleteGitAutomationState ($id: String!) {
 	return &data, err
 }
 
-func deleteGitAutomationTargetBranch(
+func templateDelete(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*deleteGitAutomationTargetBranchResponse, error) {
+) (*templateDeleteResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteGitAutomationTargetBranch",
+		OpName: "templateDelete",
 		Query: `
-mutation deleteGitAutomationTargetBranch ($id: String!) {
-	gitAutomationTargetBranchDelete(id: $id) {
+mutation templateDelete ($id: String!) {
+	templateDelete(id: $id) {
 		success
 	}
 }
 `,
-		Variables: &__deleteGitAutomationTargetBranchInput{
+		Variables: &__templateDeleteInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data deleteGitAutomationTargetBranchResponse
+	var data templateDeleteResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4887,59 +18601,41 @@ mutation deleteGitAutomationTargetBranch ($id: String!) {
 	return &data, err
 }
 
-func deleteLabel(
+func templateUpdate(
 	ctx context.Context,
 	client graphql.Client,
+	input TemplateUpdateInput,
 	id string,
-) (*deleteLabelResponse, error) {
+) (*templateUpdateResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteLabel",
+		OpName: "templateUpdate",
 		Query: `
-mutation deleteLabel ($id: String!) {
-	issueLabelDelete(id: $id) {
-		success
-	}
-}
-`,
-		Variables: &__deleteLabelInput{
-			Id: id,
-		},
+mutation templateUpdate ($input: TemplateUpdateInput!, $id: String!) {
+	templateUpdate(input: $input, id: $id) {
+		template {
+			... Template
+		}
 	}
-	var err error
-
-	var data deleteLabelResponse
-	resp := &graphql.Response{Data: &data}
-
-	err = client.MakeRequest(
-		ctx,
-		req,
-		resp,
-	)
-
-	return &data, err
 }
-
-func deleteTeam(
-	ctx context.Context,
-	client graphql.Client,
-	key string,
-) (*deleteTeamResponse, error) {
-	req := &graphql.Request{
-		OpName: "deleteTeam",
-		Query: `
-mutation deleteTeam ($key: String!) {
-	teamDelete(id: $key) {
-		success
+fragment Template on Template {
+	id
+	name
+	description
+	type
+	team {
+		id
 	}
+	templateData
 }
 `,
-		Variables: &__deleteTeamInput{
-			Key: key,
+		Variables: &__templateUpdateInput{
+			Input: input,
+			Id:    id,
 		},
 	}
 	var err error
 
-	var data deleteTeamResponse
+	var data templateUpdateResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4951,27 +18647,27 @@ mutation deleteTeam ($key: String!) {
 	return &data, err
 }
 
-func deleteWorkflowState(
+func unarchiveInitiative(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*deleteWorkflowStateResponse, error) {
+) (*unarchiveInitiativeResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteWorkflowState",
+		OpName: "unarchiveInitiative",
 		Query: `
-mutation deleteWorkflowState ($id: String!) {
-	workflowStateArchive(id: $id) {
+mutation unarchiveInitiative ($id: String!) {
+	initiativeUnarchive(id: $id) {
 		success
 	}
 }
 `,
-		Variables: &__deleteWorkflowStateInput{
+		Variables: &__unarchiveInitiativeInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data deleteWorkflowStateResponse
+	var data unarchiveInitiativeResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4983,31 +18679,27 @@ mutation deleteWorkflowState ($id: String!) {
 	return &data, err
 }
 
-func findTeamLabel(
+func unarchiveProject(
 	ctx context.Context,
 	client graphql.Client,
-	name string,
-	key string,
-) (*findTeamLabelResponse, error) {
+	id string,
+) (*unarchiveProjectResponse, error) {
 	req := &graphql.Request{
-		OpName: "findTeamLabel",
+		OpName: "unarchiveProject",
 		Query: `
-query findTeamLabel ($name: String!, $key: String!) {
-	issueLabels(filter: {name:{eq:$name},team:{key:{eq:$key}}}) {
-		nodes {
-			id
-		}
+mutation unarchiveProject ($id: String!) {
+	projectUnarchive(id: $id) {
+		success
 	}
 }
 `,
-		Variables: &__findTeamLabelInput{
-			Name: name,
-			Key:  key,
+		Variables: &__unarchiveProjectInput{
+			Id: id,
 		},
 	}
 	var err error
 
-	var data findTeamLabelResponse
+	var data unarchiveProjectResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -5019,31 +18711,44 @@ query findTeamLabel ($name: String!, $key: String!) {
 	return &data, err
 }
 
-func findWorkflowState(
+func updateCustomView(
 	ctx context.Context,
 	client graphql.Client,
-	name string,
-	key string,
-) (*findWorkflowStateResponse, error) {
+	input CustomViewUpdateInput,
+	id string,
+) (*updateCustomViewResponse, error) {
 	req := &graphql.Request{
-		OpName: "findWorkflowState",
+		OpName: "updateCustomView",
 		Query: `
-query findWorkflowState ($name: String!, $key: String!) {
-	workflowStates(filter: {name:{eq:$name},team:{key:{eq:$key}}}) {
-		nodes {
-			id
+mutation updateCustomView ($input: CustomViewUpdateInput!, $id: String!) {
+	customViewUpdate(input: $input, id: $id) {
+		customView {
+			... CustomView
 		}
 	}
 }
+fragment CustomView on CustomView {
+	id
+	name
+	description
+	icon
+	color
+	team {
+		id
+	}
+	shared
+	filterData
+	projectFilterData
+}
 `,
-		Variables: &__findWorkflowStateInput{
-			Name: name,
-			Key:  key,
+		Variables: &__updateCustomViewInput{
+			Input: input,
+			Id:    id,
 		},
 	}
 	var err error
 
-	var data findWorkflowStateResponse
+	var data updateCustomViewResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -5055,32 +18760,43 @@ query findWorkflowState ($name: String!, $key: String!) {
 	return &data, err
 }
 
-func findWorkspaceLabel(
+func updateCycle(
 	ctx context.Context,
 	client graphql.Client,
-	name string,
-) (*findWorkspaceLabelResponse, error) {
+	input CycleUpdateInput,
+	id string,
+) (*updateCycleResponse, error) {
 	req := &graphql.Request{
-		OpName: "findWorkspaceLabel",
+		OpName: "updateCycle",
 		Query: `
-query findWorkspaceLabel ($name: String!) {
-	issueLabels(filter: {name:{eq:$name}}) {
-		nodes {
-			id
-			team {
-				id
-			}
+mutation updateCycle ($input: CycleUpdateInput!, $id: String!) {
+	cycleUpdate(input: $input, id: $id) {
+		cycle {
+			... Cycle
 		}
 	}
 }
+fragment Cycle on Cycle {
+	id
+	number
+	name
+	description
+	startsAt
+	endsAt
+	team {
+		id
+	}
+	archivedAt
+}
 `,
-		Variables: &__findWorkspaceLabelInput{
-			Name: name,
+		Variables: &__updateCycleInput{
+			Input: input,
+			Id:    id,
 		},
 	}
 	var err error
 
-	var data findWorkspaceLabelResponse
+	var data updateCycleResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -5092,39 +18808,46 @@ query findWorkspaceLabel ($name: String!) {
 	return &data, err
 }
 
-func getLabel(
+func updateEmailIntakeAddress(
 	ctx context.Context,
 	client graphql.Client,
+	input EmailIntakeAddressUpdateInput,
 	id string,
-) (*getLabelResponse, error) {
+) (*updateEmailIntakeAddressResponse, error) {
 	req := &graphql.Request{
-		OpName: "getLabel",
+		OpName: "updateEmailIntakeAddress",
 		Query: `
-query getLabel ($id: String!) {
-	issueLabel(id: $id) {
-		... IssueLabel
+mutation updateEmailIntakeAddress ($input: EmailIntakeAddressUpdateInput!, $id: String!) {
+	emailIntakeAddressUpdate(input: $input, id: $id) {
+		emailIntakeAddress {
+			... EmailIntakeAddress
+		}
 	}
 }
-fragment IssueLabel on IssueLabel {
+fragment EmailIntakeAddress on EmailIntakeAddress {
 	id
-	name
-	description
-	color
-	parent {
+	address
+	forwardingEmailAddress
+	senderName
+	enabled
+	repliesEnabled
+	team {
 		id
 	}
-	team {
+	template {
 		id
 	}
+	archivedAt
 }
 `,
-		Variables: &__getLabelInput{
-			Id: id,
+		Variables: &__updateEmailIntakeAddressInput{
+			Input: input,
+			Id:    id,
 		},
 	}
 	var err error
 
-	var data getLabelResponse
+	var data updateEmailIntakeAddressResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -5136,61 +18859,29 @@ fragment IssueLabel on IssueLabel {
 	return &data, err
 }
 
-func getTeam(
+func updateGitAutomationState(
 	ctx context.Context,
 	client graphql.Client,
-	key string,
-) (*getTeamResponse, error) {
+	id string,
+	input GitAutomationStateUpdateInput,
+) (*updateGitAutomationStateResponse, error) {
 	req := &graphql.Request{
-		OpName: "getTeam",
+		OpName: "updateGitAutomationState",
 		Query: `
-query getTeam ($key: String!) {
-	team(id: $key) {
-		... Team
-	}
-}
-fragment Team on Team {
-	id
-	name
-	key
-	private
-	description
-	icon
-	color
-	parent {
-		id
+mutation updateGitAutomationState ($id: String!, $input: GitAutomationStateUpdateInput!) {
+	gitAutomationStateUpdate(id: $id, input: $input) {
+		success
 	}
-	timezone
-	groupIssueHistory
-	setIssueSortOrderOnStateChange
-	aiThreadSummariesEnabled
-	autoArchivePeriod
-	autoClosePeriod
-	autoCloseParentIssues
-	autoCloseChildIssues
-	triageEnabled
-	requirePriorityToLeaveTriage
-	cyclesEnabled
-	cycleStartDay
-	cycleDuration
-	cycleCooldownTime
-	upcomingCycleCount
-	cycleIssueAutoAssignStarted
-	cycleIssueAutoAssignCompleted
-	cycleLockToActive
-	issueEstimationType
-	issueEstimationAllowZero
-	issueEstimationExtended
-	defaultIssueEstimate
 }
 `,
-		Variables: &__getTeamInput{
-			Key: key,
+		Variables: &__updateGitAutomationStateInput{
+			Id:    id,
+			Input: input,
 		},
 	}
 	var err error
 
-	var data getTeamResponse
+	var data updateGitAutomationStateResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -5202,45 +18893,47 @@ fragment Team on Team {
 	return &data, err
 }
 
-func getTeamWorkflow(
+func updateInitiative(
 	ctx context.Context,
 	client graphql.Client,
-	key string,
-) (*getTeamWorkflowResponse, error) {
+	input InitiativeUpdateInput,
+	id string,
+) (*updateInitiativeResponse, error) {
 	req := &graphql.Request{
-		OpName: "getTeamWorkflow",
+		OpName: "updateInitiative",
 		Query: `
-query getTeamWorkflow ($key: String!) {
-	team(id: $key) {
-		... TeamWorkflow
+mutation updateInitiative ($input: InitiativeUpdateInput!, $id: String!) {
+	initiativeUpdate(input: $input, id: $id) {
+		initiative {
+			... Initiative
+		}
 	}
 }
-fragment TeamWorkflow on Team {
+fragment Initiative on Initiative {
 	id
-	key
-	gitAutomationStates {
-		nodes {
-			id
-			state {
-				id
-			}
-			event
-			targetBranch {
-				id
-				branchPattern
-				isRegex
-			}
-		}
+	name
+	slugId
+	url
+	description
+	owner {
+		id
 	}
+	color
+	icon
+	status
+	targetDate
+	trashed
+	archivedAt
 }
 `,
-		Variables: &__getTeamWorkflowInput{
-			Key: key,
+		Variables: &__updateInitiativeInput{
+			Input: input,
+			Id:    id,
 		},
 	}
 	var err error
 
-	var data getTeamWorkflowResponse
+	var data updateInitiativeResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -5252,40 +18945,43 @@ fragment TeamWorkflow on Team {
 	return &data, err
 }
 
-func getTeamWorkflowStates(
+func updateLabel(
 	ctx context.Context,
 	client graphql.Client,
-	key string,
-) (*getTeamWorkflowStatesResponse, error) {
+	input IssueLabelUpdateInput,
+	id string,
+) (*updateLabelResponse, error) {
 	req := &graphql.Request{
-		OpName: "getTeamWorkflowStates",
+		OpName: "updateLabel",
 		Query: `
-query getTeamWorkflowStates ($key: String!) {
-	workflowStates(filter: {team:{key:{eq:$key}}}) {
-		nodes {
-			... WorkflowState
+mutation updateLabel ($input: IssueLabelUpdateInput!, $id: String!) {
+	issueLabelUpdate(input: $input, id: $id) {
+		issueLabel {
+			... IssueLabel
 		}
 	}
 }
-fragment WorkflowState on WorkflowState {
+fragment IssueLabel on IssueLabel {
 	id
 	name
-	color
 	description
-	type
-	position
+	color
+	parent {
+		id
+	}
 	team {
 		id
 	}
 }
 `,
-		Variables: &__getTeamWorkflowStatesInput{
-			Key: key,
+		Variables: &__updateLabelInput{
+			Input: input,
+			Id:    id,
 		},
 	}
 	var err error
 
-	var data getTeamWorkflowStatesResponse
+	var data updateLabelResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -5297,37 +18993,40 @@ fragment WorkflowState on WorkflowState {
 	return &data, err
 }
 
-func getTemplate(
+func updateOrganizationDomain(
 	ctx context.Context,
 	client graphql.Client,
+	input OrganizationDomainUpdateInput,
 	id string,
-) (*getTemplateResponse, error) {
+) (*updateOrganizationDomainResponse, error) {
 	req := &graphql.Request{
-		OpName: "getTemplate",
+		OpName: "updateOrganizationDomain",
 		Query: `
-query getTemplate ($id: String!) {
-	template(id: $id) {
-		... Template
+mutation updateOrganizationDomain ($input: OrganizationDomainUpdateInput!, $id: String!) {
+	organizationDomainUpdate(input: $input, id: $id) {
+		organizationDomain {
+			... OrganizationDomain
+		}
 	}
 }
-fragment Template on Template {
+fragment OrganizationDomain on OrganizationDomain {
 	id
 	name
-	description
-	type
-	team {
-		id
-	}
-	templateData
+	verificationEmail
+	authType
+	verified
+	claimed
+	disableOrganizationCreation
 }
 `,
-		Variables: &__getTemplateInput{
-			Id: id,
+		Variables: &__updateOrganizationDomainInput{
+			Input: input,
+			Id:    id,
 		},
 	}
 	var err error
 
-	var data getTemplateResponse
+	var data updateOrganizationDomainResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -5339,38 +19038,39 @@ fragment Template on Template {
 	return &data, err
 }
 
-func getWorkflowState(
+func updateOrganizationInvite(
 	ctx context.Context,
 	client graphql.Client,
+	input OrganizationInviteUpdateInput,
 	id string,
-) (*getWorkflowStateResponse, error) {
+) (*updateOrganizationInviteResponse, error) {
 	req := &graphql.Request{
-		OpName: "getWorkflowState",
+		OpName: "updateOrganizationInvite",
 		Query: `
-query getWorkflowState ($id: String!) {
-	workflowState(id: $id) {
-		... WorkflowState
+mutation updateOrganizationInvite ($input: OrganizationInviteUpdateInput!, $id: String!) {
+	organizationInviteUpdate(input: $input, id: $id) {
+		organizationInvite {
+			... OrganizationInvite
+		}
 	}
 }
-fragment WorkflowState on WorkflowState {
+fragment OrganizationInvite on OrganizationInvite {
 	id
-	name
-	color
-	description
-	type
-	position
-	team {
-		id
-	}
+	email
+	role
+	metadata
+	acceptedAt
+	expiresAt
 }
 `,
-		Variables: &__getWorkflowStateInput{
-			Id: id,
+		Variables: &__updateOrganizationInviteInput{
+			Input: input,
+			Id:    id,
 		},
 	}
 	var err error
 
-	var data getWorkflowStateResponse
+	var data updateOrganizationInviteResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -5382,25 +19082,57 @@ fragment WorkflowState on WorkflowState {
 	return &data, err
 }
 
-func getWorkspace(
+func updateProject(
 	ctx context.Context,
 	client graphql.Client,
-) (*getWorkspaceResponse, error) {
+	input ProjectUpdateInput,
+	id string,
+) (*updateProjectResponse, error) {
 	req := &graphql.Request{
-		OpName: "getWorkspace",
+		OpName: "updateProject",
 		Query: `
-query getWorkspace {
-	organization {
+mutation updateProject ($input: ProjectUpdateInput!, $id: String!) {
+	projectUpdate(input: $input, id: $id) {
+		project {
+			... Project
+		}
+	}
+}
+fragment Project on Project {
+	id
+	name
+	slugId
+	url
+	description
+	icon
+	color
+	status {
 		id
-		urlKey
-		name
 	}
+	lead {
+		id
+	}
+	teams {
+		nodes {
+			id
+		}
+	}
+	priority
+	startDate
+	targetDate
+	labelIds
+	trashed
+	archivedAt
 }
 `,
+		Variables: &__updateProjectInput{
+			Input: input,
+			Id:    id,
+		},
 	}
 	var err error
 
-	var data getWorkspaceResponse
+	var data updateProjectResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -5412,42 +19144,41 @@ query getWorkspace {
 	return &data, err
 }
 
-func getWorkspaceSettings(
+func updateProjectMilestone(
 	ctx context.Context,
 	client graphql.Client,
-) (*getWorkspaceSettingsResponse, error) {
+	input ProjectMilestoneUpdateInput,
+	id string,
+) (*updateProjectMilestoneResponse, error) {
 	req := &graphql.Request{
-		OpName: "getWorkspaceSettings",
+		OpName: "updateProjectMilestone",
 		Query: `
-query getWorkspaceSettings {
-	organization {
-		... Organization
+mutation updateProjectMilestone ($input: ProjectMilestoneUpdateInput!, $id: String!) {
+	projectMilestoneUpdate(input: $input, id: $id) {
+		projectMilestone {
+			... ProjectMilestone
+		}
 	}
 }
-fragment Organization on Organization {
+fragment ProjectMilestone on ProjectMilestone {
 	id
-	allowMembersToInvite
-	restrictTeamCreationToAdmins
-	restrictLabelManagementToAdmins
-	gitLinkbackMessagesEnabled
-	gitPublicLinkbackMessagesEnabled
-	fiscalYearStartMonth
-	projectUpdateReminderFrequencyInWeeks
-	projectUpdateRemindersDay
-	projectUpdateRemindersHour
-	roadmapEnabled
-	initiativeUpdateReminderFrequencyInWeeks
-	initiativeUpdateRemindersDay
-	initiativeUpdateRemindersHour
-	feedEnabled
-	defaultFeedSummarySchedule
-	customersEnabled
+	name
+	description
+	targetDate
+	sortOrder
+	project {
+		id
+	}
 }
 `,
+		Variables: &__updateProjectMilestoneInput{
+			Input: input,
+			Id:    id,
+		},
 	}
 	var err error
 
-	var data getWorkspaceSettingsResponse
+	var data updateProjectMilestoneResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -5459,39 +19190,41 @@ fragment Organization on Organization {
 	return &data, err
 }
 
-func templateCreate(
+func updateProjectStatus(
 	ctx context.Context,
 	client graphql.Client,
-	input TemplateCreateInput,
-) (*templateCreateResponse, error) {
+	input ProjectStatusUpdateInput,
+	id string,
+) (*updateProjectStatusResponse, error) {
 	req := &graphql.Request{
-		OpName: "templateCreate",
+		OpName: "updateProjectStatus",
 		Query: `
-mutation templateCreate ($input: TemplateCreateInput!) {
-	templateCreate(input: $input) {
-		template {
-			... Template
+mutation updateProjectStatus ($input: ProjectStatusUpdateInput!, $id: String!) {
+	projectStatusUpdate(input: $input, id: $id) {
+		status {
+			... ProjectStatus
 		}
 	}
 }
-fragment Template on Template {
+fragment ProjectStatus on ProjectStatus {
 	id
 	name
+	color
 	description
 	type
-	team {
-		id
-	}
-	templateData
+	position
+	indefinite
+	archivedAt
 }
 `,
-		Variables: &__templateCreateInput{
+		Variables: &__updateProjectStatusInput{
 			Input: input,
+			Id:    id,
 		},
 	}
 	var err error
 
-	var data templateCreateResponse
+	var data updateProjectStatusResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -5503,27 +19236,65 @@ fragment Template on Template {
 	return &data, err
 }
 
-func templateDelete(
+func updateTeam(
 	ctx context.Context,
 	client graphql.Client,
+	input TeamUpdateInput,
 	id string,
-) (*templateDeleteResponse, error) {
+) (*updateTeamResponse, error) {
 	req := &graphql.Request{
-		OpName: "templateDelete",
+		OpName: "updateTeam",
 		Query: `
-mutation templateDelete ($id: String!) {
-	templateDelete(id: $id) {
-		success
+mutation updateTeam ($input: TeamUpdateInput!, $id: String!) {
+	teamUpdate(input: $input, id: $id) {
+		team {
+			... Team
+		}
+	}
+}
+fragment Team on Team {
+	id
+	name
+	key
+	private
+	description
+	icon
+	color
+	parent {
+		id
 	}
+	timezone
+	groupIssueHistory
+	setIssueSortOrderOnStateChange
+	aiThreadSummariesEnabled
+	autoArchivePeriod
+	autoClosePeriod
+	autoCloseParentIssues
+	autoCloseChildIssues
+	triageEnabled
+	requirePriorityToLeaveTriage
+	cyclesEnabled
+	cycleStartDay
+	cycleDuration
+	cycleCooldownTime
+	upcomingCycleCount
+	cycleIssueAutoAssignStarted
+	cycleIssueAutoAssignCompleted
+	cycleLockToActive
+	issueEstimationType
+	issueEstimationAllowZero
+	issueEstimationExtended
+	defaultIssueEstimate
 }
 `,
-		Variables: &__templateDeleteInput{
-			Id: id,
+		Variables: &__updateTeamInput{
+			Input: input,
+			Id:    id,
 		},
 	}
 	var err error
 
-	var data templateDeleteResponse
+	var data updateTeamResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -5535,41 +19306,44 @@ mutation templateDelete ($id: String!) {
 	return &data, err
 }
 
-func templateUpdate(
+func updateTeamMembership(
 	ctx context.Context,
 	client graphql.Client,
-	input TemplateUpdateInput,
+	input TeamMembershipUpdateInput,
 	id string,
-) (*templateUpdateResponse, error) {
+) (*updateTeamMembershipResponse, error) {
 	req := &graphql.Request{
-		OpName: "templateUpdate",
+		OpName: "updateTeamMembership",
 		Query: `
-mutation templateUpdate ($input: TemplateUpdateInput!, $id: String!) {
-	templateUpdate(input: $input, id: $id) {
-		template {
-			... Template
+mutation updateTeamMembership ($input: TeamMembershipUpdateInput!, $id: String!) {
+	teamMembershipUpdate(input: $input, id: $id) {
+		teamMembership {
+			... TeamMembership
 		}
 	}
 }
-fragment Template on Template {
+fragment TeamMembership on TeamMembership {
 	id
-	name
-	description
-	type
+	owner
+	sortOrder
 	team {
 		id
 	}
-	templateData
+	user {
+		id
+		email
+	}
+	archivedAt
 }
 `,
-		Variables: &__templateUpdateInput{
+		Variables: &__updateTeamMembershipInput{
 			Input: input,
 			Id:    id,
 		},
 	}
 	var err error
 
-	var data templateUpdateResponse
+	var data updateTeamMembershipResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -5581,29 +19355,41 @@ fragment Template on Template {
 	return &data, err
 }
 
-func updateGitAutomationState(
+func updateTimeSchedule(
 	ctx context.Context,
 	client graphql.Client,
+	input TimeScheduleUpdateInput,
 	id string,
-	input GitAutomationStateUpdateInput,
-) (*updateGitAutomationStateResponse, error) {
+) (*updateTimeScheduleResponse, error) {
 	req := &graphql.Request{
-		OpName: "updateGitAutomationState",
+		OpName: "updateTimeSchedule",
 		Query: `
-mutation updateGitAutomationState ($id: String!, $input: GitAutomationStateUpdateInput!) {
-	gitAutomationStateUpdate(id: $id, input: $input) {
-		success
+mutation updateTimeSchedule ($input: TimeScheduleUpdateInput!, $id: String!) {
+	timeScheduleUpdate(input: $input, id: $id) {
+		timeSchedule {
+			... TimeSchedule
+		}
+	}
+}
+fragment TimeSchedule on TimeSchedule {
+	id
+	name
+	entries {
+		userId
+		startsAt
+		endsAt
 	}
+	archivedAt
 }
 `,
-		Variables: &__updateGitAutomationStateInput{
-			Id:    id,
+		Variables: &__updateTimeScheduleInput{
 			Input: input,
+			Id:    id,
 		},
 	}
 	var err error
 
-	var data updateGitAutomationStateResponse
+	var data updateTimeScheduleResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -5615,43 +19401,45 @@ mutation updateGitAutomationState ($id: String!, $input: GitAutomationStateUpdat
 	return &data, err
 }
 
-func updateLabel(
+func updateTriageResponsibility(
 	ctx context.Context,
 	client graphql.Client,
-	input IssueLabelUpdateInput,
+	input TriageResponsibilityUpdateInput,
 	id string,
-) (*updateLabelResponse, error) {
+) (*updateTriageResponsibilityResponse, error) {
 	req := &graphql.Request{
-		OpName: "updateLabel",
+		OpName: "updateTriageResponsibility",
 		Query: `
-mutation updateLabel ($input: IssueLabelUpdateInput!, $id: String!) {
-	issueLabelUpdate(input: $input, id: $id) {
-		issueLabel {
-			... IssueLabel
+mutation updateTriageResponsibility ($input: TriageResponsibilityUpdateInput!, $id: String!) {
+	triageResponsibilityUpdate(input: $input, id: $id) {
+		triageResponsibility {
+			... TriageResponsibility
 		}
 	}
 }
-fragment IssueLabel on IssueLabel {
+fragment TriageResponsibility on TriageResponsibility {
 	id
-	name
-	description
-	color
-	parent {
+	action
+	manualSelection {
+		userIds
+	}
+	timeSchedule {
 		id
 	}
 	team {
 		id
 	}
+	archivedAt
 }
 `,
-		Variables: &__updateLabelInput{
+		Variables: &__updateTriageResponsibilityInput{
 			Input: input,
 			Id:    id,
 		},
 	}
 	var err error
 
-	var data updateLabelResponse
+	var data updateTriageResponsibilityResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -5663,65 +19451,42 @@ fragment IssueLabel on IssueLabel {
 	return &data, err
 }
 
-func updateTeam(
+func updateWebhook(
 	ctx context.Context,
 	client graphql.Client,
-	input TeamUpdateInput,
+	input WebhookUpdateInput,
 	id string,
-) (*updateTeamResponse, error) {
+) (*updateWebhookResponse, error) {
 	req := &graphql.Request{
-		OpName: "updateTeam",
+		OpName: "updateWebhook",
 		Query: `
-mutation updateTeam ($input: TeamUpdateInput!, $id: String!) {
-	teamUpdate(input: $input, id: $id) {
-		team {
-			... Team
+mutation updateWebhook ($input: WebhookUpdateInput!, $id: String!) {
+	webhookUpdate(input: $input, id: $id) {
+		webhook {
+			... Webhook
 		}
 	}
 }
-fragment Team on Team {
+fragment Webhook on Webhook {
 	id
-	name
-	key
-	private
-	description
-	icon
-	color
-	parent {
+	label
+	url
+	enabled
+	team {
 		id
 	}
-	timezone
-	groupIssueHistory
-	setIssueSortOrderOnStateChange
-	aiThreadSummariesEnabled
-	autoArchivePeriod
-	autoClosePeriod
-	autoCloseParentIssues
-	autoCloseChildIssues
-	triageEnabled
-	requirePriorityToLeaveTriage
-	cyclesEnabled
-	cycleStartDay
-	cycleDuration
-	cycleCooldownTime
-	upcomingCycleCount
-	cycleIssueAutoAssignStarted
-	cycleIssueAutoAssignCompleted
-	cycleLockToActive
-	issueEstimationType
-	issueEstimationAllowZero
-	issueEstimationExtended
-	defaultIssueEstimate
+	allPublicTeams
+	resourceTypes
 }
 `,
-		Variables: &__updateTeamInput{
+		Variables: &__updateWebhookInput{
 			Input: input,
 			Id:    id,
 		},
 	}
 	var err error
 
-	var data updateTeamResponse
+	var data updateWebhookResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -5832,3 +19597,46 @@ fragment Organization on Organization {
 
 	return &data, err
 }
+
+func verifyOrganizationDomain(
+	ctx context.Context,
+	client graphql.Client,
+	input OrganizationDomainVerificationInput,
+) (*verifyOrganizationDomainResponse, error) {
+	req := &graphql.Request{
+		OpName: "verifyOrganizationDomain",
+		Query: `
+mutation verifyOrganizationDomain ($input: OrganizationDomainVerificationInput!) {
+	organizationDomainVerify(input: $input) {
+		organizationDomain {
+			... OrganizationDomain
+		}
+	}
+}
+fragment OrganizationDomain on OrganizationDomain {
+	id
+	name
+	verificationEmail
+	authType
+	verified
+	claimed
+	disableOrganizationCreation
+}
+`,
+		Variables: &__verifyOrganizationDomainInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data verifyOrganizationDomainResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
@@ -2,14 +2,24 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"net/url"
 	"os"
 	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/Khan/genqlient/graphql"
@@ -17,13 +27,49 @@ import (
 
 var (
 	envVarName          = "LINEAR_TOKEN"
-	errMissingAuthToken = "Required token could not be found. Please set the token using an input variable in the provider configuration block or by using the `" + envVarName + "` environment variable."
+	errMissingAuthToken = "Required token could not be found. Please set the token using an input variable in the provider configuration block or by using the `" + envVarName + "` environment variable. Alternatively, authenticate as an OAuth application with `access_token` or with `client_id` & `client_secret`."
+
+	accessTokenEnvVarName   = "LINEAR_ACCESS_TOKEN"
+	clientIdEnvVarName      = "LINEAR_CLIENT_ID"
+	clientSecretEnvVarName  = "LINEAR_CLIENT_SECRET"
+	oauthTokenUrlEnvVarName = "LINEAR_OAUTH_TOKEN_URL"
+	oauthScopesEnvVarName   = "LINEAR_OAUTH_SCOPES"
+
+	apiUrlEnvVarName       = "LINEAR_API_URL"
+	timeoutEnvVarName      = "LINEAR_TIMEOUT"
+	proxyUrlEnvVarName     = "LINEAR_PROXY_URL"
+	caBundleFileEnvVarName = "LINEAR_CA_BUNDLE_FILE"
+
+	retryMaxAttemptsEnvVarName = "LINEAR_RETRY_MAX_ATTEMPTS"
+	retryMaxWaitEnvVarName     = "LINEAR_RETRY_MAX_WAIT"
+
+	maxConcurrentRequestsEnvVarName = "LINEAR_MAX_CONCURRENT_REQUESTS"
+	readCacheEnvVarName             = "LINEAR_READ_CACHE"
+
+	expectedWorkspaceIdEnvVarName     = "LINEAR_EXPECTED_WORKSPACE_ID"
+	expectedWorkspaceUrlKeyEnvVarName = "LINEAR_EXPECTED_WORKSPACE_URL_KEY"
+)
+
+const (
+	defaultApiUrl           = "https://api.linear.app/graphql"
+	defaultOauthTokenUrl    = "https://api.linear.app/oauth/token"
+	defaultOauthScopes      = "read,write"
+	defaultTimeout          = 60
+	defaultRetryMaxAttempts = 5
+	defaultRetryMaxWait     = 60
+
+	defaultMaxConcurrentRequests = 0
+	defaultReadCache             = true
 )
 
 func colorRegex() *regexp.Regexp {
 	return regexp.MustCompile("^#[0-9a-fA-F]{6}$")
 }
 
+func dateRegex() *regexp.Regexp {
+	return regexp.MustCompile("^[0-9]{4}-[0-9]{2}-[0-9]{2}$")
+}
+
 func uuidRegex() *regexp.Regexp {
 	return regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
 }
@@ -38,7 +84,26 @@ type LinearProvider struct {
 }
 
 type LinearProviderModel struct {
-	Token types.String `tfsdk:"token"`
+	Token        types.String `tfsdk:"token"`
+	AccessToken  types.String `tfsdk:"access_token"`
+	ClientId     types.String `tfsdk:"client_id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+	ApiUrl       types.String `tfsdk:"api_url"`
+	Timeout      types.Int64  `tfsdk:"timeout"`
+	ProxyUrl     types.String `tfsdk:"proxy_url"`
+	CaBundleFile types.String `tfsdk:"ca_bundle_file"`
+
+	RetryMaxAttempts types.Int64 `tfsdk:"retry_max_attempts"`
+	RetryMaxWait     types.Int64 `tfsdk:"retry_max_wait"`
+
+	MaxConcurrentRequests types.Int64 `tfsdk:"max_concurrent_requests"`
+	ReadCache             types.Bool  `tfsdk:"read_cache"`
+
+	OauthTokenUrl types.String `tfsdk:"oauth_token_url"`
+	OauthScopes   types.List   `tfsdk:"oauth_scopes"`
+
+	ExpectedWorkspaceId     types.String `tfsdk:"expected_workspace_id"`
+	ExpectedWorkspaceUrlKey types.String `tfsdk:"expected_workspace_url_key"`
 }
 
 func (p *LinearProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -53,6 +118,90 @@ func (p *LinearProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 				MarkdownDescription: "The token used to authenticate with Linear.",
 				Optional:            true,
 			},
+			"access_token": schema.StringAttribute{
+				MarkdownDescription: "OAuth access token of a Linear application, sent as a `Bearer` token. Can also be set with the `" + accessTokenEnvVarName + "` environment variable.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "Client ID of a Linear OAuth application, exchanged together with `client_secret` for an access token that is refreshed when it expires. Can also be set with the `" + clientIdEnvVarName + "` environment variable.",
+				Optional:            true,
+			},
+			"client_secret": schema.StringAttribute{
+				MarkdownDescription: "Client secret of a Linear OAuth application. Can also be set with the `" + clientSecretEnvVarName + "` environment variable.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"oauth_token_url": schema.StringAttribute{
+				MarkdownDescription: "URL the client credentials are exchanged at for an access token. Can also be set with the `" + oauthTokenUrlEnvVarName + "` environment variable. **Default** `" + defaultOauthTokenUrl + "`.",
+				Optional:            true,
+			},
+			"oauth_scopes": schema.ListAttribute{
+				MarkdownDescription: "Scopes requested for the access token exchanged for the client credentials. Can also be set with the `" + oauthScopesEnvVarName + "` environment variable, separated by commas. **Default** `" + defaultOauthScopes + "`.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"expected_workspace_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the workspace the credentials are expected to belong to. The provider refuses to run against any other workspace. Can also be set with the `" + expectedWorkspaceIdEnvVarName + "` environment variable.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"expected_workspace_url_key": schema.StringAttribute{
+				MarkdownDescription: "URL key of the workspace the credentials are expected to belong to. The provider refuses to run against any other workspace. Can also be set with the `" + expectedWorkspaceUrlKeyEnvVarName + "` environment variable.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"api_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the Linear GraphQL API. Can also be set with the `" + apiUrlEnvVarName + "` environment variable. **Default** `" + defaultApiUrl + "`.",
+				Optional:            true,
+			},
+			"timeout": schema.Int64Attribute{
+				MarkdownDescription: "Timeout in seconds for each attempt of a request to the API. Can also be set with the `" + timeoutEnvVarName + "` environment variable. **Default** `" + strconv.Itoa(defaultTimeout) + "`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the proxy used to reach the API. Can also be set with the `" + proxyUrlEnvVarName + "` environment variable. Defaults to the standard `HTTPS_PROXY` and `NO_PROXY` environment variables.",
+				Optional:            true,
+			},
+			"ca_bundle_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a PEM encoded CA bundle that is trusted in addition to the system certificates. Can also be set with the `" + caBundleFileEnvVarName + "` environment variable.",
+				Optional:            true,
+			},
+			"retry_max_attempts": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of attempts for a request that was rate limited, could not be sent or, for queries, failed with a server error or a lost connection. Can also be set with the `" + retryMaxAttemptsEnvVarName + "` environment variable. **Default** `" + strconv.Itoa(defaultRetryMaxAttempts) + "`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"retry_max_wait": schema.Int64Attribute{
+				MarkdownDescription: "Maximum wait in seconds between two attempts of a request. Can also be set with the `" + retryMaxWaitEnvVarName + "` environment variable. **Default** `" + strconv.Itoa(defaultRetryMaxWait) + "`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of requests sent to the API at the same time, shared by all resources, or `0` for no limit. Can also be set with the `" + maxConcurrentRequestsEnvVarName + "` environment variable. **Default** `" + strconv.Itoa(defaultMaxConcurrentRequests) + "`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"read_cache": schema.BoolAttribute{
+				MarkdownDescription: "Whether to share the results of read queries between resources until the next change is made, which saves many requests when refreshing. Can also be set with the `" + readCacheEnvVarName + "` environment variable. **Default** `" + strconv.FormatBool(defaultReadCache) + "`.",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -66,32 +215,157 @@ func (p *LinearProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
-	token := ""
+	token, accessToken, clientId := providerCredentials(data)
+	clientSecret := stringValueOrEnv(data.ClientSecret, clientSecretEnvVarName, "")
 
-	if !data.Token.IsNull() {
-		token = data.Token.ValueString()
-	}
+	credentials := 0
 
-	// If a token wasn't set in the provider configuration block, try and fetch it
-	// from the environment variable.
-	if token == "" {
-		token = os.Getenv(envVarName)
+	for _, value := range []string{token, accessToken, clientId} {
+		if value != "" {
+			credentials++
+		}
 	}
 
 	// If we still don't have a token at this point, we return an error.
-	if token == "" {
+	if credentials == 0 {
 		resp.Diagnostics.AddError("Missing API token", errMissingAuthToken)
 		return
 	}
 
+	if credentials > 1 {
+		resp.Diagnostics.AddError("Conflicting credentials", "Only one of `token`, `access_token` or `client_id` & `client_secret` can be used to authenticate with Linear.")
+		return
+	}
+
+	if clientId != "" && clientSecret == "" {
+		resp.Diagnostics.AddError("Missing client secret", "The `client_secret` of the OAuth application is required along with its `client_id`. Please set it in the provider configuration block or by using the `"+clientSecretEnvVarName+"` environment variable.")
+		return
+	}
+
+	apiUrl := stringValueOrEnv(data.ApiUrl, apiUrlEnvVarName, defaultApiUrl)
+
+	if !isHttpUrl(apiUrl) {
+		resp.Diagnostics.AddError("Invalid API URL", fmt.Sprintf("Expected an absolute http(s) URL for the API, got: %q", apiUrl))
+		return
+	}
+
+	oauthTokenUrl := stringValueOrEnv(data.OauthTokenUrl, oauthTokenUrlEnvVarName, defaultOauthTokenUrl)
+
+	if !isHttpUrl(oauthTokenUrl) {
+		resp.Diagnostics.AddError("Invalid OAuth token URL", fmt.Sprintf("Expected an absolute http(s) URL for the OAuth token endpoint, got: %q", oauthTokenUrl))
+		return
+	}
+
+	oauthScopes := strings.Split(defaultOauthScopes, ",")
+
+	if env := os.Getenv(oauthScopesEnvVarName); env != "" {
+		oauthScopes = strings.Split(env, ",")
+	}
+
+	if !data.OauthScopes.IsNull() {
+		resp.Diagnostics.Append(data.OauthScopes.ElementsAs(ctx, &oauthScopes, false)...)
+	}
+
+	timeout := int64ValueOrEnv(data.Timeout, timeoutEnvVarName, defaultTimeout, 1, &resp.Diagnostics)
+	retryMaxAttempts := int64ValueOrEnv(data.RetryMaxAttempts, retryMaxAttemptsEnvVarName, defaultRetryMaxAttempts, 1, &resp.Diagnostics)
+	retryMaxWait := int64ValueOrEnv(data.RetryMaxWait, retryMaxWaitEnvVarName, defaultRetryMaxWait, 0, &resp.Diagnostics)
+	maxConcurrentRequests := int64ValueOrEnv(data.MaxConcurrentRequests, maxConcurrentRequestsEnvVarName, defaultMaxConcurrentRequests, 0, &resp.Diagnostics)
+	readCache := boolValueOrEnv(data.ReadCache, readCacheEnvVarName, defaultReadCache, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	transport, err := newHttpTransport(
+		stringValueOrEnv(data.ProxyUrl, proxyUrlEnvVarName, ""),
+		stringValueOrEnv(data.CaBundleFile, caBundleFileEnvVarName, ""),
+	)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to configure HTTP client", err.Error())
+		return
+	}
+
+	retry := &retryTransport{
+		maxAttempts: int(retryMaxAttempts),
+		maxWait:     time.Duration(retryMaxWait) * time.Second,
+		timeout:     time.Duration(timeout) * time.Second,
+		wrapped:     newThrottleTransport(int(maxConcurrentRequests), &loggingTransport{wrapped: transport}),
+	}
+
+	var roundTripper http.RoundTripper
+	var uploadRoundTripper http.RoundTripper = retry
+	var secret string
+
+	switch {
+	case accessToken != "":
+		roundTripper = &authedTransport{token: "Bearer " + accessToken, wrapped: retry}
+		secret = accessToken
+	case clientId != "":
+		roundTripper = &oauthTransport{
+			source: &oauthTokenSource{
+				tokenUrl:     oauthTokenUrl,
+				clientId:     clientId,
+				clientSecret: clientSecret,
+				scopes:       oauthScopes,
+				client:       &http.Client{Transport: retry},
+				now:          time.Now,
+			},
+			wrapped: retry,
+		}
+		secret = clientSecret
+	default:
+		roundTripper = &authedTransport{token: token, wrapped: retry}
+		secret = token
+	}
+
+	// Used by the acceptance tests to record and replay API interactions
+	if path := os.Getenv(cassetteEnvVarName); path != "" {
+		cassette, err := loadCassette(path, os.Getenv(cassetteModeEnvVarName))
+
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to load cassette", err.Error())
+			return
+		}
+
+		roundTripper = &cassetteTransport{
+			cassette: cassette,
+			token:    secret,
+			wrapped:  roundTripper,
+		}
+
+		uploadRoundTripper = &cassetteTransport{
+			cassette: cassette,
+			token:    secret,
+			wrapped:  uploadRoundTripper,
+		}
+	}
+
 	httpClient := http.Client{
-		Transport: &authedTransport{
-			token:   token,
-			wrapped: http.DefaultTransport,
-		},
+		Transport: roundTripper,
+	}
+
+	client := graphql.NewClient(apiUrl, &httpClient)
+
+	if readCache {
+		client = newCachingClient(client)
 	}
 
-	client := graphql.NewClient("https://api.linear.app/graphql", &httpClient)
+	expectedWorkspaceId := stringValueOrEnv(data.ExpectedWorkspaceId, expectedWorkspaceIdEnvVarName, "")
+	expectedWorkspaceUrlKey := stringValueOrEnv(data.ExpectedWorkspaceUrlKey, expectedWorkspaceUrlKeyEnvVarName, "")
+
+	if expectedWorkspaceId != "" || expectedWorkspaceUrlKey != "" {
+		resp.Diagnostics.Append(checkWorkspace(ctx, client, expectedWorkspaceId, expectedWorkspaceUrlKey)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	client = &uploadingClient{
+		Client:  client,
+		uploads: &http.Client{Transport: uploadRoundTripper},
+	}
 
 	resp.DataSourceData = &client
 	resp.ResourceData = &client
@@ -99,10 +373,27 @@ func (p *LinearProvider) Configure(ctx context.Context, req provider.ConfigureRe
 
 func (p *LinearProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewCycleResource,
+		NewCustomViewResource,
+		NewEmailIntakeAddressResource,
+		NewEmojiResource,
+		NewInitiativeResource,
+		NewInitiativeProjectResource,
+		NewInitiativeRelationResource,
+		NewOrganizationDomainResource,
+		NewOrganizationInviteResource,
+		NewProjectResource,
+		NewProjectMilestoneResource,
+		NewProjectStatusResource,
 		NewTeamResource,
 		NewTeamLabelResource,
+		NewTeamMembersResource,
+		NewTeamMembershipResource,
 		NewTeamWorkflowResource,
 		NewTemplateResource,
+		NewTimeScheduleResource,
+		NewTriageResponsibilityResource,
+		NewWebhookResource,
 		NewWorkflowStateResource,
 		NewWorkspaceLabelResource,
 		NewWorkspaceSettingsResource,
@@ -115,6 +406,120 @@ func (p *LinearProvider) DataSources(ctx context.Context) []func() datasource.Da
 	}
 }
 
+// providerCredentials returns the token, access token and client id to
+// authenticate with. The environment variables are only used when none of them
+// is set in the provider configuration block, so that a token in the
+// environment does not conflict with the credentials that are configured.
+func providerCredentials(data LinearProviderModel) (string, string, string) {
+	token := data.Token.ValueString()
+	accessToken := data.AccessToken.ValueString()
+	clientId := data.ClientId.ValueString()
+
+	if token != "" || accessToken != "" || clientId != "" {
+		return token, accessToken, clientId
+	}
+
+	return os.Getenv(envVarName), os.Getenv(accessTokenEnvVarName), os.Getenv(clientIdEnvVarName)
+}
+
+// checkWorkspace makes sure that the credentials belong to the expected
+// workspace, so that a token of the wrong workspace is never used to apply.
+func checkWorkspace(ctx context.Context, client graphql.Client, expectedId string, expectedUrlKey string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	response, err := getWorkspace(ctx, client)
+
+	if err != nil {
+		diags.AddError("Unable to verify workspace", fmt.Sprintf("Unable to read the workspace the credentials belong to, got error: %s", err))
+		return diags
+	}
+
+	workspace := response.Organization
+
+	if (expectedId != "" && workspace.Id != expectedId) || (expectedUrlKey != "" && workspace.UrlKey != expectedUrlKey) {
+		expected := expectedUrlKey
+
+		if expected == "" {
+			expected = expectedId
+		}
+
+		diags.AddError(
+			"Unexpected workspace",
+			fmt.Sprintf("The credentials belong to the workspace %q (url key %q, id %s), but the provider is configured to only manage the workspace %q. Check that the right token is used.", workspace.Name, workspace.UrlKey, workspace.Id, expected),
+		)
+	}
+
+	return diags
+}
+
+// isHttpUrl reports whether the value is an absolute http or https URL.
+func isHttpUrl(value string) bool {
+	parsed, err := url.Parse(value)
+
+	return err == nil && (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != ""
+}
+
+// stringValueOrEnv returns the configured value, falling back to the given
+// environment variable and then to the default value.
+func stringValueOrEnv(value types.String, envVar string, defaultValue string) string {
+	if !value.IsNull() && value.ValueString() != "" {
+		return value.ValueString()
+	}
+
+	if env := os.Getenv(envVar); env != "" {
+		return env
+	}
+
+	return defaultValue
+}
+
+// boolValueOrEnv returns the configured value, falling back to the given
+// environment variable and then to the default value.
+func boolValueOrEnv(value types.Bool, envVar string, defaultValue bool, diags *diag.Diagnostics) bool {
+	if !value.IsNull() {
+		return value.ValueBool()
+	}
+
+	env := os.Getenv(envVar)
+
+	if env == "" {
+		return defaultValue
+	}
+
+	parsed, err := strconv.ParseBool(env)
+
+	if err != nil {
+		diags.AddError("Invalid provider configuration", fmt.Sprintf("Expected a boolean in the `%s` environment variable, got: %q", envVar, env))
+		return defaultValue
+	}
+
+	return parsed
+}
+
+// int64ValueOrEnv returns the configured value, falling back to the given
+// environment variable and then to the default value. An environment variable
+// that is not a number of at least min is reported as an error.
+func int64ValueOrEnv(value types.Int64, envVar string, defaultValue int64, min int64, diags *diag.Diagnostics) int64 {
+	if !value.IsNull() {
+		return value.ValueInt64()
+	}
+
+	env := os.Getenv(envVar)
+
+	if env == "" {
+		return defaultValue
+	}
+
+	parsed, err := strconv.ParseInt(env, 10, 64)
+
+	if err != nil || parsed < min {
+		diags.AddError("Invalid provider configuration", fmt.Sprintf("Expected a number of at least %d in the `%s` environment variable, got: %q", min, envVar, env))
+		return defaultValue
+	}
+
+	return parsed
+}
+
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
 		return &LinearProvider{
@@ -1,11 +1,18 @@
 package provider
 
 import (
+	"fmt"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/terraform-community-providers/terraform-provider-linear/internal/linearmock"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -16,8 +23,204 @@ var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServe
 	"linear": providerserver.NewProtocol6WithError(New("test")()),
 }
 
+// testAccPreCheck runs the acceptance tests against the Linear API when
+// `LINEAR_TOKEN` is set, and against a fresh in-memory mock of the API
+// otherwise. When `LINEAR_CASSETTE_MODE` is set, the API interactions of each
+// test are recorded to, or replayed from, a cassette in testdata/cassettes.
 func testAccPreCheck(t *testing.T) {
-	if v := os.Getenv("LINEAR_TOKEN"); v == "" {
-		t.Fatal("LINEAR_TOKEN must be set for acceptance tests")
+	if mode := os.Getenv(cassetteModeEnvVarName); mode != "" {
+		path := filepath.Join("testdata", "cassettes", t.Name()+".json")
+
+		t.Setenv(cassetteEnvVarName, path)
+
+		t.Cleanup(func() {
+			if err := ejectCassette(path); err != nil {
+				t.Errorf("unable to save cassette: %s", err)
+			}
+		})
+
+		if mode == cassetteModeReplay {
+			t.Setenv("LINEAR_TOKEN", "lin_api_replay")
+			return
+		}
+	}
+
+	if v := os.Getenv("LINEAR_TOKEN"); v != "" {
+		return
+	}
+
+	server := testAccMockServer(t)
+
+	t.Setenv("LINEAR_TOKEN", "lin_api_mock")
+	t.Setenv(apiUrlEnvVarName, server.URL)
+}
+
+// testAccMockServer starts a mock Linear API seeded with the fixtures that the
+// acceptance tests expect to exist in the test workspace.
+func testAccMockServer(t *testing.T) *httptest.Server {
+	schema, err := linearmock.LoadSchema("../../schema.graphql")
+
+	if err != nil {
+		t.Fatalf("unable to load schema: %s", err)
+	}
+
+	mock := linearmock.NewServer(schema)
+
+	mock.Add("Organization", linearmock.Object{
+		"id":                               "1e73fcad-aac6-4bbe-a5e1-e08cffe04eb5",
+		"name":                             "terraform",
+		"urlKey":                           "terraform-test",
+		"allowMembersToInvite":             true,
+		"gitLinkbackMessagesEnabled":       true,
+		"projectUpdateRemindersDay":        "Friday",
+		"projectUpdateRemindersHour":       float64(14),
+		"initiativeUpdateRemindersDay":     "Friday",
+		"initiativeUpdateRemindersHour":    float64(14),
+		"defaultFeedSummarySchedule":       "daily",
+		"gitPublicLinkbackMessagesEnabled": false,
+	})
+
+	mock.Add("Team", linearmock.Object{
+		"id":                             "ff0a060a-eceb-4b34-9140-fd7231f0cd28",
+		"key":                            "DEF",
+		"name":                           "Default",
+		"icon":                           "Bank",
+		"color":                          "#bec2c8",
+		"timezone":                       "Etc/GMT",
+		"groupIssueHistory":              true,
+		"setIssueSortOrderOnStateChange": "first",
+		"autoArchivePeriod":              float64(6),
+		"autoClosePeriod":                float64(6),
+		"cycleDuration":                  float64(1),
+		"upcomingCycleCount":             float64(2),
+		"issueEstimationType":            "notUsed",
+		"defaultIssueEstimate":           float64(1),
+	})
+
+	for _, state := range []linearmock.Object{
+		{"name": "Backlog", "color": "#bec2c8", "type": "backlog"},
+		{"id": "5dbca6c1-9ee2-4bf7-a275-8b69ae27ad14", "name": "Todo", "color": "#e2e2e2", "type": "unstarted"},
+		{"id": "9b6fdbd0-fd66-4ea2-a01d-a24ecf0c1191", "name": "In Progress", "color": "#f2c94c", "type": "started"},
+		{"id": "53099a59-c811-4b9c-8016-5443ce513de4", "name": "In Review", "color": "#0f783c", "type": "started", "position": float64(1)},
+		{"id": "66df5c88-cae8-416b-b4e9-85a42b159e18", "name": "Done", "color": "#5e6ad2", "type": "completed"},
+		{"name": "Canceled", "color": "#95a2b3", "type": "canceled"},
+	} {
+		state["team"] = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+		mock.Add("WorkflowState", state)
+	}
+
+	mock.Add("IssueLabel", linearmock.Object{
+		"id":      "db165e46-2b39-4516-8605-e7b2cb749c1c",
+		"name":    "Team Group",
+		"color":   "#5e6ad2",
+		"isGroup": true,
+		"team":    "ff0a060a-eceb-4b34-9140-fd7231f0cd28",
+	})
+
+	mock.Add("IssueLabel", linearmock.Object{
+		"id":      "09b38784-8d8b-453a-83b6-84c08d094803",
+		"name":    "Workspace Group",
+		"color":   "#5e6ad2",
+		"isGroup": true,
+	})
+
+	mock.Add("IssueLabel", linearmock.Object{
+		"id":    "53c7964a-5bd4-4679-8cca-a5b78498b2b3",
+		"name":  "Bug",
+		"color": "#eb5757",
+	})
+
+	for _, status := range []linearmock.Object{
+		{"id": "2f3c4f1e-8a8e-4b8f-9c6a-0e0f1d5c7a11", "name": "Backlog", "color": "#bec2c8", "type": "backlog"},
+		{"id": "8c2b8d4a-1f0e-4c55-9b0a-6d1c3e7f9a22", "name": "Planned", "color": "#e2e2e2", "type": "planned", "position": float64(1)},
+		{"id": "c5e6a7b8-3d2c-4e1f-8a9b-7c6d5e4f3a33", "name": "In Progress", "color": "#f2c94c", "type": "started", "position": float64(2)},
+		{"id": "4a5b6c7d-8e9f-4a0b-9c1d-2e3f4a5b6c44", "name": "Completed", "color": "#5e6ad2", "type": "completed", "position": float64(3)},
+		{"id": "9d8c7b6a-5f4e-4d3c-8b2a-1f0e9d8c7b55", "name": "Canceled", "color": "#95a2b3", "type": "canceled", "position": float64(4)},
+	} {
+		mock.Add("ProjectStatus", status)
+	}
+
+	mock.Add("User", linearmock.Object{
+		"id":          "6f5ae5a5-1b4c-4f2a-9c77-2c0b8a3d9e66",
+		"name":        "Terraform",
+		"displayName": "terraform",
+		"email":       "terraform@example.com",
+		"active":      true,
+		"admin":       true,
+	})
+
+	mock.Add("User", linearmock.Object{
+		"id":          "3b7c9e2d-4a1f-4e6b-8d5c-9f0a1b2c3d77",
+		"name":        "Reviewer",
+		"displayName": "reviewer",
+		"email":       "reviewer@example.com",
+		"active":      true,
+		"admin":       false,
+	})
+
+	server := httptest.NewServer(mock)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestAccProviderExpectedWorkspace(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderExpectedWorkspaceConfig("expected_workspace_url_key", "production"),
+				ExpectError: regexp.MustCompile("Unexpected workspace"),
+			},
+			{
+				Config:      testAccProviderExpectedWorkspaceConfig("expected_workspace_id", "00000000-0000-4000-8000-000000000000"),
+				ExpectError: regexp.MustCompile("Unexpected workspace"),
+			},
+			{
+				Config: testAccProviderExpectedWorkspaceConfig("expected_workspace_url_key", "terraform-test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.linear_workspace.test", "url_key", "terraform-test"),
+				),
+			},
+			{
+				Config: testAccProviderExpectedWorkspaceConfig("expected_workspace_id", "1e73fcad-aac6-4bbe-a5e1-e08cffe04eb5"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.linear_workspace.test", "id", "1e73fcad-aac6-4bbe-a5e1-e08cffe04eb5"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProviderExpectedWorkspaceConfig(attribute string, value string) string {
+	return fmt.Sprintf(`
+provider "linear" {
+  %s = "%s"
+}
+
+data "linear_workspace" "test" {}
+`, attribute, value)
+}
+
+func TestProviderCredentials(t *testing.T) {
+	t.Setenv("LINEAR_TOKEN", "lin_api_env")
+	t.Setenv(accessTokenEnvVarName, "")
+	t.Setenv(clientIdEnvVarName, "")
+
+	token, accessToken, clientId := providerCredentials(LinearProviderModel{
+		AccessToken: types.StringValue("lin_oauth_config"),
+	})
+
+	if token != "" || accessToken != "lin_oauth_config" || clientId != "" {
+		t.Errorf("expected only the configured access token, got %q, %q, %q", token, accessToken, clientId)
+	}
+
+	token, accessToken, clientId = providerCredentials(LinearProviderModel{
+		Token: types.StringNull(),
+	})
+
+	if token != "lin_api_env" || accessToken != "" || clientId != "" {
+		t.Errorf("expected the token from the environment, got %q, %q, %q", token, accessToken, clientId)
 	}
 }
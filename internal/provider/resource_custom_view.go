@@ -0,0 +1,383 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &CustomViewResource{}
+var _ resource.ResourceWithImportState = &CustomViewResource{}
+var _ resource.ResourceWithValidateConfig = &CustomViewResource{}
+
+func NewCustomViewResource() resource.Resource {
+	return &CustomViewResource{}
+}
+
+type CustomViewResource struct {
+	client *graphql.Client
+}
+
+type CustomViewResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Description   types.String `tfsdk:"description"`
+	Icon          types.String `tfsdk:"icon"`
+	Color         types.String `tfsdk:"color"`
+	TeamId        types.String `tfsdk:"team_id"`
+	Shared        types.Bool   `tfsdk:"shared"`
+	Filter        types.String `tfsdk:"filter"`
+	ProjectFilter types.String `tfsdk:"project_filter"`
+}
+
+func (r *CustomViewResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_view"
+}
+
+func (r *CustomViewResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Linear custom view.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the custom view.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the custom view.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the custom view.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"icon": schema.StringAttribute{
+				MarkdownDescription: "Icon of the custom view.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"color": schema.StringAttribute{
+				MarkdownDescription: "Color of the custom view.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(colorRegex(), "must be a hex color"),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the team the custom view belongs to. The custom view belongs to the workspace when not set.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"shared": schema.BoolAttribute{
+				MarkdownDescription: "Whether the custom view is shared with everyone. **Default** `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"filter": schema.StringAttribute{
+				MarkdownDescription: "Issue filter of the custom view, as a JSON encoded `IssueFilter`. Differences in formatting or key order are ignored.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					filterPlanModifier{},
+				},
+			},
+			"project_filter": schema.StringAttribute{
+				MarkdownDescription: "Project filter of the custom view, as a JSON encoded `ProjectFilter`. Differences in formatting or key order are ignored.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					filterPlanModifier{},
+				},
+			},
+		},
+	}
+}
+
+func (r *CustomViewResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *CustomViewResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for attribute, value := range map[string]types.String{"filter": data.Filter, "project_filter": data.ProjectFilter} {
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		if _, err := parseFilter(value); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(attribute), "Invalid Filter", fmt.Sprintf("Expected a JSON object. Got: %q", value.ValueString()))
+		}
+	}
+}
+
+func (r *CustomViewResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CustomViewResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *CustomViewResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := CustomViewCreateInput{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueStringPointer(),
+		Icon:        data.Icon.ValueStringPointer(),
+		Color:       data.Color.ValueStringPointer(),
+		TeamId:      data.TeamId.ValueStringPointer(),
+		Shared:      data.Shared.ValueBool(),
+	}
+
+	if !data.Filter.IsNull() {
+		filter, _ := parseFilter(data.Filter)
+		input.FilterData = &filter
+	}
+
+	if !data.ProjectFilter.IsNull() {
+		filter, _ := parseFilter(data.ProjectFilter)
+		input.ProjectFilterData = &filter
+	}
+
+	response, err := createCustomView(ctx, *r.client, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create custom view, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a custom view")
+
+	readCustomView(data, response.CustomViewCreate.CustomView.CustomView)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomViewResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *CustomViewResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := getCustomView(ctx, *r.client, data.Id.ValueString())
+
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read custom view, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "read a custom view")
+
+	readCustomView(data, response.CustomView.CustomView)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomViewResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *CustomViewResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An empty filter clears the filter of the custom view
+	filter, _ := parseFilter(data.Filter)
+	projectFilter, _ := parseFilter(data.ProjectFilter)
+
+	input := CustomViewUpdateInput{
+		Name:              data.Name.ValueString(),
+		Description:       data.Description.ValueStringPointer(),
+		Icon:              data.Icon.ValueStringPointer(),
+		Color:             data.Color.ValueStringPointer(),
+		TeamId:            data.TeamId.ValueStringPointer(),
+		Shared:            data.Shared.ValueBool(),
+		FilterData:        &filter,
+		ProjectFilterData: &projectFilter,
+	}
+
+	response, err := updateCustomView(ctx, *r.client, input, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update custom view, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated a custom view")
+
+	readCustomView(data, response.CustomViewUpdate.CustomView.CustomView)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomViewResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *CustomViewResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := deleteCustomView(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete custom view, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a custom view")
+}
+
+func (r *CustomViewResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func readCustomView(data *CustomViewResourceModel, view CustomView) {
+	data.Id = types.StringValue(view.Id)
+	data.Name = types.StringValue(view.Name)
+	data.Description = types.StringPointerValue(view.Description)
+	data.Icon = types.StringPointerValue(view.Icon)
+	data.Color = types.StringPointerValue(view.Color)
+	data.Shared = types.BoolValue(view.Shared)
+	data.Filter = readFilter(data.Filter, view.FilterData)
+	data.ProjectFilter = readFilter(data.ProjectFilter, view.ProjectFilterData)
+
+	if view.Team != nil {
+		data.TeamId = types.StringValue(view.Team.Id)
+	} else {
+		data.TeamId = types.StringNull()
+	}
+}
+
+// parseFilter decodes a JSON encoded filter, which is empty when not set.
+func parseFilter(value types.String) (map[string]interface{}, error) {
+	filter := map[string]interface{}{}
+
+	if value.IsNull() || value.IsUnknown() {
+		return filter, nil
+	}
+
+	err := json.Unmarshal([]byte(value.ValueString()), &filter)
+
+	if filter == nil {
+		return nil, fmt.Errorf("filter is not an object")
+	}
+
+	return filter, err
+}
+
+// filterPlanModifier keeps the filter of the state when the configured filter
+// only differs from it in formatting or key order.
+type filterPlanModifier struct{}
+
+func (m filterPlanModifier) Description(ctx context.Context) string {
+	return "Ignores differences in formatting or key order of the filter."
+}
+
+func (m filterPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m filterPlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.StateValue.IsNull() || req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+
+	planned, err := parseFilter(req.PlanValue)
+
+	if err != nil {
+		return
+	}
+
+	if current, err := parseFilter(req.StateValue); err == nil && reflect.DeepEqual(planned, current) {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+// readFilter keeps the configured formatting and key order of a filter,
+// unless the filter itself changed. An empty filter is read as not set.
+func readFilter(current types.String, value map[string]interface{}) types.String {
+	if parsed, err := parseFilter(current); err == nil && reflect.DeepEqual(parsed, normalizeFilter(value)) {
+		return current
+	}
+
+	if len(value) == 0 {
+		return types.StringNull()
+	}
+
+	encoded, _ := json.Marshal(value)
+
+	return types.StringValue(string(encoded))
+}
+
+// normalizeFilter gives a filter read from the API the same types as a
+// filter decoded from the configuration.
+func normalizeFilter(value map[string]interface{}) map[string]interface{} {
+	normalized := map[string]interface{}{}
+
+	if len(value) == 0 {
+		return normalized
+	}
+
+	if encoded, err := json.Marshal(value); err == nil {
+		_ = json.Unmarshal(encoded, &normalized)
+	}
+
+	return normalized
+}
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCustomViewResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCustomViewResourceConfigDefault("Blocked"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_custom_view.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_custom_view.test", "name", "Blocked"),
+					resource.TestCheckNoResourceAttr("linear_custom_view.test", "description"),
+					resource.TestCheckNoResourceAttr("linear_custom_view.test", "icon"),
+					resource.TestCheckNoResourceAttr("linear_custom_view.test", "color"),
+					resource.TestCheckNoResourceAttr("linear_custom_view.test", "team_id"),
+					resource.TestCheckResourceAttr("linear_custom_view.test", "shared", "false"),
+					resource.TestCheckNoResourceAttr("linear_custom_view.test", "filter"),
+					resource.TestCheckNoResourceAttr("linear_custom_view.test", "project_filter"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_custom_view.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccCustomViewResourceConfigNonDefault("Blocked in current cycle"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_custom_view.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_custom_view.test", "name", "Blocked in current cycle"),
+					resource.TestCheckResourceAttr("linear_custom_view.test", "description", "blocked issues"),
+					resource.TestCheckResourceAttr("linear_custom_view.test", "icon", "Warning"),
+					resource.TestCheckResourceAttr("linear_custom_view.test", "color", "#eb5757"),
+					resource.TestCheckResourceAttr("linear_custom_view.test", "team_id", "ff0a060a-eceb-4b34-9140-fd7231f0cd28"),
+					resource.TestCheckResourceAttr("linear_custom_view.test", "shared", "true"),
+					resource.TestCheckResourceAttr("linear_custom_view.test", "filter", "{\n  \"hasBlockedByRelations\": { \"eq\": true },\n  \"cycle\": { \"isActive\": { \"eq\": true } }\n}\n"),
+				),
+			},
+			// Reordered filter testing
+			{
+				Config:   testAccCustomViewResourceConfigReordered("Blocked in current cycle"),
+				PlanOnly: true,
+			},
+			// ImportState testing
+			{
+				ResourceName:            "linear_custom_view.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"filter"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccCustomViewResourceConfigDefault("Blocked"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("linear_custom_view.test", "name", "Blocked"),
+					resource.TestCheckNoResourceAttr("linear_custom_view.test", "team_id"),
+					resource.TestCheckNoResourceAttr("linear_custom_view.test", "filter"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccCustomViewResourceInvalidFilter(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "linear_custom_view" "test" {
+  name = "Invalid"
+  filter = "[]"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Filter`),
+			},
+		},
+	})
+}
+
+func testAccCustomViewResourceConfigDefault(name string) string {
+	return fmt.Sprintf(`
+resource "linear_custom_view" "test" {
+  name = "%s"
+}
+`, name)
+}
+
+func testAccCustomViewResourceConfigNonDefault(name string) string {
+	return fmt.Sprintf(`
+resource "linear_custom_view" "test" {
+  name = "%s"
+  description = "blocked issues"
+  icon = "Warning"
+  color = "#eb5757"
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+  shared = true
+  filter = <<-EOT
+    {
+      "hasBlockedByRelations": { "eq": true },
+      "cycle": { "isActive": { "eq": true } }
+    }
+  EOT
+}
+`, name)
+}
+
+func testAccCustomViewResourceConfigReordered(name string) string {
+	return fmt.Sprintf(`
+resource "linear_custom_view" "test" {
+  name = "%s"
+  description = "blocked issues"
+  icon = "Warning"
+  color = "#eb5757"
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+  shared = true
+  filter = jsonencode({ cycle = { isActive = { eq = true } }, hasBlockedByRelations = { eq = true } })
+}
+`, name)
+}
@@ -0,0 +1,373 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &CycleResource{}
+var _ resource.ResourceWithImportState = &CycleResource{}
+var _ resource.ResourceWithValidateConfig = &CycleResource{}
+var _ resource.ResourceWithModifyPlan = &CycleResource{}
+
+func NewCycleResource() resource.Resource {
+	return &CycleResource{}
+}
+
+type CycleResource struct {
+	client *graphql.Client
+}
+
+type CycleResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Number      types.Int64  `tfsdk:"number"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	StartsAt    types.String `tfsdk:"starts_at"`
+	EndsAt      types.String `tfsdk:"ends_at"`
+	TeamId      types.String `tfsdk:"team_id"`
+}
+
+func (r *CycleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cycle"
+}
+
+func (r *CycleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Linear team cycle. Planning rejects a cycle that overlaps with existing cycles of its team. Cycles that do not exist yet, such as other `linear_cycle` resources created in the same apply, are not checked against each other, so an overlap between them only fails when applying.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the cycle.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"number": schema.Int64Attribute{
+				MarkdownDescription: "Number of the cycle within the team.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the cycle.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the cycle.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"starts_at": schema.StringAttribute{
+				MarkdownDescription: "Start time of the cycle, in RFC 3339 format.",
+				Required:            true,
+			},
+			"ends_at": schema.StringAttribute{
+				MarkdownDescription: "End time of the cycle, in RFC 3339 format.",
+				Required:            true,
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the team.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+		},
+	}
+}
+
+func (r *CycleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CycleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *CycleResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	startsAt, startsErr := time.Parse(time.RFC3339, data.StartsAt.ValueString())
+	endsAt, endsErr := time.Parse(time.RFC3339, data.EndsAt.ValueString())
+
+	if !data.StartsAt.IsUnknown() && startsErr != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("starts_at"), "Invalid Time", fmt.Sprintf("Expected a time in RFC 3339 format. Got: %q", data.StartsAt.ValueString()))
+	}
+
+	if !data.EndsAt.IsUnknown() && endsErr != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("ends_at"), "Invalid Time", fmt.Sprintf("Expected a time in RFC 3339 format. Got: %q", data.EndsAt.ValueString()))
+	}
+
+	if startsErr == nil && endsErr == nil && !endsAt.After(startsAt) {
+		resp.Diagnostics.AddAttributeError(path.Root("ends_at"), "Invalid Time", "The cycle must end after it starts.")
+	}
+}
+
+func (r *CycleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the cycle is destroyed or the provider is not configured yet
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var data *CycleResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.TeamId.IsUnknown() {
+		return
+	}
+
+	startsAt, startsErr := time.Parse(time.RFC3339, data.StartsAt.ValueString())
+	endsAt, endsErr := time.Parse(time.RFC3339, data.EndsAt.ValueString())
+
+	if startsErr != nil || endsErr != nil {
+		return
+	}
+
+	// Only the existing cycles of the team are known here, so cycles planned in
+	// the same run are not checked against each other
+	cycles, err := listTeamCycles(ctx, *r.client, data.TeamId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read team cycles, got error: %s", err))
+		return
+	}
+
+	for _, cycle := range cycles {
+		if cycle.Id == data.Id.ValueString() {
+			continue
+		}
+
+		if startsAt.Before(cycle.EndsAt) && cycle.StartsAt.Before(endsAt) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("starts_at"),
+				"Overlapping Cycle",
+				fmt.Sprintf("The cycle overlaps with existing cycle %d of the team, which runs from %s to %s.", int64(cycle.Number), cycle.StartsAt.Format(time.RFC3339), cycle.EndsAt.Format(time.RFC3339)),
+			)
+		}
+	}
+}
+
+func (r *CycleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *CycleResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	startsAt, _ := time.Parse(time.RFC3339, data.StartsAt.ValueString())
+	endsAt, _ := time.Parse(time.RFC3339, data.EndsAt.ValueString())
+
+	input := CycleCreateInput{
+		Name:        data.Name.ValueStringPointer(),
+		Description: data.Description.ValueStringPointer(),
+		StartsAt:    startsAt,
+		EndsAt:      endsAt,
+		TeamId:      data.TeamId.ValueString(),
+	}
+
+	response, err := createCycle(ctx, *r.client, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create cycle, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a cycle")
+
+	readCycle(data, response.CycleCreate.Cycle.Cycle)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CycleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *CycleResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := getCycle(ctx, *r.client, data.Id.ValueString())
+
+	// Cycles are archived rather than deleted
+	if isNotFound(err) || (err == nil && response.Cycle.ArchivedAt != nil) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read cycle, got error: %s", err))
+		return
+	}
+
+	readCycle(data, response.Cycle.Cycle)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CycleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *CycleResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	startsAt, _ := time.Parse(time.RFC3339, data.StartsAt.ValueString())
+	endsAt, _ := time.Parse(time.RFC3339, data.EndsAt.ValueString())
+
+	input := CycleUpdateInput{
+		Name:        data.Name.ValueStringPointer(),
+		Description: data.Description.ValueStringPointer(),
+		StartsAt:    startsAt,
+		EndsAt:      endsAt,
+	}
+
+	response, err := updateCycle(ctx, *r.client, input, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update cycle, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated a cycle")
+
+	readCycle(data, response.CycleUpdate.Cycle.Cycle)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CycleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *CycleResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := deleteCycle(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete cycle, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a cycle")
+}
+
+func (r *CycleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ":")
+	number, err := strconv.ParseInt(parts[len(parts)-1], 10, 64)
+
+	if len(parts) != 2 || parts[0] == "" || err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: team_key:cycle_number. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	response, err := findCycle(ctx, *r.client, float64(number), parts[0])
+
+	if err != nil || len(response.Cycles.Nodes) != 1 {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import cycle, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), response.Cycles.Nodes[0].Id)...)
+}
+
+func readCycle(data *CycleResourceModel, cycle Cycle) {
+	data.Id = types.StringValue(cycle.Id)
+	data.Number = types.Int64Value(int64(cycle.Number))
+	data.Name = types.StringPointerValue(cycle.Name)
+	data.Description = types.StringPointerValue(cycle.Description)
+	data.StartsAt = readTime(data.StartsAt, cycle.StartsAt)
+	data.EndsAt = readTime(data.EndsAt, cycle.EndsAt)
+	data.TeamId = types.StringValue(cycle.Team.Id)
+}
+
+// readTime keeps the configured format of a time, such as its time zone,
+// unless the time itself changed.
+func readTime(current types.String, value time.Time) types.String {
+	if parsed, err := time.Parse(time.RFC3339, current.ValueString()); err == nil && parsed.Equal(value) {
+		return current
+	}
+
+	return types.StringValue(value.UTC().Format(time.RFC3339))
+}
+
+// listTeamCycles pages through the cycles of a team.
+func listTeamCycles(ctx context.Context, client graphql.Client, teamId string) ([]Cycle, error) {
+	var cycles []Cycle
+
+	after := ""
+
+	for {
+		response, err := getTeamCycles(ctx, client, teamId, after)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, node := range response.Cycles.Nodes {
+			cycles = append(cycles, node.Cycle)
+		}
+
+		if !response.Cycles.PageInfo.HasNextPage {
+			return cycles, nil
+		}
+
+		after = response.Cycles.PageInfo.EndCursor
+	}
+}
@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccCycleResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCycleResourceConfigDefault("2030-01-07T00:00:00Z", "2030-01-21T00:00:00Z"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_cycle.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttrSet("linear_cycle.test", "number"),
+					resource.TestCheckNoResourceAttr("linear_cycle.test", "name"),
+					resource.TestCheckNoResourceAttr("linear_cycle.test", "description"),
+					resource.TestCheckResourceAttr("linear_cycle.test", "starts_at", "2030-01-07T00:00:00Z"),
+					resource.TestCheckResourceAttr("linear_cycle.test", "ends_at", "2030-01-21T00:00:00Z"),
+					resource.TestCheckResourceAttr("linear_cycle.test", "team_id", "ff0a060a-eceb-4b34-9140-fd7231f0cd28"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_cycle.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccCycleImportId("linear_cycle.test"),
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccCycleResourceConfigNonDefault("Hardening", "2030-01-07T09:00:00+09:00", "2030-01-14T09:00:00+09:00"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_cycle.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_cycle.test", "name", "Hardening"),
+					resource.TestCheckResourceAttr("linear_cycle.test", "description", "release prep"),
+					resource.TestCheckResourceAttr("linear_cycle.test", "starts_at", "2030-01-07T09:00:00+09:00"),
+					resource.TestCheckResourceAttr("linear_cycle.test", "ends_at", "2030-01-14T09:00:00+09:00"),
+					resource.TestCheckResourceAttr("linear_cycle.test", "team_id", "ff0a060a-eceb-4b34-9140-fd7231f0cd28"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "linear_cycle.test",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccCycleImportId("linear_cycle.test"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"starts_at", "ends_at"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccCycleResourceNonDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCycleResourceConfigNonDefault("Hardening", "2030-02-04T00:00:00Z", "2030-02-11T00:00:00Z"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_cycle.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_cycle.test", "name", "Hardening"),
+					resource.TestCheckResourceAttr("linear_cycle.test", "description", "release prep"),
+					resource.TestCheckResourceAttr("linear_cycle.test", "starts_at", "2030-02-04T00:00:00Z"),
+					resource.TestCheckResourceAttr("linear_cycle.test", "ends_at", "2030-02-11T00:00:00Z"),
+					resource.TestCheckResourceAttr("linear_cycle.test", "team_id", "ff0a060a-eceb-4b34-9140-fd7231f0cd28"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_cycle.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccCycleImportId("linear_cycle.test"),
+				ImportStateVerify: true,
+			},
+			// Update with null values
+			{
+				Config: testAccCycleResourceConfigDefault("2030-02-04T00:00:00Z", "2030-02-18T00:00:00Z"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_cycle.test", "id", uuidRegex()),
+					resource.TestCheckNoResourceAttr("linear_cycle.test", "name"),
+					resource.TestCheckNoResourceAttr("linear_cycle.test", "description"),
+					resource.TestCheckResourceAttr("linear_cycle.test", "starts_at", "2030-02-04T00:00:00Z"),
+					resource.TestCheckResourceAttr("linear_cycle.test", "ends_at", "2030-02-18T00:00:00Z"),
+					resource.TestCheckResourceAttr("linear_cycle.test", "team_id", "ff0a060a-eceb-4b34-9140-fd7231f0cd28"),
+				),
+			},
+			// Overlap testing
+			{
+				Config: testAccCycleResourceConfigDefault("2030-02-04T00:00:00Z", "2030-02-18T00:00:00Z") + `
+resource "linear_cycle" "overlap" {
+  starts_at = "2030-02-11T00:00:00Z"
+  ends_at = "2030-02-25T00:00:00Z"
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+}
+`,
+				ExpectError: regexp.MustCompile("Overlapping Cycle"),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccCycleResourceInvalidRange(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCycleResourceConfigDefault("2030-03-04T00:00:00Z", "2030-03-01T00:00:00Z"),
+				ExpectError: regexp.MustCompile("The cycle must end after it starts"),
+			},
+			{
+				Config:      testAccCycleResourceConfigDefault("2030-03-04", "2030-03-11T00:00:00Z"),
+				ExpectError: regexp.MustCompile("Expected a time in RFC 3339 format"),
+			},
+		},
+	})
+}
+
+func testAccCycleImportId(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", name)
+		}
+
+		return "DEF:" + rs.Primary.Attributes["number"], nil
+	}
+}
+
+func testAccCycleResourceConfigDefault(startsAt string, endsAt string) string {
+	return fmt.Sprintf(`
+resource "linear_cycle" "test" {
+  starts_at = "%s"
+  ends_at = "%s"
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+}
+`, startsAt, endsAt)
+}
+
+func testAccCycleResourceConfigNonDefault(name string, startsAt string, endsAt string) string {
+	return fmt.Sprintf(`
+resource "linear_cycle" "test" {
+  name = "%s"
+  description = "release prep"
+  starts_at = "%s"
+  ends_at = "%s"
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+}
+`, name, startsAt, endsAt)
+}
@@ -0,0 +1,356 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// emailIntakeDomain is the domain of the addresses Linear generates for
+// email intake.
+const emailIntakeDomain = "intake.linear.app"
+
+var _ resource.Resource = &EmailIntakeAddressResource{}
+var _ resource.ResourceWithImportState = &EmailIntakeAddressResource{}
+var _ resource.ResourceWithValidateConfig = &EmailIntakeAddressResource{}
+var _ resource.ResourceWithModifyPlan = &EmailIntakeAddressResource{}
+
+func NewEmailIntakeAddressResource() resource.Resource {
+	return &EmailIntakeAddressResource{}
+}
+
+type EmailIntakeAddressResource struct {
+	client *graphql.Client
+}
+
+type EmailIntakeAddressResourceModel struct {
+	Id                     types.String `tfsdk:"id"`
+	TeamId                 types.String `tfsdk:"team_id"`
+	TemplateId             types.String `tfsdk:"template_id"`
+	Enabled                types.Bool   `tfsdk:"enabled"`
+	RepliesEnabled         types.Bool   `tfsdk:"replies_enabled"`
+	SenderName             types.String `tfsdk:"sender_name"`
+	ForwardingEmailAddress types.String `tfsdk:"forwarding_email_address"`
+	RotateTrigger          types.String `tfsdk:"rotate_trigger"`
+	Address                types.String `tfsdk:"address"`
+	Email                  types.String `tfsdk:"email"`
+}
+
+func (r *EmailIntakeAddressResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_email_intake_address"
+}
+
+func (r *EmailIntakeAddressResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Linear email intake address, which creates issues in a team, or from a template, for the emails it receives.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the email intake address.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the team the issues are created in.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"template_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the template the issues are created from.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the email intake address accepts emails. **Default** `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"replies_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether replies to the issue are emailed back to the sender. **Default** `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"sender_name": schema.StringAttribute{
+				MarkdownDescription: "Name used for the outgoing emails.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"forwarding_email_address": schema.StringAttribute{
+				MarkdownDescription: "Email address that forwards its emails to the email intake address.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"rotate_trigger": schema.StringAttribute{
+				MarkdownDescription: "Arbitrary value, which generates a new address for the email intake address whenever it changes.",
+				Optional:            true,
+			},
+			"address": schema.StringAttribute{
+				MarkdownDescription: "Generated user name of the email intake address, which is the part of `email` before the `@`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Generated email address of the email intake address.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *EmailIntakeAddressResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *EmailIntakeAddressResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.TeamId.IsUnknown() || data.TemplateId.IsUnknown() {
+		return
+	}
+
+	if data.TeamId.IsNull() == data.TemplateId.IsNull() {
+		resp.Diagnostics.AddError("Invalid Email Intake Address Scope", "Expected either team_id or template_id to be set, but not both.")
+	}
+}
+
+func (r *EmailIntakeAddressResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to rotate when the email intake address is created or destroyed
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data *EmailIntakeAddressResourceModel
+	var state *EmailIntakeAddressResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() || data.RotateTrigger.Equal(state.RotateTrigger) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("address"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("email"), types.StringUnknown())...)
+}
+
+func (r *EmailIntakeAddressResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *EmailIntakeAddressResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *EmailIntakeAddressResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := EmailIntakeAddressCreateInput{
+		TeamId:                 data.TeamId.ValueStringPointer(),
+		TemplateId:             data.TemplateId.ValueStringPointer(),
+		RepliesEnabled:         data.RepliesEnabled.ValueBool(),
+		SenderName:             data.SenderName.ValueStringPointer(),
+		ForwardingEmailAddress: data.ForwardingEmailAddress.ValueStringPointer(),
+	}
+
+	response, err := createEmailIntakeAddress(ctx, *r.client, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create email intake address, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created an email intake address")
+
+	address := response.EmailIntakeAddressCreate.EmailIntakeAddress.EmailIntakeAddress
+
+	// New email intake addresses are always enabled
+	if !data.Enabled.ValueBool() {
+		response, err := updateEmailIntakeAddress(ctx, *r.client, emailIntakeAddressUpdateInput(data), address.Id)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update email intake address, got error: %s", err))
+			return
+		}
+
+		address = response.EmailIntakeAddressUpdate.EmailIntakeAddress.EmailIntakeAddress
+	}
+
+	readEmailIntakeAddress(data, address)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EmailIntakeAddressResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *EmailIntakeAddressResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := getEmailIntakeAddress(ctx, *r.client, data.Id.ValueString())
+
+	if isNotFound(err) || (err == nil && response.EmailIntakeAddress.ArchivedAt != nil) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read email intake address, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "read an email intake address")
+
+	readEmailIntakeAddress(data, response.EmailIntakeAddress.EmailIntakeAddress)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EmailIntakeAddressResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *EmailIntakeAddressResourceModel
+	var state *EmailIntakeAddressResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := updateEmailIntakeAddress(ctx, *r.client, emailIntakeAddressUpdateInput(data), data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update email intake address, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated an email intake address")
+
+	address := response.EmailIntakeAddressUpdate.EmailIntakeAddress.EmailIntakeAddress
+
+	if !data.RotateTrigger.Equal(state.RotateTrigger) {
+		response, err := rotateEmailIntakeAddress(ctx, *r.client, data.Id.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to rotate email intake address, got error: %s", err))
+			return
+		}
+
+		tflog.Trace(ctx, "rotated an email intake address")
+
+		address = response.EmailIntakeAddressRotate.EmailIntakeAddress.EmailIntakeAddress
+	}
+
+	readEmailIntakeAddress(data, address)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EmailIntakeAddressResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *EmailIntakeAddressResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := deleteEmailIntakeAddress(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete email intake address, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted an email intake address")
+}
+
+func (r *EmailIntakeAddressResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func emailIntakeAddressUpdateInput(data *EmailIntakeAddressResourceModel) EmailIntakeAddressUpdateInput {
+	return EmailIntakeAddressUpdateInput{
+		Enabled:                data.Enabled.ValueBool(),
+		RepliesEnabled:         data.RepliesEnabled.ValueBool(),
+		SenderName:             data.SenderName.ValueStringPointer(),
+		ForwardingEmailAddress: data.ForwardingEmailAddress.ValueStringPointer(),
+	}
+}
+
+func readEmailIntakeAddress(data *EmailIntakeAddressResourceModel, address EmailIntakeAddress) {
+	data.Id = types.StringValue(address.Id)
+	data.Enabled = types.BoolValue(address.Enabled)
+	data.RepliesEnabled = types.BoolValue(address.RepliesEnabled)
+	data.SenderName = types.StringPointerValue(address.SenderName)
+	data.ForwardingEmailAddress = types.StringPointerValue(address.ForwardingEmailAddress)
+	data.Address = types.StringValue(address.Address)
+	data.Email = types.StringValue(address.Address + "@" + emailIntakeDomain)
+
+	if address.Team != nil {
+		data.TeamId = types.StringValue(address.Team.Id)
+	} else {
+		data.TeamId = types.StringNull()
+	}
+
+	if address.Template != nil {
+		data.TemplateId = types.StringValue(address.Template.Id)
+	} else {
+		data.TemplateId = types.StringNull()
+	}
+}
@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccEmailIntakeAddressResourceDefault(t *testing.T) {
+	var address string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccEmailIntakeAddressResourceConfigDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_email_intake_address.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_email_intake_address.test", "team_id", "ff0a060a-eceb-4b34-9140-fd7231f0cd28"),
+					resource.TestCheckNoResourceAttr("linear_email_intake_address.test", "template_id"),
+					resource.TestCheckResourceAttr("linear_email_intake_address.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("linear_email_intake_address.test", "replies_enabled", "false"),
+					resource.TestCheckNoResourceAttr("linear_email_intake_address.test", "sender_name"),
+					resource.TestCheckNoResourceAttr("linear_email_intake_address.test", "forwarding_email_address"),
+					resource.TestCheckNoResourceAttr("linear_email_intake_address.test", "rotate_trigger"),
+					resource.TestMatchResourceAttr("linear_email_intake_address.test", "email", regexp.MustCompile("^[^@]+@intake.linear.app$")),
+					testAccEmailIntakeAddress("linear_email_intake_address.test", &address),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_email_intake_address.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccEmailIntakeAddressResourceConfigNonDefault("1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_email_intake_address.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_email_intake_address.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("linear_email_intake_address.test", "replies_enabled", "true"),
+					resource.TestCheckResourceAttr("linear_email_intake_address.test", "sender_name", "Support"),
+					resource.TestCheckResourceAttr("linear_email_intake_address.test", "forwarding_email_address", "support@example.com"),
+					resource.TestCheckResourceAttr("linear_email_intake_address.test", "rotate_trigger", "1"),
+					testAccEmailIntakeAddressRotated("linear_email_intake_address.test", &address),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "linear_email_intake_address.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rotate_trigger"},
+			},
+			// Update without rotating
+			{
+				Config: testAccEmailIntakeAddressResourceConfigDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("linear_email_intake_address.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("linear_email_intake_address.test", "replies_enabled", "false"),
+					resource.TestCheckNoResourceAttr("linear_email_intake_address.test", "sender_name"),
+					resource.TestCheckNoResourceAttr("linear_email_intake_address.test", "forwarding_email_address"),
+					testAccEmailIntakeAddressRotated("linear_email_intake_address.test", &address),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccEmailIntakeAddressResourceTemplate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccEmailIntakeAddressResourceConfigTemplate("Email intake"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_email_intake_address.test", "id", uuidRegex()),
+					resource.TestCheckNoResourceAttr("linear_email_intake_address.test", "team_id"),
+					resource.TestCheckResourceAttrPair("linear_email_intake_address.test", "template_id", "linear_template.test", "id"),
+					resource.TestCheckResourceAttr("linear_email_intake_address.test", "enabled", "false"),
+					resource.TestMatchResourceAttr("linear_email_intake_address.test", "address", regexp.MustCompile("^[^@]+$")),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_email_intake_address.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccEmailIntakeAddressResourceInvalidScope(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccEmailIntakeAddressResourceConfigInvalidScope(),
+				ExpectError: regexp.MustCompile("Invalid Email Intake Address Scope"),
+			},
+		},
+	})
+}
+
+// testAccEmailIntakeAddress remembers the generated address of an email intake
+// address.
+func testAccEmailIntakeAddress(name string, address *string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rawState, ok := state.RootModule().Resources[name]
+
+		if !ok {
+			return fmt.Errorf("Not Found: %s", name)
+		}
+
+		*address = rawState.Primary.Attributes["address"]
+
+		return nil
+	}
+}
+
+// testAccEmailIntakeAddressRotated checks that the generated address of an
+// email intake address changed since it was last remembered.
+func testAccEmailIntakeAddressRotated(name string, address *string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		previous := *address
+
+		if err := testAccEmailIntakeAddress(name, address)(state); err != nil {
+			return err
+		}
+
+		if *address == previous {
+			return fmt.Errorf("Expected the address to be rotated. Got: %q", *address)
+		}
+
+		return nil
+	}
+}
+
+func testAccEmailIntakeAddressResourceConfigDefault() string {
+	return `
+resource "linear_email_intake_address" "test" {
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+}
+`
+}
+
+func testAccEmailIntakeAddressResourceConfigNonDefault(rotateTrigger string) string {
+	return fmt.Sprintf(`
+resource "linear_email_intake_address" "test" {
+  team_id                  = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+  enabled                  = false
+  replies_enabled          = true
+  sender_name              = "Support"
+  forwarding_email_address = "support@example.com"
+  rotate_trigger           = "%s"
+}
+`, rotateTrigger)
+}
+
+func testAccEmailIntakeAddressResourceConfigTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "linear_template" "test" {
+  name = "%s"
+  type = "issue"
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+  data = jsonencode({
+    "title" = ""
+  })
+}
+
+resource "linear_email_intake_address" "test" {
+  template_id = linear_template.test.id
+  enabled     = false
+}
+`, name)
+}
+
+func testAccEmailIntakeAddressResourceConfigInvalidScope() string {
+	return `
+resource "linear_email_intake_address" "test" {
+  team_id     = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+  template_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+}
+`
+}
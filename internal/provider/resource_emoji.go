@@ -0,0 +1,329 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &EmojiResource{}
+var _ resource.ResourceWithImportState = &EmojiResource{}
+var _ resource.ResourceWithValidateConfig = &EmojiResource{}
+var _ resource.ResourceWithModifyPlan = &EmojiResource{}
+
+func NewEmojiResource() resource.Resource {
+	return &EmojiResource{}
+}
+
+type EmojiResource struct {
+	client *graphql.Client
+}
+
+type EmojiResourceModel struct {
+	Id       types.String `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	File     types.String `tfsdk:"file"`
+	FileHash types.String `tfsdk:"file_hash"`
+	Url      types.String `tfsdk:"url"`
+}
+
+func (r *EmojiResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_emoji"
+}
+
+func (r *EmojiResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Linear custom emoji. The image is either uploaded from the local `file`, or taken from `url`. Emojis can not be updated, so any change replaces the emoji.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the emoji.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the emoji, which is used as `:name:`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"file": schema.StringAttribute{
+				MarkdownDescription: "Path of the local image file of the emoji, which is uploaded to Linear.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"file_hash": schema.StringAttribute{
+				MarkdownDescription: "SHA256 hash of the content of `file`, which replaces the emoji when the file changes.",
+				Computed:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "URL of the image of the emoji. Computed when `file` is set.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *EmojiResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *EmojiResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Url.IsNull() && !data.Url.IsUnknown() && !isHttpUrl(data.Url.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("url"), "Invalid URL", fmt.Sprintf("Expected an absolute http(s) URL. Got: %q", data.Url.ValueString()))
+	}
+
+	if data.File.IsUnknown() || data.Url.IsUnknown() {
+		return
+	}
+
+	if data.File.IsNull() == data.Url.IsNull() {
+		resp.Diagnostics.AddError("Invalid Emoji Image", "Expected either file or url to be set, but not both.")
+	}
+}
+
+func (r *EmojiResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to hash when the emoji is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data *EmojiResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fileHash := types.StringNull()
+
+	switch {
+	case data.File.IsUnknown():
+		fileHash = types.StringUnknown()
+	case !data.File.IsNull():
+		content, err := os.ReadFile(data.File.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("file"), "Invalid File", fmt.Sprintf("Unable to read the emoji image, got error: %s", err))
+			return
+		}
+
+		fileHash = types.StringValue(hashFile(content))
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("file_hash"), fileHash)...)
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state *EmojiResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !fileHash.Equal(state.FileHash) {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("file_hash"))
+	}
+}
+
+func (r *EmojiResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *EmojiResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *EmojiResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := EmojiCreateInput{
+		Name: data.Name.ValueString(),
+		Url:  data.Url.ValueString(),
+	}
+
+	if !data.File.IsNull() {
+		content, err := os.ReadFile(data.File.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read emoji image, got error: %s", err))
+			return
+		}
+
+		fileHash := types.StringValue(hashFile(content))
+
+		// The file may have changed since it was planned
+		if !data.FileHash.IsUnknown() && !data.FileHash.Equal(fileHash) {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create emoji, got error: %s changed since the plan", data.File.ValueString()))
+			return
+		}
+
+		data.FileHash = fileHash
+
+		input.Url, err = uploadFile(ctx, *r.client, filepath.Base(data.File.ValueString()), fileContentType(data.File.ValueString(), content), content)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to upload emoji image, got error: %s", err))
+			return
+		}
+
+		tflog.Trace(ctx, "uploaded an emoji image")
+	}
+
+	response, err := createEmoji(ctx, *r.client, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create emoji, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created an emoji")
+
+	readEmoji(data, response.EmojiCreate.Emoji.Emoji)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EmojiResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *EmojiResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := getEmoji(ctx, *r.client, data.Id.ValueString())
+
+	if isNotFound(err) || (err == nil && response.Emoji.ArchivedAt != nil) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read emoji, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "read an emoji")
+
+	readEmoji(data, response.Emoji.Emoji)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EmojiResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *EmojiResourceModel
+
+	// Every attribute requires replacement, so there is nothing to update
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EmojiResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *EmojiResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := deleteEmoji(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete emoji, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted an emoji")
+}
+
+func (r *EmojiResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// readEmoji keeps the URL the emoji was created with, as Linear may serve the
+// image of the emoji from another URL.
+func readEmoji(data *EmojiResourceModel, emoji Emoji) {
+	data.Id = types.StringValue(emoji.Id)
+	data.Name = types.StringValue(emoji.Name)
+
+	if data.Url.IsNull() || data.Url.IsUnknown() {
+		data.Url = types.StringValue(emoji.Url)
+	}
+}
+
+func hashFile(content []byte) string {
+	sum := sha256.Sum256(content)
+
+	return hex.EncodeToString(sum[:])
+}
+
+// fileContentType guesses the content type of a file from its extension, or
+// from its content when the extension is unknown.
+func fileContentType(name string, content []byte) string {
+	if contentType := mime.TypeByExtension(filepath.Ext(name)); contentType != "" {
+		return contentType
+	}
+
+	return http.DetectContentType(content)
+}
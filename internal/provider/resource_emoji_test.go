@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEmojiResourceFile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "mascot.png")
+
+	writeFile := func(content string) func() {
+		return func() {
+			if err := os.WriteFile(file, []byte(content), 0o644); err != nil {
+				t.Fatalf("unable to write emoji image: %s", err)
+			}
+		}
+	}
+
+	writeFile("mascot")()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccEmojiResourceConfigFile("mascot", file),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_emoji.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_emoji.test", "name", "mascot"),
+					resource.TestCheckResourceAttr("linear_emoji.test", "file", file),
+					resource.TestCheckResourceAttr("linear_emoji.test", "file_hash", hashFile([]byte("mascot"))),
+					resource.TestMatchResourceAttr("linear_emoji.test", "url", regexp.MustCompile("^https?://.+/mascot.png$")),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "linear_emoji.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"file", "file_hash"},
+			},
+			// Replace when the file changes
+			{
+				PreConfig: writeFile("new mascot"),
+				Config:    testAccEmojiResourceConfigFile("mascot", file),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_emoji.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_emoji.test", "name", "mascot"),
+					resource.TestCheckResourceAttr("linear_emoji.test", "file_hash", hashFile([]byte("new mascot"))),
+					resource.TestMatchResourceAttr("linear_emoji.test", "url", regexp.MustCompile("^https?://.+/mascot.png$")),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccEmojiResourceUrl(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccEmojiResourceConfigUrl("status-green", "https://example.com/status-green.png"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_emoji.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_emoji.test", "name", "status-green"),
+					resource.TestCheckNoResourceAttr("linear_emoji.test", "file"),
+					resource.TestCheckNoResourceAttr("linear_emoji.test", "file_hash"),
+					resource.TestCheckResourceAttr("linear_emoji.test", "url", "https://example.com/status-green.png"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_emoji.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccEmojiResourceConfigUrl("status-red", "https://example.com/status-red.png"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_emoji.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_emoji.test", "name", "status-red"),
+					resource.TestCheckResourceAttr("linear_emoji.test", "url", "https://example.com/status-red.png"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccEmojiResourceInvalidImage(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccEmojiResourceConfigInvalidImage("mascot"),
+				ExpectError: regexp.MustCompile("Invalid Emoji Image"),
+			},
+		},
+	})
+}
+
+func testAccEmojiResourceConfigFile(name string, file string) string {
+	return fmt.Sprintf(`
+resource "linear_emoji" "test" {
+  name = "%s"
+  file = %q
+}
+`, name, file)
+}
+
+func testAccEmojiResourceConfigUrl(name string, url string) string {
+	return fmt.Sprintf(`
+resource "linear_emoji" "test" {
+  name = "%s"
+  url  = "%s"
+}
+`, name, url)
+}
+
+func testAccEmojiResourceConfigInvalidImage(name string) string {
+	return fmt.Sprintf(`
+resource "linear_emoji" "test" {
+  name = "%s"
+}
+`, name)
+}
@@ -0,0 +1,358 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &InitiativeResource{}
+var _ resource.ResourceWithImportState = &InitiativeResource{}
+
+func NewInitiativeResource() resource.Resource {
+	return &InitiativeResource{}
+}
+
+type InitiativeResource struct {
+	client *graphql.Client
+}
+
+type InitiativeResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	SlugId      types.String `tfsdk:"slug_id"`
+	Url         types.String `tfsdk:"url"`
+	Description types.String `tfsdk:"description"`
+	OwnerId     types.String `tfsdk:"owner_id"`
+	Status      types.String `tfsdk:"status"`
+	TargetDate  types.String `tfsdk:"target_date"`
+	Icon        types.String `tfsdk:"icon"`
+	Color       types.String `tfsdk:"color"`
+	Archived    types.Bool   `tfsdk:"archived"`
+}
+
+func (r *InitiativeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_initiative"
+}
+
+func (r *InitiativeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Linear initiative.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the initiative.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the initiative.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"slug_id": schema.StringAttribute{
+				MarkdownDescription: "Slug of the initiative, used in its URL.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "URL of the initiative.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the initiative.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"owner_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the user owning the initiative.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the initiative. **Default** `Planned`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("Planned"),
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"Planned", "Active", "Completed"}...),
+				},
+			},
+			"target_date": schema.StringAttribute{
+				MarkdownDescription: "Planned completion date of the initiative, in `YYYY-MM-DD` format.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(dateRegex(), "must be a date in YYYY-MM-DD format"),
+				},
+			},
+			"icon": schema.StringAttribute{
+				MarkdownDescription: "Icon of the initiative.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"color": schema.StringAttribute{
+				MarkdownDescription: "Color of the initiative.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(colorRegex(), "must be a hex color"),
+				},
+			},
+			"archived": schema.BoolAttribute{
+				MarkdownDescription: "Whether the initiative is archived. **Default** `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *InitiativeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *InitiativeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *InitiativeResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := InitiativeCreateInput{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueStringPointer(),
+		OwnerId:     data.OwnerId.ValueStringPointer(),
+		Status:      InitiativeStatus(data.Status.ValueString()),
+		TargetDate:  data.TargetDate.ValueStringPointer(),
+		Icon:        data.Icon.ValueStringPointer(),
+	}
+
+	if !data.Color.IsUnknown() {
+		value := data.Color.ValueString()
+		input.Color = &value
+	}
+
+	response, err := createInitiative(ctx, *r.client, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create initiative, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created an initiative")
+
+	initiative := response.InitiativeCreate.Initiative.Initiative
+
+	if data.Archived.ValueBool() {
+		_, err := archiveInitiative(ctx, *r.client, initiative.Id)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to archive initiative, got error: %s", err))
+			return
+		}
+
+		tflog.Trace(ctx, "archived an initiative")
+
+		readResponse, err := getInitiative(ctx, *r.client, initiative.Id)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read initiative, got error: %s", err))
+			return
+		}
+
+		initiative = readResponse.Initiative.Initiative
+	}
+
+	readInitiative(data, initiative)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InitiativeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *InitiativeResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := getInitiative(ctx, *r.client, data.Id.ValueString())
+
+	if isNotFound(err) || (err == nil && response.Initiative.Trashed) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read initiative, got error: %s", err))
+		return
+	}
+
+	readInitiative(data, response.Initiative.Initiative)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InitiativeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *InitiativeResourceModel
+	var state *InitiativeResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Archived initiatives can not be updated, so they are restored first
+	if state.Archived.ValueBool() {
+		_, err := unarchiveInitiative(ctx, *r.client, data.Id.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to unarchive initiative, got error: %s", err))
+			return
+		}
+
+		tflog.Trace(ctx, "unarchived an initiative")
+	}
+
+	input := InitiativeUpdateInput{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueStringPointer(),
+		OwnerId:     data.OwnerId.ValueStringPointer(),
+		Status:      InitiativeStatus(data.Status.ValueString()),
+		TargetDate:  data.TargetDate.ValueStringPointer(),
+		Icon:        data.Icon.ValueStringPointer(),
+	}
+
+	if !data.Color.IsUnknown() {
+		value := data.Color.ValueString()
+		input.Color = &value
+	}
+
+	response, err := updateInitiative(ctx, *r.client, input, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update initiative, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated an initiative")
+
+	initiative := response.InitiativeUpdate.Initiative.Initiative
+
+	if data.Archived.ValueBool() {
+		_, err := archiveInitiative(ctx, *r.client, initiative.Id)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to archive initiative, got error: %s", err))
+			return
+		}
+
+		tflog.Trace(ctx, "archived an initiative")
+
+		readResponse, err := getInitiative(ctx, *r.client, initiative.Id)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read initiative, got error: %s", err))
+			return
+		}
+
+		initiative = readResponse.Initiative.Initiative
+	}
+
+	readInitiative(data, initiative)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InitiativeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *InitiativeResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := deleteInitiative(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete initiative, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted an initiative")
+}
+
+func (r *InitiativeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func readInitiative(data *InitiativeResourceModel, initiative Initiative) {
+	data.Id = types.StringValue(initiative.Id)
+	data.Name = types.StringValue(initiative.Name)
+	data.SlugId = types.StringValue(initiative.SlugId)
+	data.Url = types.StringValue(initiative.Url)
+	data.Description = types.StringPointerValue(initiative.Description)
+	data.Status = types.StringValue(string(initiative.Status))
+	data.TargetDate = types.StringPointerValue(initiative.TargetDate)
+	data.Icon = types.StringPointerValue(initiative.Icon)
+	data.Color = types.StringPointerValue(initiative.Color)
+	data.Archived = types.BoolValue(initiative.ArchivedAt != nil)
+
+	if initiative.Owner != nil {
+		data.OwnerId = types.StringValue(initiative.Owner.Id)
+	} else {
+		data.OwnerId = types.StringNull()
+	}
+}
@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &InitiativeProjectResource{}
+var _ resource.ResourceWithImportState = &InitiativeProjectResource{}
+
+func NewInitiativeProjectResource() resource.Resource {
+	return &InitiativeProjectResource{}
+}
+
+type InitiativeProjectResource struct {
+	client *graphql.Client
+}
+
+type InitiativeProjectResourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	InitiativeId types.String `tfsdk:"initiative_id"`
+	ProjectId    types.String `tfsdk:"project_id"`
+}
+
+func (r *InitiativeProjectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_initiative_project"
+}
+
+func (r *InitiativeProjectResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Linear project of an initiative.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the link between the initiative and the project.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"initiative_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the initiative.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+		},
+	}
+}
+
+func (r *InitiativeProjectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *InitiativeProjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *InitiativeProjectResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := InitiativeToProjectCreateInput{
+		InitiativeId: data.InitiativeId.ValueString(),
+		ProjectId:    data.ProjectId.ValueString(),
+	}
+
+	response, err := createInitiativeToProject(ctx, *r.client, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add project to initiative, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "added a project to an initiative")
+
+	readInitiativeProject(data, response.InitiativeToProjectCreate.InitiativeToProject.InitiativeToProject)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InitiativeProjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *InitiativeProjectResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := getInitiativeToProject(ctx, *r.client, data.Id.ValueString())
+
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read initiative project, got error: %s", err))
+		return
+	}
+
+	readInitiativeProject(data, response.InitiativeToProject.InitiativeToProject)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InitiativeProjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *InitiativeProjectResourceModel
+
+	// Every attribute requires replacement, so there is nothing to update
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InitiativeProjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *InitiativeProjectResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := deleteInitiativeToProject(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove project from initiative, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "removed a project from an initiative")
+}
+
+func (r *InitiativeProjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ":")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: initiative_id:project_id. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	response, err := getInitiativeToProjects(ctx, *r.client)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import initiative project, got error: %s", err))
+		return
+	}
+
+	for _, node := range response.InitiativeToProjects.Nodes {
+		if node.Initiative.Id == parts[0] && node.Project.Id == parts[1] {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), node.Id)...)
+			return
+		}
+	}
+
+	resp.Diagnostics.AddError("Client Error", "Unable to import initiative project, got error: project is not part of the initiative")
+}
+
+func readInitiativeProject(data *InitiativeProjectResourceModel, initiativeToProject InitiativeToProject) {
+	data.Id = types.StringValue(initiativeToProject.Id)
+	data.InitiativeId = types.StringValue(initiativeToProject.Initiative.Id)
+	data.ProjectId = types.StringValue(initiativeToProject.Project.Id)
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccInitiativeProjectResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccInitiativeProjectResourceConfig("first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_initiative_project.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttrPair("linear_initiative_project.test", "initiative_id", "linear_initiative.test", "id"),
+					resource.TestCheckResourceAttrPair("linear_initiative_project.test", "project_id", "linear_project.first", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_initiative_project.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccInitiativeProjectImportId("linear_initiative_project.test"),
+				ImportStateVerify: true,
+			},
+			// Replace testing
+			{
+				Config: testAccInitiativeProjectResourceConfig("second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_initiative_project.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttrPair("linear_initiative_project.test", "initiative_id", "linear_initiative.test", "id"),
+					resource.TestCheckResourceAttrPair("linear_initiative_project.test", "project_id", "linear_project.second", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_initiative_project.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccInitiativeProjectImportId("linear_initiative_project.test"),
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccInitiativeProjectImportId(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", name)
+		}
+
+		return rs.Primary.Attributes["initiative_id"] + ":" + rs.Primary.Attributes["project_id"], nil
+	}
+}
+
+func testAccInitiativeProjectResourceConfig(project string) string {
+	return fmt.Sprintf(`
+resource "linear_initiative" "test" {
+  name = "Portfolio"
+}
+
+resource "linear_project" "first" {
+  name = "First"
+  team_ids = ["ff0a060a-eceb-4b34-9140-fd7231f0cd28"]
+}
+
+resource "linear_project" "second" {
+  name = "Second"
+  team_ids = ["ff0a060a-eceb-4b34-9140-fd7231f0cd28"]
+}
+
+resource "linear_initiative_project" "test" {
+  initiative_id = linear_initiative.test.id
+  project_id = linear_project.%s.id
+}
+`, project)
+}
@@ -0,0 +1,242 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &InitiativeRelationResource{}
+var _ resource.ResourceWithImportState = &InitiativeRelationResource{}
+
+func NewInitiativeRelationResource() resource.Resource {
+	return &InitiativeRelationResource{}
+}
+
+type InitiativeRelationResource struct {
+	client *graphql.Client
+}
+
+type InitiativeRelationResourceModel struct {
+	Id                 types.String `tfsdk:"id"`
+	ParentInitiativeId types.String `tfsdk:"parent_initiative_id"`
+	ChildInitiativeId  types.String `tfsdk:"child_initiative_id"`
+}
+
+func (r *InitiativeRelationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_initiative_relation"
+}
+
+func (r *InitiativeRelationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Linear sub-initiative, nesting an initiative under another one.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the relation.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"parent_initiative_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the parent initiative.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"child_initiative_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the initiative nested under the parent initiative.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+		},
+	}
+}
+
+func (r *InitiativeRelationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *InitiativeRelationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *InitiativeRelationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := InitiativeRelationCreateInput{
+		InitiativeId:        data.ParentInitiativeId.ValueString(),
+		RelatedInitiativeId: data.ChildInitiativeId.ValueString(),
+	}
+
+	response, err := createInitiativeRelation(ctx, *r.client, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create initiative relation, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created an initiative relation")
+
+	readInitiativeRelation(data, response.InitiativeRelationCreate.InitiativeRelation.InitiativeRelation)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InitiativeRelationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *InitiativeRelationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The API has no query for a single initiative relation, so it is looked up
+	// among all of them, which are shared between relations through the read cache
+	relation, err := findInitiativeRelation(ctx, *r.client, func(relation InitiativeRelation) bool {
+		return relation.Id == data.Id.ValueString()
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read initiative relation, got error: %s", err))
+		return
+	}
+
+	if relation == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	readInitiativeRelation(data, *relation)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InitiativeRelationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *InitiativeRelationResourceModel
+
+	// Every attribute requires replacement, so there is nothing to update
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InitiativeRelationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *InitiativeRelationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := deleteInitiativeRelation(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete initiative relation, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted an initiative relation")
+}
+
+func (r *InitiativeRelationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ":")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: parent_initiative_id:child_initiative_id. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	relation, err := findInitiativeRelation(ctx, *r.client, func(relation InitiativeRelation) bool {
+		return relation.Initiative.Id == parts[0] && relation.RelatedInitiative.Id == parts[1]
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import initiative relation, got error: %s", err))
+		return
+	}
+
+	if relation == nil {
+		resp.Diagnostics.AddError("Client Error", "Unable to import initiative relation, got error: initiative is not nested under the parent initiative")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), relation.Id)...)
+}
+
+// findInitiativeRelation pages through the initiative relations until one
+// matches, returning nil when none does.
+func findInitiativeRelation(ctx context.Context, client graphql.Client, match func(InitiativeRelation) bool) (*InitiativeRelation, error) {
+	after := ""
+
+	for {
+		response, err := getInitiativeRelations(ctx, client, after)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, node := range response.InitiativeRelations.Nodes {
+			if match(node.InitiativeRelation) {
+				return &node.InitiativeRelation, nil
+			}
+		}
+
+		pageInfo := response.InitiativeRelations.PageInfo
+
+		if !pageInfo.HasNextPage {
+			return nil, nil
+		}
+
+		after = pageInfo.EndCursor
+	}
+}
+
+func readInitiativeRelation(data *InitiativeRelationResourceModel, relation InitiativeRelation) {
+	data.Id = types.StringValue(relation.Id)
+	data.ParentInitiativeId = types.StringValue(relation.Initiative.Id)
+	data.ChildInitiativeId = types.StringValue(relation.RelatedInitiative.Id)
+}
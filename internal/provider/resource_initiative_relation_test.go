@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccInitiativeRelationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccInitiativeRelationResourceConfig("first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_initiative_relation.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttrPair("linear_initiative_relation.test", "parent_initiative_id", "linear_initiative.parent", "id"),
+					resource.TestCheckResourceAttrPair("linear_initiative_relation.test", "child_initiative_id", "linear_initiative.first", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_initiative_relation.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccInitiativeRelationImportId("linear_initiative_relation.test"),
+				ImportStateVerify: true,
+			},
+			// Replace testing
+			{
+				Config: testAccInitiativeRelationResourceConfig("second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_initiative_relation.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttrPair("linear_initiative_relation.test", "parent_initiative_id", "linear_initiative.parent", "id"),
+					resource.TestCheckResourceAttrPair("linear_initiative_relation.test", "child_initiative_id", "linear_initiative.second", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_initiative_relation.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccInitiativeRelationImportId("linear_initiative_relation.test"),
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccInitiativeRelationImportId(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", name)
+		}
+
+		return rs.Primary.Attributes["parent_initiative_id"] + ":" + rs.Primary.Attributes["child_initiative_id"], nil
+	}
+}
+
+func testAccInitiativeRelationResourceConfig(child string) string {
+	return fmt.Sprintf(`
+resource "linear_initiative" "parent" {
+  name = "Company Goals"
+}
+
+resource "linear_initiative" "first" {
+  name = "Growth"
+}
+
+resource "linear_initiative" "second" {
+  name = "Efficiency"
+}
+
+resource "linear_initiative_relation" "test" {
+  parent_initiative_id = linear_initiative.parent.id
+  child_initiative_id = linear_initiative.%s.id
+}
+`, child)
+}
@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccInitiativeResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccInitiativeResourceConfigDefault("Expansion"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_initiative.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_initiative.test", "name", "Expansion"),
+					resource.TestCheckResourceAttrSet("linear_initiative.test", "slug_id"),
+					resource.TestCheckResourceAttrSet("linear_initiative.test", "url"),
+					resource.TestCheckNoResourceAttr("linear_initiative.test", "description"),
+					resource.TestCheckNoResourceAttr("linear_initiative.test", "owner_id"),
+					resource.TestCheckResourceAttr("linear_initiative.test", "status", "Planned"),
+					resource.TestCheckNoResourceAttr("linear_initiative.test", "target_date"),
+					resource.TestCheckNoResourceAttr("linear_initiative.test", "icon"),
+					resource.TestCheckResourceAttr("linear_initiative.test", "archived", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_initiative.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccInitiativeResourceConfigNonDefault("Global Expansion"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_initiative.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_initiative.test", "name", "Global Expansion"),
+					resource.TestCheckResourceAttr("linear_initiative.test", "description", "new markets"),
+					resource.TestCheckResourceAttr("linear_initiative.test", "owner_id", "6f5ae5a5-1b4c-4f2a-9c77-2c0b8a3d9e66"),
+					resource.TestCheckResourceAttr("linear_initiative.test", "status", "Active"),
+					resource.TestCheckResourceAttr("linear_initiative.test", "target_date", "2024-12-31"),
+					resource.TestCheckResourceAttr("linear_initiative.test", "icon", "Globe"),
+					resource.TestCheckResourceAttr("linear_initiative.test", "color", "#00ff00"),
+					resource.TestCheckResourceAttr("linear_initiative.test", "archived", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_initiative.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccInitiativeResourceNonDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccInitiativeResourceConfigNonDefault("Reliability"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_initiative.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_initiative.test", "name", "Reliability"),
+					resource.TestCheckResourceAttr("linear_initiative.test", "description", "new markets"),
+					resource.TestCheckResourceAttr("linear_initiative.test", "owner_id", "6f5ae5a5-1b4c-4f2a-9c77-2c0b8a3d9e66"),
+					resource.TestCheckResourceAttr("linear_initiative.test", "status", "Active"),
+					resource.TestCheckResourceAttr("linear_initiative.test", "target_date", "2024-12-31"),
+					resource.TestCheckResourceAttr("linear_initiative.test", "icon", "Globe"),
+					resource.TestCheckResourceAttr("linear_initiative.test", "color", "#00ff00"),
+					resource.TestCheckResourceAttr("linear_initiative.test", "archived", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_initiative.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Archive testing
+			{
+				Config: testAccInitiativeResourceConfigArchived("Reliability"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("linear_initiative.test", "status", "Completed"),
+					resource.TestCheckResourceAttr("linear_initiative.test", "archived", "true"),
+				),
+			},
+			// Update with null values
+			{
+				Config: testAccInitiativeResourceConfigDefault("Reliability"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_initiative.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_initiative.test", "name", "Reliability"),
+					resource.TestCheckNoResourceAttr("linear_initiative.test", "description"),
+					resource.TestCheckNoResourceAttr("linear_initiative.test", "owner_id"),
+					resource.TestCheckResourceAttr("linear_initiative.test", "status", "Planned"),
+					resource.TestCheckNoResourceAttr("linear_initiative.test", "target_date"),
+					resource.TestCheckNoResourceAttr("linear_initiative.test", "icon"),
+					resource.TestCheckResourceAttr("linear_initiative.test", "color", "#00ff00"),
+					resource.TestCheckResourceAttr("linear_initiative.test", "archived", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_initiative.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccInitiativeResourceConfigDefault(name string) string {
+	return fmt.Sprintf(`
+resource "linear_initiative" "test" {
+  name = "%s"
+}
+`, name)
+}
+
+func testAccInitiativeResourceConfigNonDefault(name string) string {
+	return fmt.Sprintf(`
+resource "linear_initiative" "test" {
+  name = "%s"
+  description = "new markets"
+  owner_id = "6f5ae5a5-1b4c-4f2a-9c77-2c0b8a3d9e66"
+  status = "Active"
+  target_date = "2024-12-31"
+  icon = "Globe"
+  color = "#00ff00"
+}
+`, name)
+}
+
+func testAccInitiativeResourceConfigArchived(name string) string {
+	return fmt.Sprintf(`
+resource "linear_initiative" "test" {
+  name = "%s"
+  description = "new markets"
+  owner_id = "6f5ae5a5-1b4c-4f2a-9c77-2c0b8a3d9e66"
+  status = "Completed"
+  target_date = "2024-12-31"
+  icon = "Globe"
+  color = "#00ff00"
+  archived = true
+}
+`, name)
+}
@@ -0,0 +1,366 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &OrganizationDomainResource{}
+var _ resource.ResourceWithModifyPlan = &OrganizationDomainResource{}
+
+func NewOrganizationDomainResource() resource.Resource {
+	return &OrganizationDomainResource{}
+}
+
+type OrganizationDomainResource struct {
+	client *graphql.Client
+}
+
+type OrganizationDomainResourceModel struct {
+	Id                          types.String `tfsdk:"id"`
+	Name                        types.String `tfsdk:"name"`
+	VerificationEmail           types.String `tfsdk:"verification_email"`
+	AuthType                    types.String `tfsdk:"auth_type"`
+	VerificationCode            types.String `tfsdk:"verification_code"`
+	Claim                       types.Bool   `tfsdk:"claim"`
+	DisableOrganizationCreation types.Bool   `tfsdk:"disable_organization_creation"`
+	VerificationRecord          types.String `tfsdk:"verification_record"`
+	Verified                    types.Bool   `tfsdk:"verified"`
+	Claimed                     types.Bool   `tfsdk:"claimed"`
+}
+
+func (r *OrganizationDomainResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_domain"
+}
+
+func (r *OrganizationDomainResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Linear organization domain. The domain is verified either with the code emailed to `verification_email`, or claimed by publishing `verification_record` as a DNS TXT record of the domain. Both take two applies: the first one creates the domain, which sends the code and hands out `verification_record`, and `verification_code` or `claim` are set in a later one. The domain can not be imported.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the domain.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the domain.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"verification_email": schema.StringAttribute{
+				MarkdownDescription: "Email the verification code of the domain is sent to.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"auth_type": schema.StringAttribute{
+				MarkdownDescription: "Authentication type of the domain, either `general` or `saml`. **Default** `general`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("general"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(OrganizationDomainAuthTypeGeneral), string(OrganizationDomainAuthTypeSaml)),
+				},
+			},
+			"verification_code": schema.StringAttribute{
+				MarkdownDescription: "Code emailed to `verification_email`, which verifies the domain when set. Can only be set once the domain exists.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"claim": schema.BoolAttribute{
+				MarkdownDescription: "Whether to claim the domain, which requires `verification_record` to be published as a DNS TXT record of the domain. Can only be set once the domain exists, and the domain is claimed when it changes to `true`. **Default** `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"disable_organization_creation": schema.BoolAttribute{
+				MarkdownDescription: "Whether to prevent users with the domain from creating new workspaces. Requires `claim`. **Default** `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"verification_record": schema.StringAttribute{
+				MarkdownDescription: "Content of the DNS TXT record that proves the ownership of the domain.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"verified": schema.BoolAttribute{
+				MarkdownDescription: "Whether the domain is verified.",
+				Computed:            true,
+			},
+			"claimed": schema.BoolAttribute{
+				MarkdownDescription: "Whether the domain is claimed.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *OrganizationDomainResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the domain is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data *OrganizationDomainResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The verification record and code only exist once the domain is created,
+	// so the domain can not be claimed or verified in the same apply
+	if req.State.Raw.IsNull() && data.Claim.ValueBool() {
+		resp.Diagnostics.AddAttributeError(path.Root("claim"), "Invalid Claim", "The domain can only be claimed once `verification_record` is published as a DNS TXT record, which is known after the domain is created. Create the domain with `claim = false` first, and set `claim = true` in a later apply.")
+	}
+
+	if req.State.Raw.IsNull() && !data.VerificationCode.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("verification_code"), "Invalid Verification Code", "The verification code is emailed once the domain is created. Create the domain without `verification_code` first, and set it in a later apply.")
+	}
+
+	if !data.Claim.IsUnknown() && !data.Claim.ValueBool() && data.DisableOrganizationCreation.ValueBool() {
+		resp.Diagnostics.AddAttributeError(path.Root("disable_organization_creation"), "Invalid Organization Creation", "Organization creation can only be disabled on claimed domains, which requires `claim = true`.")
+	}
+}
+
+func (r *OrganizationDomainResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OrganizationDomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *OrganizationDomainResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := OrganizationDomainCreateInput{
+		Name:              data.Name.ValueString(),
+		VerificationEmail: data.VerificationEmail.ValueStringPointer(),
+		AuthType:          data.AuthType.ValueString(),
+	}
+
+	response, err := createOrganizationDomain(ctx, *r.client, input, !data.VerificationEmail.IsNull())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create organization domain, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created an organization domain")
+
+	readOrganizationDomain(data, response.OrganizationDomainCreate.OrganizationDomain.OrganizationDomain)
+
+	record, err := getOrganizationDomainClaimRequest(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read organization domain, got error: %s", err))
+		return
+	}
+
+	data.VerificationRecord = types.StringValue(record.OrganizationDomainClaimRequest.VerificationString)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationDomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *OrganizationDomainResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := getOrganizationDomainClaimRequest(ctx, *r.client, data.Id.ValueString())
+
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read organization domain, got error: %s", err))
+		return
+	}
+
+	domain, err := refreshOrganizationDomain(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read organization domain, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "read an organization domain")
+
+	readOrganizationDomain(data, domain.OrganizationDomainUpdate.OrganizationDomain.OrganizationDomain)
+
+	data.VerificationRecord = types.StringValue(response.OrganizationDomainClaimRequest.VerificationString)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationDomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *OrganizationDomainResourceModel
+	var state *OrganizationDomainResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Verified = state.Verified
+	data.Claimed = state.Claimed
+
+	if err := r.apply(ctx, data, state); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update organization domain, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated an organization domain")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationDomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *OrganizationDomainResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := deleteOrganizationDomain(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete organization domain, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted an organization domain")
+}
+
+// apply verifies the domain when the verification code changes and claims it
+// when claim changes to true, and then reads the domain back while updating
+// its settings, which may only be allowed once it is claimed.
+func (r *OrganizationDomainResource) apply(ctx context.Context, data *OrganizationDomainResourceModel, state *OrganizationDomainResourceModel) error {
+	if !data.VerificationCode.IsNull() && !data.VerificationCode.Equal(state.VerificationCode) && !data.Verified.ValueBool() {
+		response, err := verifyOrganizationDomain(ctx, *r.client, OrganizationDomainVerificationInput{
+			OrganizationDomainId: data.Id.ValueString(),
+			VerificationCode:     data.VerificationCode.ValueString(),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		tflog.Trace(ctx, "verified an organization domain")
+
+		readOrganizationDomain(data, response.OrganizationDomainVerify.OrganizationDomain.OrganizationDomain)
+	}
+
+	claimed := false
+
+	if data.Claim.ValueBool() && !state.Claim.ValueBool() && !data.Claimed.ValueBool() {
+		_, err := claimOrganizationDomain(ctx, *r.client, data.Id.ValueString())
+
+		if err != nil {
+			return err
+		}
+
+		tflog.Trace(ctx, "claimed an organization domain")
+
+		claimed = true
+	}
+
+	// Organization creation is only ever disabled on claimed domains, so other
+	// domains are only read back
+	if data.DisableOrganizationCreation.ValueBool() || data.Claimed.ValueBool() || claimed {
+		response, err := updateOrganizationDomain(ctx, *r.client, OrganizationDomainUpdateInput{
+			DisableOrganizationCreation: data.DisableOrganizationCreation.ValueBool(),
+		}, data.Id.ValueString())
+
+		if err != nil {
+			return err
+		}
+
+		readOrganizationDomain(data, response.OrganizationDomainUpdate.OrganizationDomain.OrganizationDomain)
+
+		return nil
+	}
+
+	response, err := refreshOrganizationDomain(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil {
+		return err
+	}
+
+	readOrganizationDomain(data, response.OrganizationDomainUpdate.OrganizationDomain.OrganizationDomain)
+
+	return nil
+}
+
+func readOrganizationDomain(data *OrganizationDomainResourceModel, domain OrganizationDomain) {
+	data.Id = types.StringValue(domain.Id)
+	data.Name = types.StringValue(domain.Name)
+	data.VerificationEmail = types.StringPointerValue(domain.VerificationEmail)
+	data.AuthType = types.StringValue(string(domain.AuthType))
+	data.Verified = types.BoolValue(domain.Verified)
+	data.Claimed = types.BoolValue(domain.Claimed != nil && *domain.Claimed)
+	data.DisableOrganizationCreation = types.BoolValue(domain.DisableOrganizationCreation != nil && *domain.DisableOrganizationCreation)
+}
@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrganizationDomainResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccOrganizationDomainResourceConfigDefault("tf-acc-default.example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_organization_domain.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_organization_domain.test", "name", "tf-acc-default.example.com"),
+					resource.TestCheckNoResourceAttr("linear_organization_domain.test", "verification_email"),
+					resource.TestCheckResourceAttr("linear_organization_domain.test", "auth_type", "general"),
+					resource.TestCheckResourceAttr("linear_organization_domain.test", "claim", "false"),
+					resource.TestCheckResourceAttr("linear_organization_domain.test", "disable_organization_creation", "false"),
+					resource.TestMatchResourceAttr("linear_organization_domain.test", "verification_record", regexp.MustCompile("^linear-domain-verification=")),
+					resource.TestCheckResourceAttr("linear_organization_domain.test", "verified", "false"),
+					resource.TestCheckResourceAttr("linear_organization_domain.test", "claimed", "false"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccOrganizationDomainResourceConfigClaim("tf-acc-default.example.com", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_organization_domain.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_organization_domain.test", "claim", "true"),
+					resource.TestCheckResourceAttr("linear_organization_domain.test", "disable_organization_creation", "false"),
+					resource.TestCheckResourceAttr("linear_organization_domain.test", "verified", "true"),
+					resource.TestCheckResourceAttr("linear_organization_domain.test", "claimed", "true"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccOrganizationDomainResourceConfigClaim("tf-acc-default.example.com", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_organization_domain.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_organization_domain.test", "claim", "true"),
+					resource.TestCheckResourceAttr("linear_organization_domain.test", "disable_organization_creation", "true"),
+					resource.TestCheckResourceAttr("linear_organization_domain.test", "verified", "true"),
+					resource.TestCheckResourceAttr("linear_organization_domain.test", "claimed", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccOrganizationDomainResourceVerificationEmail(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccOrganizationDomainResourceConfigVerificationEmail("tf-acc-email.example.com", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_organization_domain.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_organization_domain.test", "name", "tf-acc-email.example.com"),
+					resource.TestCheckResourceAttr("linear_organization_domain.test", "verification_email", "admin@tf-acc-email.example.com"),
+					resource.TestCheckResourceAttr("linear_organization_domain.test", "auth_type", "saml"),
+					resource.TestCheckNoResourceAttr("linear_organization_domain.test", "verification_code"),
+					resource.TestCheckResourceAttr("linear_organization_domain.test", "verified", "false"),
+					resource.TestCheckResourceAttr("linear_organization_domain.test", "claimed", "false"),
+				),
+			},
+			// Update with an invalid code
+			{
+				Config:      testAccOrganizationDomainResourceConfigVerificationEmail("tf-acc-email.example.com", "000000"),
+				ExpectError: regexp.MustCompile("Unable to update organization domain"),
+			},
+			// Update and Read testing
+			{
+				Config: testAccOrganizationDomainResourceConfigVerificationEmail("tf-acc-email.example.com", "123456"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_organization_domain.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_organization_domain.test", "verification_code", "123456"),
+					resource.TestCheckResourceAttr("linear_organization_domain.test", "verified", "true"),
+					resource.TestCheckResourceAttr("linear_organization_domain.test", "claimed", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccOrganizationDomainResourceDisableWithoutClaim(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccOrganizationDomainResourceConfigDisableWithoutClaim("tf-acc-unclaimed.example.com"),
+				ExpectError: regexp.MustCompile("Invalid Organization Creation"),
+			},
+		},
+	})
+}
+
+func TestAccOrganizationDomainResourceClaimOnCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccOrganizationDomainResourceConfigClaim("tf-acc-claim.example.com", false),
+				ExpectError: regexp.MustCompile("Invalid Claim"),
+			},
+			{
+				Config:      testAccOrganizationDomainResourceConfigVerificationEmail("tf-acc-claim.example.com", "123456"),
+				ExpectError: regexp.MustCompile("Invalid Verification Code"),
+			},
+		},
+	})
+}
+
+func testAccOrganizationDomainResourceConfigDefault(name string) string {
+	return fmt.Sprintf(`
+resource "linear_organization_domain" "test" {
+  name = "%s"
+}
+`, name)
+}
+
+func testAccOrganizationDomainResourceConfigClaim(name string, disableOrganizationCreation bool) string {
+	return fmt.Sprintf(`
+resource "linear_organization_domain" "test" {
+  name  = "%s"
+  claim = true
+
+  disable_organization_creation = %t
+}
+`, name, disableOrganizationCreation)
+}
+
+func testAccOrganizationDomainResourceConfigVerificationEmail(name string, verificationCode string) string {
+	code := "null"
+
+	if verificationCode != "" {
+		code = fmt.Sprintf("%q", verificationCode)
+	}
+
+	return fmt.Sprintf(`
+resource "linear_organization_domain" "test" {
+  name               = "%s"
+  verification_email = "admin@%s"
+  auth_type          = "saml"
+  verification_code  = %s
+}
+`, name, name, code)
+}
+
+func testAccOrganizationDomainResourceConfigDisableWithoutClaim(name string) string {
+	return fmt.Sprintf(`
+resource "linear_organization_domain" "test" {
+  name = "%s"
+
+  disable_organization_creation = true
+}
+`, name)
+}
@@ -0,0 +1,356 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &OrganizationInviteResource{}
+var _ resource.ResourceWithImportState = &OrganizationInviteResource{}
+
+func NewOrganizationInviteResource() resource.Resource {
+	return &OrganizationInviteResource{}
+}
+
+type OrganizationInviteResource struct {
+	client *graphql.Client
+}
+
+type OrganizationInviteResourceModel struct {
+	Id         types.String `tfsdk:"id"`
+	Email      types.String `tfsdk:"email"`
+	Role       types.String `tfsdk:"role"`
+	TeamIds    types.Set    `tfsdk:"team_ids"`
+	Metadata   types.Map    `tfsdk:"metadata"`
+	Accepted   types.Bool   `tfsdk:"accepted"`
+	AcceptedAt types.String `tfsdk:"accepted_at"`
+	ExpiresAt  types.String `tfsdk:"expires_at"`
+}
+
+// organizationInviteRoles maps the roles, named as in the Linear UI, to the
+// roles of the API, which calls members users.
+var organizationInviteRoles = map[string]UserRoleType{
+	"admin":  UserRoleTypeAdmin,
+	"member": UserRoleTypeUser,
+	"guest":  UserRoleTypeGuest,
+}
+
+func (r *OrganizationInviteResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_invite"
+}
+
+func (r *OrganizationInviteResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Linear organization invite. Once the invite is accepted, changes are only recorded in the state instead of inviting the user again.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the invite.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email of the invited user.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(requiresReplaceUnlessAccepted, "Replaces the invite unless it was accepted.", "Replaces the invite unless it was accepted."),
+				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "Role of the invited user, either `admin`, `member` or `guest`. **Default** `member`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("member"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(requiresReplaceUnlessAccepted, "Replaces the invite unless it was accepted.", "Replaces the invite unless it was accepted."),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("admin", "member", "guest"),
+				},
+			},
+			"team_ids": schema.SetAttribute{
+				MarkdownDescription: "Identifiers of the teams the invited user joins. Linear does not return the teams of an invite, so changes made outside of Terraform are not detected.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.RegexMatches(uuidRegex(), "must be an uuid")),
+				},
+			},
+			"metadata": schema.MapAttribute{
+				MarkdownDescription: "Metadata of the invite.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplaceIf(func(ctx context.Context, req planmodifier.MapRequest, resp *mapplanmodifier.RequiresReplaceIfFuncResponse) {
+						resp.RequiresReplace, resp.Diagnostics = organizationInviteReplaceable(ctx, req.State)
+					}, "Replaces the invite unless it was accepted.", "Replaces the invite unless it was accepted."),
+				},
+			},
+			"accepted": schema.BoolAttribute{
+				MarkdownDescription: "Whether the invite was accepted.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"accepted_at": schema.StringAttribute{
+				MarkdownDescription: "Time at which the invite was accepted.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "Time at which the invite expires. Not set when the invite does not expire.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *OrganizationInviteResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OrganizationInviteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *OrganizationInviteResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := OrganizationInviteCreateInput{
+		Email: data.Email.ValueString(),
+		Role:  organizationInviteRoles[data.Role.ValueString()],
+	}
+
+	resp.Diagnostics.Append(data.TeamIds.ElementsAs(ctx, &input.TeamIds, false)...)
+
+	if !data.Metadata.IsNull() {
+		var metadata map[string]string
+
+		resp.Diagnostics.Append(data.Metadata.ElementsAs(ctx, &metadata, false)...)
+
+		input.Metadata = map[string]interface{}{}
+
+		for key, value := range metadata {
+			input.Metadata[key] = value
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := createOrganizationInvite(ctx, *r.client, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create organization invite, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created an organization invite")
+
+	resp.Diagnostics.Append(readOrganizationInvite(ctx, data, response.OrganizationInviteCreate.OrganizationInvite.OrganizationInvite)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationInviteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *OrganizationInviteResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := getOrganizationInvite(ctx, *r.client, data.Id.ValueString())
+
+	// An accepted invite has done its job, so it is kept even when it is gone
+	// instead of inviting the user again
+	if isNotFound(err) && data.Accepted.ValueBool() {
+		return
+	}
+
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read organization invite, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "read an organization invite")
+
+	resp.Diagnostics.Append(readOrganizationInvite(ctx, data, response.OrganizationInvite.OrganizationInvite)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationInviteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *OrganizationInviteResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The user already joined, so the changes are only recorded
+	if data.Accepted.ValueBool() {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	input := OrganizationInviteUpdateInput{
+		TeamIds: []string{},
+	}
+
+	resp.Diagnostics.Append(data.TeamIds.ElementsAs(ctx, &input.TeamIds, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := updateOrganizationInvite(ctx, *r.client, input, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update organization invite, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated an organization invite")
+
+	resp.Diagnostics.Append(readOrganizationInvite(ctx, data, response.OrganizationInviteUpdate.OrganizationInvite.OrganizationInvite)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationInviteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *OrganizationInviteResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := deleteOrganizationInvite(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete organization invite, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted an organization invite")
+}
+
+func (r *OrganizationInviteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func requiresReplaceUnlessAccepted(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace, resp.Diagnostics = organizationInviteReplaceable(ctx, req.State)
+}
+
+// organizationInviteReplaceable reports whether the invite can still be
+// replaced, which is no longer the case once it is accepted.
+func organizationInviteReplaceable(ctx context.Context, state tfsdk.State) (bool, diag.Diagnostics) {
+	var accepted types.Bool
+
+	diags := state.GetAttribute(ctx, path.Root("accepted"), &accepted)
+
+	return !accepted.ValueBool(), diags
+}
+
+// readOrganizationInvite keeps the email, role and metadata in the state once
+// the invite is accepted, as changes to them are then only recorded in the
+// state. They are still read when the invite is imported.
+func readOrganizationInvite(ctx context.Context, data *OrganizationInviteResourceModel, invite OrganizationInvite) diag.Diagnostics {
+	recorded := invite.AcceptedAt != nil && !data.Email.IsNull()
+
+	data.Id = types.StringValue(invite.Id)
+	data.Accepted = types.BoolValue(invite.AcceptedAt != nil)
+	data.AcceptedAt = types.StringNull()
+	data.ExpiresAt = types.StringNull()
+
+	if invite.AcceptedAt != nil {
+		data.AcceptedAt = types.StringValue(invite.AcceptedAt.UTC().Format(time.RFC3339))
+	}
+
+	if invite.ExpiresAt != nil {
+		data.ExpiresAt = types.StringValue(invite.ExpiresAt.UTC().Format(time.RFC3339))
+	}
+
+	if recorded {
+		return nil
+	}
+
+	data.Email = types.StringValue(invite.Email)
+
+	for name, role := range organizationInviteRoles {
+		if role == invite.Role {
+			data.Role = types.StringValue(name)
+		}
+	}
+
+	if len(invite.Metadata) == 0 {
+		data.Metadata = types.MapNull(types.StringType)
+		return nil
+	}
+
+	metadata := map[string]string{}
+
+	for key, value := range invite.Metadata {
+		metadata[key] = fmt.Sprint(value)
+	}
+
+	var diags diag.Diagnostics
+
+	data.Metadata, diags = types.MapValueFrom(ctx, types.StringType, metadata)
+
+	return diags
+}
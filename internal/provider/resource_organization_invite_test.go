@@ -0,0 +1,195 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrganizationInviteResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccOrganizationInviteResourceConfigDefault("new-hire@example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_organization_invite.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_organization_invite.test", "email", "new-hire@example.com"),
+					resource.TestCheckResourceAttr("linear_organization_invite.test", "role", "member"),
+					resource.TestCheckNoResourceAttr("linear_organization_invite.test", "team_ids"),
+					resource.TestCheckNoResourceAttr("linear_organization_invite.test", "metadata"),
+					resource.TestCheckResourceAttr("linear_organization_invite.test", "accepted", "false"),
+					resource.TestCheckNoResourceAttr("linear_organization_invite.test", "accepted_at"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_organization_invite.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccOrganizationInviteResourceConfigTeams("new-hire@example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_organization_invite.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_organization_invite.test", "email", "new-hire@example.com"),
+					resource.TestCheckResourceAttr("linear_organization_invite.test", "role", "member"),
+					resource.TestCheckResourceAttr("linear_organization_invite.test", "team_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr("linear_organization_invite.test", "team_ids.*", "ff0a060a-eceb-4b34-9140-fd7231f0cd28"),
+					resource.TestCheckResourceAttr("linear_organization_invite.test", "accepted", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "linear_organization_invite.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"team_ids"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccOrganizationInviteResourceNonDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccOrganizationInviteResourceConfigNonDefault("contractor@example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_organization_invite.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_organization_invite.test", "email", "contractor@example.com"),
+					resource.TestCheckResourceAttr("linear_organization_invite.test", "role", "guest"),
+					resource.TestCheckResourceAttr("linear_organization_invite.test", "team_ids.#", "1"),
+					resource.TestCheckResourceAttr("linear_organization_invite.test", "metadata.%", "1"),
+					resource.TestCheckResourceAttr("linear_organization_invite.test", "metadata.source", "hr"),
+					resource.TestCheckResourceAttr("linear_organization_invite.test", "accepted", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "linear_organization_invite.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"team_ids"},
+			},
+			// Replace and Read testing
+			{
+				Config: testAccOrganizationInviteResourceConfigDefault("contractor@example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_organization_invite.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_organization_invite.test", "role", "member"),
+					resource.TestCheckNoResourceAttr("linear_organization_invite.test", "metadata"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+// Invites can not be accepted in the acceptance tests, which is why reading an
+// accepted invite is tested on its own.
+func TestReadOrganizationInviteAccepted(t *testing.T) {
+	acceptedAt := time.Date(2024, 3, 4, 9, 30, 0, 0, time.FixedZone("CET", 3600))
+	data := &OrganizationInviteResourceModel{}
+
+	diags := readOrganizationInvite(context.Background(), data, OrganizationInvite{
+		Id:         "5c3e1a2b-7d4f-4e8a-9b6c-1d2e3f4a5b6c",
+		Email:      "new-hire@example.com",
+		Role:       UserRoleTypeUser,
+		AcceptedAt: &acceptedAt,
+	})
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if data.Role.ValueString() != "member" {
+		t.Errorf("expected role member, got %s", data.Role)
+	}
+
+	if !data.Accepted.ValueBool() {
+		t.Errorf("expected the invite to be accepted")
+	}
+
+	if data.AcceptedAt.ValueString() != "2024-03-04T08:30:00Z" {
+		t.Errorf("expected accepted_at in UTC, got %s", data.AcceptedAt)
+	}
+
+	if !data.ExpiresAt.IsNull() || !data.Metadata.IsNull() {
+		t.Errorf("expected expires_at and metadata not to be set, got %s and %s", data.ExpiresAt, data.Metadata)
+	}
+}
+
+func TestReadOrganizationInviteAcceptedKeepsState(t *testing.T) {
+	acceptedAt := time.Date(2024, 3, 4, 9, 30, 0, 0, time.UTC)
+	data := &OrganizationInviteResourceModel{
+		Email:    types.StringValue("renamed@example.com"),
+		Role:     types.StringValue("admin"),
+		Metadata: types.MapNull(types.StringType),
+	}
+
+	diags := readOrganizationInvite(context.Background(), data, OrganizationInvite{
+		Id:         "5c3e1a2b-7d4f-4e8a-9b6c-1d2e3f4a5b6c",
+		Email:      "new-hire@example.com",
+		Role:       UserRoleTypeUser,
+		AcceptedAt: &acceptedAt,
+		Metadata:   map[string]interface{}{"source": "hr"},
+	})
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if data.Email.ValueString() != "renamed@example.com" || data.Role.ValueString() != "admin" {
+		t.Errorf("expected the recorded email and role to be kept, got %s and %s", data.Email, data.Role)
+	}
+
+	if !data.Metadata.IsNull() {
+		t.Errorf("expected the recorded metadata to be kept, got %s", data.Metadata)
+	}
+
+	if data.AcceptedAt.ValueString() != "2024-03-04T09:30:00Z" {
+		t.Errorf("expected accepted_at to be read, got %s", data.AcceptedAt)
+	}
+}
+
+func testAccOrganizationInviteResourceConfigDefault(email string) string {
+	return fmt.Sprintf(`
+resource "linear_organization_invite" "test" {
+  email = "%s"
+}
+`, email)
+}
+
+func testAccOrganizationInviteResourceConfigTeams(email string) string {
+	return fmt.Sprintf(`
+resource "linear_organization_invite" "test" {
+  email = "%s"
+  team_ids = ["ff0a060a-eceb-4b34-9140-fd7231f0cd28"]
+}
+`, email)
+}
+
+func testAccOrganizationInviteResourceConfigNonDefault(email string) string {
+	return fmt.Sprintf(`
+resource "linear_organization_invite" "test" {
+  email = "%s"
+  role = "guest"
+  team_ids = ["ff0a060a-eceb-4b34-9140-fd7231f0cd28"]
+  metadata = {
+    source = "hr"
+  }
+}
+`, email)
+}
@@ -0,0 +1,466 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &ProjectResource{}
+var _ resource.ResourceWithImportState = &ProjectResource{}
+
+func NewProjectResource() resource.Resource {
+	return &ProjectResource{}
+}
+
+type ProjectResource struct {
+	client *graphql.Client
+}
+
+type ProjectResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	SlugId      types.String `tfsdk:"slug_id"`
+	Url         types.String `tfsdk:"url"`
+	Description types.String `tfsdk:"description"`
+	Icon        types.String `tfsdk:"icon"`
+	Color       types.String `tfsdk:"color"`
+	StatusId    types.String `tfsdk:"status_id"`
+	LeadId      types.String `tfsdk:"lead_id"`
+	TeamIds     types.Set    `tfsdk:"team_ids"`
+	Priority    types.Int64  `tfsdk:"priority"`
+	StartDate   types.String `tfsdk:"start_date"`
+	TargetDate  types.String `tfsdk:"target_date"`
+	LabelIds    types.Set    `tfsdk:"label_ids"`
+	Archived    types.Bool   `tfsdk:"archived"`
+}
+
+func (r *ProjectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project"
+}
+
+func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Linear project.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the project.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the project.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"slug_id": schema.StringAttribute{
+				MarkdownDescription: "Slug of the project, used in its URL.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "URL of the project.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the project.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"icon": schema.StringAttribute{
+				MarkdownDescription: "Icon of the project.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"color": schema.StringAttribute{
+				MarkdownDescription: "Color of the project.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(colorRegex(), "must be a hex color"),
+				},
+			},
+			"status_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the status of the project. Defaults to the default status of the workspace.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"lead_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the user leading the project.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"team_ids": schema.SetAttribute{
+				MarkdownDescription: "Identifiers of the teams the project belongs to.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.RegexMatches(uuidRegex(), "must be an uuid")),
+				},
+			},
+			"priority": schema.Int64Attribute{
+				MarkdownDescription: "Priority of the project. `0` for no priority, `1` for urgent, `2` for high, `3` for normal and `4` for low. **Default** `0`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.Between(0, 4),
+				},
+			},
+			"start_date": schema.StringAttribute{
+				MarkdownDescription: "Planned start date of the project, in `YYYY-MM-DD` format.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(dateRegex(), "must be a date in YYYY-MM-DD format"),
+				},
+			},
+			"target_date": schema.StringAttribute{
+				MarkdownDescription: "Planned completion date of the project, in `YYYY-MM-DD` format.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(dateRegex(), "must be a date in YYYY-MM-DD format"),
+				},
+			},
+			"label_ids": schema.SetAttribute{
+				MarkdownDescription: "Identifiers of the project labels of the project. **Default** `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.RegexMatches(uuidRegex(), "must be an uuid")),
+				},
+			},
+			"archived": schema.BoolAttribute{
+				MarkdownDescription: "Whether the project is archived. **Default** `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *ProjectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ProjectResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := ProjectCreateInput{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueStringPointer(),
+		Icon:        data.Icon.ValueStringPointer(),
+		LeadId:      data.LeadId.ValueStringPointer(),
+		Priority:    int(data.Priority.ValueInt64()),
+		StartDate:   data.StartDate.ValueStringPointer(),
+		TargetDate:  data.TargetDate.ValueStringPointer(),
+	}
+
+	if !data.Color.IsUnknown() {
+		value := data.Color.ValueString()
+		input.Color = &value
+	}
+
+	if !data.StatusId.IsUnknown() {
+		value := data.StatusId.ValueString()
+		input.StatusId = &value
+	}
+
+	resp.Diagnostics.Append(data.TeamIds.ElementsAs(ctx, &input.TeamIds, false)...)
+	resp.Diagnostics.Append(data.LabelIds.ElementsAs(ctx, &input.LabelIds, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := createProject(ctx, *r.client, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create project, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a project")
+
+	project := response.ProjectCreate.Project.Project
+
+	if data.Archived.ValueBool() {
+		_, err := archiveProject(ctx, *r.client, project.Id)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to archive project, got error: %s", err))
+			return
+		}
+
+		tflog.Trace(ctx, "archived a project")
+
+		readResponse, err := getProject(ctx, *r.client, project.Id)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project, got error: %s", err))
+			return
+		}
+
+		project = readResponse.Project.Project
+	}
+
+	resp.Diagnostics.Append(readProject(ctx, data, project)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *ProjectResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := getProject(ctx, *r.client, data.Id.ValueString())
+
+	if isNotFound(err) || (err == nil && response.Project.Trashed) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(readProject(ctx, data, response.Project.Project)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *ProjectResourceModel
+	var state *ProjectResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Archived projects can not be updated, so they are restored first
+	if state.Archived.ValueBool() {
+		_, err := unarchiveProject(ctx, *r.client, data.Id.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to unarchive project, got error: %s", err))
+			return
+		}
+
+		tflog.Trace(ctx, "unarchived a project")
+	}
+
+	input := ProjectUpdateInput{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueString(),
+		Icon:        data.Icon.ValueStringPointer(),
+		LeadId:      data.LeadId.ValueStringPointer(),
+		Priority:    int(data.Priority.ValueInt64()),
+		StartDate:   data.StartDate.ValueStringPointer(),
+		TargetDate:  data.TargetDate.ValueStringPointer(),
+	}
+
+	if !data.Color.IsUnknown() {
+		value := data.Color.ValueString()
+		input.Color = &value
+	}
+
+	if !data.StatusId.IsUnknown() {
+		value := data.StatusId.ValueString()
+		input.StatusId = &value
+	}
+
+	resp.Diagnostics.Append(data.TeamIds.ElementsAs(ctx, &input.TeamIds, false)...)
+	resp.Diagnostics.Append(data.LabelIds.ElementsAs(ctx, &input.LabelIds, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := updateProject(ctx, *r.client, input, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update project, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated a project")
+
+	project := response.ProjectUpdate.Project.Project
+
+	if data.Archived.ValueBool() {
+		_, err := archiveProject(ctx, *r.client, project.Id)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to archive project, got error: %s", err))
+			return
+		}
+
+		tflog.Trace(ctx, "archived a project")
+
+		readResponse, err := getProject(ctx, *r.client, project.Id)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project, got error: %s", err))
+			return
+		}
+
+		project = readResponse.Project.Project
+	}
+
+	resp.Diagnostics.Append(readProject(ctx, data, project)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ProjectResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := deleteProject(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete project, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a project")
+}
+
+func (r *ProjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The API accepts both the identifier and the slug of a project
+	response, err := getProject(ctx, *r.client, req.ID)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import project, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), response.Project.Id)...)
+}
+
+func readProject(ctx context.Context, data *ProjectResourceModel, project Project) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Id = types.StringValue(project.Id)
+	data.Name = types.StringValue(project.Name)
+	data.SlugId = types.StringValue(project.SlugId)
+	data.Url = types.StringValue(project.Url)
+	data.Icon = types.StringPointerValue(project.Icon)
+	data.Color = types.StringValue(project.Color)
+	data.StatusId = types.StringValue(project.Status.Id)
+	data.Priority = types.Int64Value(int64(project.Priority))
+	data.StartDate = types.StringPointerValue(project.StartDate)
+	data.TargetDate = types.StringPointerValue(project.TargetDate)
+	data.Archived = types.BoolValue(project.ArchivedAt != nil)
+
+	if project.Description != "" {
+		data.Description = types.StringValue(project.Description)
+	} else {
+		data.Description = types.StringNull()
+	}
+
+	if project.Lead != nil {
+		data.LeadId = types.StringValue(project.Lead.Id)
+	} else {
+		data.LeadId = types.StringNull()
+	}
+
+	teamIds := make([]string, 0, len(project.Teams.Nodes))
+
+	for _, team := range project.Teams.Nodes {
+		teamIds = append(teamIds, team.Id)
+	}
+
+	var setDiags diag.Diagnostics
+
+	data.TeamIds, setDiags = types.SetValueFrom(ctx, types.StringType, teamIds)
+	diags.Append(setDiags...)
+
+	labelIds := project.LabelIds
+
+	if labelIds == nil {
+		labelIds = []string{}
+	}
+
+	data.LabelIds, setDiags = types.SetValueFrom(ctx, types.StringType, labelIds)
+	diags.Append(setDiags...)
+
+	return diags
+}
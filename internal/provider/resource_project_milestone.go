@@ -0,0 +1,265 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &ProjectMilestoneResource{}
+var _ resource.ResourceWithImportState = &ProjectMilestoneResource{}
+
+func NewProjectMilestoneResource() resource.Resource {
+	return &ProjectMilestoneResource{}
+}
+
+type ProjectMilestoneResource struct {
+	client *graphql.Client
+}
+
+type ProjectMilestoneResourceModel struct {
+	Id          types.String  `tfsdk:"id"`
+	Name        types.String  `tfsdk:"name"`
+	Description types.String  `tfsdk:"description"`
+	TargetDate  types.String  `tfsdk:"target_date"`
+	SortOrder   types.Float64 `tfsdk:"sort_order"`
+	ProjectId   types.String  `tfsdk:"project_id"`
+}
+
+func (r *ProjectMilestoneResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_milestone"
+}
+
+func (r *ProjectMilestoneResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Linear project milestone.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the milestone.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the milestone.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the milestone.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"target_date": schema.StringAttribute{
+				MarkdownDescription: "Planned completion date of the milestone, in `YYYY-MM-DD` format.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(dateRegex(), "must be a date in YYYY-MM-DD format"),
+				},
+			},
+			"sort_order": schema.Float64Attribute{
+				MarkdownDescription: "Sort order of the milestone within the project.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+		},
+	}
+}
+
+func (r *ProjectMilestoneResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ProjectMilestoneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ProjectMilestoneResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := ProjectMilestoneCreateInput{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueStringPointer(),
+		TargetDate:  data.TargetDate.ValueStringPointer(),
+		ProjectId:   data.ProjectId.ValueString(),
+	}
+
+	if !data.SortOrder.IsUnknown() {
+		value := data.SortOrder.ValueFloat64()
+		input.SortOrder = &value
+	}
+
+	response, err := createProjectMilestone(ctx, *r.client, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create project milestone, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a project milestone")
+
+	readProjectMilestone(data, response.ProjectMilestoneCreate.ProjectMilestone.ProjectMilestone)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectMilestoneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *ProjectMilestoneResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := getProjectMilestone(ctx, *r.client, data.Id.ValueString())
+
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project milestone, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "read a project milestone")
+
+	readProjectMilestone(data, response.ProjectMilestone.ProjectMilestone)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectMilestoneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *ProjectMilestoneResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := ProjectMilestoneUpdateInput{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueStringPointer(),
+		TargetDate:  data.TargetDate.ValueStringPointer(),
+	}
+
+	if !data.SortOrder.IsUnknown() {
+		value := data.SortOrder.ValueFloat64()
+		input.SortOrder = &value
+	}
+
+	response, err := updateProjectMilestone(ctx, *r.client, input, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update project milestone, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated a project milestone")
+
+	readProjectMilestone(data, response.ProjectMilestoneUpdate.ProjectMilestone.ProjectMilestone)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectMilestoneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ProjectMilestoneResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := deleteProjectMilestone(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete project milestone, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a project milestone")
+}
+
+func (r *ProjectMilestoneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Milestone names may contain colons, project identifiers never do
+	separator := strings.Index(req.ID, ":")
+
+	if separator <= 0 || separator == len(req.ID)-1 {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: project_id:milestone_name. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	response, err := findProjectMilestone(ctx, *r.client, req.ID[separator+1:], req.ID[:separator])
+
+	if err != nil || len(response.Project.ProjectMilestones.Nodes) != 1 {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import project milestone, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), response.Project.ProjectMilestones.Nodes[0].Id)...)
+}
+
+func readProjectMilestone(data *ProjectMilestoneResourceModel, milestone ProjectMilestone) {
+	data.Id = types.StringValue(milestone.Id)
+	data.Name = types.StringValue(milestone.Name)
+	data.Description = types.StringPointerValue(milestone.Description)
+	data.TargetDate = types.StringPointerValue(milestone.TargetDate)
+	data.SortOrder = types.Float64Value(milestone.SortOrder)
+	data.ProjectId = types.StringValue(milestone.Project.Id)
+}
@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccProjectMilestoneResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProjectMilestoneResourceConfigDefault("Design"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_project_milestone.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_project_milestone.test", "name", "Design"),
+					resource.TestCheckNoResourceAttr("linear_project_milestone.test", "description"),
+					resource.TestCheckNoResourceAttr("linear_project_milestone.test", "target_date"),
+					resource.TestCheckResourceAttrSet("linear_project_milestone.test", "sort_order"),
+					resource.TestCheckResourceAttrPair("linear_project_milestone.test", "project_id", "linear_project.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_project_milestone.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccProjectMilestoneImportId("linear_project_milestone.test"),
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccProjectMilestoneResourceConfigNonDefault("Beta: first cut"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_project_milestone.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_project_milestone.test", "name", "Beta: first cut"),
+					resource.TestCheckResourceAttr("linear_project_milestone.test", "description", "ready for testers"),
+					resource.TestCheckResourceAttr("linear_project_milestone.test", "target_date", "2024-02-15"),
+					resource.TestCheckResourceAttr("linear_project_milestone.test", "sort_order", "10"),
+					resource.TestCheckResourceAttrPair("linear_project_milestone.test", "project_id", "linear_project.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_project_milestone.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccProjectMilestoneImportId("linear_project_milestone.test"),
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccProjectMilestoneResourceNonDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProjectMilestoneResourceConfigNonDefault("GA"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_project_milestone.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_project_milestone.test", "name", "GA"),
+					resource.TestCheckResourceAttr("linear_project_milestone.test", "description", "ready for testers"),
+					resource.TestCheckResourceAttr("linear_project_milestone.test", "target_date", "2024-02-15"),
+					resource.TestCheckResourceAttr("linear_project_milestone.test", "sort_order", "10"),
+					resource.TestCheckResourceAttrPair("linear_project_milestone.test", "project_id", "linear_project.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_project_milestone.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccProjectMilestoneImportId("linear_project_milestone.test"),
+				ImportStateVerify: true,
+			},
+			// Update with null values
+			{
+				Config: testAccProjectMilestoneResourceConfigDefault("GA"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_project_milestone.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_project_milestone.test", "name", "GA"),
+					resource.TestCheckNoResourceAttr("linear_project_milestone.test", "description"),
+					resource.TestCheckNoResourceAttr("linear_project_milestone.test", "target_date"),
+					resource.TestCheckResourceAttr("linear_project_milestone.test", "sort_order", "10"),
+					resource.TestCheckResourceAttrPair("linear_project_milestone.test", "project_id", "linear_project.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_project_milestone.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccProjectMilestoneImportId("linear_project_milestone.test"),
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccProjectMilestoneImportId(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", name)
+		}
+
+		return rs.Primary.Attributes["project_id"] + ":" + rs.Primary.Attributes["name"], nil
+	}
+}
+
+func testAccProjectMilestoneResourceConfigDefault(name string) string {
+	return fmt.Sprintf(`
+resource "linear_project" "test" {
+  name = "Milestones"
+  team_ids = ["ff0a060a-eceb-4b34-9140-fd7231f0cd28"]
+}
+
+resource "linear_project_milestone" "test" {
+  name = "%s"
+  project_id = linear_project.test.id
+}
+`, name)
+}
+
+func testAccProjectMilestoneResourceConfigNonDefault(name string) string {
+	return fmt.Sprintf(`
+resource "linear_project" "test" {
+  name = "Milestones"
+  team_ids = ["ff0a060a-eceb-4b34-9140-fd7231f0cd28"]
+}
+
+resource "linear_project_milestone" "test" {
+  name = "%s"
+  description = "ready for testers"
+  target_date = "2024-02-15"
+  sort_order = 10
+  project_id = linear_project.test.id
+}
+`, name)
+}
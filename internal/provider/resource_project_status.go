@@ -0,0 +1,261 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &ProjectStatusResource{}
+var _ resource.ResourceWithImportState = &ProjectStatusResource{}
+
+func NewProjectStatusResource() resource.Resource {
+	return &ProjectStatusResource{}
+}
+
+type ProjectStatusResource struct {
+	client *graphql.Client
+}
+
+type ProjectStatusResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Type        types.String `tfsdk:"type"`
+	Description types.String `tfsdk:"description"`
+	Color       types.String `tfsdk:"color"`
+	Position    types.Number `tfsdk:"position"`
+	Indefinite  types.Bool   `tfsdk:"indefinite"`
+}
+
+func (r *ProjectStatusResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_status"
+}
+
+func (r *ProjectStatusResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Linear workspace project status.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the project status.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the project status.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the project status.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"backlog", "planned", "started", "paused", "completed", "canceled"}...),
+				},
+			},
+			"position": schema.NumberAttribute{
+				MarkdownDescription: "Position of the project status.",
+				Required:            true,
+			},
+			"color": schema.StringAttribute{
+				MarkdownDescription: "Color of the project status.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(colorRegex(), "must be a hex color"),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the project status.",
+				Optional:            true,
+			},
+			"indefinite": schema.BoolAttribute{
+				MarkdownDescription: "Whether projects can stay in the status indefinitely. **Default** `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *ProjectStatusResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ProjectStatusResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ProjectStatusResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	position, _ := data.Position.ValueBigFloat().Float64()
+
+	input := ProjectStatusCreateInput{
+		Name:        data.Name.ValueString(),
+		Type:        ProjectStatusType(data.Type.ValueString()),
+		Position:    position,
+		Color:       data.Color.ValueString(),
+		Description: data.Description.ValueStringPointer(),
+		Indefinite:  data.Indefinite.ValueBool(),
+	}
+
+	response, err := createProjectStatus(ctx, *r.client, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create project status, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a project status")
+
+	readProjectStatus(data, response.ProjectStatusCreate.Status.ProjectStatus)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectStatusResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *ProjectStatusResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := getProjectStatus(ctx, *r.client, data.Id.ValueString())
+
+	// Project statuses are archived rather than deleted
+	if isNotFound(err) || (err == nil && response.ProjectStatus.ArchivedAt != nil) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project status, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "read a project status")
+
+	readProjectStatus(data, response.ProjectStatus.ProjectStatus)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectStatusResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *ProjectStatusResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	position, _ := data.Position.ValueBigFloat().Float64()
+
+	input := ProjectStatusUpdateInput{
+		Name:        data.Name.ValueString(),
+		Color:       data.Color.ValueString(),
+		Description: data.Description.ValueStringPointer(),
+		Position:    position,
+		Indefinite:  data.Indefinite.ValueBool(),
+	}
+
+	response, err := updateProjectStatus(ctx, *r.client, input, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update project status, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated a project status")
+
+	readProjectStatus(data, response.ProjectStatusUpdate.Status.ProjectStatus)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectStatusResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ProjectStatusResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := deleteProjectStatus(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete project status, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a project status")
+}
+
+func (r *ProjectStatusResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Project statuses can not be filtered, so they are all listed and matched by name
+	response, err := getProjectStatuses(ctx, *r.client)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import project status, got error: %s", err))
+		return
+	}
+
+	for _, node := range response.ProjectStatuses.Nodes {
+		if node.Name == req.ID {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), node.Id)...)
+			return
+		}
+	}
+
+	resp.Diagnostics.AddError("Client Error", "Unable to import project status, got error: status not found")
+}
+
+func readProjectStatus(data *ProjectStatusResourceModel, status ProjectStatus) {
+	data.Id = types.StringValue(status.Id)
+	data.Name = types.StringValue(status.Name)
+	data.Type = types.StringValue(string(status.Type))
+	data.Position = types.NumberValue(big.NewFloat(status.Position))
+	data.Color = types.StringValue(status.Color)
+	data.Description = types.StringPointerValue(status.Description)
+	data.Indefinite = types.BoolValue(status.Indefinite)
+}
@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProjectStatusResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProjectStatusResourceConfigDefault("On Hold", "paused"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_project_status.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_project_status.test", "name", "On Hold"),
+					resource.TestCheckResourceAttr("linear_project_status.test", "type", "paused"),
+					resource.TestCheckNoResourceAttr("linear_project_status.test", "description"),
+					resource.TestCheckResourceAttr("linear_project_status.test", "color", "#ffff00"),
+					resource.TestCheckResourceAttr("linear_project_status.test", "position", "10"),
+					resource.TestCheckResourceAttr("linear_project_status.test", "indefinite", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_project_status.test",
+				ImportState:       true,
+				ImportStateId:     "On Hold",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccProjectStatusResourceConfigNonDefault("Parked", "paused", "Waiting on someone"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_project_status.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_project_status.test", "name", "Parked"),
+					resource.TestCheckResourceAttr("linear_project_status.test", "type", "paused"),
+					resource.TestCheckResourceAttr("linear_project_status.test", "description", "Waiting on someone"),
+					resource.TestCheckResourceAttr("linear_project_status.test", "color", "#00ffff"),
+					resource.TestCheckResourceAttr("linear_project_status.test", "position", "20"),
+					resource.TestCheckResourceAttr("linear_project_status.test", "indefinite", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_project_status.test",
+				ImportState:       true,
+				ImportStateId:     "Parked",
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccProjectStatusResourceNonDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProjectStatusResourceConfigNonDefault("Shipping", "started", "Rolling out"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_project_status.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_project_status.test", "name", "Shipping"),
+					resource.TestCheckResourceAttr("linear_project_status.test", "type", "started"),
+					resource.TestCheckResourceAttr("linear_project_status.test", "description", "Rolling out"),
+					resource.TestCheckResourceAttr("linear_project_status.test", "color", "#00ffff"),
+					resource.TestCheckResourceAttr("linear_project_status.test", "position", "20"),
+					resource.TestCheckResourceAttr("linear_project_status.test", "indefinite", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_project_status.test",
+				ImportState:       true,
+				ImportStateId:     "Shipping",
+				ImportStateVerify: true,
+			},
+			// Update with null values
+			{
+				Config: testAccProjectStatusResourceConfigDefault("Shipping", "started"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_project_status.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_project_status.test", "name", "Shipping"),
+					resource.TestCheckResourceAttr("linear_project_status.test", "type", "started"),
+					resource.TestCheckNoResourceAttr("linear_project_status.test", "description"),
+					resource.TestCheckResourceAttr("linear_project_status.test", "color", "#ffff00"),
+					resource.TestCheckResourceAttr("linear_project_status.test", "position", "10"),
+					resource.TestCheckResourceAttr("linear_project_status.test", "indefinite", "false"),
+				),
+			},
+			// Replace with another type
+			{
+				Config: testAccProjectStatusResourceConfigDefault("Shipping", "planned"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_project_status.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_project_status.test", "type", "planned"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccProjectStatusResourceInvalidType(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProjectStatusResourceConfigDefault("Doing", "unstarted"),
+				ExpectError: regexp.MustCompile(`Attribute type value must be one of`),
+			},
+		},
+	})
+}
+
+func testAccProjectStatusResourceConfigDefault(name string, ty string) string {
+	return fmt.Sprintf(`
+resource "linear_project_status" "test" {
+  name = "%s"
+  type = "%s"
+  color = "#ffff00"
+  position = 10
+}
+`, name, ty)
+}
+
+func testAccProjectStatusResourceConfigNonDefault(name string, ty string, description string) string {
+	return fmt.Sprintf(`
+resource "linear_project_status" "test" {
+  name = "%s"
+  type = "%s"
+  description = "%s"
+  color = "#00ffff"
+  position = 20
+  indefinite = true
+}
+`, name, ty, description)
+}
@@ -0,0 +1,199 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccProjectResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProjectResourceConfigDefault("Launch"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_project.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_project.test", "name", "Launch"),
+					resource.TestCheckResourceAttrSet("linear_project.test", "slug_id"),
+					resource.TestCheckResourceAttrSet("linear_project.test", "url"),
+					resource.TestCheckNoResourceAttr("linear_project.test", "description"),
+					resource.TestCheckNoResourceAttr("linear_project.test", "icon"),
+					resource.TestMatchResourceAttr("linear_project.test", "color", colorRegex()),
+					resource.TestMatchResourceAttr("linear_project.test", "status_id", uuidRegex()),
+					resource.TestCheckNoResourceAttr("linear_project.test", "lead_id"),
+					resource.TestCheckResourceAttr("linear_project.test", "team_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr("linear_project.test", "team_ids.*", "ff0a060a-eceb-4b34-9140-fd7231f0cd28"),
+					resource.TestCheckResourceAttr("linear_project.test", "priority", "0"),
+					resource.TestCheckNoResourceAttr("linear_project.test", "start_date"),
+					resource.TestCheckNoResourceAttr("linear_project.test", "target_date"),
+					resource.TestCheckResourceAttr("linear_project.test", "label_ids.#", "0"),
+					resource.TestCheckResourceAttr("linear_project.test", "archived", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_project.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// ImportState by slug testing
+			{
+				ResourceName:      "linear_project.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccProjectSlugId("linear_project.test"),
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccProjectResourceConfigNonDefault("Big Launch"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_project.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_project.test", "name", "Big Launch"),
+					resource.TestCheckResourceAttr("linear_project.test", "description", "going to the moon"),
+					resource.TestCheckResourceAttr("linear_project.test", "icon", "Rocket"),
+					resource.TestCheckResourceAttr("linear_project.test", "color", "#00ff00"),
+					resource.TestCheckResourceAttr("linear_project.test", "status_id", "8c2b8d4a-1f0e-4c55-9b0a-6d1c3e7f9a22"),
+					resource.TestCheckResourceAttr("linear_project.test", "lead_id", "6f5ae5a5-1b4c-4f2a-9c77-2c0b8a3d9e66"),
+					resource.TestCheckResourceAttr("linear_project.test", "team_ids.#", "1"),
+					resource.TestCheckResourceAttr("linear_project.test", "priority", "2"),
+					resource.TestCheckResourceAttr("linear_project.test", "start_date", "2024-01-01"),
+					resource.TestCheckResourceAttr("linear_project.test", "target_date", "2024-03-31"),
+					resource.TestCheckResourceAttr("linear_project.test", "label_ids.#", "0"),
+					resource.TestCheckResourceAttr("linear_project.test", "archived", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_project.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccProjectResourceNonDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProjectResourceConfigNonDefault("Rewrite"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_project.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_project.test", "name", "Rewrite"),
+					resource.TestCheckResourceAttr("linear_project.test", "description", "going to the moon"),
+					resource.TestCheckResourceAttr("linear_project.test", "icon", "Rocket"),
+					resource.TestCheckResourceAttr("linear_project.test", "color", "#00ff00"),
+					resource.TestCheckResourceAttr("linear_project.test", "status_id", "8c2b8d4a-1f0e-4c55-9b0a-6d1c3e7f9a22"),
+					resource.TestCheckResourceAttr("linear_project.test", "lead_id", "6f5ae5a5-1b4c-4f2a-9c77-2c0b8a3d9e66"),
+					resource.TestCheckResourceAttr("linear_project.test", "priority", "2"),
+					resource.TestCheckResourceAttr("linear_project.test", "start_date", "2024-01-01"),
+					resource.TestCheckResourceAttr("linear_project.test", "target_date", "2024-03-31"),
+					resource.TestCheckResourceAttr("linear_project.test", "archived", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_project.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Archive testing
+			{
+				Config: testAccProjectResourceConfigArchived("Rewrite"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("linear_project.test", "name", "Rewrite"),
+					resource.TestCheckResourceAttr("linear_project.test", "archived", "true"),
+				),
+			},
+			// Update with null values
+			{
+				Config: testAccProjectResourceConfigDefault("Rewrite"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_project.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_project.test", "name", "Rewrite"),
+					resource.TestCheckNoResourceAttr("linear_project.test", "description"),
+					resource.TestCheckNoResourceAttr("linear_project.test", "icon"),
+					resource.TestCheckResourceAttr("linear_project.test", "color", "#00ff00"),
+					resource.TestCheckResourceAttr("linear_project.test", "status_id", "8c2b8d4a-1f0e-4c55-9b0a-6d1c3e7f9a22"),
+					resource.TestCheckNoResourceAttr("linear_project.test", "lead_id"),
+					resource.TestCheckResourceAttr("linear_project.test", "priority", "0"),
+					resource.TestCheckNoResourceAttr("linear_project.test", "start_date"),
+					resource.TestCheckNoResourceAttr("linear_project.test", "target_date"),
+					resource.TestCheckResourceAttr("linear_project.test", "archived", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_project.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccProjectSlugId(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", name)
+		}
+
+		return rs.Primary.Attributes["slug_id"], nil
+	}
+}
+
+func testAccProjectResourceConfigDefault(name string) string {
+	return fmt.Sprintf(`
+resource "linear_project" "test" {
+  name = "%s"
+  team_ids = ["ff0a060a-eceb-4b34-9140-fd7231f0cd28"]
+}
+`, name)
+}
+
+func testAccProjectResourceConfigNonDefault(name string) string {
+	return fmt.Sprintf(`
+resource "linear_project" "test" {
+  name = "%s"
+  description = "going to the moon"
+  icon = "Rocket"
+  color = "#00ff00"
+  status_id = "8c2b8d4a-1f0e-4c55-9b0a-6d1c3e7f9a22"
+  lead_id = "6f5ae5a5-1b4c-4f2a-9c77-2c0b8a3d9e66"
+  team_ids = ["ff0a060a-eceb-4b34-9140-fd7231f0cd28"]
+  priority = 2
+  start_date = "2024-01-01"
+  target_date = "2024-03-31"
+}
+`, name)
+}
+
+func testAccProjectResourceConfigArchived(name string) string {
+	return fmt.Sprintf(`
+resource "linear_project" "test" {
+  name = "%s"
+  description = "going to the moon"
+  icon = "Rocket"
+  color = "#00ff00"
+  status_id = "8c2b8d4a-1f0e-4c55-9b0a-6d1c3e7f9a22"
+  lead_id = "6f5ae5a5-1b4c-4f2a-9c77-2c0b8a3d9e66"
+  team_ids = ["ff0a060a-eceb-4b34-9140-fd7231f0cd28"]
+  priority = 2
+  start_date = "2024-01-01"
+  target_date = "2024-03-31"
+  archived = true
+}
+`, name)
+}
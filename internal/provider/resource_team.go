@@ -802,7 +802,7 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 	// Read the workflow states so that we can update them
 
-	workflowStatesResponse, workflowStatesErr := getTeamWorkflowStates(ctx, *r.client, team.Key)
+	workflowStatesResponse, workflowStatesErr := getTeamWorkflowStates(ctx, *r.client, team.Id)
 
 	if workflowStatesErr != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get team workflow states, got error: %s", workflowStatesErr))
@@ -854,6 +854,11 @@ func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	response, err := getTeam(ctx, *r.client, data.Key.ValueString())
 
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read team, got error: %s", err))
 		return
@@ -919,7 +924,7 @@ func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		},
 	)
 
-	workflowStatesResponse, workflowStatesErr := getTeamWorkflowStates(ctx, *r.client, team.Key)
+	workflowStatesResponse, workflowStatesErr := getTeamWorkflowStates(ctx, *r.client, team.Id)
 
 	if workflowStatesErr != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get team workflow states, got error: %s", workflowStatesErr))
@@ -1002,7 +1007,7 @@ func (r *TeamResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 
 	_, err := deleteTeam(ctx, *r.client, data.Key.ValueString())
 
-	if err != nil {
+	if err != nil && !isNotFound(err) {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete team, got error: %s", err))
 		return
 	}
@@ -1109,7 +1114,22 @@ func update(ctx context.Context, client *graphql.Client, state TeamResourceModel
 
 	tflog.Trace(ctx, "updated a team")
 
-	team := response.TeamUpdate.Team
+	team := response.TeamUpdate.Team.Team
+
+	// The update input leaves out the parent when it is not set, so a removed
+	// parent is cleared separately
+	if data.ParentId.IsNull() && !state.ParentId.IsNull() {
+		response, err := removeTeamParent(ctx, *client, data.Key.ValueString())
+
+		if err != nil {
+			diags = diag.Diagnostics{}
+			diags.AddError("Client Error", fmt.Sprintf("Unable to update team, got error: %s", err))
+
+			return diags
+		}
+
+		team = response.TeamUpdate.Team.Team
+	}
 
 	data.Id = types.StringValue(team.Id)
 	data.Private = types.BoolValue(team.Private)
@@ -172,15 +172,18 @@ func (r *TeamLabelResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	response, err := getLabel(ctx, *r.client, data.Id.ValueString())
+	issueLabel, err := readTeamLabel(ctx, *r.client, data.TeamId.ValueString(), data.Id.ValueString())
+
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read team label, got error: %s", err))
 		return
 	}
 
-	issueLabel := response.IssueLabel
-
 	data.Id = types.StringValue(issueLabel.Id)
 	data.Name = types.StringValue(issueLabel.Name)
 	data.Description = types.StringPointerValue(issueLabel.Description)
@@ -255,7 +258,7 @@ func (r *TeamLabelResource) Delete(ctx context.Context, req resource.DeleteReque
 
 	_, err := deleteLabel(ctx, *r.client, data.Id.ValueString())
 
-	if err != nil {
+	if err != nil && !isNotFound(err) {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete team label, got error: %s", err))
 		return
 	}
@@ -289,3 +292,30 @@ func (r *TeamLabelResource) ImportState(ctx context.Context, req resource.Import
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), response.IssueLabels.Nodes[0].Id)...)
 }
+
+// readTeamLabel looks the label up among the labels of its team when the read
+// cache shares them between all the labels of the team, and otherwise reads
+// the label on its own.
+func readTeamLabel(ctx context.Context, client graphql.Client, teamId string, id string) (*IssueLabel, error) {
+	if teamId != "" && isCaching(client) {
+		response, err := getTeamLabels(ctx, client, teamId)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, node := range response.IssueLabels.Nodes {
+			if node.Id == id {
+				return &node.IssueLabel, nil
+			}
+		}
+	}
+
+	response, err := getLabel(ctx, client, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.IssueLabel.IssueLabel, nil
+}
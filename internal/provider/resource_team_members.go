@@ -0,0 +1,318 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &TeamMembersResource{}
+var _ resource.ResourceWithImportState = &TeamMembersResource{}
+
+func NewTeamMembersResource() resource.Resource {
+	return &TeamMembersResource{}
+}
+
+type TeamMembersResource struct {
+	client *graphql.Client
+}
+
+type TeamMembersResourceModel struct {
+	Id      types.String `tfsdk:"id"`
+	TeamId  types.String `tfsdk:"team_id"`
+	UserIds types.Set    `tfsdk:"user_ids"`
+}
+
+func (r *TeamMembersResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_members"
+}
+
+func (r *TeamMembersResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Linear team members. This resource is authoritative, so anyone not listed is removed from the team. It can not be used together with `linear_team_membership` for the same team. On destroy, only the listed users are removed from the team.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the team.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the team.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"user_ids": schema.SetAttribute{
+				MarkdownDescription: "Identifiers of the users that are members of the team.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.RegexMatches(uuidRegex(), "must be an uuid")),
+				},
+			},
+		},
+	}
+}
+
+func (r *TeamMembersResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TeamMembersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *TeamMembersResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var userIds []string
+
+	resp.Diagnostics.Append(data.UserIds.ElementsAs(ctx, &userIds, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := syncTeamMembers(ctx, *r.client, data.TeamId.ValueString(), userIds)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create team members, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created team members")
+
+	data.Id = data.TeamId
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamMembersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *TeamMembersResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamId, memberships, err := listTeamMemberships(ctx, *r.client, data.Id.ValueString())
+
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read team members, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "read team members")
+
+	resp.Diagnostics.Append(readTeamMembers(ctx, data, teamId, memberships)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamMembersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *TeamMembersResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var userIds []string
+
+	resp.Diagnostics.Append(data.UserIds.ElementsAs(ctx, &userIds, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := syncTeamMembers(ctx, *r.client, data.TeamId.ValueString(), userIds)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update team members, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated team members")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamMembersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *TeamMembersResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var userIds []string
+
+	resp.Diagnostics.Append(data.UserIds.ElementsAs(ctx, &userIds, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Members added outside of Terraform are left in the team
+	err := removeTeamMembers(ctx, *r.client, data.TeamId.ValueString(), userIds)
+
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete team members, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted team members")
+}
+
+func (r *TeamMembersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	response, err := getTeamMemberships(ctx, *r.client, req.ID, "")
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import team members, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), response.Team.Id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), response.Team.Id)...)
+}
+
+// syncTeamMembers adds the users missing from the team and removes the
+// members that are not listed. Users are added before members are removed, so
+// that the team is never left without the members it keeps.
+func syncTeamMembers(ctx context.Context, client graphql.Client, teamId string, userIds []string) error {
+	_, memberships, err := listTeamMemberships(ctx, client, teamId)
+
+	if err != nil {
+		return err
+	}
+
+	wanted := map[string]bool{}
+	existing := map[string]bool{}
+
+	for _, userId := range userIds {
+		wanted[userId] = true
+	}
+
+	for _, membership := range memberships {
+		existing[membership.User.Id] = true
+	}
+
+	for _, userId := range userIds {
+		if existing[userId] {
+			continue
+		}
+
+		_, err := createTeamMembership(ctx, client, TeamMembershipCreateInput{
+			TeamId: teamId,
+			UserId: userId,
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, membership := range memberships {
+		if wanted[membership.User.Id] {
+			continue
+		}
+
+		_, err := deleteTeamMembership(ctx, client, membership.Id)
+
+		if err != nil && !isNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// removeTeamMembers removes the listed users from the team, leaving any other
+// members in place.
+func removeTeamMembers(ctx context.Context, client graphql.Client, teamId string, userIds []string) error {
+	_, memberships, err := listTeamMemberships(ctx, client, teamId)
+
+	if err != nil {
+		return err
+	}
+
+	listed := map[string]bool{}
+
+	for _, userId := range userIds {
+		listed[userId] = true
+	}
+
+	for _, membership := range memberships {
+		if !listed[membership.User.Id] {
+			continue
+		}
+
+		_, err := deleteTeamMembership(ctx, client, membership.Id)
+
+		if err != nil && !isNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func readTeamMembers(ctx context.Context, data *TeamMembersResourceModel, teamId string, memberships []TeamMembership) diag.Diagnostics {
+	userIds := make([]string, 0, len(memberships))
+
+	for _, membership := range memberships {
+		userIds = append(userIds, membership.User.Id)
+	}
+
+	data.Id = types.StringValue(teamId)
+	data.TeamId = types.StringValue(teamId)
+
+	var diags diag.Diagnostics
+
+	data.UserIds, diags = types.SetValueFrom(ctx, types.StringType, userIds)
+
+	return diags
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTeamMembersResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTeamMembersResourceConfig(`"6f5ae5a5-1b4c-4f2a-9c77-2c0b8a3d9e66"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("linear_team_members.test", "id", "ff0a060a-eceb-4b34-9140-fd7231f0cd28"),
+					resource.TestCheckResourceAttr("linear_team_members.test", "team_id", "ff0a060a-eceb-4b34-9140-fd7231f0cd28"),
+					resource.TestCheckResourceAttr("linear_team_members.test", "user_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr("linear_team_members.test", "user_ids.*", "6f5ae5a5-1b4c-4f2a-9c77-2c0b8a3d9e66"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_team_members.test",
+				ImportState:       true,
+				ImportStateId:     "DEF",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccTeamMembersResourceConfig(`"3b7c9e2d-4a1f-4e6b-8d5c-9f0a1b2c3d77"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("linear_team_members.test", "id", "ff0a060a-eceb-4b34-9140-fd7231f0cd28"),
+					resource.TestCheckResourceAttr("linear_team_members.test", "user_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr("linear_team_members.test", "user_ids.*", "3b7c9e2d-4a1f-4e6b-8d5c-9f0a1b2c3d77"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccTeamMembersResourceConfig(`"6f5ae5a5-1b4c-4f2a-9c77-2c0b8a3d9e66", "3b7c9e2d-4a1f-4e6b-8d5c-9f0a1b2c3d77"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("linear_team_members.test", "user_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttr("linear_team_members.test", "user_ids.*", "6f5ae5a5-1b4c-4f2a-9c77-2c0b8a3d9e66"),
+					resource.TestCheckTypeSetElemAttr("linear_team_members.test", "user_ids.*", "3b7c9e2d-4a1f-4e6b-8d5c-9f0a1b2c3d77"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccTeamMembersResourceConfig(userIds string) string {
+	return fmt.Sprintf(`
+resource "linear_team_members" "test" {
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+  user_ids = [%s]
+}
+`, userIds)
+}
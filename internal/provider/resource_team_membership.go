@@ -0,0 +1,290 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &TeamMembershipResource{}
+var _ resource.ResourceWithImportState = &TeamMembershipResource{}
+
+func NewTeamMembershipResource() resource.Resource {
+	return &TeamMembershipResource{}
+}
+
+type TeamMembershipResource struct {
+	client *graphql.Client
+}
+
+type TeamMembershipResourceModel struct {
+	Id        types.String  `tfsdk:"id"`
+	TeamId    types.String  `tfsdk:"team_id"`
+	UserId    types.String  `tfsdk:"user_id"`
+	Owner     types.Bool    `tfsdk:"owner"`
+	SortOrder types.Float64 `tfsdk:"sort_order"`
+}
+
+func (r *TeamMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_membership"
+}
+
+func (r *TeamMembershipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Linear team membership.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the membership.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the team.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the user.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"owner": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user is an owner of the team. **Default** `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"sort_order": schema.Float64Attribute{
+				MarkdownDescription: "Sort order of the team in the sidebar of the user.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *TeamMembershipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TeamMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *TeamMembershipResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := TeamMembershipCreateInput{
+		TeamId: data.TeamId.ValueString(),
+		UserId: data.UserId.ValueString(),
+		Owner:  data.Owner.ValueBool(),
+	}
+
+	if !data.SortOrder.IsUnknown() {
+		value := data.SortOrder.ValueFloat64()
+		input.SortOrder = &value
+	}
+
+	response, err := createTeamMembership(ctx, *r.client, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create team membership, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a team membership")
+
+	readTeamMembership(data, response.TeamMembershipCreate.TeamMembership.TeamMembership)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *TeamMembershipResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := getTeamMembership(ctx, *r.client, data.Id.ValueString())
+
+	if isNotFound(err) || (err == nil && response.TeamMembership.ArchivedAt != nil) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read team membership, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "read a team membership")
+
+	readTeamMembership(data, response.TeamMembership.TeamMembership)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *TeamMembershipResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := TeamMembershipUpdateInput{
+		Owner: data.Owner.ValueBool(),
+	}
+
+	if !data.SortOrder.IsUnknown() {
+		value := data.SortOrder.ValueFloat64()
+		input.SortOrder = &value
+	}
+
+	response, err := updateTeamMembership(ctx, *r.client, input, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update team membership, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated a team membership")
+
+	readTeamMembership(data, response.TeamMembershipUpdate.TeamMembership.TeamMembership)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *TeamMembershipResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := deleteTeamMembership(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete team membership, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a team membership")
+}
+
+func (r *TeamMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ":")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: team_key:user_email. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	_, memberships, err := listTeamMemberships(ctx, *r.client, parts[0])
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import team membership, got error: %s", err))
+		return
+	}
+
+	for _, membership := range memberships {
+		if strings.EqualFold(membership.User.Email, parts[1]) {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), membership.Id)...)
+			return
+		}
+	}
+
+	resp.Diagnostics.AddError("Client Error", "Unable to import team membership, got error: membership not found")
+}
+
+func readTeamMembership(data *TeamMembershipResourceModel, membership TeamMembership) {
+	data.Id = types.StringValue(membership.Id)
+	data.TeamId = types.StringValue(membership.Team.Id)
+	data.UserId = types.StringValue(membership.User.Id)
+	data.Owner = types.BoolValue(membership.Owner)
+	data.SortOrder = types.Float64Value(membership.SortOrder)
+}
+
+// listTeamMemberships pages through the memberships of a team, returning the
+// identifier of the team along with them.
+func listTeamMemberships(ctx context.Context, client graphql.Client, key string) (string, []TeamMembership, error) {
+	var memberships []TeamMembership
+
+	after := ""
+
+	for {
+		response, err := getTeamMemberships(ctx, client, key, after)
+
+		if err != nil {
+			return "", nil, err
+		}
+
+		for _, node := range response.Team.Memberships.Nodes {
+			memberships = append(memberships, node.TeamMembership)
+		}
+
+		pageInfo := response.Team.Memberships.PageInfo
+
+		if !pageInfo.HasNextPage {
+			return response.Team.Id, memberships, nil
+		}
+
+		after = pageInfo.EndCursor
+	}
+}
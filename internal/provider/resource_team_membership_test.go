@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTeamMembershipResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTeamMembershipResourceConfigDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_team_membership.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_team_membership.test", "team_id", "ff0a060a-eceb-4b34-9140-fd7231f0cd28"),
+					resource.TestCheckResourceAttr("linear_team_membership.test", "user_id", "3b7c9e2d-4a1f-4e6b-8d5c-9f0a1b2c3d77"),
+					resource.TestCheckResourceAttr("linear_team_membership.test", "owner", "false"),
+					resource.TestCheckResourceAttrSet("linear_team_membership.test", "sort_order"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_team_membership.test",
+				ImportState:       true,
+				ImportStateId:     "DEF:reviewer@example.com",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccTeamMembershipResourceConfigNonDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_team_membership.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_team_membership.test", "team_id", "ff0a060a-eceb-4b34-9140-fd7231f0cd28"),
+					resource.TestCheckResourceAttr("linear_team_membership.test", "user_id", "3b7c9e2d-4a1f-4e6b-8d5c-9f0a1b2c3d77"),
+					resource.TestCheckResourceAttr("linear_team_membership.test", "owner", "true"),
+					resource.TestCheckResourceAttr("linear_team_membership.test", "sort_order", "2.5"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_team_membership.test",
+				ImportState:       true,
+				ImportStateId:     "DEF:reviewer@example.com",
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccTeamMembershipResourceConfigDefault() string {
+	return `
+resource "linear_team_membership" "test" {
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+  user_id = "3b7c9e2d-4a1f-4e6b-8d5c-9f0a1b2c3d77"
+}
+`
+}
+
+func testAccTeamMembershipResourceConfigNonDefault() string {
+	return `
+resource "linear_team_membership" "test" {
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+  user_id = "3b7c9e2d-4a1f-4e6b-8d5c-9f0a1b2c3d77"
+  owner = true
+  sort_order = 2.5
+}
+`
+}
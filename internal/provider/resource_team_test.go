@@ -421,6 +421,40 @@ func TestAccTeamResourceNonDefault(t *testing.T) {
 	})
 }
 
+func TestAccTeamResourceParent(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with a parent
+			{
+				Config: testAccTeamResourceConfigParent("PAR", "Parented", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("linear_team.test", "key", "PAR"),
+					resource.TestCheckResourceAttr("linear_team.test", "parent_id", "ff0a060a-eceb-4b34-9140-fd7231f0cd28"),
+				),
+			},
+			// Update keeping the parent
+			{
+				Config: testAccTeamResourceConfigParent("PAR", "Still Parented", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("linear_team.test", "name", "Still Parented"),
+					resource.TestCheckResourceAttr("linear_team.test", "parent_id", "ff0a060a-eceb-4b34-9140-fd7231f0cd28"),
+				),
+			},
+			// Remove the parent
+			{
+				Config: testAccTeamResourceConfigParent("PAR", "Orphaned", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("linear_team.test", "name", "Orphaned"),
+					resource.TestCheckNoResourceAttr("linear_team.test", "parent_id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
 func testAccTeamResourceConfigDefault(key string, name string) string {
 	return fmt.Sprintf(`
 resource "linear_team" "test" {
@@ -504,3 +538,19 @@ resource "linear_team" "test" {
 }
 `, key, name)
 }
+
+func testAccTeamResourceConfigParent(key string, name string, parent bool) string {
+	parentId := ""
+
+	if parent {
+		parentId = `parent_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"`
+	}
+
+	return fmt.Sprintf(`
+resource "linear_team" "test" {
+  key = "%s"
+  name = "%s"
+  %s
+}
+`, key, name, parentId)
+}
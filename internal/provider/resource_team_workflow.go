@@ -209,6 +209,11 @@ func (r *TeamWorkflowResource) Read(ctx context.Context, req resource.ReadReques
 
 	response, err := getTeamWorkflow(ctx, *r.client, data.Key.ValueString())
 
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read team workflow, got error: %s", err))
 		return
@@ -288,7 +293,7 @@ func (r *TeamWorkflowResource) Delete(ctx context.Context, req resource.DeleteRe
 
 	err := updateTeamWorkflow(ctx, r.client, &data, nil, branchState)
 
-	if err != nil {
+	if err != nil && !isNotFound(err) {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete team workflow, got error: %s", err))
 		return
 	}
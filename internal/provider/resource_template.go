@@ -165,6 +165,11 @@ func (r *TemplateResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 	response, err := getTemplate(ctx, *r.client, data.Id.ValueString())
 
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read template, got error: %s", err))
 		return
@@ -240,7 +245,7 @@ func (r *TemplateResource) Delete(ctx context.Context, req resource.DeleteReques
 
 	_, err := templateDelete(ctx, *r.client, data.Id.ValueString())
 
-	if err != nil {
+	if err != nil && !isNotFound(err) {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete template, got error: %s", err))
 		return
 	}
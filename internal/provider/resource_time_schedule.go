@@ -0,0 +1,357 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &TimeScheduleResource{}
+var _ resource.ResourceWithImportState = &TimeScheduleResource{}
+var _ resource.ResourceWithValidateConfig = &TimeScheduleResource{}
+
+func NewTimeScheduleResource() resource.Resource {
+	return &TimeScheduleResource{}
+}
+
+type TimeScheduleResource struct {
+	client *graphql.Client
+}
+
+type TimeScheduleResourceModel struct {
+	Id      types.String `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	Entries types.List   `tfsdk:"entries"`
+}
+
+type TimeScheduleResourceEntryModel struct {
+	UserId   types.String `tfsdk:"user_id"`
+	StartsAt types.String `tfsdk:"starts_at"`
+	EndsAt   types.String `tfsdk:"ends_at"`
+}
+
+var timeScheduleEntryAttrTypes = map[string]attr.Type{
+	"user_id":   types.StringType,
+	"starts_at": types.StringType,
+	"ends_at":   types.StringType,
+}
+
+func (r *TimeScheduleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_time_schedule"
+}
+
+func (r *TimeScheduleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Linear time schedule, which can be followed by a triage responsibility.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the time schedule.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the time schedule.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"entries": schema.ListNestedAttribute{
+				MarkdownDescription: "Entries of the time schedule. The entries are in chronological order and can not overlap.",
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"user_id": schema.StringAttribute{
+							MarkdownDescription: "Identifier of the user on schedule.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+							},
+						},
+						"starts_at": schema.StringAttribute{
+							MarkdownDescription: "Start time of the entry, in RFC 3339 format.",
+							Required:            true,
+						},
+						"ends_at": schema.StringAttribute{
+							MarkdownDescription: "End time of the entry, in RFC 3339 format.",
+							Required:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *TimeScheduleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *TimeScheduleResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Entries.IsUnknown() {
+		return
+	}
+
+	var entries []TimeScheduleResourceEntryModel
+
+	resp.Diagnostics.Append(data.Entries.ElementsAs(ctx, &entries, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var previousEndsAt *time.Time
+
+	for i, entry := range entries {
+		entryPath := path.Root("entries").AtListIndex(i)
+
+		if entry.StartsAt.IsUnknown() || entry.EndsAt.IsUnknown() {
+			previousEndsAt = nil
+			continue
+		}
+
+		startsAt, startsErr := time.Parse(time.RFC3339, entry.StartsAt.ValueString())
+		endsAt, endsErr := time.Parse(time.RFC3339, entry.EndsAt.ValueString())
+
+		if startsErr != nil {
+			resp.Diagnostics.AddAttributeError(entryPath.AtName("starts_at"), "Invalid Time", fmt.Sprintf("Expected a time in RFC 3339 format. Got: %q", entry.StartsAt.ValueString()))
+		}
+
+		if endsErr != nil {
+			resp.Diagnostics.AddAttributeError(entryPath.AtName("ends_at"), "Invalid Time", fmt.Sprintf("Expected a time in RFC 3339 format. Got: %q", entry.EndsAt.ValueString()))
+		}
+
+		if startsErr != nil || endsErr != nil {
+			previousEndsAt = nil
+			continue
+		}
+
+		if !endsAt.After(startsAt) {
+			resp.Diagnostics.AddAttributeError(entryPath.AtName("ends_at"), "Invalid Time", "The entry must end after it starts.")
+		}
+
+		if previousEndsAt != nil && startsAt.Before(*previousEndsAt) {
+			resp.Diagnostics.AddAttributeError(entryPath.AtName("starts_at"), "Invalid Time", "The entry must start after the previous entry ends.")
+		}
+
+		previousEndsAt = &endsAt
+	}
+}
+
+func (r *TimeScheduleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TimeScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *TimeScheduleResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entries, diags := timeScheduleEntries(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := TimeScheduleCreateInput{
+		Name:    data.Name.ValueString(),
+		Entries: entries,
+	}
+
+	response, err := createTimeSchedule(ctx, *r.client, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create time schedule, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a time schedule")
+
+	resp.Diagnostics.Append(readTimeSchedule(ctx, data, response.TimeScheduleCreate.TimeSchedule.TimeSchedule)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TimeScheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *TimeScheduleResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := getTimeSchedule(ctx, *r.client, data.Id.ValueString())
+
+	if isNotFound(err) || (err == nil && response.TimeSchedule.ArchivedAt != nil) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read time schedule, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "read a time schedule")
+
+	resp.Diagnostics.Append(readTimeSchedule(ctx, data, response.TimeSchedule.TimeSchedule)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TimeScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *TimeScheduleResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entries, diags := timeScheduleEntries(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := TimeScheduleUpdateInput{
+		Name:    data.Name.ValueString(),
+		Entries: entries,
+	}
+
+	response, err := updateTimeSchedule(ctx, *r.client, input, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update time schedule, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated a time schedule")
+
+	resp.Diagnostics.Append(readTimeSchedule(ctx, data, response.TimeScheduleUpdate.TimeSchedule.TimeSchedule)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TimeScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *TimeScheduleResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := deleteTimeSchedule(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete time schedule, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a time schedule")
+}
+
+func (r *TimeScheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func timeScheduleEntries(ctx context.Context, data *TimeScheduleResourceModel) ([]TimeScheduleEntryInput, diag.Diagnostics) {
+	var entries []TimeScheduleResourceEntryModel
+
+	diags := data.Entries.ElementsAs(ctx, &entries, false)
+	input := make([]TimeScheduleEntryInput, 0, len(entries))
+
+	for _, entry := range entries {
+		// The times were validated along with the configuration
+		startsAt, _ := time.Parse(time.RFC3339, entry.StartsAt.ValueString())
+		endsAt, _ := time.Parse(time.RFC3339, entry.EndsAt.ValueString())
+
+		input = append(input, TimeScheduleEntryInput{
+			UserId:   entry.UserId.ValueString(),
+			StartsAt: startsAt,
+			EndsAt:   endsAt,
+		})
+	}
+
+	return input, diags
+}
+
+func readTimeSchedule(ctx context.Context, data *TimeScheduleResourceModel, timeSchedule TimeSchedule) diag.Diagnostics {
+	var current []TimeScheduleResourceEntryModel
+	var diags diag.Diagnostics
+
+	// Nothing is known about the entries when importing
+	if !data.Entries.IsNull() && !data.Entries.IsUnknown() {
+		diags = data.Entries.ElementsAs(ctx, &current, false)
+	}
+
+	entries := make([]TimeScheduleResourceEntryModel, 0, len(timeSchedule.Entries))
+
+	for i, entry := range timeSchedule.Entries {
+		model := TimeScheduleResourceEntryModel{
+			UserId:   types.StringPointerValue(entry.UserId),
+			StartsAt: types.StringNull(),
+			EndsAt:   types.StringNull(),
+		}
+
+		if i < len(current) {
+			model.StartsAt = current[i].StartsAt
+			model.EndsAt = current[i].EndsAt
+		}
+
+		model.StartsAt = readTime(model.StartsAt, entry.StartsAt)
+		model.EndsAt = readTime(model.EndsAt, entry.EndsAt)
+
+		entries = append(entries, model)
+	}
+
+	data.Id = types.StringValue(timeSchedule.Id)
+	data.Name = types.StringValue(timeSchedule.Name)
+
+	var listDiags diag.Diagnostics
+
+	data.Entries, listDiags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: timeScheduleEntryAttrTypes}, entries)
+	diags.Append(listDiags...)
+
+	return diags
+}
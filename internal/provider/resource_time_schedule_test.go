@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTimeScheduleResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTimeScheduleResourceConfigDefault("On call"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_time_schedule.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_time_schedule.test", "name", "On call"),
+					resource.TestCheckResourceAttr("linear_time_schedule.test", "entries.#", "1"),
+					resource.TestCheckResourceAttr("linear_time_schedule.test", "entries.0.user_id", "6f5ae5a5-1b4c-4f2a-9c77-2c0b8a3d9e66"),
+					resource.TestCheckResourceAttr("linear_time_schedule.test", "entries.0.starts_at", "2024-03-04T00:00:00Z"),
+					resource.TestCheckResourceAttr("linear_time_schedule.test", "entries.0.ends_at", "2024-03-11T00:00:00Z"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_time_schedule.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccTimeScheduleResourceConfigNonDefault("Triage rotation"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_time_schedule.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_time_schedule.test", "name", "Triage rotation"),
+					resource.TestCheckResourceAttr("linear_time_schedule.test", "entries.#", "2"),
+					resource.TestCheckResourceAttr("linear_time_schedule.test", "entries.0.user_id", "6f5ae5a5-1b4c-4f2a-9c77-2c0b8a3d9e66"),
+					resource.TestCheckResourceAttr("linear_time_schedule.test", "entries.0.starts_at", "2024-03-04T09:00:00+01:00"),
+					resource.TestCheckResourceAttr("linear_time_schedule.test", "entries.0.ends_at", "2024-03-11T09:00:00+01:00"),
+					resource.TestCheckResourceAttr("linear_time_schedule.test", "entries.1.user_id", "3b7c9e2d-4a1f-4e6b-8d5c-9f0a1b2c3d77"),
+					resource.TestCheckResourceAttr("linear_time_schedule.test", "entries.1.starts_at", "2024-03-11T09:00:00+01:00"),
+					resource.TestCheckResourceAttr("linear_time_schedule.test", "entries.1.ends_at", "2024-03-18T09:00:00+01:00"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "linear_time_schedule.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"entries"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccTimeScheduleResourceInvalidEntries(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTimeScheduleResourceConfigEntries(
+					"2024-03-11T00:00:00Z", "2024-03-04T00:00:00Z",
+					"2024-03-11T00:00:00Z", "2024-03-18T00:00:00Z",
+				),
+				ExpectError: regexp.MustCompile(`The entry must end after it starts`),
+			},
+			{
+				Config: testAccTimeScheduleResourceConfigEntries(
+					"2024-03-04T00:00:00Z", "2024-03-11T00:00:00Z",
+					"2024-03-10T00:00:00Z", "2024-03-18T00:00:00Z",
+				),
+				ExpectError: regexp.MustCompile(`The entry must start after the previous entry ends`),
+			},
+			{
+				Config: testAccTimeScheduleResourceConfigEntries(
+					"2024-03-04", "2024-03-11T00:00:00Z",
+					"2024-03-11T00:00:00Z", "2024-03-18T00:00:00Z",
+				),
+				ExpectError: regexp.MustCompile(`Expected a time in RFC 3339 format`),
+			},
+		},
+	})
+}
+
+func testAccTimeScheduleResourceConfigDefault(name string) string {
+	return fmt.Sprintf(`
+resource "linear_time_schedule" "test" {
+  name = "%s"
+  entries = [
+    {
+      user_id = "6f5ae5a5-1b4c-4f2a-9c77-2c0b8a3d9e66"
+      starts_at = "2024-03-04T00:00:00Z"
+      ends_at = "2024-03-11T00:00:00Z"
+    },
+  ]
+}
+`, name)
+}
+
+func testAccTimeScheduleResourceConfigNonDefault(name string) string {
+	return fmt.Sprintf(`
+resource "linear_time_schedule" "test" {
+  name = "%s"
+  entries = [
+    {
+      user_id = "6f5ae5a5-1b4c-4f2a-9c77-2c0b8a3d9e66"
+      starts_at = "2024-03-04T09:00:00+01:00"
+      ends_at = "2024-03-11T09:00:00+01:00"
+    },
+    {
+      user_id = "3b7c9e2d-4a1f-4e6b-8d5c-9f0a1b2c3d77"
+      starts_at = "2024-03-11T09:00:00+01:00"
+      ends_at = "2024-03-18T09:00:00+01:00"
+    },
+  ]
+}
+`, name)
+}
+
+func testAccTimeScheduleResourceConfigEntries(firstStartsAt string, firstEndsAt string, secondStartsAt string, secondEndsAt string) string {
+	return fmt.Sprintf(`
+resource "linear_time_schedule" "test" {
+  name = "Invalid"
+  entries = [
+    {
+      user_id = "6f5ae5a5-1b4c-4f2a-9c77-2c0b8a3d9e66"
+      starts_at = "%s"
+      ends_at = "%s"
+    },
+    {
+      user_id = "3b7c9e2d-4a1f-4e6b-8d5c-9f0a1b2c3d77"
+      starts_at = "%s"
+      ends_at = "%s"
+    },
+  ]
+}
+`, firstStartsAt, firstEndsAt, secondStartsAt, secondEndsAt)
+}
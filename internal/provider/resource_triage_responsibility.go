@@ -0,0 +1,299 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &TriageResponsibilityResource{}
+var _ resource.ResourceWithImportState = &TriageResponsibilityResource{}
+var _ resource.ResourceWithValidateConfig = &TriageResponsibilityResource{}
+
+func NewTriageResponsibilityResource() resource.Resource {
+	return &TriageResponsibilityResource{}
+}
+
+type TriageResponsibilityResource struct {
+	client *graphql.Client
+}
+
+type TriageResponsibilityResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	TeamId         types.String `tfsdk:"team_id"`
+	Action         types.String `tfsdk:"action"`
+	UserIds        types.List   `tfsdk:"user_ids"`
+	TimeScheduleId types.String `tfsdk:"time_schedule_id"`
+}
+
+func (r *TriageResponsibilityResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_triage_responsibility"
+}
+
+func (r *TriageResponsibilityResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Linear team triage responsibility. The responsible users are either listed in `user_ids`, which they take turns in order, or follow the time schedule `time_schedule_id`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the triage responsibility.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the team.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"action": schema.StringAttribute{
+				MarkdownDescription: "Action taken for new triage issues, either `assign` to assign them to the responsible user or `notify` to notify the responsible user.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(TriageResponsibilityActionAssign), string(TriageResponsibilityActionNotify)),
+				},
+			},
+			"user_ids": schema.ListAttribute{
+				MarkdownDescription: "Identifiers of the users responsible for triage.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.RegexMatches(uuidRegex(), "must be an uuid")),
+				},
+			},
+			"time_schedule_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the time schedule of the users responsible for triage.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+		},
+	}
+}
+
+func (r *TriageResponsibilityResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *TriageResponsibilityResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.UserIds.IsUnknown() || data.TimeScheduleId.IsUnknown() {
+		return
+	}
+
+	if data.UserIds.IsNull() == data.TimeScheduleId.IsNull() {
+		resp.Diagnostics.AddError("Invalid Triage Responsibility", "Expected either user_ids or time_schedule_id to be set, but not both.")
+	}
+}
+
+func (r *TriageResponsibilityResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TriageResponsibilityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *TriageResponsibilityResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := TriageResponsibilityCreateInput{
+		TeamId:         data.TeamId.ValueString(),
+		Action:         data.Action.ValueString(),
+		TimeScheduleId: data.TimeScheduleId.ValueStringPointer(),
+	}
+
+	manualSelection, diags := triageResponsibilityManualSelection(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input.ManualSelection = manualSelection
+
+	response, err := createTriageResponsibility(ctx, *r.client, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create triage responsibility, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a triage responsibility")
+
+	resp.Diagnostics.Append(readTriageResponsibility(ctx, data, response.TriageResponsibilityCreate.TriageResponsibility.TriageResponsibility)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TriageResponsibilityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *TriageResponsibilityResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := getTriageResponsibility(ctx, *r.client, data.Id.ValueString())
+
+	if isNotFound(err) || (err == nil && response.TriageResponsibility.ArchivedAt != nil) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read triage responsibility, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "read a triage responsibility")
+
+	resp.Diagnostics.Append(readTriageResponsibility(ctx, data, response.TriageResponsibility.TriageResponsibility)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TriageResponsibilityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *TriageResponsibilityResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := TriageResponsibilityUpdateInput{
+		Action:         data.Action.ValueString(),
+		TimeScheduleId: data.TimeScheduleId.ValueStringPointer(),
+	}
+
+	manualSelection, diags := triageResponsibilityManualSelection(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input.ManualSelection = manualSelection
+
+	response, err := updateTriageResponsibility(ctx, *r.client, input, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update triage responsibility, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated a triage responsibility")
+
+	resp.Diagnostics.Append(readTriageResponsibility(ctx, data, response.TriageResponsibilityUpdate.TriageResponsibility.TriageResponsibility)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TriageResponsibilityResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *TriageResponsibilityResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := deleteTriageResponsibility(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete triage responsibility, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a triage responsibility")
+}
+
+func (r *TriageResponsibilityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	response, err := findTriageResponsibility(ctx, *r.client, req.ID)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import triage responsibility, got error: %s", err))
+		return
+	}
+
+	if response.Team.TriageResponsibility == nil {
+		resp.Diagnostics.AddError("Client Error", "Unable to import triage responsibility, got error: triage responsibility not found")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), response.Team.TriageResponsibility.Id)...)
+}
+
+func triageResponsibilityManualSelection(ctx context.Context, data *TriageResponsibilityResourceModel) (*TriageResponsibilityManualSelectionInput, diag.Diagnostics) {
+	if data.UserIds.IsNull() {
+		return nil, nil
+	}
+
+	manualSelection := TriageResponsibilityManualSelectionInput{}
+	diags := data.UserIds.ElementsAs(ctx, &manualSelection.UserIds, false)
+
+	return &manualSelection, diags
+}
+
+func readTriageResponsibility(ctx context.Context, data *TriageResponsibilityResourceModel, triageResponsibility TriageResponsibility) diag.Diagnostics {
+	data.Id = types.StringValue(triageResponsibility.Id)
+	data.TeamId = types.StringValue(triageResponsibility.Team.Id)
+	data.Action = types.StringValue(string(triageResponsibility.Action))
+
+	if triageResponsibility.TimeSchedule != nil {
+		data.TimeScheduleId = types.StringValue(triageResponsibility.TimeSchedule.Id)
+	} else {
+		data.TimeScheduleId = types.StringNull()
+	}
+
+	if triageResponsibility.ManualSelection == nil {
+		data.UserIds = types.ListNull(types.StringType)
+		return nil
+	}
+
+	var diags diag.Diagnostics
+
+	data.UserIds, diags = types.ListValueFrom(ctx, types.StringType, triageResponsibility.ManualSelection.UserIds)
+
+	return diags
+}
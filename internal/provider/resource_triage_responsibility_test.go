@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTriageResponsibilityResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTriageResponsibilityResourceConfigDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_triage_responsibility.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_triage_responsibility.test", "team_id", "ff0a060a-eceb-4b34-9140-fd7231f0cd28"),
+					resource.TestCheckResourceAttr("linear_triage_responsibility.test", "action", "notify"),
+					resource.TestCheckResourceAttr("linear_triage_responsibility.test", "user_ids.#", "1"),
+					resource.TestCheckResourceAttr("linear_triage_responsibility.test", "user_ids.0", "6f5ae5a5-1b4c-4f2a-9c77-2c0b8a3d9e66"),
+					resource.TestCheckNoResourceAttr("linear_triage_responsibility.test", "time_schedule_id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_triage_responsibility.test",
+				ImportState:       true,
+				ImportStateId:     "DEF",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccTriageResponsibilityResourceConfigNonDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_triage_responsibility.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_triage_responsibility.test", "team_id", "ff0a060a-eceb-4b34-9140-fd7231f0cd28"),
+					resource.TestCheckResourceAttr("linear_triage_responsibility.test", "action", "assign"),
+					resource.TestCheckResourceAttr("linear_triage_responsibility.test", "user_ids.#", "2"),
+					resource.TestCheckResourceAttr("linear_triage_responsibility.test", "user_ids.0", "3b7c9e2d-4a1f-4e6b-8d5c-9f0a1b2c3d77"),
+					resource.TestCheckResourceAttr("linear_triage_responsibility.test", "user_ids.1", "6f5ae5a5-1b4c-4f2a-9c77-2c0b8a3d9e66"),
+					resource.TestCheckNoResourceAttr("linear_triage_responsibility.test", "time_schedule_id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_triage_responsibility.test",
+				ImportState:       true,
+				ImportStateId:     "DEF",
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccTriageResponsibilityResourceTimeSchedule(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTriageResponsibilityResourceConfigTimeSchedule(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_triage_responsibility.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_triage_responsibility.test", "action", "assign"),
+					resource.TestCheckNoResourceAttr("linear_triage_responsibility.test", "user_ids"),
+					resource.TestCheckResourceAttrPair("linear_triage_responsibility.test", "time_schedule_id", "linear_time_schedule.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_triage_responsibility.test",
+				ImportState:       true,
+				ImportStateId:     "DEF",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccTriageResponsibilityResourceConfigNonDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("linear_triage_responsibility.test", "user_ids.#", "2"),
+					resource.TestCheckNoResourceAttr("linear_triage_responsibility.test", "time_schedule_id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccTriageResponsibilityResourceInvalid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "linear_triage_responsibility" "test" {
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+  action = "notify"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Triage Responsibility`),
+			},
+		},
+	})
+}
+
+func testAccTriageResponsibilityResourceConfigDefault() string {
+	return `
+resource "linear_triage_responsibility" "test" {
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+  action = "notify"
+  user_ids = ["6f5ae5a5-1b4c-4f2a-9c77-2c0b8a3d9e66"]
+}
+`
+}
+
+func testAccTriageResponsibilityResourceConfigNonDefault() string {
+	return `
+resource "linear_triage_responsibility" "test" {
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+  action = "assign"
+  user_ids = ["3b7c9e2d-4a1f-4e6b-8d5c-9f0a1b2c3d77", "6f5ae5a5-1b4c-4f2a-9c77-2c0b8a3d9e66"]
+}
+`
+}
+
+func testAccTriageResponsibilityResourceConfigTimeSchedule() string {
+	return `
+resource "linear_time_schedule" "test" {
+  name = "Triage rotation"
+  entries = [
+    {
+      user_id = "6f5ae5a5-1b4c-4f2a-9c77-2c0b8a3d9e66"
+      starts_at = "2024-03-04T00:00:00Z"
+      ends_at = "2024-03-11T00:00:00Z"
+    },
+  ]
+}
+
+resource "linear_triage_responsibility" "test" {
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+  action = "assign"
+  time_schedule_id = linear_time_schedule.test.id
+}
+`
+}
@@ -0,0 +1,322 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &WebhookResource{}
+var _ resource.ResourceWithImportState = &WebhookResource{}
+var _ resource.ResourceWithValidateConfig = &WebhookResource{}
+
+func NewWebhookResource() resource.Resource {
+	return &WebhookResource{}
+}
+
+type WebhookResource struct {
+	client *graphql.Client
+}
+
+type WebhookResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	Url            types.String `tfsdk:"url"`
+	Label          types.String `tfsdk:"label"`
+	Enabled        types.Bool   `tfsdk:"enabled"`
+	ResourceTypes  types.Set    `tfsdk:"resource_types"`
+	TeamId         types.String `tfsdk:"team_id"`
+	AllPublicTeams types.Bool   `tfsdk:"all_public_teams"`
+	Secret         types.String `tfsdk:"secret"`
+}
+
+var webhookResourceTypes = []string{
+	"AuditEntry",
+	"Comment",
+	"Customer",
+	"CustomerNeed",
+	"Cycle",
+	"Document",
+	"Initiative",
+	"InitiativeUpdate",
+	"Issue",
+	"IssueAttachment",
+	"IssueLabel",
+	"IssueSLA",
+	"OAuthApp",
+	"Project",
+	"ProjectUpdate",
+	"Reaction",
+	"User",
+}
+
+func (r *WebhookResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook"
+}
+
+func (r *WebhookResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Linear webhook. The webhook receives the events of either a single team or all the public teams.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the webhook.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "URL the events are sent to.",
+				Required:            true,
+			},
+			"label": schema.StringAttribute{
+				MarkdownDescription: "Label of the webhook.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the webhook is enabled. **Default** `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"resource_types": schema.SetAttribute{
+				MarkdownDescription: "Types of the resources the webhook receives events for.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(webhookResourceTypes...)),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the team the webhook receives events for.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"all_public_teams": schema.BoolAttribute{
+				MarkdownDescription: "Whether the webhook receives events for all the public teams. **Default** `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"secret": schema.StringAttribute{
+				MarkdownDescription: "Secret used to sign the events. The secret is never returned by Linear, so changes made outside of Terraform are not detected.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *WebhookResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *WebhookResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Url.IsUnknown() && !isHttpUrl(data.Url.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("url"), "Invalid URL", fmt.Sprintf("Expected an absolute http(s) URL. Got: %q", data.Url.ValueString()))
+	}
+
+	if data.TeamId.IsUnknown() || data.AllPublicTeams.IsUnknown() {
+		return
+	}
+
+	if data.TeamId.IsNull() == !data.AllPublicTeams.ValueBool() {
+		resp.Diagnostics.AddError("Invalid Webhook Scope", "Expected either team_id to be set or all_public_teams to be true, but not both.")
+	}
+}
+
+func (r *WebhookResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *WebhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *WebhookResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := WebhookCreateInput{
+		Url:            data.Url.ValueString(),
+		Label:          data.Label.ValueStringPointer(),
+		Enabled:        data.Enabled.ValueBool(),
+		TeamId:         data.TeamId.ValueStringPointer(),
+		AllPublicTeams: data.AllPublicTeams.ValueBool(),
+		Secret:         data.Secret.ValueStringPointer(),
+	}
+
+	resp.Diagnostics.Append(data.ResourceTypes.ElementsAs(ctx, &input.ResourceTypes, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := createWebhook(ctx, *r.client, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create webhook, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a webhook")
+
+	resp.Diagnostics.Append(readWebhook(ctx, data, response.WebhookCreate.Webhook.Webhook)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WebhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *WebhookResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := getWebhook(ctx, *r.client, data.Id.ValueString())
+
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read webhook, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "read a webhook")
+
+	resp.Diagnostics.Append(readWebhook(ctx, data, response.Webhook.Webhook)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WebhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *WebhookResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := WebhookUpdateInput{
+		Url:     data.Url.ValueString(),
+		Label:   data.Label.ValueStringPointer(),
+		Enabled: data.Enabled.ValueBool(),
+		Secret:  data.Secret.ValueStringPointer(),
+	}
+
+	resp.Diagnostics.Append(data.ResourceTypes.ElementsAs(ctx, &input.ResourceTypes, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := updateWebhook(ctx, *r.client, input, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update webhook, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated a webhook")
+
+	resp.Diagnostics.Append(readWebhook(ctx, data, response.WebhookUpdate.Webhook.Webhook)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WebhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *WebhookResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := deleteWebhook(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete webhook, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a webhook")
+}
+
+func (r *WebhookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// readWebhook leaves the secret untouched, since it is write only and the
+// value known to Terraform is the one last sent to Linear.
+func readWebhook(ctx context.Context, data *WebhookResourceModel, webhook Webhook) diag.Diagnostics {
+	data.Id = types.StringValue(webhook.Id)
+	data.Url = types.StringValue(webhook.Url)
+	data.Label = types.StringPointerValue(webhook.Label)
+	data.Enabled = types.BoolValue(webhook.Enabled)
+	data.AllPublicTeams = types.BoolValue(webhook.AllPublicTeams)
+
+	if webhook.Team != nil {
+		data.TeamId = types.StringValue(webhook.Team.Id)
+	} else {
+		data.TeamId = types.StringNull()
+	}
+
+	var diags diag.Diagnostics
+
+	data.ResourceTypes, diags = types.SetValueFrom(ctx, types.StringType, webhook.ResourceTypes)
+
+	return diags
+}
@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccWebhookResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccWebhookResourceConfigDefault("https://example.com/linear"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_webhook.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_webhook.test", "url", "https://example.com/linear"),
+					resource.TestCheckNoResourceAttr("linear_webhook.test", "label"),
+					resource.TestCheckResourceAttr("linear_webhook.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("linear_webhook.test", "resource_types.#", "1"),
+					resource.TestCheckTypeSetElemAttr("linear_webhook.test", "resource_types.*", "Issue"),
+					resource.TestCheckResourceAttr("linear_webhook.test", "team_id", "ff0a060a-eceb-4b34-9140-fd7231f0cd28"),
+					resource.TestCheckResourceAttr("linear_webhook.test", "all_public_teams", "false"),
+					resource.TestCheckNoResourceAttr("linear_webhook.test", "secret"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_webhook.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccWebhookResourceConfigNonDefault("https://example.com/linear/events"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_webhook.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_webhook.test", "url", "https://example.com/linear/events"),
+					resource.TestCheckResourceAttr("linear_webhook.test", "label", "Events"),
+					resource.TestCheckResourceAttr("linear_webhook.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("linear_webhook.test", "resource_types.#", "2"),
+					resource.TestCheckTypeSetElemAttr("linear_webhook.test", "resource_types.*", "Issue"),
+					resource.TestCheckTypeSetElemAttr("linear_webhook.test", "resource_types.*", "Comment"),
+					resource.TestCheckResourceAttr("linear_webhook.test", "team_id", "ff0a060a-eceb-4b34-9140-fd7231f0cd28"),
+					resource.TestCheckResourceAttr("linear_webhook.test", "all_public_teams", "false"),
+					resource.TestCheckResourceAttr("linear_webhook.test", "secret", "s3cr3t"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "linear_webhook.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret"},
+			},
+			// Update with null values
+			{
+				Config: testAccWebhookResourceConfigDefault("https://example.com/linear"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_webhook.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_webhook.test", "url", "https://example.com/linear"),
+					resource.TestCheckNoResourceAttr("linear_webhook.test", "label"),
+					resource.TestCheckResourceAttr("linear_webhook.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("linear_webhook.test", "resource_types.#", "1"),
+					resource.TestCheckNoResourceAttr("linear_webhook.test", "secret"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccWebhookResourceAllPublicTeams(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccWebhookResourceConfigAllPublicTeams("https://example.com/linear"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_webhook.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_webhook.test", "url", "https://example.com/linear"),
+					resource.TestCheckNoResourceAttr("linear_webhook.test", "team_id"),
+					resource.TestCheckResourceAttr("linear_webhook.test", "all_public_teams", "true"),
+					resource.TestCheckResourceAttr("linear_webhook.test", "secret", "s3cr3t"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "linear_webhook.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccWebhookResourceInvalid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "linear_webhook" "test" {
+  url = "https://example.com/linear"
+  resource_types = ["Unknown"]
+  all_public_teams = true
+}
+`,
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+			{
+				Config: `
+resource "linear_webhook" "test" {
+  url = "https://example.com/linear"
+  resource_types = ["Issue"]
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Webhook Scope`),
+			},
+			{
+				Config: `
+resource "linear_webhook" "test" {
+  url = "example.com"
+  resource_types = ["Issue"]
+  all_public_teams = true
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid URL`),
+			},
+		},
+	})
+}
+
+func testAccWebhookResourceConfigDefault(url string) string {
+	return fmt.Sprintf(`
+resource "linear_webhook" "test" {
+  url = "%s"
+  resource_types = ["Issue"]
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+}
+`, url)
+}
+
+func testAccWebhookResourceConfigNonDefault(url string) string {
+	return fmt.Sprintf(`
+resource "linear_webhook" "test" {
+  url = "%s"
+  label = "Events"
+  enabled = false
+  resource_types = ["Issue", "Comment"]
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+  secret = "s3cr3t"
+}
+`, url)
+}
+
+func testAccWebhookResourceConfigAllPublicTeams(url string) string {
+	return fmt.Sprintf(`
+resource "linear_webhook" "test" {
+  url = "%s"
+  resource_types = ["Issue"]
+  all_public_teams = true
+  secret = "s3cr3t"
+}
+`, url)
+}
@@ -170,7 +170,12 @@ func (r *WorkflowStateResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
-	response, err := getWorkflowState(ctx, *r.client, data.Id.ValueString())
+	workflowState, err := readWorkflowState(ctx, *r.client, data.TeamId.ValueString(), data.Id.ValueString())
+
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workflow state, got error: %s", err))
@@ -179,8 +184,6 @@ func (r *WorkflowStateResource) Read(ctx context.Context, req resource.ReadReque
 
 	tflog.Trace(ctx, "read a workflow state")
 
-	workflowState := response.WorkflowState
-
 	data.Name = types.StringValue(workflowState.Name)
 	data.Type = types.StringValue(workflowState.Type)
 	data.Position = types.NumberValue(big.NewFloat(workflowState.Position))
@@ -239,7 +242,7 @@ func (r *WorkflowStateResource) Delete(ctx context.Context, req resource.DeleteR
 
 	_, err := deleteWorkflowState(ctx, *r.client, data.Id.ValueString())
 
-	if err != nil {
+	if err != nil && !isNotFound(err) {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete workflow state, got error: %s", err))
 		return
 	}
@@ -268,3 +271,30 @@ func (r *WorkflowStateResource) ImportState(ctx context.Context, req resource.Im
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), response.WorkflowStates.Nodes[0].Id)...)
 }
+
+// readWorkflowState looks the workflow state up among the workflow states of
+// its team when the read cache shares them with the team and its other
+// workflow states, and otherwise reads the state on its own.
+func readWorkflowState(ctx context.Context, client graphql.Client, teamId string, id string) (*WorkflowState, error) {
+	if teamId != "" && isCaching(client) {
+		response, err := getTeamWorkflowStates(ctx, client, teamId)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, node := range response.WorkflowStates.Nodes {
+			if node.Id == id {
+				return &node.WorkflowState, nil
+			}
+		}
+	}
+
+	response, err := getWorkflowState(ctx, client, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.WorkflowState.WorkflowState, nil
+}
@@ -155,15 +155,18 @@ func (r *WorkspaceLabelResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	response, err := getLabel(ctx, *r.client, data.Id.ValueString())
+	issueLabel, err := readWorkspaceLabel(ctx, *r.client, data.Id.ValueString())
+
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workspace label, got error: %s", err))
 		return
 	}
 
-	issueLabel := response.IssueLabel
-
 	data.Id = types.StringValue(issueLabel.Id)
 	data.Name = types.StringValue(issueLabel.Name)
 	data.Description = types.StringPointerValue(issueLabel.Description)
@@ -230,7 +233,7 @@ func (r *WorkspaceLabelResource) Delete(ctx context.Context, req resource.Delete
 
 	_, err := deleteLabel(ctx, *r.client, data.Id.ValueString())
 
-	if err != nil {
+	if err != nil && !isNotFound(err) {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete workspace label, got error: %s", err))
 		return
 	}
@@ -258,3 +261,30 @@ func (r *WorkspaceLabelResource) ImportState(ctx context.Context, req resource.I
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), response.IssueLabels.Nodes[0].Id)...)
 }
+
+// readWorkspaceLabel looks the label up among the workspace labels when the
+// read cache shares them between all the workspace labels, and otherwise reads
+// the label on its own.
+func readWorkspaceLabel(ctx context.Context, client graphql.Client, id string) (*IssueLabel, error) {
+	if isCaching(client) {
+		response, err := getWorkspaceLabels(ctx, client)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, node := range response.IssueLabels.Nodes {
+			if node.Id == id {
+				return &node.IssueLabel, nil
+			}
+		}
+	}
+
+	label, err := getLabel(ctx, client, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &label.IssueLabel.IssueLabel, nil
+}